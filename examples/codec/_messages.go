@@ -0,0 +1,32 @@
+package main
+
+import crunch "github.com/superwhiskers/crunch/v3"
+
+// Kind is the type of a packet
+type Kind uint8
+
+// Point is a position on a map
+type Point struct {
+	X int16 `crunch:"le"`
+	Y int16 `crunch:"le"`
+}
+
+//generator:codec Point
+
+// Packet is a message sent between a client and a server
+type Packet struct {
+	Magic   uint32
+	Version uint8 `crunch:"bits=3"`
+	Urgent  bool  `crunch:"bits=1"`
+	Delta   int8  `crunch:"bits=4"`
+	Kind    Kind
+	Size    uint32 `crunch:"u24,le"`
+	Count   uint16
+	Points  []Point `crunch:"len=Count"`
+	Payload []byte  `crunch:"len=4"`
+	Name    string  `crunch:"prefix=u8"`
+	Offset  int64   `crunch:"varint"`
+	Ratio   float32 `crunch:"le"`
+}
+
+//generator:codec Packet
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+
+	crunch "github.com/superwhiskers/crunch/v3"
+)
+
+// the methods of the types in _messages.go are generated by running
+//
+//	../../generation/generation -generation codec
+//
+// in this directory, which writes them to messages.generated.go
+
+func main() {
+
+	// create a new buffer that grows as the packet is written to it
+	buf := crunch.NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	packet := &Packet{
+		Magic:   0xcafebabe,
+		Version: 5,
+		Urgent:  true,
+		Delta:   -2,
+		Kind:    3,
+		Size:    0x010203,
+		Count:   2,
+		Points:  []Point{{-1, 1}, {2, -2}},
+		Payload: []byte{0xde, 0xad, 0xbe, 0xef},
+		Name:    "crunch",
+		Offset:  -300,
+		Ratio:   1.5,
+	}
+
+	// write the packet to the buffer without any reflection
+	packet.EncodeTo(buf)
+
+	// output the buffer
+	fmt.Println(buf.Bytes())
+
+	// seek to the beginning again
+	buf.SeekByte(0x00, false)
+
+	// read the packet back out to ensure validity
+	var out Packet
+	out.DecodeFrom(buf)
+	fmt.Printf("%+v\n", out)
+
+}
@@ -0,0 +1,99 @@
+package main
+
+import crunch "github.com/superwhiskers/crunch/v3"
+
+// Kind is the type of a packet
+type Kind uint8
+
+// Point is a position on a map
+type Point struct {
+	X int16 `crunch:"le"`
+	Y int16 `crunch:"le"`
+}
+
+// EncodeTo writes the fields of m to the buffer at its current offset
+func (m *Point) EncodeTo(b *crunch.Buffer) {
+	b.PutU16LENext(uint16(m.X))
+	b.PutU16LENext(uint16(m.Y))
+}
+
+// DecodeFrom reads the fields of m from the buffer at its current offset
+func (m *Point) DecodeFrom(b *crunch.Buffer) {
+	m.X = b.ReadI16LEAtNext()
+	m.Y = b.ReadI16LEAtNext()
+}
+
+// Packet is a message sent between a client and a server
+type Packet struct {
+	Magic   uint32
+	Version uint8 `crunch:"bits=3"`
+	Urgent  bool  `crunch:"bits=1"`
+	Delta   int8  `crunch:"bits=4"`
+	Kind    Kind
+	Size    uint32 `crunch:"u24,le"`
+	Count   uint16
+	Points  []Point `crunch:"len=Count"`
+	Payload []byte  `crunch:"len=4"`
+	Name    string  `crunch:"prefix=u8"`
+	Offset  int64   `crunch:"varint"`
+	Ratio   float32 `crunch:"le"`
+}
+
+// EncodeTo writes the fields of m to the buffer at its current offset
+func (m *Packet) EncodeTo(b *crunch.Buffer) {
+	if b.CursorMode() == crunch.SeparateCursors {
+		b.SetCursorMode(crunch.UnifiedCursor)
+		defer b.SetCursorMode(crunch.SeparateCursors)
+	}
+	b.PutU32BENext(m.Magic)
+	b.SetBitsNext(uint64(m.Version), 3)
+	if m.Urgent {
+		b.SetBitsNext(1, 1)
+	} else {
+		b.SetBitsNext(0, 1)
+	}
+	b.SetBitsNext(uint64(m.Delta), 4)
+	b.WriteByteNext(byte(m.Kind))
+	b.PutU24LENext(m.Size)
+	b.PutU16BENext(m.Count)
+	if int64(len(m.Points)) != int64(m.Count) {
+		panic(crunch.MarshalLengthMismatchError)
+	}
+	for i := range m.Points {
+		m.Points[i].EncodeTo(b)
+	}
+	if int64(len(m.Payload)) != 4 {
+		panic(crunch.MarshalLengthMismatchError)
+	}
+	b.WriteBytesNext(m.Payload)
+	b.WritePStringNext(crunch.PrefixU8, m.Name)
+	b.WriteVarintNext(m.Offset)
+	b.PutF32LENext(m.Ratio)
+}
+
+// DecodeFrom reads the fields of m from the buffer at its current offset
+func (m *Packet) DecodeFrom(b *crunch.Buffer) {
+	if b.CursorMode() == crunch.SeparateCursors {
+		b.SetCursorMode(crunch.UnifiedCursor)
+		defer b.SetCursorMode(crunch.SeparateCursors)
+	}
+	m.Magic = b.ReadU32BEAtNext()
+	m.Version = uint8(b.ReadBitsNext(3))
+	m.Urgent = b.ReadBitsNext(1) != 0
+	m.Delta = int8(int64(b.ReadBitsNext(4)<<60) >> 60)
+	m.Kind = Kind(b.ReadByteNext())
+	m.Size = b.ReadU24LEAtNext()
+	m.Count = b.ReadU16BEAtNext()
+	if n := int64(m.Count); n < 0 || n > b.AfterByte()/4 {
+		b.Fail(crunch.MarshalInvalidLengthError)
+		return
+	}
+	m.Points = make([]Point, int64(m.Count))
+	for i := range m.Points {
+		m.Points[i].DecodeFrom(b)
+	}
+	m.Payload = append([]byte(nil), b.ReadBytesNext(4)...)
+	m.Name = b.ReadPStringNext(crunch.PrefixU8)
+	m.Offset = b.ReadVarintNext()
+	m.Ratio = b.ReadF32LEAtNext()
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	crunch "github.com/superwhiskers/crunch/v3"
+)
+
+/*
+
+utilities
+
+*/
+
+var packet = Packet{
+	Magic:   0xcafebabe,
+	Version: 5,
+	Urgent:  true,
+	Delta:   -2,
+	Kind:    3,
+	Size:    0x010203,
+	Count:   2,
+	Points:  []Point{{-1, 1}, {2, -2}},
+	Payload: []byte{0xde, 0xad, 0xbe, 0xef},
+	Name:    "crunch",
+	Offset:  -300,
+	Ratio:   1.5,
+}
+
+/*
+
+tests
+
+*/
+
+func TestPacketRoundTrip(t *testing.T) {
+
+	// a plain buffer keeps its cursors separate
+	buf := crunch.NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	packet.EncodeTo(buf)
+	if out := buf.Bytes(); out[0] != 0xca || out[4] != 0xbe {
+
+		t.Fatalf("bit fields overwrote the byte fields (got %#v)", out[:5])
+
+	}
+
+	if buf.CursorMode() != crunch.SeparateCursors {
+
+		t.Fatalf("expected cursor mode does not match the one gotten (got %d, expected %d)", buf.CursorMode(), crunch.SeparateCursors)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	buf.SeekBit(0x00, false)
+
+	var out Packet
+	out.DecodeFrom(buf)
+	if !reflect.DeepEqual(packet, out) {
+
+		t.Fatalf("expected packet does not match the one gotten (got %+v, expected %+v)", out, packet)
+
+	}
+
+}
+
+func TestPacketHostileCount(t *testing.T) {
+
+	// a count of 0xffff points with only a few bytes after it
+	buf := crunch.NewBuffer([]byte{0xca, 0xfe, 0xba, 0xbe, 0xbe, 0x03, 0x03, 0x02, 0x01, 0xff, 0xff, 0x00})
+	buf.SetSticky(true)
+
+	var out Packet
+	out.DecodeFrom(buf)
+	if !errors.Is(buf.Err(), crunch.MarshalInvalidLengthError) || out.Points != nil {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), crunch.MarshalInvalidLengthError)
+
+	}
+
+}
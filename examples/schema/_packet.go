@@ -0,0 +1,5 @@
+package main
+
+import crunch "github.com/superwhiskers/crunch/v3"
+
+//generator:schema packet.crunch
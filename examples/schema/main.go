@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+
+	crunch "github.com/superwhiskers/crunch/v3"
+)
+
+// the types in packet.crunch are compiled into go by running
+//
+//	../../generation/generation -generation schema
+//
+// in this directory, which writes them to packet.generated.go
+
+func main() {
+
+	// create a new buffer that grows as the packet is written to it
+	buf := crunch.NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	packet := &Packet{
+		Magic: 0xcafebabe,
+		Header: Header{
+			Version: 5,
+			Urgent:  true,
+			Channel: 9,
+			Flags:   0x80,
+		},
+		Count:         2,
+		Points:        []Point{{-1, 1}, {2, -2}},
+		Checksum:      [4]uint8{0xde, 0xad, 0xbe, 0xef},
+		Name:          "crunch",
+		Note:          "urgent",
+		PayloadLength: 3,
+		Payload:       []byte{0x01, 0x02, 0x03},
+		Delta:         -300,
+		Ratio:         1.5,
+	}
+
+	// write the packet to the buffer
+	packet.EncodeTo(buf)
+
+	// output the buffer
+	fmt.Println(buf.Bytes())
+
+	// seek to the beginning again
+	buf.SeekByte(0x00, false)
+
+	// read the packet back out to ensure validity
+	var out Packet
+	out.DecodeFrom(buf)
+	fmt.Printf("%+v\n", out)
+
+}
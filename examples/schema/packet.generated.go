@@ -0,0 +1,141 @@
+package main
+
+import crunch "github.com/superwhiskers/crunch/v3"
+
+// Header is a structure described by packet.crunch
+type Header struct {
+	Version uint8
+	Urgent  bool
+	Channel uint8
+	Flags   uint8
+}
+
+// EncodeTo writes the fields of m to the buffer at its current offset
+func (m *Header) EncodeTo(b *crunch.Buffer) {
+	if b.CursorMode() == crunch.SeparateCursors {
+		b.SetCursorMode(crunch.UnifiedCursor)
+		defer b.SetCursorMode(crunch.SeparateCursors)
+	}
+	b.SetBitsNext(uint64(m.Version), 3)
+	if m.Urgent {
+		b.SetBitsNext(1, 1)
+	} else {
+		b.SetBitsNext(0, 1)
+	}
+	b.SetBitsNext(uint64(m.Channel), 4)
+	b.WriteByteNext(m.Flags)
+}
+
+// DecodeFrom reads the fields of m from the buffer at its current offset
+func (m *Header) DecodeFrom(b *crunch.Buffer) {
+	if b.CursorMode() == crunch.SeparateCursors {
+		b.SetCursorMode(crunch.UnifiedCursor)
+		defer b.SetCursorMode(crunch.SeparateCursors)
+	}
+	m.Version = uint8(b.ReadBitsNext(3))
+	m.Urgent = b.ReadBitsNext(1) != 0
+	m.Channel = uint8(b.ReadBitsNext(4))
+	m.Flags = b.ReadByteNext()
+}
+
+// Point is a structure described by packet.crunch
+type Point struct {
+	X int16
+	Y int16
+}
+
+// EncodeTo writes the fields of m to the buffer at its current offset
+func (m *Point) EncodeTo(b *crunch.Buffer) {
+	b.PutU16LENext(uint16(m.X))
+	b.PutU16LENext(uint16(m.Y))
+}
+
+// DecodeFrom reads the fields of m from the buffer at its current offset
+func (m *Point) DecodeFrom(b *crunch.Buffer) {
+	m.X = b.ReadI16LEAtNext()
+	m.Y = b.ReadI16LEAtNext()
+}
+
+// Packet is a structure described by packet.crunch
+type Packet struct {
+	Magic         uint32
+	Header        Header
+	Count         uint16
+	Points        []Point
+	Checksum      [4]uint8
+	Name          string
+	Note          string
+	PayloadLength uint64
+	Payload       []byte
+	Delta         int64
+	Ratio         float32
+}
+
+// EncodeTo writes the fields of m to the buffer at its current offset
+func (m *Packet) EncodeTo(b *crunch.Buffer) {
+	b.PutU32BENext(m.Magic)
+	{
+		p := b.ReserveNext(crunch.PrefixU8)
+		m.Header.EncodeTo(b)
+		p.Close()
+	}
+	b.PutU16BENext(m.Count)
+	if int64(len(m.Points)) != int64(m.Count) {
+		panic(crunch.MarshalLengthMismatchError)
+	}
+	for i := range m.Points {
+		m.Points[i].EncodeTo(b)
+	}
+	for i := range m.Checksum {
+		b.WriteByteNext(m.Checksum[i])
+	}
+	b.WritePStringNext(crunch.PrefixU8, m.Name)
+	if m.Header.Urgent {
+		b.WriteCStringNext(m.Note)
+	}
+	b.WriteUvarintNext(m.PayloadLength)
+	if int64(len(m.Payload)) != int64(m.PayloadLength) {
+		panic(crunch.MarshalLengthMismatchError)
+	}
+	b.WriteBytesNext(m.Payload)
+	b.WriteVarintNext(m.Delta)
+	b.PutF32LENext(m.Ratio)
+}
+
+// DecodeFrom reads the fields of m from the buffer at its current offset
+func (m *Packet) DecodeFrom(b *crunch.Buffer) {
+	m.Magic = b.ReadU32BEAtNext()
+	{
+		end := int64(b.ReadByteNext()) + b.ByteOffset()
+		m.Header.DecodeFrom(b)
+		if b.ByteOffset() > end {
+			b.Fail(crunch.MarshalInvalidLengthError)
+			return
+		}
+		b.SeekByte(end, false)
+	}
+	m.Count = b.ReadU16BEAtNext()
+	if n := int64(m.Count); n < 0 || n > b.AfterByte()/4 {
+		b.Fail(crunch.MarshalInvalidLengthError)
+		return
+	}
+	m.Points = make([]Point, m.Count)
+	for i := range m.Points {
+		m.Points[i].DecodeFrom(b)
+	}
+	for i := range m.Checksum {
+		m.Checksum[i] = b.ReadByteNext()
+	}
+	m.Name = b.ReadPStringNext(crunch.PrefixU8)
+	if m.Header.Urgent {
+		m.Note = b.ReadCStringNext(-1)
+	}
+	m.PayloadLength = b.ReadUvarintNext()
+	if n := int64(m.PayloadLength); n < 0 || n > b.AfterByte() {
+		b.Fail(crunch.MarshalInvalidLengthError)
+		return
+	}
+	m.Payload = append([]byte(nil), b.ReadBytesNext(int64(m.PayloadLength))...)
+	m.Delta = b.ReadVarintNext()
+	m.Ratio = b.ReadF32LEAtNext()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	crunch "github.com/superwhiskers/crunch/v3"
+)
+
+/*
+
+utilities
+
+*/
+
+var packet = Packet{
+	Magic: 0xcafebabe,
+	Header: Header{
+		Version: 5,
+		Urgent:  true,
+		Channel: 9,
+		Flags:   0x80,
+	},
+	Count:         2,
+	Points:        []Point{{-1, 1}, {2, -2}},
+	Checksum:      [4]uint8{0xde, 0xad, 0xbe, 0xef},
+	Name:          "crunch",
+	Note:          "urgent",
+	PayloadLength: 3,
+	Payload:       []byte{0x01, 0x02, 0x03},
+	Delta:         -300,
+	Ratio:         1.5,
+}
+
+/*
+
+tests
+
+*/
+
+func TestPacketRoundTrip(t *testing.T) {
+
+	// a plain buffer keeps its cursors separate
+	buf := crunch.NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	packet.EncodeTo(buf)
+	if out := buf.Bytes(); out[4] != 0x02 || out[5] != 0xb9 || out[6] != 0x80 {
+
+		t.Fatalf("the header was not written after its size (got %#v)", out[:7])
+
+	}
+
+	if buf.CursorMode() != crunch.SeparateCursors {
+
+		t.Fatalf("expected cursor mode does not match the one gotten (got %d, expected %d)", buf.CursorMode(), crunch.SeparateCursors)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	buf.SeekBit(0x00, false)
+
+	var out Packet
+	out.DecodeFrom(buf)
+	if !reflect.DeepEqual(packet, out) {
+
+		t.Fatalf("expected packet does not match the one gotten (got %+v, expected %+v)", out, packet)
+
+	}
+
+}
+
+func TestPacketHostileCount(t *testing.T) {
+
+	// a count of 0xffff points with only a single byte after it
+	buf := crunch.NewBuffer([]byte{0xca, 0xfe, 0xba, 0xbe, 0x02, 0xb9, 0x80, 0xff, 0xff, 0x00})
+	buf.SetSticky(true)
+
+	var out Packet
+	out.DecodeFrom(buf)
+	if !errors.Is(buf.Err(), crunch.MarshalInvalidLengthError) || out.Points != nil {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), crunch.MarshalInvalidLengthError)
+
+	}
+
+}
+
+func TestPacketHeaderOverrun(t *testing.T) {
+
+	// a size of a single byte for a header that takes up two
+	buf := crunch.NewBuffer([]byte{0xca, 0xfe, 0xba, 0xbe, 0x01, 0xb9, 0x80, 0x00, 0x00})
+	buf.SetSticky(true)
+
+	var out Packet
+	out.DecodeFrom(buf)
+	if !errors.Is(buf.Err(), crunch.MarshalInvalidLengthError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), crunch.MarshalInvalidLengthError)
+
+	}
+
+}
+
+func TestPacketHostileLength(t *testing.T) {
+
+	buf := crunch.NewBuffer()
+	buf.SetGrowth(true, 0)
+	packet.EncodeTo(buf)
+
+	// the payload length is replaced with 0xffffffffffffffff, which is
+	// negative once it is converted to an int64
+	out := buf.Bytes()
+	at := len(out) - 10
+	hostile := append(append(append([]byte{}, out[:at]...), 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01), out[at+1:]...)
+
+	buf = crunch.NewBuffer(hostile)
+	buf.SetSticky(true)
+
+	var decoded Packet
+	decoded.DecodeFrom(buf)
+	if !errors.Is(buf.Err(), crunch.MarshalInvalidLengthError) || decoded.Payload != nil {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), crunch.MarshalInvalidLengthError)
+
+	}
+
+}
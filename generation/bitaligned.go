@@ -0,0 +1,294 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// GenerateBitAligned generates the variants of a complex method that
+// operate at a bit offset instead of a byte offset. it is called by
+// GenerateComplex with the already-verified arguments of a magic comment.
+// for a read, it outputs functions in this pattern:
+//
+// 	// Read<naming>Bits reads a <integer type> from the buffer at the specified
+// 	// bit offset in <big-endian | little-endian> without modifying the internal
+// 	// bit offset value
+// 	func (b *Buffer) Read<naming>Bits(off int64) (out <integer type>) {
+//
+// 		/* standard buffer method prelude omitted for brevity */
+//
+// 		out = <integer type>(readBitsBytes(b.buf, off, <number of bits / 8>, b.order, <little-endian>))
+// 		return
+//
+// 	}
+//
+// and for a write, it outputs functions in this pattern:
+//
+// 	// Put<naming>Bits writes a <integer type> to the buffer at the specified
+// 	// bit offset in <big-endian | little-endian> without modifying the internal
+// 	// bit offset value
+// 	func (b *Buffer) Put<naming>Bits(off int64, data <integer type>) {
+//
+// 		/* standard buffer method prelude omitted for brevity */
+//
+// 		writeBitsBytes(b.buf, off, uint64(data), <number of bits / 8>, b.order, <little-endian>)
+//
+// 	}
+//
+// each of them also gets a ...Next variant that operates at the current bit
+// offset and moves it forward. the MiniBuffer variants store their results in
+// an out parameter and the CheckedBuffer ones return an error alongside them
+func GenerateBitAligned(arguments []string, intType string, intBytes int) ([]byte, error) {
+	receiver := arguments[0]
+	naming := strings.Join(arguments[2:5], "")
+	uintType := strings.Join([]string{"uint", containerBits[arguments[3]]}, "")
+	endianness := map[string]string{
+		"BE": "big-endian",
+		"LE": "little-endian",
+	}[arguments[4]]
+	article := "a"
+	if intType[0] == 'i' {
+		article = "an"
+	}
+
+	buf := func() *jen.Statement {
+		if receiver == "CheckedBuffer" {
+			return jen.Id("b").Dot("buf")
+		}
+		return jen.Id("b")
+	}
+	length := func() *jen.Statement {
+		return jen.Lit(intBytes * 8)
+	}
+
+	// prelude generates the bounds checks of the Buffer and CheckedBuffer
+	// functions, along with automatic growth
+	prelude := func(g *jen.Group, name string) {
+		reserve := jen.Parens(jen.Id("off").Op("+").Add(length()).Op("+").Lit(7)).Op("/").Lit(8)
+		if receiver != "MiniBuffer" {
+			// off is not negative by then, but may be large enough for
+			// off + the length to overflow
+			reserve = jen.Id("off").Op("/").Lit(8).Op("+").Parens(jen.Id("off").Op("%").Lit(8).Op("+").Add(length()).Op("+").Lit(7)).Op("/").Lit(8)
+		}
+
+		if receiver == "MiniBuffer" {
+			if arguments[1] == "Write" {
+				g.If(jen.Id("b").Dot("grow").Op("&&").Parens(jen.Id("off").Op("+").Add(length())).Op(">").Id("b").Dot("bcap")).
+					Block(jen.Id("b").Dot("reserve").Call(reserve))
+			}
+			return
+		}
+
+		failure := func(err string) jen.Code {
+			e := jen.Id(err).Dot("atBit").Call(jen.Lit(name), jen.Id("off"), length(), buf().Dot("bcap"))
+			if receiver == "CheckedBuffer" {
+				return jen.Id("err").Op("=").Add(e)
+			}
+			return jen.Id("b").Dot("fail").Call(e)
+		}
+
+		// an error recorded by a buffer in sticky mode is returned again
+		if receiver == "Buffer" {
+			g.If(jen.Id("b").Dot("err").Op("!=").Nil()).
+				Block(jen.Return())
+		} else {
+			g.If(jen.Id("err").Op("=").Id("b").Dot("buf").Dot("err"), jen.Id("err").Op("!=").Nil()).
+				Block(jen.Return())
+		}
+
+		// the offset is checked first so that the remaining capacity can
+		// be computed without overflowing
+		if arguments[1] == "Read" {
+			g.If(jen.Id("off").Op("<").Lit(0x00)).
+				Block(failure("BufferUnderreadError"), jen.Return())
+			g.If(length().Op(">").Add(buf()).Dot("bcap").Op("-").Id("off")).
+				Block(failure("BufferOverreadError"), jen.Return())
+		} else {
+			g.If(jen.Id("off").Op("<").Lit(0x00)).
+				Block(failure("BufferUnderwriteError"), jen.Return())
+			g.If(length().Op(">").Add(buf()).Dot("bcap").Op("-").Id("off").Op("&&").
+				Op("!").Add(buf()).Dot("reserve").Call(reserve)).
+				Block(failure("BufferOverwriteError"), jen.Return())
+
+			// the overwritten bytes are journaled while a mark is active
+			if receiver == "Buffer" {
+				g.Id("b").Dot("saveBits").Call(jen.Id("off"), length())
+			}
+		}
+	}
+
+	// decode assigns the value located at the bit offset off to target
+	decode := func(g *jen.Group, target jen.Code) {
+		raw := jen.Id("readBitsBytes").Call(jen.Id("b").Dot("buf"), jen.Id("off"), jen.Lit(intBytes),
+			jen.Id("b").Dot("order"), jen.Lit(arguments[4] == "LE"))
+
+		if arguments[2] == "F" {
+			g.Id("u").Op(":=").Id(uintType).Call(raw)
+			g.Add(target).Op("=").Op("*").Parens(jen.Op("*").Id(intType)).Parens(jen.Id("unsafe").Dot("Pointer").
+				Call(jen.Op("&").Id("u")))
+			return
+		}
+
+		g.Add(target).Op("=").Id(intType).Call(raw)
+		if shift := signExtension(arguments); shift > 0 {
+			g.Add(target).Op("=").Add(target).Op("<<").Lit(shift).Op(">>").Lit(shift)
+		}
+	}
+
+	// encode writes data to the buffer at the bit offset off
+	encode := func(g *jen.Group) {
+		value := jen.Id("uint64").Call(jen.Id("data"))
+		if arguments[2] == "F" {
+			value = jen.Id("uint64").Call(jen.Op("*").Parens(jen.Op("*").Id(uintType)).Parens(jen.Id("unsafe").Dot("Pointer").
+				Call(jen.Op("&").Id("data"))))
+		}
+
+		g.Id("writeBitsBytes").Call(jen.Id("b").Dot("buf"), jen.Id("off"), value, jen.Lit(intBytes),
+			jen.Id("b").Dot("order"), jen.Lit(arguments[4] == "LE"))
+	}
+
+	// next generates the body of a ...Next function, which calls the
+	// function it wraps at the current bit offset and moves it forward
+	next := func(name string, args func(offset jen.Code) []jen.Code, results []jen.Code) func(*jen.Group) {
+		return func(g *jen.Group) {
+			call := jen.Id("b").Dot(name).Call(args(buf().Dot("boff"))...)
+			if len(results) > 0 {
+				g.List(results...).Op("=").Add(call)
+			} else {
+				g.Add(call)
+			}
+
+			seek := buf().Dot("SeekBit").Call(length(), jen.Lit(true))
+			if receiver == "CheckedBuffer" {
+				g.If(jen.Id("err").Op("==").Nil()).Block(seek)
+			} else {
+				g.Add(seek)
+			}
+
+			if len(results) > 0 {
+				g.Return()
+			}
+		}
+	}
+
+	var functions []scalarFunction
+	if arguments[1] == "Read" {
+		name := strings.Join([]string{"Read", naming, "Bits"}, "")
+
+		target := ""
+		if receiver == "MiniBuffer" {
+			target = " into out"
+		}
+
+		function := scalarFunction{
+			name: name,
+			comment: []string{
+				strings.Join([]string{name, " reads ", article, " ", intType, target, " from the buffer at the specified bit"}, ""),
+				strings.Join([]string{"offset in ", endianness, " without modifying the internal bit offset value"}, ""),
+			},
+		}
+		nextFunction := scalarFunction{
+			name: strings.Join([]string{name, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{name, "Next reads ", article, " ", intType, target, " from the buffer at the current bit"}, ""),
+				strings.Join([]string{"offset in ", endianness, " and moves the bit offset forward the amount of bits read"}, ""),
+			},
+		}
+
+		switch receiver {
+		case "Buffer":
+			function.params = []jen.Code{jen.Id("off").Id("int64")}
+			function.results = []jen.Code{jen.Id("out").Id(intType)}
+			function.body = func(g *jen.Group) {
+				prelude(g, name)
+				decode(g, jen.Id("out"))
+				g.Return()
+			}
+			nextFunction.results = []jen.Code{jen.Id("out").Id(intType)}
+			nextFunction.body = next(name, func(offset jen.Code) []jen.Code {
+				return []jen.Code{offset}
+			}, []jen.Code{jen.Id("out")})
+
+		case "MiniBuffer":
+			function.params = []jen.Code{jen.Id("out").Op("*").Id(intType), jen.Id("off").Id("int64")}
+			function.body = func(g *jen.Group) {
+				decode(g, jen.Op("*").Id("out"))
+			}
+			nextFunction.params = []jen.Code{jen.Id("out").Op("*").Id(intType)}
+			nextFunction.body = next(name, func(offset jen.Code) []jen.Code {
+				return []jen.Code{jen.Id("out"), offset}
+			}, nil)
+
+		case "CheckedBuffer":
+			function.params = []jen.Code{jen.Id("off").Id("int64")}
+			function.results = []jen.Code{jen.Id("out").Id(intType), jen.Id("err").Id("error")}
+			function.body = func(g *jen.Group) {
+				prelude(g, name)
+				g.Id("out").Op("=").Id("b").Dot("buf").Dot(name).Call(jen.Id("off"))
+				g.Return()
+			}
+			nextFunction.results = []jen.Code{jen.Id("out").Id(intType), jen.Id("err").Id("error")}
+			nextFunction.body = next(name, func(offset jen.Code) []jen.Code {
+				return []jen.Code{offset}
+			}, []jen.Code{jen.Id("out"), jen.Id("err")})
+		}
+
+		functions = []scalarFunction{function, nextFunction}
+	} else {
+		name := strings.Join([]string{"Put", naming, "Bits"}, "")
+
+		function := scalarFunction{
+			name: name,
+			comment: []string{
+				strings.Join([]string{name, " writes ", article, " ", intType, " to the buffer at the specified bit"}, ""),
+				strings.Join([]string{"offset in ", endianness, " without modifying the internal bit offset value"}, ""),
+			},
+			params: []jen.Code{jen.Id("off").Id("int64"), jen.Id("data").Id(intType)},
+		}
+		nextFunction := scalarFunction{
+			name: strings.Join([]string{name, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{name, "Next writes ", article, " ", intType, " to the buffer at the current bit"}, ""),
+				strings.Join([]string{"offset in ", endianness, " and moves the bit offset forward the amount of bits written"}, ""),
+			},
+			params: []jen.Code{jen.Id("data").Id(intType)},
+		}
+
+		if receiver == "CheckedBuffer" {
+			function.results = []jen.Code{jen.Id("err").Id("error")}
+			function.body = func(g *jen.Group) {
+				prelude(g, name)
+				g.Id("b").Dot("buf").Dot(name).Call(jen.Id("off"), jen.Id("data"))
+				g.Return()
+			}
+			nextFunction.results = []jen.Code{jen.Id("err").Id("error")}
+			nextFunction.body = next(name, func(offset jen.Code) []jen.Code {
+				return []jen.Code{offset, jen.Id("data")}
+			}, []jen.Code{jen.Id("err")})
+		} else {
+			function.body = func(g *jen.Group) {
+				prelude(g, name)
+				encode(g)
+			}
+			nextFunction.body = next(name, func(offset jen.Code) []jen.Code {
+				return []jen.Code{offset, jen.Id("data")}
+			}, nil)
+		}
+
+		functions = []scalarFunction{function, nextFunction}
+	}
+
+	return renderFunctions(receiver, functions)
+}
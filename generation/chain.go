@@ -0,0 +1,303 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// GenerateChain generates the ChainBuffer variants of a complex method and
+// of the ones that GenerateScalar and GenerateBitAligned output alongside
+// it. instead of reimplementing the conversions, each of them points the
+// scratch Buffer of the ChainBuffer at the bytes it operates on and calls
+// the Buffer method of the same name on it. it is called by GenerateComplex
+// with the already-verified arguments of a magic comment and outputs
+// functions in this pattern:
+//
+// 	// <naming> <reads | writes> ...
+// 	func (b *ChainBuffer) <naming>(off int64, data <integer type>) {
+//
+// 		if w := b.view("<naming>", off, 1, <number of bits / 8>, <is a write>); w != nil {
+//
+// 			w.<naming>(0, data)
+// 			b.store(off)
+//
+// 		}
+//
+// 	}
+//
+// the bit-aligned methods use bitView instead of view and the ...Next
+// variants call the method they are a variant of at the current offset
+// before moving it forward
+func GenerateChain(arguments []string, intType string, intBytes int) ([]byte, error) {
+	naming := strings.Join(arguments[2:5], "")
+	endianness := map[string]string{
+		"BE": "big-endian",
+		"LE": "little-endian",
+	}[arguments[4]]
+	article := "a"
+	if intType[0] == 'i' {
+		article = "an"
+	}
+
+	// delegate generates a call to the Buffer method of the same name on
+	// the scratch buffer, which is only made if the operation is in
+	// bounds. writes are stored back into the chunks afterwards. length is
+	// an amount of bits for bitView and an amount of values for view, which
+	// is passed their size separately so that it can reject a count whose
+	// length in bytes would overflow
+	delegate := func(name string, bits bool, length, condition *jen.Statement, call []jen.Code, result bool) func(*jen.Group) {
+		write := arguments[1] == "Write"
+		return func(g *jen.Group) {
+			var (
+				view  *jen.Statement
+				store *jen.Statement
+			)
+			if bits {
+				view = jen.List(jen.Id("w"), jen.Id("bit")).Op(":=").Id("b").Dot("bitView").Call(jen.Lit(name), jen.Id("off"), length, jen.Lit(write))
+				store = jen.Id("b").Dot("store").Call(jen.Id("off").Op("/").Lit(8))
+			} else {
+				view = jen.Id("w").Op(":=").Id("b").Dot("view").Call(jen.Lit(name), jen.Id("off"), length, jen.Lit(intBytes), jen.Lit(write))
+				store = jen.Id("b").Dot("store").Call(jen.Id("off"))
+			}
+
+			check := jen.Id("w").Op("!=").Nil()
+			if condition != nil {
+				check = check.Op("&&").Add(condition)
+			}
+
+			g.If(view, check).BlockFunc(func(g *jen.Group) {
+				if result {
+					g.Id("out").Op("=").Id("w").Dot(name).Call(call...)
+				} else {
+					g.Id("w").Dot(name).Call(call...)
+				}
+				if write {
+					g.Add(store)
+				}
+			})
+			if result {
+				g.Return()
+			}
+		}
+	}
+
+	// next generates a call to the method a ...Next variant is a variant of
+	// at the current offset, with the arguments returned by call
+	next := func(name string, bits bool, length *jen.Statement, call func(*jen.Statement) []jen.Code, result bool) func(*jen.Group) {
+		return func(g *jen.Group) {
+			offset, seek := jen.Id("b").Dot("off"), "SeekByte"
+			if bits {
+				offset, seek = jen.Id("b").Dot("boff"), "SeekBit"
+			}
+
+			if result {
+				g.Id("out").Op("=").Id("b").Dot(name).Call(call(offset)...)
+			} else {
+				g.Id("b").Dot(name).Call(call(offset)...)
+			}
+			g.Id("b").Dot(seek).Call(length, jen.Lit(true))
+			if result {
+				g.Return()
+			}
+		}
+	}
+
+	// the arguments of the ...Next variants
+	offset := func(off *jen.Statement) []jen.Code {
+		return []jen.Code{off}
+	}
+	offsetThen := func(name string) func(*jen.Statement) []jen.Code {
+		return func(off *jen.Statement) []jen.Code {
+			return []jen.Code{off, jen.Id(name)}
+		}
+	}
+	thenOffset := func(name string) func(*jen.Statement) []jen.Code {
+		return func(off *jen.Statement) []jen.Code {
+			return []jen.Code{jen.Id(name), off}
+		}
+	}
+
+	slice := strings.Join([]string{arguments[1], naming}, "")
+	bits := func() *jen.Statement {
+		return jen.Lit(intBytes * 8)
+	}
+	single := func() *jen.Statement {
+		return jen.Lit(intBytes)
+	}
+
+	var functions []scalarFunction
+	if arguments[1] == "Read" {
+		at := strings.Join([]string{"Read", naming, "At"}, "")
+		into := strings.Join([]string{"Read", naming, "Into"}, "")
+		aligned := strings.Join([]string{"Read", naming, "Bits"}, "")
+		count := func() *jen.Statement {
+			return jen.Id("n").Op("*").Lit(intBytes)
+		}
+		multiple := func() *jen.Statement {
+			return jen.Id("int64").Call(jen.Len(jen.Id("dst"))).Op("*").Lit(intBytes)
+		}
+
+		functions = []scalarFunction{
+			{
+				name: slice,
+				comment: []string{
+					strings.Join([]string{slice, " reads a slice of ", intType, "s from the buffer at the"}, ""),
+					strings.Join([]string{"specified offset in ", endianness, " without modifying the internal"}, ""),
+					"offset value",
+				},
+				params:  []jen.Code{jen.Id("off"), jen.Id("n").Int64()},
+				results: []jen.Code{jen.Id("out").Index().Id(intType)},
+				body:    delegate(slice, false, jen.Id("n"), jen.Id("n").Op(">").Lit(0x00), []jen.Code{jen.Lit(0x00), jen.Id("n")}, true),
+			},
+			{
+				name: strings.Join([]string{slice, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{slice, "Next reads a slice of ", intType, "s from the buffer at the"}, ""),
+					strings.Join([]string{"current offset in ", endianness, " and moves the offset forward the"}, ""),
+					"amount of bytes read",
+				},
+				params:  []jen.Code{jen.Id("n").Int64()},
+				results: []jen.Code{jen.Id("out").Index().Id(intType)},
+				body:    next(slice, false, count(), offsetThen("n"), true),
+			},
+			{
+				name: at,
+				comment: []string{
+					strings.Join([]string{at, " reads ", article, " ", intType, " from the buffer at the specified offset"}, ""),
+					strings.Join([]string{"in ", endianness, " without modifying the internal offset value"}, ""),
+				},
+				params:  []jen.Code{jen.Id("off").Int64()},
+				results: []jen.Code{jen.Id("out").Id(intType)},
+				body:    delegate(at, false, jen.Lit(1), nil, []jen.Code{jen.Lit(0x00)}, true),
+			},
+			{
+				name: strings.Join([]string{at, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{at, "Next reads ", article, " ", intType, " from the buffer at the current offset"}, ""),
+					strings.Join([]string{"in ", endianness, " and moves the offset forward the amount of bytes read"}, ""),
+				},
+				results: []jen.Code{jen.Id("out").Id(intType)},
+				body:    next(at, false, single(), offset, true),
+			},
+			{
+				name: into,
+				comment: []string{
+					strings.Join([]string{into, " reads len(dst) ", intType, "s from the buffer at the specified"}, ""),
+					strings.Join([]string{"offset in ", endianness, " into dst without modifying the internal offset value"}, ""),
+				},
+				params: []jen.Code{jen.Id("dst").Index().Id(intType), jen.Id("off").Int64()},
+				body:   delegate(into, false, jen.Id("int64").Call(jen.Len(jen.Id("dst"))), nil, []jen.Code{jen.Id("dst"), jen.Lit(0x00)}, false),
+			},
+			{
+				name: strings.Join([]string{into, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{into, "Next reads len(dst) ", intType, "s from the buffer at the current"}, ""),
+					strings.Join([]string{"offset in ", endianness, " into dst and moves the offset forward the amount of bytes read"}, ""),
+				},
+				params: []jen.Code{jen.Id("dst").Index().Id(intType)},
+				body:   next(into, false, multiple(), thenOffset("dst"), false),
+			},
+			{
+				name: aligned,
+				comment: []string{
+					strings.Join([]string{aligned, " reads ", article, " ", intType, " from the buffer at the specified bit"}, ""),
+					strings.Join([]string{"offset in ", endianness, " without modifying the internal bit offset value"}, ""),
+				},
+				params:  []jen.Code{jen.Id("off").Int64()},
+				results: []jen.Code{jen.Id("out").Id(intType)},
+				body:    delegate(aligned, true, bits(), nil, []jen.Code{jen.Id("bit")}, true),
+			},
+			{
+				name: strings.Join([]string{aligned, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{aligned, "Next reads ", article, " ", intType, " from the buffer at the current bit"}, ""),
+					strings.Join([]string{"offset in ", endianness, " and moves the bit offset forward the amount of bits read"}, ""),
+				},
+				results: []jen.Code{jen.Id("out").Id(intType)},
+				body:    next(aligned, true, bits(), offset, true),
+			},
+		}
+	} else {
+		put := strings.Join([]string{"Put", naming}, "")
+		aligned := strings.Join([]string{"Put", naming, "Bits"}, "")
+		multiple := func() *jen.Statement {
+			return jen.Id("int64").Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)
+		}
+
+		functions = []scalarFunction{
+			{
+				name: slice,
+				comment: []string{
+					strings.Join([]string{slice, " writes a slice of ", intType, "s to the buffer at the"}, ""),
+					strings.Join([]string{"specified offset in ", endianness, " without modifying the internal"}, ""),
+					"offset value",
+				},
+				params: []jen.Code{jen.Id("off").Int64(), jen.Id("data").Index().Id(intType)},
+				body:   delegate(slice, false, jen.Id("int64").Call(jen.Len(jen.Id("data"))), jen.Len(jen.Id("data")).Op(">").Lit(0x00), []jen.Code{jen.Lit(0x00), jen.Id("data")}, false),
+			},
+			{
+				name: strings.Join([]string{slice, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{slice, "Next writes a slice of ", intType, "s to the buffer at the"}, ""),
+					strings.Join([]string{"current offset in ", endianness, " and moves the offset forward the"}, ""),
+					"amount of bytes written",
+				},
+				params: []jen.Code{jen.Id("data").Index().Id(intType)},
+				body:   next(slice, false, multiple(), offsetThen("data"), false),
+			},
+			{
+				name: put,
+				comment: []string{
+					strings.Join([]string{put, " writes ", article, " ", intType, " to the buffer at the specified offset"}, ""),
+					strings.Join([]string{"in ", endianness, " without modifying the internal offset value"}, ""),
+				},
+				params: []jen.Code{jen.Id("off").Int64(), jen.Id("data").Id(intType)},
+				body:   delegate(put, false, jen.Lit(1), nil, []jen.Code{jen.Lit(0x00), jen.Id("data")}, false),
+			},
+			{
+				name: strings.Join([]string{put, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{put, "Next writes ", article, " ", intType, " to the buffer at the current offset"}, ""),
+					strings.Join([]string{"in ", endianness, " and moves the offset forward the amount of bytes written"}, ""),
+				},
+				params: []jen.Code{jen.Id("data").Id(intType)},
+				body:   next(put, false, single(), offsetThen("data"), false),
+			},
+			{
+				name: aligned,
+				comment: []string{
+					strings.Join([]string{aligned, " writes ", article, " ", intType, " to the buffer at the specified bit"}, ""),
+					strings.Join([]string{"offset in ", endianness, " without modifying the internal bit offset value"}, ""),
+				},
+				params: []jen.Code{jen.Id("off").Int64(), jen.Id("data").Id(intType)},
+				body:   delegate(aligned, true, bits(), nil, []jen.Code{jen.Id("bit"), jen.Id("data")}, false),
+			},
+			{
+				name: strings.Join([]string{aligned, "Next"}, ""),
+				comment: []string{
+					strings.Join([]string{aligned, "Next writes ", article, " ", intType, " to the buffer at the current bit"}, ""),
+					strings.Join([]string{"offset in ", endianness, " and moves the bit offset forward the amount of bits written"}, ""),
+				},
+				params: []jen.Code{jen.Id("data").Id(intType)},
+				body:   next(aligned, true, bits(), offsetThen("data"), false),
+			},
+		}
+	}
+
+	// the magic comment is already surrounded by blank lines
+	generated, err := renderFunctions("ChainBuffer", functions)
+	return bytes.TrimLeft(generated, "\n"), err
+}
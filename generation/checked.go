@@ -0,0 +1,204 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// GenerateChecked generates the CheckedBuffer variant of a complex method.
+// instead of reimplementing the conversion, it checks the bounds of the
+// operation itself and only calls into the wrapped Buffer once it knows
+// that it will not panic. it is called by GenerateComplex with the
+// already-verified arguments of a magic comment and outputs this:
+//
+// 	// <naming> <reads | writes> a slice of <integer type>s <from | to> the buffer at the
+// 	// specified offset in <big-endian | little-endian> without modifying the internal
+// 	// offset value. an error is returned if the operation is out of bounds
+// 	func (b *CheckedBuffer) <naming>(off int64, data []<integer type>) (err error) {
+//
+// 		/* bounds checks that return an error instead of panicking */
+//
+// 		b.buf.<naming>(off, data)
+// 		return
+//
+// 	}
+//
+// 	// <naming>Next <reads | writes> a slice of <integer type>s <from | to> the buffer at the
+// 	// current offset in <big-endian | little-endian> and moves the offset forward the
+// 	// amount of bytes <read | written>. the offset is not moved if an error is returned
+// 	func (b *CheckedBuffer) <naming>Next(data []<integer type>) (err error) {
+//
+// 		err = b.<naming>(b.buf.off, data)
+// 		if err == nil {
+//
+// 			b.buf.SeekByte(int64(len(data))*<number of bits / 8>, true)
+//
+// 		}
+// 		return
+//
+// 	}
+//
+// (the read variants take an offset and a count and return the slice alongside the error)
+func GenerateChecked(arguments []string, intType string, intBytes int) ([]byte, error) {
+	functionName := strings.Join([]string{arguments[1], arguments[2], arguments[3], arguments[4]}, "")
+	functionNameNext := strings.Join([]string{functionName, "Next"}, "")
+
+	verbs := map[string]string{
+		"Read":  "reads",
+		"Write": "writes",
+	}
+	prepositions := map[string]string{
+		"Read":  "from",
+		"Write": "to",
+	}
+	participles := map[string]string{
+		"Read":  "read",
+		"Write": "written",
+	}
+	endianness := map[string]string{
+		"BE": "big-endian",
+		"LE": "little-endian",
+	}
+
+	// length is the amount of bytes touched by the operation
+	var length *jen.Statement
+	if arguments[1] == "Read" {
+		length = jen.Id("n").Op("*").Lit(intBytes)
+	} else {
+		length = jen.Id("int64").Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)
+	}
+
+	builder := &jen.Group{}
+	builder.Comment(strings.Join([]string{
+		"// ", functionName, " ", verbs[arguments[1]], " a slice of ", intType, "s ",
+		prepositions[arguments[1]], " the buffer at the\n",
+	}, ""))
+	builder.Comment(strings.Join([]string{
+		"// specified offset in ", endianness[arguments[4]], " without modifying the internal\n",
+	}, ""))
+	builder.Comment("// offset value. an error is returned if the operation is out of bounds\n")
+
+	function := builder.Func().Params(jen.Id("b").Op("*").Id("CheckedBuffer")).Id(functionName)
+	if arguments[1] == "Read" {
+		function.Params(
+			jen.Id("off"),
+			jen.Id("n").Id("int64")).Params(
+			jen.Id("out").Index().Id(intType),
+			jen.Id("err").Id("error"))
+	} else {
+		function.Params(
+			jen.Id("off").Id("int64"),
+			jen.Id("data").Index().Id(intType)).Params(
+			jen.Id("err").Id("error"))
+	}
+
+	// the errors carry the details of the failed operation
+	failure := func(err string) *jen.Statement {
+		return jen.Id("err").Op("=").Id(err).Dot("at").
+			Call(jen.Lit(functionName), jen.Id("off"), length, jen.Id("b").Dot("buf").Dot("cap"))
+	}
+
+	// negative arguments are rejected before the bounds are compared, as
+	// the helpers that compare them rely on it to not overflow
+	function.BlockFunc(func(body *jen.Group) {
+		// an error recorded by the buffer in sticky mode is returned again
+		body.If(jen.Id("err").Op("=").Id("b").Dot("buf").Dot("err"), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return())
+
+		if arguments[1] == "Read" {
+			body.If(jen.Id("n").Op("<").Lit(0x00)).Block(
+				failure("BufferInvalidByteCountError"),
+				jen.Return())
+			body.If(jen.Id("off").Op("<").Lit(0x00)).Block(
+				failure("BufferUnderreadError"),
+				jen.Return())
+			body.If(jen.Op("!").Id("b").Dot("buf").Dot("readable").Call(jen.Id("off"), jen.Id("n"), jen.Lit(intBytes))).Block(
+				failure("BufferOverreadError"),
+				jen.Return())
+
+			// the wrapped method always converts at least one value, so
+			// empty reads are handled here
+			body.If(jen.Id("n").Op("==").Lit(0x00)).Block(
+				jen.Id("out").Op("=").Index().Id(intType).Values(),
+				jen.Return())
+			body.Id("out").Op("=").Id("b").Dot("buf").Dot(functionName).Call(jen.Id("off"), jen.Id("n"))
+		} else {
+			body.If(jen.Id("off").Op("<").Lit(0x00)).Block(
+				failure("BufferUnderwriteError"),
+				jen.Return())
+			body.If(jen.Op("!").Id("b").Dot("buf").Dot("writable").Call(jen.Id("off"), length)).Block(
+				failure("BufferOverwriteError"),
+				jen.Return())
+
+			// see above
+			body.If(jen.Len(jen.Id("data")).Op("==").Lit(0x00)).Block(
+				jen.Return())
+			body.Id("b").Dot("buf").Dot(functionName).Call(jen.Id("off"), jen.Id("data"))
+		}
+		body.Return()
+	})
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	err := builder.Render(outputBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = outputBuffer.Write([]byte("\n\n"))
+
+	builder = &jen.Group{}
+	builder.Comment(strings.Join([]string{
+		"// ", functionNameNext, " ", verbs[arguments[1]], " a slice of ", intType, "s ",
+		prepositions[arguments[1]], " the buffer at the\n",
+	}, ""))
+	builder.Comment(strings.Join([]string{
+		"// current offset in ", endianness[arguments[4]], " and moves the offset forward the\n",
+	}, ""))
+	builder.Comment(strings.Join([]string{
+		"// amount of bytes ", participles[arguments[1]], ". the offset is not moved if an error is returned\n",
+	}, ""))
+
+	function = builder.Func().Params(jen.Id("b").Op("*").Id("CheckedBuffer")).Id(functionNameNext)
+	if arguments[1] == "Read" {
+		function.Params(jen.Id("n").Id("int64")).Params(
+			jen.Id("out").Index().Id(intType),
+			jen.Id("err").Id("error"))
+	} else {
+		function.Params(jen.Id("data").Index().Id(intType)).Params(
+			jen.Id("err").Id("error"))
+	}
+
+	function.BlockFunc(func(body *jen.Group) {
+		body.Add(alignmentCheck(functionNameNext))
+		if arguments[1] == "Read" {
+			body.List(jen.Id("out"), jen.Id("err")).Op("=").Id("b").Dot(functionName).
+				Call(jen.Id("b").Dot("buf").Dot("off"), jen.Id("n"))
+		} else {
+			body.Id("err").Op("=").Id("b").Dot(functionName).
+				Call(jen.Id("b").Dot("buf").Dot("off"), jen.Id("data"))
+		}
+		body.If(jen.Id("err").Op("==").Nil()).Block(
+			jen.Id("b").Dot("buf").Dot("SeekByte").Call(length, jen.Lit(true)))
+		body.Return()
+	})
+
+	err = builder.Render(outputBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputBuffer.Bytes(), nil
+}
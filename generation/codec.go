@@ -0,0 +1,851 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// crunchPath is the import path of the package that holds Buffer
+const crunchPath = "github.com/superwhiskers/crunch/v3"
+
+// codecTag holds the options of a crunch struct tag. it accepts the same
+// options as the tags read by Marshal and Unmarshal
+type codecTag struct {
+	encoding string
+	size     int
+	little   bool
+	bits     int
+	length   string
+	prefix   int
+	width    int
+}
+
+// parseCodecTag parses the contents of a crunch struct tag. skip is set if
+// the field is to be left alone
+func parseCodecTag(tag string) (t codecTag, skip bool, err error) {
+	if tag == "-" {
+		return t, true, nil
+	}
+	if tag == "" {
+		return t, false, nil
+	}
+
+	for _, option := range strings.Split(tag, ",") {
+		key, value := option, ""
+		if i := strings.IndexByte(option, '='); i >= 0 {
+			key, value = option[:i], option[i+1:]
+		}
+
+		switch key {
+		case "be", "le":
+			t.little = key == "le"
+		case "uvarint", "varint", "uleb128", "sleb128", "cstring":
+			t.encoding = key
+		case "bits":
+			t.bits, err = strconv.Atoi(value)
+			if err != nil || t.bits < 1 || t.bits > 64 {
+				return t, false, fmt.Errorf("invalid bit count %q", value)
+			}
+		case "len":
+			if value == "" {
+				return t, false, errors.New("empty length")
+			}
+			t.length = value
+		case "prefix":
+			t.prefix = map[string]int{"u8": 1, "u16": 2, "u32": 4, "uvarint": -1}[value]
+			if t.prefix == 0 {
+				return t, false, fmt.Errorf("invalid prefix %q", value)
+			}
+		case "size":
+			t.width, err = strconv.Atoi(value)
+			if err != nil || t.width < 0 {
+				return t, false, fmt.Errorf("invalid size %q", value)
+			}
+		default:
+			// the remaining options are integer and float types such as
+			// u24 or f32
+			if len(key) < 2 || (key[0] != 'u' && key[0] != 'i' && key[0] != 'f') {
+				return t, false, fmt.Errorf("unknown option %q", key)
+			}
+			n, err := strconv.Atoi(key[1:])
+			if err != nil || n%8 != 0 || n < 8 || n > 64 || (key[0] == 'f' && n != 32 && n != 64) {
+				return t, false, fmt.Errorf("unknown option %q", key)
+			}
+			t.encoding, t.size = key[:1], n/8
+		}
+	}
+	return t, false, nil
+}
+
+// codecGenerator holds the information about a package needed to generate
+// the codecs of its structs
+type codecGenerator struct {
+	// structs maps the name of each struct type to its definition
+	structs map[string]*ast.StructType
+
+	// named maps the name of each non-struct type to its underlying type
+	named map[string]ast.Expr
+
+	// annotated holds the names of the structs that get a codec
+	annotated map[string]bool
+
+	// qualifier is the name the package holding Buffer is imported as, or
+	// empty if the code is generated inside of it
+	qualifier string
+}
+
+// crunchQualifier returns the name file imports the package that holds
+// Buffer as, or an empty string if it does not import it
+func crunchQualifier(file *ast.File) string {
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		if path != crunchPath {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return "v3"
+	}
+	return ""
+}
+
+// crunch returns a reference to the exported name of the crunch package
+func (c *codecGenerator) crunch(name string) *jen.Statement {
+	if c.qualifier == "" {
+		return jen.Id(name)
+	}
+	return jen.Id(c.qualifier).Dot(name)
+}
+
+// basic resolves typ to the name of a predeclared type, following named
+// types declared in the package. it returns an empty string for anything
+// else
+func (c *codecGenerator) basic(typ ast.Expr) string {
+	ident, ok := typ.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	if underlying, ok := c.named[ident.Name]; ok {
+		return c.basic(underlying)
+	}
+
+	switch ident.Name {
+	case "bool", "string", "byte", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		return ident.Name
+	}
+	return ""
+}
+
+// typeCode renders typ as code
+func typeCode(typ ast.Expr) (*jen.Statement, error) {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		return jen.Id(t.Name), nil
+	case *ast.ArrayType:
+		elem, err := typeCode(t.Elt)
+		if err != nil {
+			return nil, err
+		}
+		if t.Len == nil {
+			return jen.Index().Add(elem), nil
+		}
+		if n, ok := t.Len.(*ast.BasicLit); ok {
+			return jen.Index(jen.Op(n.Value)).Add(elem), nil
+		}
+		if n, ok := t.Len.(*ast.Ident); ok {
+			return jen.Index(jen.Id(n.Name)).Add(elem), nil
+		}
+	}
+	return nil, errors.New("unsupported type")
+}
+
+// integerSize returns the amount of bytes a value of the predeclared type
+// name takes up by default
+func integerSize(name string) int {
+	switch name {
+	case "bool", "byte", "int8", "uint8":
+		return 1
+	case "int16", "uint16":
+		return 2
+	case "int32", "uint32", "float32":
+		return 4
+	}
+	return 8
+}
+
+// sameType returns whether or not the predeclared types a and b are the
+// same, taking byte being an alias of uint8 into account
+func sameType(a, b string) bool {
+	if a == "uint8" {
+		a = "byte"
+	}
+	if b == "uint8" {
+		b = "byte"
+	}
+	return a == b
+}
+
+// loopVariable returns the name of the index variable of a loop nested
+// depth levels deep
+func loopVariable(depth int) string {
+	if depth < 3 {
+		return []string{"i", "j", "k"}[depth]
+	}
+	return strings.Join([]string{"i", strconv.Itoa(depth)}, "")
+}
+
+// field holds what is needed to generate the code of a single value
+type field struct {
+	// target returns a new reference to the value
+	target func() *jen.Statement
+
+	typ  ast.Expr
+	tag  codecTag
+	path string
+
+	// depth is the amount of loops the value is nested in
+	depth int
+}
+
+// elem returns the field of the element of the slice or array f
+func (f field) elem() field {
+	index := loopVariable(f.depth)
+	target := f.target
+	f.target = func() *jen.Statement {
+		return target().Index(jen.Id(index))
+	}
+	f.typ = f.typ.(*ast.ArrayType).Elt
+	f.tag.length = ""
+	f.path = strings.Join([]string{f.path, "[]"}, "")
+	f.depth++
+	return f
+}
+
+// length returns the code of the length option of the tag of f
+func (f field) length() *jen.Statement {
+	if n, err := strconv.Atoi(f.tag.length); err == nil {
+		return jen.Lit(n)
+	}
+	return jen.Id("int64").Call(jen.Id("m").Dot(f.tag.length))
+}
+
+// countCheck generates the code that fails with failure if length is
+// negative or the rest of the buffer cannot hold as many values that take
+// up at least bits bits each. it keeps hostile lengths from making a
+// decoder read or allocate without bound, counting values that take up no
+// space as a bit
+func countCheck(g *jen.Group, length *jen.Statement, bits int, failure *jen.Statement) {
+	left := jen.Id("b").Dot("AfterBit").Call()
+	switch {
+	case bits < 2:
+	case bits%8 == 0 && bits > 8:
+		left = jen.Id("b").Dot("AfterByte").Call().Op("/").Lit(bits / 8)
+	case bits == 8:
+		left = jen.Id("b").Dot("AfterByte").Call()
+	default:
+		left = left.Op("/").Lit(bits)
+	}
+
+	g.If(jen.Id("n").Op(":=").Add(length), jen.Id("n").Op("<").Lit(0).Op("||").Id("n").Op(">").Add(left)).Block(
+		jen.Id("b").Dot("Fail").Call(failure),
+		jen.Return())
+}
+
+// scalarName returns the name of the generated Buffer method that reads or
+// writes an integer or a float of the given kind and size
+func scalarName(prefix, kind string, size int, little bool, suffix string) string {
+	endianness := "BE"
+	if little {
+		endianness = "LE"
+	}
+	return strings.Join([]string{prefix, kind, strconv.Itoa(size * 8), endianness, suffix}, "")
+}
+
+// prefixName returns the name of the StringPrefix described by t
+func prefixName(t codecTag) string {
+	switch {
+	case t.prefix == 1:
+		return "PrefixU8"
+	case t.prefix == -1:
+		return "PrefixUvarint"
+	case t.little:
+		return strings.Join([]string{"PrefixU", strconv.Itoa(t.prefix * 8), "LE"}, "")
+	}
+	return strings.Join([]string{"PrefixU", strconv.Itoa(t.prefix * 8), "BE"}, "")
+}
+
+// encode generates the code that writes f to the buffer
+func (c *codecGenerator) encode(g *jen.Group, f field) error {
+	name := c.basic(f.typ)
+	ident, _ := f.typ.(*ast.Ident)
+
+	// convert converts the value to the type to unless it already is one
+	convert := func(to string) *jen.Statement {
+		if ident != nil && sameType(ident.Name, to) {
+			return f.target()
+		}
+		return jen.Id(to).Call(f.target())
+	}
+	raw := func() *jen.Statement {
+		return convert(name)
+	}
+
+	switch {
+	case name == "bool":
+		value := "WriteByteNext"
+		args := func(v int) []jen.Code { return []jen.Code{jen.Lit(v)} }
+		if f.tag.bits != 0 {
+			value = "SetBitsNext"
+			args = func(v int) []jen.Code { return []jen.Code{jen.Lit(v), jen.Lit(f.tag.bits)} }
+		}
+		g.If(f.target()).Block(jen.Id("b").Dot(value).Call(args(1)...)).
+			Else().Block(jen.Id("b").Dot(value).Call(args(0)...))
+
+	case name == "float32" || name == "float64":
+		if f.tag.bits != 0 || (f.tag.encoding != "" && f.tag.encoding != "f") {
+			return errors.New("invalid struct tag")
+		}
+		size := f.tag.size
+		if size == 0 {
+			size = integerSize(name)
+		}
+		g.Id("b").Dot(scalarName("Put", "F", size, f.tag.little, "Next")).
+			Call(convert(strings.Join([]string{"float", strconv.Itoa(size * 8)}, "")))
+
+	case name == "string":
+		switch {
+		case f.tag.encoding == "cstring":
+			g.Id("b").Dot("WriteCStringNext").Call(raw())
+		case f.tag.prefix != 0:
+			g.Id("b").Dot("WritePStringNext").Call(c.crunch(prefixName(f.tag)), raw())
+		case f.tag.width != 0:
+			g.Id("b").Dot("WriteFixedStringNext").Call(jen.Lit(f.tag.width), raw(), jen.Lit(0x00))
+		case f.tag.length != "":
+			c.lengthCheck(g, f)
+			g.Id("b").Dot("WriteBytesNext").Call(jen.Index().Byte().Parens(f.target()))
+		default:
+			return errors.New("strings need a cstring, prefix, size or len option")
+		}
+
+	case name != "":
+		if f.tag.encoding == "f" {
+			return errors.New("invalid struct tag")
+		}
+		if f.tag.bits != 0 {
+			g.Id("b").Dot("SetBitsNext").Call(convert("uint64"), jen.Lit(f.tag.bits))
+			return nil
+		}
+
+		switch f.tag.encoding {
+		case "uvarint", "uleb128":
+			method := map[string]string{"uvarint": "WriteUvarintNext", "uleb128": "WriteULEB128Next"}[f.tag.encoding]
+			g.Id("b").Dot(method).Call(convert("uint64"))
+			return nil
+		case "varint", "sleb128":
+			method := map[string]string{"varint": "WriteVarintNext", "sleb128": "WriteSLEB128Next"}[f.tag.encoding]
+			g.Id("b").Dot(method).Call(convert("int64"))
+			return nil
+		}
+
+		size := f.tag.size
+		if size == 0 {
+			size = integerSize(name)
+		}
+		if size == 1 {
+			g.Id("b").Dot("WriteByteNext").Call(convert("byte"))
+			return nil
+		}
+		container := strings.Join([]string{"uint", containerBits[strconv.Itoa(size*8)]}, "")
+		g.Id("b").Dot(scalarName("Put", "U", size, f.tag.little, "Next")).Call(convert(container))
+
+	default:
+		switch t := f.typ.(type) {
+		case *ast.Ident:
+			if !c.annotated[t.Name] {
+				return fmt.Errorf("%s does not have a codec", t.Name)
+			}
+			g.Add(f.target()).Dot("EncodeTo").Call(jen.Id("b"))
+
+		case *ast.ArrayType:
+			if t.Len == nil {
+				if f.tag.length == "" {
+					return errors.New("slices need a len option")
+				}
+				c.lengthCheck(g, f)
+				if elem, ok := t.Elt.(*ast.Ident); ok && (elem.Name == "byte" || elem.Name == "uint8") && f.tag.encoding == "" && f.tag.bits == 0 {
+					g.Id("b").Dot("WriteBytesNext").Call(f.target())
+					return nil
+				}
+			}
+
+			var err error
+			index := loopVariable(f.depth)
+			g.For(jen.Id(index).Op(":=").Range().Add(f.target())).BlockFunc(func(g *jen.Group) {
+				err = c.encode(g, f.elem())
+			})
+			return err
+
+		default:
+			return errors.New("unsupported type")
+		}
+	}
+	return nil
+}
+
+// lengthCheck generates the code that panics if the length of f does not
+// match its len option
+func (c *codecGenerator) lengthCheck(g *jen.Group, f field) {
+	g.If(jen.Id("int64").Call(jen.Len(f.target())).Op("!=").Add(f.length())).
+		Block(jen.Panic(c.crunch("MarshalLengthMismatchError")))
+}
+
+// minBits returns the least amount of bits that a value of the type typ
+// takes up in the encoding described by t. slices and strings whose length
+// is stored elsewhere may be empty, so they count as nothing
+func (c *codecGenerator) minBits(typ ast.Expr, t codecTag) int {
+	if t.bits != 0 {
+		return t.bits
+	}
+
+	switch name := c.basic(typ); {
+	case name == "string":
+		switch {
+		case t.encoding == "cstring", t.prefix < 0:
+			return 8
+		case t.prefix != 0:
+			return t.prefix * 8
+		}
+		return t.width * 8
+	case name != "":
+		switch t.encoding {
+		case "uvarint", "varint", "uleb128", "sleb128":
+			return 8
+		}
+		if t.size != 0 {
+			return t.size * 8
+		}
+		return integerSize(name) * 8
+	}
+
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		definition, ok := c.structs[typ.Name]
+		if !ok {
+			return 0
+		}
+
+		n := 0
+		for _, f := range definition.Fields.List {
+			tag := ""
+			if f.Tag != nil {
+				value, _ := strconv.Unquote(f.Tag.Value)
+				tag = reflect.StructTag(value).Get("crunch")
+			}
+			options, skip, err := parseCodecTag(tag)
+			if err != nil || skip {
+				continue
+			}
+			for _, name := range f.Names {
+				if unicode.IsUpper([]rune(name.Name)[0]) {
+					n += c.minBits(f.Type, options)
+				}
+			}
+		}
+		return n
+	case *ast.ArrayType:
+		length, ok := typ.Len.(*ast.BasicLit)
+		if !ok {
+			return 0
+		}
+		n, _ := strconv.Atoi(length.Value)
+		t.length = ""
+		return n * c.minBits(typ.Elt, t)
+	}
+	return 0
+}
+
+// countCheck generates the code that fails with MarshalInvalidLengthError
+// if the len option of f is not a constant and the rest of the buffer
+// cannot hold as many values that take up at least bits bits each
+func (c *codecGenerator) countCheck(g *jen.Group, f field, bits int) {
+	if _, err := strconv.Atoi(f.tag.length); err == nil {
+		return
+	}
+	countCheck(g, f.length(), bits, c.crunch("MarshalInvalidLengthError"))
+}
+
+// decode generates the code that reads f from the buffer
+func (c *codecGenerator) decode(g *jen.Group, f field) error {
+	name := c.basic(f.typ)
+	ident, _ := f.typ.(*ast.Ident)
+
+	// assign stores value in the field, converting it to its type if it is
+	// not of the predeclared type raw already
+	assign := func(value *jen.Statement, raw string) {
+		if ident != nil && !sameType(ident.Name, raw) {
+			value = jen.Id(ident.Name).Call(value)
+		}
+		g.Add(f.target()).Op("=").Add(value)
+	}
+
+	switch {
+	case name == "bool":
+		if f.tag.bits != 0 {
+			assign(jen.Id("b").Dot("ReadBitsNext").Call(jen.Lit(f.tag.bits)).Op("!=").Lit(0), "bool")
+		} else {
+			assign(jen.Id("b").Dot("ReadByteNext").Call().Op("!=").Lit(0), "bool")
+		}
+
+	case name == "float32" || name == "float64":
+		if f.tag.bits != 0 || (f.tag.encoding != "" && f.tag.encoding != "f") {
+			return errors.New("invalid struct tag")
+		}
+		size := f.tag.size
+		if size == 0 {
+			size = integerSize(name)
+		}
+		assign(jen.Id("b").Dot(scalarName("Read", "F", size, f.tag.little, "AtNext")).Call(),
+			strings.Join([]string{"float", strconv.Itoa(size * 8)}, ""))
+
+	case name == "string":
+		switch {
+		case f.tag.encoding == "cstring":
+			assign(jen.Id("b").Dot("ReadCStringNext").Call(jen.Lit(-1)), "string")
+		case f.tag.prefix != 0:
+			assign(jen.Id("b").Dot("ReadPStringNext").Call(c.crunch(prefixName(f.tag))), "string")
+		case f.tag.width != 0:
+			assign(jen.Id("b").Dot("ReadFixedStringNext").Call(jen.Lit(f.tag.width), jen.Lit(0x00)), "string")
+		case f.tag.length != "":
+			c.countCheck(g, f, 8)
+			assign(jen.String().Call(jen.Id("b").Dot("ReadBytesNext").Call(f.length())), "string")
+		default:
+			return errors.New("strings need a cstring, prefix, size or len option")
+		}
+
+	case name != "":
+		if f.tag.encoding == "f" {
+			return errors.New("invalid struct tag")
+		}
+		signed := name[0] == 'i'
+
+		if f.tag.bits != 0 {
+			value := jen.Id("b").Dot("ReadBitsNext").Call(jen.Lit(f.tag.bits))
+			if signed && f.tag.bits < 64 {
+				shift := 64 - f.tag.bits
+				value = jen.Id("int64").Call(value.Op("<<").Lit(shift)).Op(">>").Lit(shift)
+				assign(value, "int64")
+				return nil
+			}
+			assign(value, "uint64")
+			return nil
+		}
+
+		switch f.tag.encoding {
+		case "uvarint", "uleb128":
+			method := map[string]string{"uvarint": "ReadUvarintNext", "uleb128": "ReadULEB128Next"}[f.tag.encoding]
+			assign(jen.Id("b").Dot(method).Call(), "uint64")
+			return nil
+		case "varint", "sleb128":
+			method := map[string]string{"varint": "ReadVarintNext", "sleb128": "ReadSLEB128Next"}[f.tag.encoding]
+			assign(jen.Id("b").Dot(method).Call(), "int64")
+			return nil
+		}
+
+		size := f.tag.size
+		if size == 0 {
+			size = integerSize(name)
+		}
+		if f.tag.encoding != "" {
+			signed = f.tag.encoding == "i"
+		}
+
+		if size == 1 {
+			if signed {
+				assign(jen.Id("int8").Call(jen.Id("b").Dot("ReadByteNext").Call()), "int8")
+			} else {
+				assign(jen.Id("b").Dot("ReadByteNext").Call(), "byte")
+			}
+			return nil
+		}
+
+		kind, container := "U", "uint"
+		if signed {
+			kind, container = "I", "int"
+		}
+		assign(jen.Id("b").Dot(scalarName("Read", kind, size, f.tag.little, "AtNext")).Call(),
+			strings.Join([]string{container, containerBits[strconv.Itoa(size*8)]}, ""))
+
+	default:
+		switch t := f.typ.(type) {
+		case *ast.Ident:
+			if !c.annotated[t.Name] {
+				return fmt.Errorf("%s does not have a codec", t.Name)
+			}
+			g.Add(f.target()).Dot("DecodeFrom").Call(jen.Id("b"))
+
+		case *ast.ArrayType:
+			if t.Len == nil {
+				if f.tag.length == "" {
+					return errors.New("slices need a len option")
+				}
+				elem := f.tag
+				elem.length = ""
+				c.countCheck(g, f, c.minBits(t.Elt, elem))
+				if elem, ok := t.Elt.(*ast.Ident); ok && (elem.Name == "byte" || elem.Name == "uint8") && f.tag.encoding == "" && f.tag.bits == 0 {
+					// the bytes are copied so that the value does not change
+					// along with the buffer
+					g.Add(f.target()).Op("=").Append(jen.Index().Byte().Parens(jen.Nil()), jen.Id("b").Dot("ReadBytesNext").Call(f.length()).Op("..."))
+					return nil
+				}
+
+				code, err := typeCode(t)
+				if err != nil {
+					return err
+				}
+				g.Add(f.target()).Op("=").Make(code, f.length())
+			}
+
+			var err error
+			index := loopVariable(f.depth)
+			g.For(jen.Id(index).Op(":=").Range().Add(f.target())).BlockFunc(func(g *jen.Group) {
+				err = c.decode(g, f.elem())
+			})
+			return err
+
+		default:
+			return errors.New("unsupported type")
+		}
+	}
+	return nil
+}
+
+// generate generates the EncodeTo and DecodeFrom methods of the struct
+// named name
+func (c *codecGenerator) generate(name string) ([]byte, error) {
+	definition, ok := c.structs[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct type", name)
+	}
+
+	var fields []field
+	for _, f := range definition.Fields.List {
+		if len(f.Names) == 0 {
+			return nil, fmt.Errorf("embedded fields are not supported (in %s)", name)
+		}
+
+		tag := ""
+		if f.Tag != nil {
+			value, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(value).Get("crunch")
+		}
+
+		options, skip, err := parseCodecTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", name, f.Names[0].Name, err)
+		}
+		if skip {
+			continue
+		}
+
+		for _, n := range f.Names {
+			if !unicode.IsUpper([]rune(n.Name)[0]) {
+				continue
+			}
+
+			fieldName := n.Name
+			fields = append(fields, field{
+				target: func() *jen.Statement { return jen.Id("m").Dot(fieldName) },
+				typ:    f.Type,
+				tag:    options,
+				path:   strings.Join([]string{name, fieldName}, "."),
+			})
+		}
+	}
+
+	buffer := jen.Id("b").Op("*").Add(c.crunch("Buffer"))
+	builder := &jen.Group{}
+
+	// bit fields are read and written at the bit offset of the buffer and
+	// every other field at its byte offset, so structs that have both
+	// unify the cursors of the buffer while they are encoded or decoded
+	unify := func(g *jen.Group) {}
+	for _, f := range fields {
+		if f.tag.bits != 0 {
+			unify = func(g *jen.Group) {
+				g.If(jen.Id("b").Dot("CursorMode").Call().Op("==").Add(c.crunch("SeparateCursors"))).Block(
+					jen.Id("b").Dot("SetCursorMode").Call(c.crunch("UnifiedCursor")),
+					jen.Defer().Id("b").Dot("SetCursorMode").Call(c.crunch("SeparateCursors")))
+			}
+			break
+		}
+	}
+
+	var err error
+	builder.Comment(strings.Join([]string{"// EncodeTo writes the fields of m to the buffer at its current offset\n"}, ""))
+	builder.Func().Params(jen.Id("m").Op("*").Id(name)).Id("EncodeTo").Params(buffer).BlockFunc(func(g *jen.Group) {
+		unify(g)
+		for _, f := range fields {
+			if err = c.encode(g, f); err != nil {
+				err = fmt.Errorf("%s: %v", f.path, err)
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Line()
+	builder.Comment(strings.Join([]string{"// DecodeFrom reads the fields of m from the buffer at its current offset\n"}, ""))
+	builder.Func().Params(jen.Id("m").Op("*").Id(name)).Id("DecodeFrom").Params(buffer).BlockFunc(func(g *jen.Group) {
+		unify(g)
+		for _, f := range fields {
+			if err = c.decode(g, f); err != nil {
+				err = fmt.Errorf("%s: %v", f.path, err)
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	err = builder.Render(outputBuffer)
+	return outputBuffer.Bytes(), err
+}
+
+// GenerateCodec searches the provided files for magic comments that look
+// like this:
+//
+// 	//generator:codec <name of a struct type>
+//
+// and replaces each of them with methods that encode and decode the struct
+// without reflection, in this pattern:
+//
+// 	// EncodeTo writes the fields of m to the buffer at its current offset
+// 	func (m *<struct type>) EncodeTo(b *crunch.Buffer) {
+//
+// 		/* unification of the cursors, as the struct has bit fields */
+//
+// 		b.PutU32BENext(m.Magic)
+// 		b.SetBitsNext(uint64(m.Version), 3)
+// 		/* and so on for every other exported field */
+//
+// 	}
+//
+// 	// DecodeFrom reads the fields of m from the buffer at its current offset
+// 	func (m *<struct type>) DecodeFrom(b *crunch.Buffer) {
+//
+// 		/* unification of the cursors, as the struct has bit fields */
+//
+// 		m.Magic = b.ReadU32BEAtNext()
+// 		m.Version = uint8(b.ReadBitsNext(3))
+// 		/* and so on for every other exported field */
+//
+// 	}
+//
+// the encoding of each field is controlled by the same crunch struct tags
+// that Marshal and Unmarshal read. struct fields have to be of a type that
+// has a codec of its own, and EncodeTo panics with
+// MarshalLengthMismatchError if a slice or a string does not have the
+// length given by its len option. DecodeFrom fails with
+// MarshalInvalidLengthError if a length read from the buffer is negative
+// or longer than what is left of it. errors encountered by the buffer are
+// handled the way they always are.
+//
+// the methods of structs that have bit fields switch a buffer that is in
+// SeparateCursors mode to UnifiedCursor mode until they return, so that
+// bit fields and byte fields are laid out one after another.
+//
+// the struct types may be declared in any of the provided files. only the
+// files that contain magic comments are output, with names like this:
+//
+// 	<filename w/o leading underscore>.generated.go
+func GenerateCodec(oldFiles map[string][]byte) (files map[string][]byte, e error) {
+	magicCommentRegex := regexp.MustCompile("(?m)^\\/\\/generator:codec ([A-Za-z_][A-Za-z0-9_]*)$")
+
+	generator := &codecGenerator{
+		structs:   map[string]*ast.StructType{},
+		named:     map[string]ast.Expr{},
+		annotated: map[string]bool{},
+	}
+	qualifiers := map[string]string{}
+
+	fileSet := token.NewFileSet()
+	for name, contents := range oldFiles {
+		file, err := parser.ParseFile(fileSet, name, contents, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		qualifiers[name] = crunchQualifier(file)
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if definition, ok := spec.Type.(*ast.StructType); ok {
+				generator.structs[spec.Name.Name] = definition
+			} else {
+				generator.named[spec.Name.Name] = spec.Type
+			}
+			return false
+		})
+
+		for _, arguments := range magicCommentRegex.FindAllSubmatch(contents, -1) {
+			generator.annotated[string(arguments[1])] = true
+		}
+	}
+
+	files = map[string][]byte{}
+	for name, contents := range oldFiles {
+		if !magicCommentRegex.Match(contents) {
+			continue
+		}
+		fmt.Println("* scanning", name)
+
+		generator.qualifier = qualifiers[name]
+		files[name] = magicCommentRegex.ReplaceAllFunc(contents, func(comment []byte) []byte {
+			typeName := magicCommentRegex.FindSubmatch(comment)[1]
+			fmt.Printf("* invocation. type: %s\n", typeName)
+
+			generated, err := generator.generate(string(typeName))
+			if err != nil {
+				fmt.Println("! unable to generate codec:", err)
+				return []byte(fmt.Sprint("// codec generation failure: ", err))
+			}
+			return generated
+		})
+	}
+	return
+}
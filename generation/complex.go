@@ -21,11 +21,56 @@ import (
 	"github.com/dave/jennifer/jen"
 )
 
+// containerBits maps each supported integer size to the size of the go type
+// that holds it. floats are only available in their native sizes
+var containerBits = map[string]string{
+	"16": "16",
+	"24": "32",
+	"32": "32",
+	"40": "64",
+	"48": "64",
+	"56": "64",
+	"64": "64",
+}
+
+// signExtension returns the amount of bits a signed integer read into a
+// larger type needs to be shifted left and then right by in order to
+// restore its sign. zero is returned if no extension is necessary
+func signExtension(arguments []string) int {
+	if arguments[2] != "I" {
+		return 0
+	}
+
+	size, _ := strconv.Atoi(arguments[3])
+	container, _ := strconv.Atoi(containerBits[arguments[3]])
+	return container - size
+}
+
+// cursor returns the byte offset that a ...Next function named name
+// operates at. Buffer goes through its cursor method so that unified
+// cursors are respected
+func cursor(receiver, name string) *jen.Statement {
+	switch receiver {
+	case "Buffer":
+		return jen.Id("b").Dot("cursor").Call(jen.Lit(name))
+	case "CheckedBuffer":
+		return jen.Id("b").Dot("buf").Dot("off")
+	}
+	return jen.Id("b").Dot("off")
+}
+
+// alignmentCheck returns the statement that makes a CheckedBuffer ...Next
+// function named name return an error when its position is unaligned
+func alignmentCheck(name string) *jen.Statement {
+	return jen.If(jen.Id("err").Op("=").Id("b").Dot("buf").Dot("unaligned").Call(jen.Lit(name)), jen.Id("err").Op("!=").Nil()).
+		Block(jen.Return())
+}
+
 // GenerateComplex takes an array of file names and the prefix for all of them
 // it runs over all of the provided files and searches for "magic comments"
 // that look like this:
 //
-// 	//generator:complex <receiver: Buffer | MiniBuffer> <rw: [read | write]> <sn: [signed | unsigned]> <is: [intsize (restricted to *8 atm)]> <en: [big | little]>
+// 	//generator:complex <receiver: Buffer | MiniBuffer | CheckedBuffer | ChainBuffer | Reader | Writer> <rw: [read | write]> <sn: [signed | unsigned]> <is: [16 | 24 | 32 | 40 | 48 | 56 | 64]> <en: [big | little]>
 //
 // if it finds one, it generates two functions in this pattern:
 //
@@ -34,7 +79,8 @@ import (
 // 	// offset value
 // 	func (b *<receiver>) <naming>(off int64, data []<integer type>) {
 //
-// 		/* standard buffer method prelude omitted for brevity */
+// 		/* standard buffer method prelude omitted for brevity. in the Buffer
+// 		   variants, it either panics or records the error in sticky mode */
 //
 // 		var (
 // 			i = 0
@@ -66,6 +112,16 @@ import (
 //
 // 	}
 //
+// if the receiver is CheckedBuffer, the functions are instead generated by
+// GenerateChecked, which wraps the Buffer ones in bounds checks that return
+// errors. if it is ChainBuffer, Reader or Writer, all of them are generated
+// by GenerateChain, GenerateReader or GenerateWriter. Reader only supports
+// reads and Writer only supports writes.
+//
+// every invocation is then followed by the non-allocating variants of the
+// method, which are generated by GenerateScalar, and the ones that operate
+// at a bit offset, which are generated by GenerateBitAligned.
+//
 // that is (mostly) it. after source tweaking, it outputs each modifed file into a new file with a name
 // like this:
 //
@@ -88,12 +144,12 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 
 			/* argument verification */
 
-			if arguments[0] != "Buffer" && arguments[0] != "MiniBuffer" {
+			if arguments[0] != "Buffer" && arguments[0] != "MiniBuffer" && arguments[0] != "CheckedBuffer" && arguments[0] != "ChainBuffer" && arguments[0] != "Reader" && arguments[0] != "Writer" {
 				fmt.Println("! invalid argument for position 0:", arguments[0])
 				return []byte(fmt.Sprint("// invalid argument provided in position zero:", arguments[0]))
 			}
 
-			if arguments[1] != "Read" && arguments[1] != "Write" {
+			if (arguments[1] != "Read" && arguments[1] != "Write") || (arguments[0] == "Reader" && arguments[1] != "Read") || (arguments[0] == "Writer" && arguments[1] != "Write") {
 				fmt.Println("! invalid argument for position 1:", arguments[1])
 				return []byte(fmt.Sprint("// invalid argument provided in position one:", arguments[1]))
 			}
@@ -103,7 +159,7 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 				return []byte(fmt.Sprint("// invalid argument provided in position two:", arguments[2]))
 			}
 
-			if arguments[3] != "16" && arguments[3] != "32" && arguments[3] != "64" {
+			if _, ok := containerBits[arguments[3]]; !ok || (arguments[2] == "F" && arguments[3] != "32" && arguments[3] != "64") {
 				fmt.Println("! invalid argument for position 3:", arguments[3])
 				return []byte(fmt.Sprint("// invalid argument provided in position three:", arguments[3]))
 			}
@@ -122,7 +178,7 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 						"U": "uint",
 						"F": "float",
 					}[arguments[2]],
-					arguments[3],
+					containerBits[arguments[3]],
 				},
 				"",
 			)
@@ -133,6 +189,55 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 				return []byte(fmt.Sprint("// conversion error:", err))
 			}
 			intBytes := intBits / 8
+
+			if arguments[0] == "Reader" {
+				generated, err := GenerateReader(arguments, intType, intBytes)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+				return generated
+			}
+
+			if arguments[0] == "Writer" {
+				generated, err := GenerateWriter(arguments, intType, intBytes)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+				return generated
+			}
+
+			if arguments[0] == "ChainBuffer" {
+				generated, err := GenerateChain(arguments, intType, intBytes)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+				return generated
+			}
+
+			if arguments[0] == "CheckedBuffer" {
+				generated, err := GenerateChecked(arguments, intType, intBytes)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+
+				scalar, err := GenerateScalar(arguments, intType, intBytes)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+
+				bitAligned, err := GenerateBitAligned(arguments, intType, intBytes)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+				return append(append(generated, scalar...), bitAligned...)
+			}
+
 			functionName := strings.Join([]string{arguments[1], arguments[2], arguments[3], arguments[4]}, "")
 			functionNameNext := strings.Join([]string{functionName, "Next"}, "")
 
@@ -186,20 +291,48 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 
 			function.BlockFunc(func(body *jen.Group) {
 				if arguments[0] == "Buffer" {
+					// a buffer in sticky mode ignores calls after an error
+					body.If(jen.Id("b").Dot("err").Op("!=").Nil()).
+						Block(jen.Return())
+
+					// the errors carry the details of the failed operation
+					failure := func(err string, length jen.Code) *jen.Statement {
+						return jen.Id("b").Dot("fail").Call(jen.Id(err).Dot("at").
+							Call(jen.Lit(functionName), jen.Id("off"), length, jen.Id("b").Dot("cap")))
+					}
+
+					// negative arguments are rejected first so that the bounds
+					// can be compared without overflowing
 					if arguments[1] == "Read" {
-						body.If(jen.Parens(jen.Id("off").Op("+").Id("n").Op("*").Lit(intBytes)).Op(">").Id("b").Dot("cap")).
-							Block(jen.Panic(jen.Id("BufferOverreadError")))
+						body.If(jen.Id("n").Op("<").Lit(0x00)).
+							Block(jen.Id("b").Dot("fail").Call(jen.Id("BufferInvalidByteCountError").Dot("count").
+								Call(jen.Lit(functionName), jen.Id("n"), jen.Id("b").Dot("cap"))), jen.Return())
 						body.If(jen.Id("off").Op("<").Lit(0x00)).
-							Block(jen.Panic(jen.Id("BufferUnderreadError")))
+							Block(failure("BufferUnderreadError", jen.Id("n").Op("*").Lit(intBytes)), jen.Return())
+						body.If(jen.Op("!").Id("b").Dot("readable").Call(jen.Id("off"), jen.Id("n"), jen.Lit(intBytes))).
+							Block(failure("BufferOverreadError", jen.Id("n").Op("*").Lit(intBytes)), jen.Return())
 					} else {
-						body.If(jen.Parens(jen.Id("off").Op("+").Id("int64").
-							Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)).Op(">").Id("b").Dot("cap")).
-							Block(jen.Panic(jen.Id("BufferOverwriteError")))
 						body.If(jen.Id("off").Op("<").Lit(0x00)).
-							Block(jen.Panic(jen.Id("BufferUnderwriteError")))
+							Block(failure("BufferUnderwriteError", jen.Id("int64").Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)), jen.Return())
+
+						// writes past the end grow the buffer if it is allowed to
+						body.If(jen.Op("!").Id("b").Dot("writable").Call(jen.Id("off"), jen.Id("int64").
+							Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes))).
+							Block(failure("BufferOverwriteError", jen.Id("int64").Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)), jen.Return())
+
+						// the overwritten bytes are journaled while a mark is active
+						body.Id("b").Dot("save").Call(jen.Id("off"), jen.Id("int64").Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes))
 					}
 				}
 
+				if arguments[0] == "MiniBuffer" && arguments[1] == "Write" {
+					// MiniBuffer has no bounds checks, but it still grows if it is allowed to
+					body.If(jen.Id("b").Dot("grow").Op("&&").Parens(jen.Id("off").Op("+").Id("int64").
+						Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)).Op(">").Id("b").Dot("cap")).
+						Block(jen.Id("b").Dot("reserve").Call(jen.Id("off").Op("+").Id("int64").
+							Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes)))
+				}
+
 				if arguments[1] == "Read" {
 					// read generation code
 					if arguments[0] == "Buffer" {
@@ -301,6 +434,16 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 									Call(jen.Op("&").Id("u")))
 							}
 						}
+						if shift := signExtension(arguments); shift > 0 {
+							// the sign bit of the value is not the sign bit of the type holding it
+							var element *jen.Statement
+							if arguments[0] == "Buffer" {
+								element = jen.Id("out").Index(jen.Id("i"))
+							} else {
+								element = jen.Parens(jen.Op("*").Id("out")).Index(jen.Id("i"))
+							}
+							loop.Add(element).Op("=").Add(element).Op("<<").Lit(shift).Op(">>").Lit(shift)
+						}
 						loop.Id("i").Op("++")
 						loop.If(jen.Id("i").Op("<").Id("n")).
 							Block(jen.Goto().Id("read_loop"))
@@ -429,14 +572,14 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 			function.BlockFunc(func(body *jen.Group) {
 				if arguments[1] == "Write" {
 					body.Id("b").Dot(functionName).
-						Call(jen.Id("b").Dot("off"), jen.Id("data"))
+						Call(cursor(arguments[0], functionNameNext), jen.Id("data"))
 					body.Id("b").Dot("SeekByte").
 						Call(jen.Id("int64").
 							Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes), jen.Lit(true))
 				} else if arguments[1] == "Read" {
 					if arguments[0] == "Buffer" {
 						body.Id("out").Op("=").Id("b").Dot(functionName).
-							Call(jen.Id("b").Dot("off"), jen.Id("n"))
+							Call(cursor(arguments[0], functionNameNext), jen.Id("n"))
 					} else {
 						body.Id("b").Dot(functionName).
 							Call(jen.Id("out"), jen.Id("b").Dot("off"), jen.Id("n"))
@@ -457,6 +600,20 @@ func GenerateComplex(oldFiles map[string][]byte) (files map[string][]byte, e err
 				return []byte("// render failure")
 			}
 
+			scalar, err := GenerateScalar(arguments, intType, intBytes)
+			if err != nil {
+				fmt.Println("! unable to render code:", err)
+				return []byte("// render failure")
+			}
+			_, _ = outputBuffer.Write(scalar)
+
+			bitAligned, err := GenerateBitAligned(arguments, intType, intBytes)
+			if err != nil {
+				fmt.Println("! unable to render code:", err)
+				return []byte("// render failure")
+			}
+			_, _ = outputBuffer.Write(bitAligned)
+
 			return outputBuffer.Bytes()
 		})
 	}
@@ -97,13 +97,25 @@ func main() {
 			fmt.Printf("! @ GenerateComplex() %v\n", err)
 			return
 		}
+	case "codec":
+		generated, err = GenerateCodec(files)
+		if err != nil {
+			fmt.Printf("! @ GenerateCodec() %v\n", err)
+			return
+		}
+	case "schema":
+		generated, err = GenerateSchema(files)
+		if err != nil {
+			fmt.Printf("! @ GenerateSchema() %v\n", err)
+			return
+		}
 	default:
 		fmt.Println("! no valid generation mode specified")
 		return
 	}
 
 	for name, contents := range generated {
-		file, err := os.OpenFile(strings.Join([]string{strings.TrimSuffix(strings.TrimPrefix(name, "_"), ".go"), ".generated.go"}, ""), os.O_RDWR|os.O_CREATE, 0644)
+		file, err := os.OpenFile(strings.Join([]string{strings.TrimSuffix(strings.TrimPrefix(name, "_"), ".go"), ".generated.go"}, ""), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 		if err != nil {
 			fmt.Printf("! unable to open source file. (%v)\n", err)
 			continue
@@ -0,0 +1,137 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// GenerateReader generates the Reader variants of a complex read method and
+// of the ones that GenerateScalar and GenerateBitAligned output alongside
+// it. as a Reader only moves forward, only the ...Next variants are
+// generated. like the ChainBuffer ones, each of them points the scratch
+// Buffer of the Reader at the bytes it consumes and calls the Buffer method
+// it is a variant of on it. it is called by GenerateComplex with the
+// already-verified arguments of a magic comment and outputs functions in
+// this pattern:
+//
+// 	// <naming>AtNext reads a <integer type> from the input ...
+// 	func (b *Reader) <naming>AtNext() (out <integer type>) {
+//
+// 		if w := b.window("<naming>AtNext", 1, <number of bits / 8>); w != nil {
+//
+// 			out = w.<naming>At(0)
+//
+// 		}
+// 		return
+//
+// 	}
+//
+// window is passed the amount of values and their size separately so that
+// it can reject a count whose length in bytes would overflow. the
+// bit-aligned methods use bitWindow instead of window
+func GenerateReader(arguments []string, intType string, intBytes int) ([]byte, error) {
+	naming := strings.Join(arguments[2:5], "")
+	endianness := map[string]string{
+		"BE": "big-endian",
+		"LE": "little-endian",
+	}[arguments[4]]
+	article := "a"
+	if intType[0] == 'i' {
+		article = "an"
+	}
+
+	// delegate generates a call to the Buffer method named method on the
+	// scratch buffer, which is only made if the input could be read
+	delegate := func(name, method string, bits bool, length []jen.Code, condition *jen.Statement, call []jen.Code, result bool) func(*jen.Group) {
+		return func(g *jen.Group) {
+			var view *jen.Statement
+			if bits {
+				view = jen.List(jen.Id("w"), jen.Id("bit")).Op(":=").Id("b").Dot("bitWindow").Call(append([]jen.Code{jen.Lit(name)}, length...)...)
+			} else {
+				view = jen.Id("w").Op(":=").Id("b").Dot("window").Call(append([]jen.Code{jen.Lit(name)}, length...)...)
+			}
+
+			check := jen.Id("w").Op("!=").Nil()
+			if condition != nil {
+				check = check.Op("&&").Add(condition)
+			}
+
+			g.If(view, check).BlockFunc(func(g *jen.Group) {
+				if result {
+					g.Id("out").Op("=").Id("w").Dot(method).Call(call...)
+				} else {
+					g.Id("w").Dot(method).Call(call...)
+				}
+			})
+			if result {
+				g.Return()
+			}
+		}
+	}
+
+	slice := strings.Join([]string{"Read", naming}, "")
+	at := strings.Join([]string{"Read", naming, "At"}, "")
+	into := strings.Join([]string{"Read", naming, "Into"}, "")
+	aligned := strings.Join([]string{"Read", naming, "Bits"}, "")
+
+	functions := []scalarFunction{
+		{
+			name: strings.Join([]string{slice, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{slice, "Next reads a slice of ", intType, "s from the input in"}, ""),
+				strings.Join([]string{endianness, " and moves the position forward the amount of bytes read"}, ""),
+			},
+			params:  []jen.Code{jen.Id("n").Int64()},
+			results: []jen.Code{jen.Id("out").Index().Id(intType)},
+			body: delegate(strings.Join([]string{slice, "Next"}, ""), slice, false, []jen.Code{jen.Id("n"), jen.Lit(intBytes)},
+				jen.Id("n").Op(">").Lit(0x00), []jen.Code{jen.Lit(0x00), jen.Id("n")}, true),
+		},
+		{
+			name: strings.Join([]string{at, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{at, "Next reads ", article, " ", intType, " from the input in"}, ""),
+				strings.Join([]string{endianness, " and moves the position forward the amount of bytes read"}, ""),
+			},
+			results: []jen.Code{jen.Id("out").Id(intType)},
+			body: delegate(strings.Join([]string{at, "Next"}, ""), at, false, []jen.Code{jen.Lit(1), jen.Lit(intBytes)},
+				nil, []jen.Code{jen.Lit(0x00)}, true),
+		},
+		{
+			name: strings.Join([]string{into, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{into, "Next reads len(dst) ", intType, "s from the input in"}, ""),
+				strings.Join([]string{endianness, " into dst and moves the position forward the amount of bytes read"}, ""),
+			},
+			params: []jen.Code{jen.Id("dst").Index().Id(intType)},
+			body: delegate(strings.Join([]string{into, "Next"}, ""), into, false, []jen.Code{jen.Id("int64").Call(jen.Len(jen.Id("dst"))), jen.Lit(intBytes)},
+				nil, []jen.Code{jen.Id("dst"), jen.Lit(0x00)}, false),
+		},
+		{
+			name: strings.Join([]string{aligned, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{aligned, "Next reads ", article, " ", intType, " from the input in"}, ""),
+				strings.Join([]string{endianness, " at the current bit position and moves it forward the amount of bits read"}, ""),
+			},
+			results: []jen.Code{jen.Id("out").Id(intType)},
+			body: delegate(strings.Join([]string{aligned, "Next"}, ""), aligned, true, []jen.Code{jen.Lit(intBytes * 8)},
+				nil, []jen.Code{jen.Id("bit")}, true),
+		},
+	}
+
+	// the magic comment is already surrounded by blank lines
+	generated, err := renderFunctions("Reader", functions)
+	return bytes.TrimLeft(generated, "\n"), err
+}
@@ -0,0 +1,423 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// scalarFunction describes one of the functions generated by GenerateScalar
+type scalarFunction struct {
+	name    string
+	comment []string
+	params  []jen.Code
+	results []jen.Code
+	body    func(*jen.Group)
+}
+
+// GenerateScalar generates the variants of a complex method that do not
+// allocate. it is called by GenerateComplex with the already-verified
+// arguments of a magic comment. for a read, it outputs functions in this
+// pattern:
+//
+// 	// Read<naming>At reads a <integer type> from the buffer at the specified
+// 	// offset in <big-endian | little-endian> without modifying the internal
+// 	// offset value
+// 	func (b *Buffer) Read<naming>At(off int64) (out <integer type>) {
+//
+// 		/* standard buffer method prelude omitted for brevity */
+//
+// 		out = <integer type>(b.buf[off]) | <integer type>(b.buf[off+1])<<8 /* ... */
+// 		return
+//
+// 	}
+//
+// 	// Read<naming>Into reads len(dst) <integer type>s from the buffer at the
+// 	// specified offset in <big-endian | little-endian> into dst without
+// 	// modifying the internal offset value
+// 	func (b *Buffer) Read<naming>Into(dst []<integer type>, off int64) {
+//
+// 		/* standard buffer method prelude omitted for brevity */
+//
+// 		for i := range dst {
+//
+// 			o := off + int64(i)*<number of bits / 8>
+// 			dst[i] = <integer type>(b.buf[o]) | <integer type>(b.buf[o+1])<<8 /* ... */
+//
+// 		}
+//
+// 	}
+//
+// and for a write, it outputs functions in this pattern:
+//
+// 	// Put<naming> writes a <integer type> to the buffer at the specified
+// 	// offset in <big-endian | little-endian> without modifying the internal
+// 	// offset value
+// 	func (b *Buffer) Put<naming>(off int64, data <integer type>) {
+//
+// 		/* standard buffer method prelude omitted for brevity */
+//
+// 		b.buf[off] = byte(data)
+// 		b.buf[off+1] = byte(data >> 8)
+// 		/* ... */
+//
+// 	}
+//
+// each of them also gets a ...Next variant that operates at the current offset
+// and moves it forward. the MiniBuffer variants store their results in an out
+// parameter and the CheckedBuffer ones return an error alongside them
+func GenerateScalar(arguments []string, intType string, intBytes int) ([]byte, error) {
+	receiver := arguments[0]
+	naming := strings.Join(arguments[2:5], "")
+	uintType := strings.Join([]string{"uint", arguments[3]}, "")
+	endianness := map[string]string{
+		"BE": "big-endian",
+		"LE": "little-endian",
+	}[arguments[4]]
+	article := "a"
+	if intType[0] == 'i' {
+		article = "an"
+	}
+
+	// shift returns the amount of bits the kth byte of a value is shifted by
+	shift := func(k int) int {
+		if arguments[4] == "BE" {
+			return (intBytes - 1 - k) * 8
+		}
+		return k * 8
+	}
+
+	// index returns the kth byte of the value located at base
+	index := func(base string, k int) *jen.Statement {
+		if k == 0 {
+			return jen.Id("b").Dot("buf").Index(jen.Id(base))
+		}
+		return jen.Id("b").Dot("buf").Index(jen.Id(base).Op("+").Lit(k))
+	}
+
+	// decode assigns the value located at base to target
+	decode := func(g *jen.Group, target jen.Code, base string) {
+		valueType := intType
+		if arguments[2] == "F" {
+			valueType = uintType
+		}
+
+		chain := jen.Null()
+		for k := 0; k < intBytes; k++ {
+			if k > 0 {
+				chain = chain.Op("|")
+			}
+			chain = chain.Id(valueType).Call(index(base, k))
+			if shift(k) > 0 {
+				chain = chain.Op("<<").Lit(shift(k))
+			}
+		}
+
+		if arguments[2] == "F" {
+			g.Id("u").Op(":=").Add(chain)
+			g.Add(target).Op("=").Op("*").Parens(jen.Op("*").Id(intType)).Parens(jen.Id("unsafe").Dot("Pointer").
+				Call(jen.Op("&").Id("u")))
+		} else {
+			g.Add(target).Op("=").Add(chain)
+		}
+
+		if shift := signExtension(arguments); shift > 0 {
+			g.Add(target).Op("=").Add(target).Op("<<").Lit(shift).Op(">>").Lit(shift)
+		}
+	}
+
+	// encode writes data to the buffer at base
+	encode := func(g *jen.Group, base string) {
+		value := "data"
+		if arguments[2] == "F" {
+			g.Id("u").Op(":=").Op("*").Parens(jen.Op("*").Id(uintType)).Parens(jen.Id("unsafe").Dot("Pointer").
+				Call(jen.Op("&").Id("data")))
+			value = "u"
+		}
+
+		for k := 0; k < intBytes; k++ {
+			conversion := jen.Id(value)
+			if shift(k) > 0 {
+				conversion = conversion.Op(">>").Lit(shift(k))
+			}
+			g.Add(index(base, k)).Op("=").Id("byte").Call(conversion)
+		}
+	}
+
+	// prelude generates the bounds checks of the Buffer and CheckedBuffer
+	// functions, along with automatic growth
+	prelude := func(g *jen.Group, name string, length func() *jen.Statement) {
+		buf := jen.Id("b")
+		if receiver == "CheckedBuffer" {
+			buf = jen.Id("b").Dot("buf")
+		}
+
+		if receiver == "MiniBuffer" {
+			if arguments[1] == "Write" {
+				g.If(jen.Id("b").Dot("grow").Op("&&").Parens(jen.Id("off").Op("+").Add(length())).Op(">").Id("b").Dot("cap")).
+					Block(jen.Id("b").Dot("reserve").Call(jen.Id("off").Op("+").Add(length())))
+			}
+			return
+		}
+
+		failure := func(err string) jen.Code {
+			e := jen.Id(err).Dot("at").Call(jen.Lit(name), jen.Id("off"), length(), jen.Add(buf).Dot("cap"))
+			if receiver == "CheckedBuffer" {
+				return jen.Id("err").Op("=").Add(e)
+			}
+			return jen.Id("b").Dot("fail").Call(e)
+		}
+
+		// an error recorded by a buffer in sticky mode is returned again
+		if receiver == "Buffer" {
+			g.If(jen.Id("b").Dot("err").Op("!=").Nil()).
+				Block(jen.Return())
+		} else {
+			g.If(jen.Id("err").Op("=").Id("b").Dot("buf").Dot("err"), jen.Id("err").Op("!=").Nil()).
+				Block(jen.Return())
+		}
+
+		// the offset is checked first so that the remaining capacity can
+		// be computed without overflowing
+		if arguments[1] == "Read" {
+			g.If(jen.Id("off").Op("<").Lit(0x00)).
+				Block(failure("BufferUnderreadError"), jen.Return())
+			g.If(length().Op(">").Add(buf).Dot("cap").Op("-").Id("off")).
+				Block(failure("BufferOverreadError"), jen.Return())
+		} else {
+			g.If(jen.Id("off").Op("<").Lit(0x00)).
+				Block(failure("BufferUnderwriteError"), jen.Return())
+			g.If(jen.Op("!").Add(buf).Dot("writable").Call(jen.Id("off"), length())).
+				Block(failure("BufferOverwriteError"), jen.Return())
+
+			// the overwritten bytes are journaled while a mark is active
+			if receiver == "Buffer" {
+				g.Id("b").Dot("save").Call(jen.Id("off"), length())
+			}
+		}
+	}
+
+	single := func() *jen.Statement {
+		return jen.Lit(intBytes)
+	}
+	multiple := func() *jen.Statement {
+		return jen.Id("int64").Call(jen.Len(jen.Id("dst"))).Op("*").Lit(intBytes)
+	}
+
+	// next generates the body of a ...Next function, which calls the
+	// function it wraps at the current offset and moves the offset forward
+	next := func(name string, args []jen.Code, results []jen.Code, length func() *jen.Statement) func(*jen.Group) {
+		return func(g *jen.Group) {
+			call := jen.Id("b").Dot(name).Call(append(args, cursor(receiver, strings.Join([]string{name, "Next"}, "")))...)
+			if receiver == "CheckedBuffer" {
+				g.Add(alignmentCheck(strings.Join([]string{name, "Next"}, "")))
+				g.List(results...).Op("=").Add(call)
+				g.If(jen.Id("err").Op("==").Nil()).
+					Block(jen.Id("b").Dot("buf").Dot("SeekByte").Call(length(), jen.Lit(true)))
+				g.Return()
+				return
+			}
+
+			if len(results) > 0 {
+				g.List(results...).Op("=").Add(call)
+			} else {
+				g.Add(call)
+			}
+			g.Id("b").Dot("SeekByte").Call(length(), jen.Lit(true))
+			if len(results) > 0 {
+				g.Return()
+			}
+		}
+	}
+
+	// target is where the read value ends up
+	target := ""
+	if receiver == "MiniBuffer" {
+		target = " into out"
+	}
+
+	var functions []scalarFunction
+	if arguments[1] == "Read" {
+		at := strings.Join([]string{"Read", naming, "At"}, "")
+		into := strings.Join([]string{"Read", naming, "Into"}, "")
+
+		atFunction := scalarFunction{
+			name: at,
+			comment: []string{
+				strings.Join([]string{at, " reads ", article, " ", intType, target, " from the buffer at the specified offset"}, ""),
+				strings.Join([]string{"in ", endianness, " without modifying the internal offset value"}, ""),
+			},
+		}
+		atNextFunction := scalarFunction{
+			name: strings.Join([]string{at, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{at, "Next reads ", article, " ", intType, target, " from the buffer at the current offset"}, ""),
+				strings.Join([]string{"in ", endianness, " and moves the offset forward the amount of bytes read"}, ""),
+			},
+		}
+		intoFunction := scalarFunction{
+			name: into,
+			comment: []string{
+				strings.Join([]string{into, " reads len(dst) ", intType, "s from the buffer at the specified"}, ""),
+				strings.Join([]string{"offset in ", endianness, " into dst without modifying the internal offset value"}, ""),
+			},
+			params: []jen.Code{jen.Id("dst").Index().Id(intType), jen.Id("off").Id("int64")},
+		}
+		intoNextFunction := scalarFunction{
+			name: strings.Join([]string{into, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{into, "Next reads len(dst) ", intType, "s from the buffer at the current"}, ""),
+				strings.Join([]string{"offset in ", endianness, " into dst and moves the offset forward the amount of bytes read"}, ""),
+			},
+			params: []jen.Code{jen.Id("dst").Index().Id(intType)},
+		}
+
+		switch receiver {
+		case "Buffer":
+			atFunction.params = []jen.Code{jen.Id("off").Id("int64")}
+			atFunction.results = []jen.Code{jen.Id("out").Id(intType)}
+			atFunction.body = func(g *jen.Group) {
+				prelude(g, at, single)
+				decode(g, jen.Id("out"), "off")
+				g.Return()
+			}
+			atNextFunction.results = []jen.Code{jen.Id("out").Id(intType)}
+			atNextFunction.body = next(at, nil, []jen.Code{jen.Id("out")}, single)
+
+		case "MiniBuffer":
+			atFunction.params = []jen.Code{jen.Id("out").Op("*").Id(intType), jen.Id("off").Id("int64")}
+			atFunction.body = func(g *jen.Group) {
+				decode(g, jen.Op("*").Id("out"), "off")
+			}
+			atNextFunction.params = []jen.Code{jen.Id("out").Op("*").Id(intType)}
+			atNextFunction.body = next(at, []jen.Code{jen.Id("out")}, nil, single)
+
+		case "CheckedBuffer":
+			atFunction.params = []jen.Code{jen.Id("off").Id("int64")}
+			atFunction.results = []jen.Code{jen.Id("out").Id(intType), jen.Id("err").Id("error")}
+			atFunction.body = func(g *jen.Group) {
+				prelude(g, at, single)
+				g.Id("out").Op("=").Id("b").Dot("buf").Dot(at).Call(jen.Id("off"))
+				g.Return()
+			}
+			atNextFunction.results = []jen.Code{jen.Id("out").Id(intType), jen.Id("err").Id("error")}
+			atNextFunction.body = next(at, nil, []jen.Code{jen.Id("out"), jen.Id("err")}, single)
+		}
+
+		if receiver == "CheckedBuffer" {
+			intoFunction.results = []jen.Code{jen.Id("err").Id("error")}
+			intoFunction.body = func(g *jen.Group) {
+				prelude(g, into, multiple)
+				g.Id("b").Dot("buf").Dot(into).Call(jen.Id("dst"), jen.Id("off"))
+				g.Return()
+			}
+			intoNextFunction.results = []jen.Code{jen.Id("err").Id("error")}
+			intoNextFunction.body = next(into, []jen.Code{jen.Id("dst")}, []jen.Code{jen.Id("err")}, multiple)
+		} else {
+			intoFunction.body = func(g *jen.Group) {
+				prelude(g, into, multiple)
+				g.For(jen.Id("i").Op(":=").Range().Id("dst")).BlockFunc(func(loop *jen.Group) {
+					loop.Id("o").Op(":=").Id("off").Op("+").Id("int64").Call(jen.Id("i")).Op("*").Lit(intBytes)
+					decode(loop, jen.Id("dst").Index(jen.Id("i")), "o")
+				})
+			}
+			intoNextFunction.body = next(into, []jen.Code{jen.Id("dst")}, nil, multiple)
+		}
+
+		functions = []scalarFunction{atFunction, atNextFunction, intoFunction, intoNextFunction}
+	} else {
+		put := strings.Join([]string{"Put", naming}, "")
+
+		putFunction := scalarFunction{
+			name: put,
+			comment: []string{
+				strings.Join([]string{put, " writes ", article, " ", intType, " to the buffer at the specified offset"}, ""),
+				strings.Join([]string{"in ", endianness, " without modifying the internal offset value"}, ""),
+			},
+			params: []jen.Code{jen.Id("off").Id("int64"), jen.Id("data").Id(intType)},
+		}
+		putNextFunction := scalarFunction{
+			name: strings.Join([]string{put, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{put, "Next writes ", article, " ", intType, " to the buffer at the current offset"}, ""),
+				strings.Join([]string{"in ", endianness, " and moves the offset forward the amount of bytes written"}, ""),
+			},
+			params: []jen.Code{jen.Id("data").Id(intType)},
+		}
+
+		if receiver == "CheckedBuffer" {
+			putFunction.results = []jen.Code{jen.Id("err").Id("error")}
+			putFunction.body = func(g *jen.Group) {
+				prelude(g, put, single)
+				g.Id("b").Dot("buf").Dot(put).Call(jen.Id("off"), jen.Id("data"))
+				g.Return()
+			}
+			putNextFunction.results = []jen.Code{jen.Id("err").Id("error")}
+			putNextFunction.body = func(g *jen.Group) {
+				g.Add(alignmentCheck(strings.Join([]string{put, "Next"}, "")))
+				g.Id("err").Op("=").Id("b").Dot(put).Call(cursor(receiver, strings.Join([]string{put, "Next"}, "")), jen.Id("data"))
+				g.If(jen.Id("err").Op("==").Nil()).
+					Block(jen.Id("b").Dot("buf").Dot("SeekByte").Call(single(), jen.Lit(true)))
+				g.Return()
+			}
+		} else {
+			putFunction.body = func(g *jen.Group) {
+				prelude(g, put, single)
+				encode(g, "off")
+			}
+			putNextFunction.body = func(g *jen.Group) {
+				g.Id("b").Dot(put).Call(cursor(receiver, strings.Join([]string{put, "Next"}, "")), jen.Id("data"))
+				g.Id("b").Dot("SeekByte").Call(single(), jen.Lit(true))
+			}
+		}
+
+		functions = []scalarFunction{putFunction, putNextFunction}
+	}
+
+	return renderFunctions(receiver, functions)
+}
+
+// renderFunctions renders the functions generated by GenerateScalar and
+// GenerateBitAligned, noting the error returned by the CheckedBuffer ones
+// in their doc comments
+func renderFunctions(receiver string, functions []scalarFunction) ([]byte, error) {
+	outputBuffer := bytes.NewBuffer([]byte{})
+	for _, function := range functions {
+		if receiver == "CheckedBuffer" {
+			last := len(function.comment) - 1
+			function.comment[last] = strings.Join([]string{function.comment[last], "."}, "")
+			function.comment = append(function.comment, "an error is returned if the operation is out of bounds")
+		}
+
+		builder := &jen.Group{}
+		for _, line := range function.comment {
+			builder.Comment(strings.Join([]string{"// ", line, "\n"}, ""))
+		}
+
+		definition := builder.Func().Params(jen.Id("b").Op("*").Id(receiver)).Id(function.name).Params(function.params...)
+		if len(function.results) > 0 {
+			definition.Params(function.results...)
+		}
+		definition.BlockFunc(function.body)
+
+		_, _ = outputBuffer.Write([]byte("\n\n"))
+		err := builder.Render(outputBuffer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outputBuffer.Bytes(), nil
+}
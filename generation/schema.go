@@ -0,0 +1,909 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+)
+
+var (
+	schemaIdentRegex = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+	schemaIntRegex   = regexp.MustCompile("^([ui])(8|16|24|32|40|48|56|64)(le|be)?$")
+	schemaFloatRegex = regexp.MustCompile("^f(32|64)(le|be)?$")
+	schemaBitsRegex  = regexp.MustCompile("^b([0-9]{1,2})$")
+)
+
+// schemaStruct is a struct declared in a schema
+type schemaStruct struct {
+	name   string
+	fields []*schemaField
+}
+
+// schemaField is a field of a schemaStruct
+type schemaField struct {
+	// name is the name of the field in go
+	name string
+	typ  *schemaType
+
+	// sized is the integer type the byte length of the field is stored
+	// in before it, or nil if it is not stored
+	sized *schemaType
+
+	// condition is the go code of the condition the field is present
+	// under, or empty if it always is
+	condition string
+}
+
+// schemaType is the type of a schemaField
+type schemaType struct {
+	// kind is one of int, float, bits, flag, varint, bytes, str, fixed,
+	// cstring, pstring, struct or repeat
+	kind   string
+	signed bool
+	little bool
+
+	// size is the amount of bytes taken up by an int or a float, or the
+	// amount of bits taken up by bits
+	size int
+
+	// encoding is the name of the encoding of a varint or the name of
+	// the StringPrefix of a pstring
+	encoding string
+
+	// length is the go code of the length of bytes, str and fixed, or the
+	// amount of elements of a repeat
+	length string
+
+	// count is the amount of elements of a repeat with a constant one, or
+	// -1 if it has to be computed
+	count int
+
+	// name is the name of a struct
+	name string
+
+	// elem is the type of the elements of a repeat
+	elem *schemaType
+}
+
+// schemaParser parses a single schema file
+type schemaParser struct {
+	file    string
+	line    int
+	little  bool
+	structs []*schemaStruct
+	names   map[string]bool
+
+	// visible maps the names of the fields declared so far in the struct
+	// being parsed to their go names
+	visible map[string]string
+}
+
+// fail returns an error at the current line of the schema
+func (p *schemaParser) fail(format string, arguments ...interface{}) error {
+	return fmt.Errorf("%s:%d: %s", p.file, p.line, fmt.Sprintf(format, arguments...))
+}
+
+// goName converts the name of a schema field to the name of an exported
+// go field, so that some_field becomes SomeField
+func goName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		if part != "" {
+			runes := []rune(part)
+			runes[0] = unicode.ToUpper(runes[0])
+			parts[i] = string(runes)
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// expression parses a go expression that refers to the fields declared
+// before it and returns it as code that refers to them through m
+func (p *schemaParser) expression(text string) (string, error) {
+	expr, err := parser.ParseExpr(text)
+	if err != nil {
+		return "", p.fail("invalid expression %q", text)
+	}
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.SelectorExpr:
+			// the fields of substructures are referred to by their
+			// names in the schema as well
+			root := node.X
+			for selector, ok := root.(*ast.SelectorExpr); ok; selector, ok = root.(*ast.SelectorExpr) {
+				root = selector.X
+			}
+			if ident, ok := root.(*ast.Ident); ok {
+				if _, ok := p.visible[ident.Name]; ok {
+					node.Sel.Name = goName(node.Sel.Name)
+				}
+			}
+		case *ast.Ident:
+			if name, ok := p.visible[node.Name]; ok {
+				node.Name = strings.Join([]string{"m.", name}, "")
+			}
+		}
+		return true
+	})
+
+	output := bytes.NewBuffer([]byte{})
+	_ = printer.Fprint(output, token.NewFileSet(), expr)
+	return output.String(), nil
+}
+
+// split splits text in front of the first space that is not enclosed in
+// brackets or parentheses
+func split(text string) (head, tail string) {
+	depth := 0
+	for i, c := range text {
+		switch {
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case unicode.IsSpace(c) && depth == 0:
+			return text[:i], strings.TrimSpace(text[i:])
+		}
+	}
+	return text, ""
+}
+
+// parseType parses the type of a field
+func (p *schemaParser) parseType(text string) (*schemaType, error) {
+	if strings.HasPrefix(text, "[") {
+		end := strings.IndexByte(text, ']')
+		if end < 0 {
+			return nil, p.fail("unterminated repeat %q", text)
+		}
+
+		elem, err := p.parseType(text[end+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		t := &schemaType{kind: "repeat", elem: elem, count: -1}
+		if n, err := strconv.Atoi(text[1:end]); err == nil && n >= 0 {
+			t.count = n
+		} else if t.length, err = p.expression(text[1:end]); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+
+	if i := strings.IndexByte(text, '('); i >= 0 && strings.HasSuffix(text, ")") {
+		name, argument := text[:i], text[i+1:len(text)-1]
+		switch name {
+		case "bytes", "str", "fixed":
+			length, err := p.expression(argument)
+			if err != nil {
+				return nil, err
+			}
+			return &schemaType{kind: name, length: length}, nil
+
+		case "pstring":
+			if argument == "uvarint" {
+				return &schemaType{kind: name, encoding: "PrefixUvarint"}, nil
+			}
+			prefix, err := p.parseType(argument)
+			if err != nil || prefix.kind != "int" || prefix.signed || (prefix.size != 1 && prefix.size != 2 && prefix.size != 4) {
+				return nil, p.fail("invalid string prefix %q", argument)
+			}
+			if prefix.size == 1 {
+				return &schemaType{kind: name, encoding: "PrefixU8"}, nil
+			}
+			return &schemaType{kind: name, encoding: strings.Join([]string{"PrefixU", strconv.Itoa(prefix.size * 8), endianness(prefix.little)}, "")}, nil
+		}
+		return nil, p.fail("unknown type %q", name)
+	}
+
+	switch {
+	case schemaIntRegex.MatchString(text):
+		arguments := schemaIntRegex.FindStringSubmatch(text)
+		bits, _ := strconv.Atoi(arguments[2])
+		return &schemaType{kind: "int", signed: arguments[1] == "i", size: bits / 8, little: p.order(arguments[3])}, nil
+
+	case schemaFloatRegex.MatchString(text):
+		arguments := schemaFloatRegex.FindStringSubmatch(text)
+		bits, _ := strconv.Atoi(arguments[1])
+		return &schemaType{kind: "float", size: bits / 8, little: p.order(arguments[2])}, nil
+
+	case schemaBitsRegex.MatchString(text):
+		bits, _ := strconv.Atoi(schemaBitsRegex.FindStringSubmatch(text)[1])
+		if bits < 1 || bits > 64 {
+			return nil, p.fail("invalid bit count %d", bits)
+		}
+		return &schemaType{kind: "bits", size: bits}, nil
+
+	case text == "flag", text == "cstring":
+		return &schemaType{kind: text}, nil
+
+	case text == "uvarint", text == "varint", text == "uleb128", text == "sleb128":
+		return &schemaType{kind: "varint", encoding: text}, nil
+
+	case schemaIdentRegex.MatchString(text):
+		return &schemaType{kind: "struct", name: text}, nil
+	}
+	return nil, p.fail("unknown type %q", text)
+}
+
+// order returns whether or not the endianness suffix of a type selects
+// little-endian, falling back to the default of the schema
+func (p *schemaParser) order(suffix string) bool {
+	if suffix == "" {
+		return p.little
+	}
+	return suffix == "le"
+}
+
+// endianness returns the suffix of the names of generated methods that
+// operate in the selected endianness
+func endianness(little bool) string {
+	if little {
+		return "LE"
+	}
+	return "BE"
+}
+
+// parseField parses the declaration of a field of a struct
+func (p *schemaParser) parseField(line string) (*schemaField, error) {
+	name, rest := split(line)
+	if !schemaIdentRegex.MatchString(name) {
+		return nil, p.fail("invalid field name %q", name)
+	}
+	if _, ok := p.visible[name]; ok {
+		return nil, p.fail("duplicate field %q", name)
+	}
+
+	text, rest := split(rest)
+	if text == "" {
+		return nil, p.fail("field %q has no type", name)
+	}
+
+	typ, err := p.parseType(text)
+	if err != nil {
+		return nil, err
+	}
+	f := &schemaField{name: goName(name), typ: typ}
+
+	if strings.HasPrefix(rest, "sized(") {
+		text, rest = split(rest)
+		f.sized, err = p.parseType(strings.TrimSuffix(strings.TrimPrefix(text, "sized("), ")"))
+		if err != nil || f.sized.kind != "int" || f.sized.signed || f.sized.size > 8 {
+			return nil, p.fail("invalid size prefix %q", text)
+		}
+	}
+
+	if strings.HasPrefix(rest, "if ") {
+		f.condition, err = p.expression(strings.TrimPrefix(rest, "if "))
+		if err != nil {
+			return nil, err
+		}
+	} else if rest != "" {
+		return nil, p.fail("unexpected %q", rest)
+	}
+
+	p.visible[name] = f.name
+	return f, nil
+}
+
+// parseSchema parses the schema file named file. a schema is made up of
+// lines that look like this:
+//
+// 	# comments start with a number sign
+// 	endian <le | be>
+// 	struct <name> {
+// 		<field name> <type> [sized(<integer type>)] [if <condition>]
+// 	}
+//
+// where the types are any of these:
+//
+// 	u8 ... u64, i8 ... i64       integers of that many bits, optionally
+// 	                             followed by le or be
+// 	f32, f64                     floats, optionally followed by le or be
+// 	b1 ... b64                   unsigned bit fields
+// 	flag                         a single bit stored in a bool
+// 	uvarint, varint,             variable-length integers
+// 	uleb128, sleb128
+// 	bytes(<length>)              a byte slice
+// 	str(<length>)                a string
+// 	fixed(<length>)              a NUL-padded string in a field of that size
+// 	cstring                      a NUL-terminated string
+// 	pstring(<integer type>)      a string preceded by its length
+// 	[<count>]<type>              a repeated type
+// 	<name>                       a struct declared in the same schema
+//
+// lengths, counts and conditions are go expressions that may refer to the
+// fields declared before them by their names in the schema. a field with a
+// size is preceded by the amount of bytes it takes up, which lets a
+// decoder skip the parts of a substructure it does not know about. the
+// endian directive changes the byte order used by types without a suffix,
+// which is big-endian by default
+func parseSchema(file string, contents []byte) ([]*schemaStruct, error) {
+	p := &schemaParser{file: file, names: map[string]bool{}}
+
+	var current *schemaStruct
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		p.line++
+
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		words := strings.Fields(line)
+
+		switch {
+		case current != nil && line == "}":
+			p.structs = append(p.structs, current)
+			current = nil
+
+		case current != nil:
+			f, err := p.parseField(line)
+			if err != nil {
+				return nil, err
+			}
+			current.fields = append(current.fields, f)
+
+		case words[0] == "endian" && len(words) == 2 && (words[1] == "le" || words[1] == "be"):
+			p.little = words[1] == "le"
+
+		case words[0] == "struct" && len(words) == 3 && words[2] == "{":
+			if !schemaIdentRegex.MatchString(words[1]) || p.names[words[1]] {
+				return nil, p.fail("invalid struct name %q", words[1])
+			}
+			p.names[words[1]] = true
+			p.visible = map[string]string{}
+			current = &schemaStruct{name: words[1]}
+
+		default:
+			return nil, p.fail("unexpected %q", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if current != nil {
+		return nil, p.fail("struct %s is not closed", current.name)
+	}
+
+	// structs may only refer to the ones declared in the same schema
+	for _, s := range p.structs {
+		for _, f := range s.fields {
+			t := f.typ
+			for t.kind == "repeat" {
+				t = t.elem
+			}
+			if t.kind == "struct" && !p.names[t.name] {
+				return nil, fmt.Errorf("%s: %s.%s: unknown type %q", file, s.name, f.name, t.name)
+			}
+		}
+	}
+	return p.structs, nil
+}
+
+// schemaCompiler generates the go code of the structs of a schema
+type schemaCompiler struct {
+	file string
+
+	// qualifier is the name the package holding Buffer is imported as, or
+	// empty if the code is generated inside of it
+	qualifier string
+
+	// structs maps the names of the structs of the schema to them
+	structs map[string]*schemaStruct
+}
+
+// crunch returns a reference to the exported name of the crunch package
+func (c *schemaCompiler) crunch(name string) *jen.Statement {
+	if c.qualifier == "" {
+		return jen.Id(name)
+	}
+	return jen.Id(c.qualifier).Dot(name)
+}
+
+// goType returns the go type values of the type t are stored in
+func (c *schemaCompiler) goType(t *schemaType) *jen.Statement {
+	switch t.kind {
+	case "int":
+		prefix := "uint"
+		if t.signed {
+			prefix = "int"
+		}
+		if t.size == 1 {
+			return jen.Id(strings.Join([]string{prefix, "8"}, ""))
+		}
+		return jen.Id(strings.Join([]string{prefix, containerBits[strconv.Itoa(t.size*8)]}, ""))
+	case "float":
+		return jen.Id(strings.Join([]string{"float", strconv.Itoa(t.size * 8)}, ""))
+	case "bits":
+		return jen.Id(bitsType(t.size))
+	case "flag":
+		return jen.Bool()
+	case "varint":
+		if t.encoding == "uvarint" || t.encoding == "uleb128" {
+			return jen.Uint64()
+		}
+		return jen.Int64()
+	case "bytes":
+		return jen.Index().Byte()
+	case "str", "fixed", "cstring", "pstring":
+		return jen.String()
+	case "struct":
+		return jen.Id(t.name)
+	}
+
+	if t.count >= 0 {
+		return jen.Index(jen.Lit(t.count)).Add(c.goType(t.elem))
+	}
+	return jen.Index().Add(c.goType(t.elem))
+}
+
+// bitsType returns the smallest unsigned integer type that holds the given
+// amount of bits
+func bitsType(bits int) string {
+	switch {
+	case bits <= 8:
+		return "uint8"
+	case bits <= 16:
+		return "uint16"
+	case bits <= 32:
+		return "uint32"
+	}
+	return "uint64"
+}
+
+// lengthCheck generates the code that panics if the length of target does
+// not match length
+func (c *schemaCompiler) lengthCheck(g *jen.Group, target *jen.Statement, length string) {
+	g.If(jen.Id("int64").Call(jen.Len(target)).Op("!=").Id("int64").Call(jen.Id(length))).
+		Block(jen.Panic(c.crunch("MarshalLengthMismatchError")))
+}
+
+// minBits returns the least amount of bits that a value of the type t
+// takes up. values whose length is computed may be empty, so they count as
+// nothing, as do fields that are only present under a condition
+func (c *schemaCompiler) minBits(t *schemaType, seen map[string]bool) int {
+	switch t.kind {
+	case "int", "float":
+		return t.size * 8
+	case "bits":
+		return t.size
+	case "flag":
+		return 1
+	case "varint", "cstring", "pstring":
+		return 8
+	case "bytes", "str", "fixed":
+		n, _ := strconv.Atoi(t.length)
+		return n * 8
+	case "struct":
+		s, ok := c.structs[t.name]
+		if !ok || seen[t.name] {
+			return 0
+		}
+		seen[t.name] = true
+		defer delete(seen, t.name)
+
+		n := 0
+		for _, f := range s.fields {
+			if f.condition != "" {
+				continue
+			}
+			if f.sized != nil {
+				n += f.sized.size * 8
+			}
+			n += c.minBits(f.typ, seen)
+		}
+		return n
+	}
+
+	if t.count < 0 {
+		return 0
+	}
+	return t.count * c.minBits(t.elem, seen)
+}
+
+// countCheck generates the code that fails with MarshalInvalidLengthError
+// if length is not a constant and the rest of the buffer cannot hold as
+// many values that take up at least bits bits each
+func (c *schemaCompiler) countCheck(g *jen.Group, length string, bits int) {
+	if _, err := strconv.Atoi(length); err == nil {
+		return
+	}
+	countCheck(g, jen.Id("int64").Call(jen.Id(length)), bits, c.crunch("MarshalInvalidLengthError"))
+}
+
+// hasBits returns whether or not values of the type t are read and written
+// at the bit offset of the buffer
+func hasBits(t *schemaType) bool {
+	switch t.kind {
+	case "bits", "flag":
+		return true
+	case "repeat":
+		return hasBits(t.elem)
+	}
+	return false
+}
+
+// encode generates the code that writes the value target of the type t to
+// the buffer
+func (c *schemaCompiler) encode(g *jen.Group, t *schemaType, target func() *jen.Statement, depth int) {
+	b := func() *jen.Statement {
+		return jen.Id("b")
+	}
+
+	switch t.kind {
+	case "int":
+		value := target()
+		if t.signed {
+			value = jen.Id(strings.Join([]string{"uint", containerBits[strconv.Itoa(t.size*8)]}, "")).Call(target())
+		}
+		if t.size == 1 {
+			if t.signed {
+				value = jen.Byte().Call(target())
+			}
+			g.Add(b()).Dot("WriteByteNext").Call(value)
+			return
+		}
+		g.Add(b()).Dot(scalarName("Put", "U", t.size, t.little, "Next")).Call(value)
+
+	case "float":
+		g.Add(b()).Dot(scalarName("Put", "F", t.size, t.little, "Next")).Call(target())
+
+	case "bits":
+		value := target()
+		if t.size <= 32 {
+			value = jen.Uint64().Call(target())
+		}
+		g.Add(b()).Dot("SetBitsNext").Call(value, jen.Lit(t.size))
+
+	case "flag":
+		g.If(target()).Block(b().Dot("SetBitsNext").Call(jen.Lit(1), jen.Lit(1))).
+			Else().Block(b().Dot("SetBitsNext").Call(jen.Lit(0), jen.Lit(1)))
+
+	case "varint":
+		method := map[string]string{
+			"uvarint": "WriteUvarintNext",
+			"varint":  "WriteVarintNext",
+			"uleb128": "WriteULEB128Next",
+			"sleb128": "WriteSLEB128Next",
+		}[t.encoding]
+		g.Add(b()).Dot(method).Call(target())
+
+	case "bytes":
+		c.lengthCheck(g, target(), t.length)
+		g.Add(b()).Dot("WriteBytesNext").Call(target())
+
+	case "str":
+		c.lengthCheck(g, target(), t.length)
+		g.Add(b()).Dot("WriteBytesNext").Call(jen.Index().Byte().Parens(target()))
+
+	case "fixed":
+		g.Add(b()).Dot("WriteFixedStringNext").Call(jen.Id("int64").Call(jen.Id(t.length)), target(), jen.Lit(0))
+
+	case "cstring":
+		g.Add(b()).Dot("WriteCStringNext").Call(target())
+
+	case "pstring":
+		g.Add(b()).Dot("WritePStringNext").Call(c.crunch(t.encoding), target())
+
+	case "struct":
+		g.Add(target()).Dot("EncodeTo").Call(b())
+
+	case "repeat":
+		if t.count < 0 {
+			c.lengthCheck(g, target(), t.length)
+		}
+		index := loopVariable(depth)
+		g.For(jen.Id(index).Op(":=").Range().Add(target())).BlockFunc(func(g *jen.Group) {
+			c.encode(g, t.elem, func() *jen.Statement {
+				return target().Index(jen.Id(index))
+			}, depth+1)
+		})
+	}
+}
+
+// decode generates the code that reads the value target of the type t from
+// the buffer
+func (c *schemaCompiler) decode(g *jen.Group, t *schemaType, target func() *jen.Statement, depth int) {
+	b := func() *jen.Statement {
+		return jen.Id("b")
+	}
+	assign := func(value jen.Code) {
+		g.Add(target()).Op("=").Add(value)
+	}
+
+	switch t.kind {
+	case "int":
+		switch {
+		case t.size == 1 && t.signed:
+			assign(jen.Int8().Call(b().Dot("ReadByteNext").Call()))
+		case t.size == 1:
+			assign(b().Dot("ReadByteNext").Call())
+		case t.signed:
+			assign(b().Dot(scalarName("Read", "I", t.size, t.little, "AtNext")).Call())
+		default:
+			assign(b().Dot(scalarName("Read", "U", t.size, t.little, "AtNext")).Call())
+		}
+
+	case "float":
+		assign(b().Dot(scalarName("Read", "F", t.size, t.little, "AtNext")).Call())
+
+	case "bits":
+		value := b().Dot("ReadBitsNext").Call(jen.Lit(t.size))
+		if t.size <= 32 {
+			value = jen.Id(bitsType(t.size)).Call(value)
+		}
+		assign(value)
+
+	case "flag":
+		assign(b().Dot("ReadBitsNext").Call(jen.Lit(1)).Op("!=").Lit(0))
+
+	case "varint":
+		method := map[string]string{
+			"uvarint": "ReadUvarintNext",
+			"varint":  "ReadVarintNext",
+			"uleb128": "ReadULEB128Next",
+			"sleb128": "ReadSLEB128Next",
+		}[t.encoding]
+		assign(b().Dot(method).Call())
+
+	case "bytes":
+		c.countCheck(g, t.length, 8)
+
+		// the bytes are copied so that the value does not change along
+		// with the buffer
+		assign(jen.Append(jen.Index().Byte().Parens(jen.Nil()), b().Dot("ReadBytesNext").Call(jen.Id("int64").Call(jen.Id(t.length))).Op("...")))
+
+	case "str":
+		c.countCheck(g, t.length, 8)
+		assign(jen.String().Call(b().Dot("ReadBytesNext").Call(jen.Id("int64").Call(jen.Id(t.length)))))
+
+	case "fixed":
+		c.countCheck(g, t.length, 8)
+		assign(b().Dot("ReadFixedStringNext").Call(jen.Id("int64").Call(jen.Id(t.length)), jen.Lit(0)))
+
+	case "cstring":
+		assign(b().Dot("ReadCStringNext").Call(jen.Lit(-1)))
+
+	case "pstring":
+		assign(b().Dot("ReadPStringNext").Call(c.crunch(t.encoding)))
+
+	case "struct":
+		g.Add(target()).Dot("DecodeFrom").Call(b())
+
+	case "repeat":
+		if t.count < 0 {
+			c.countCheck(g, t.length, c.minBits(t.elem, map[string]bool{}))
+			assign(jen.Make(c.goType(t), jen.Id(t.length)))
+		}
+		index := loopVariable(depth)
+		g.For(jen.Id(index).Op(":=").Range().Add(target())).BlockFunc(func(g *jen.Group) {
+			c.decode(g, t.elem, func() *jen.Statement {
+				return target().Index(jen.Id(index))
+			}, depth+1)
+		})
+	}
+}
+
+// encodeField generates the code that writes the field f to the buffer
+func (c *schemaCompiler) encodeField(g *jen.Group, f *schemaField) {
+	target := func() *jen.Statement {
+		return jen.Id("m").Dot(f.name)
+	}
+
+	body := func(g *jen.Group) {
+		if f.sized == nil {
+			c.encode(g, f.typ, target, 0)
+			return
+		}
+
+		// the size is written once the field has been, which fails if it
+		// does not fit in its prefix
+		prefix := "PrefixU8"
+		if f.sized.size > 1 {
+			prefix = strings.Join([]string{"PrefixU", strconv.Itoa(f.sized.size * 8), endianness(f.sized.little)}, "")
+		}
+		g.BlockFunc(func(g *jen.Group) {
+			g.Id("p").Op(":=").Id("b").Dot("ReserveNext").Call(c.crunch(prefix))
+			c.encode(g, f.typ, target, 0)
+			g.Id("p").Dot("Close").Call()
+		})
+	}
+
+	if f.condition != "" {
+		g.If(jen.Id(f.condition)).BlockFunc(body)
+	} else {
+		body(g)
+	}
+}
+
+// decodeField generates the code that reads the field f from the buffer
+func (c *schemaCompiler) decodeField(g *jen.Group, f *schemaField) {
+	target := func() *jen.Statement {
+		return jen.Id("m").Dot(f.name)
+	}
+
+	body := func(g *jen.Group) {
+		if f.sized == nil {
+			c.decode(g, f.typ, target, 0)
+			return
+		}
+
+		// whatever is left of the field after decoding it is skipped, but
+		// decoding past its end means that the size is wrong
+		g.BlockFunc(func(g *jen.Group) {
+			var size jen.Code
+			if f.sized.size == 1 {
+				size = jen.Id("b").Dot("ReadByteNext").Call()
+			} else {
+				size = jen.Id("b").Dot(scalarName("Read", "U", f.sized.size, f.sized.little, "AtNext")).Call()
+			}
+			g.Id("end").Op(":=").Id("int64").Call(size).Op("+").Id("b").Dot("ByteOffset").Call()
+			c.decode(g, f.typ, target, 0)
+			g.If(jen.Id("b").Dot("ByteOffset").Call().Op(">").Id("end")).Block(
+				jen.Id("b").Dot("Fail").Call(c.crunch("MarshalInvalidLengthError")),
+				jen.Return())
+			g.Id("b").Dot("SeekByte").Call(jen.Id("end"), jen.False())
+		})
+	}
+
+	if f.condition != "" {
+		g.If(jen.Id(f.condition)).BlockFunc(body)
+	} else {
+		body(g)
+	}
+}
+
+// compile generates the type declaration and the methods of s
+func (c *schemaCompiler) compile(s *schemaStruct) ([]byte, error) {
+	builder := &jen.Group{}
+
+	builder.Comment(strings.Join([]string{"// ", s.name, " is a structure described by ", c.file, "\n"}, ""))
+	builder.Type().Id(s.name).StructFunc(func(g *jen.Group) {
+		for _, f := range s.fields {
+			g.Id(f.name).Add(c.goType(f.typ))
+		}
+	})
+
+	buffer := jen.Id("b").Op("*").Add(c.crunch("Buffer"))
+
+	// bit fields are read and written at the bit offset of the buffer and
+	// every other field at its byte offset, so structs that have both
+	// unify the cursors of the buffer while they are encoded or decoded
+	unify := func(g *jen.Group) {}
+	for _, f := range s.fields {
+		if hasBits(f.typ) {
+			unify = func(g *jen.Group) {
+				g.If(jen.Id("b").Dot("CursorMode").Call().Op("==").Add(c.crunch("SeparateCursors"))).Block(
+					jen.Id("b").Dot("SetCursorMode").Call(c.crunch("UnifiedCursor")),
+					jen.Defer().Id("b").Dot("SetCursorMode").Call(c.crunch("SeparateCursors")))
+			}
+			break
+		}
+	}
+
+	builder.Line()
+	builder.Comment("// EncodeTo writes the fields of m to the buffer at its current offset\n")
+	builder.Func().Params(jen.Id("m").Op("*").Id(s.name)).Id("EncodeTo").Params(buffer).BlockFunc(func(g *jen.Group) {
+		unify(g)
+		for _, f := range s.fields {
+			c.encodeField(g, f)
+		}
+	})
+
+	builder.Line()
+	builder.Comment("// DecodeFrom reads the fields of m from the buffer at its current offset\n")
+	builder.Func().Params(jen.Id("m").Op("*").Id(s.name)).Id("DecodeFrom").Params(buffer).BlockFunc(func(g *jen.Group) {
+		unify(g)
+		for _, f := range s.fields {
+			c.decodeField(g, f)
+		}
+	})
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	err := builder.Render(outputBuffer)
+	return outputBuffer.Bytes(), err
+}
+
+// GenerateSchema searches the provided files for magic comments that look
+// like this:
+//
+// 	//generator:schema <path to a schema file>
+//
+// and replaces each of them with the go code compiled from the schema. a
+// struct type is declared for each struct in the schema, along with
+// EncodeTo and DecodeFrom methods like the ones generated by GenerateCodec.
+// the language the schemas are written in is described by parseSchema.
+//
+// the methods of structs that have bit fields switch a buffer that is in
+// SeparateCursors mode to UnifiedCursor mode until they return, so that
+// bit fields and byte fields are laid out one after another. counts and
+// sizes that are decoded are checked against the data left to read, and
+// both those and sizes that do not fit in their prefix are reported as
+// errors by the buffer.
+//
+// only the files that contain magic comments are output, with names like
+// this:
+//
+// 	<filename w/o leading underscore>.generated.go
+func GenerateSchema(oldFiles map[string][]byte) (files map[string][]byte, e error) {
+	magicCommentRegex := regexp.MustCompile("(?m)^\\/\\/generator:schema (\\S+)$")
+
+	files = map[string][]byte{}
+	for name, contents := range oldFiles {
+		if !magicCommentRegex.Match(contents) {
+			continue
+		}
+		fmt.Println("* scanning", name)
+
+		file, err := parser.ParseFile(token.NewFileSet(), name, contents, parser.ImportsOnly)
+		if err != nil {
+			return nil, err
+		}
+		qualifier := crunchQualifier(file)
+
+		files[name] = magicCommentRegex.ReplaceAllFunc(contents, func(comment []byte) []byte {
+			path := string(magicCommentRegex.FindSubmatch(comment)[1])
+			fmt.Printf("* invocation. schema: %s\n", path)
+
+			schema, err := ioutil.ReadFile(path)
+			if err != nil {
+				fmt.Println("! unable to read schema:", err)
+				return []byte(fmt.Sprint("// schema compilation failure: ", err))
+			}
+
+			structs, err := parseSchema(path, schema)
+			if err != nil {
+				fmt.Println("! unable to parse schema:", err)
+				return []byte(fmt.Sprint("// schema compilation failure: ", err))
+			}
+
+			compiler := &schemaCompiler{file: path, qualifier: qualifier, structs: map[string]*schemaStruct{}}
+			for _, s := range structs {
+				compiler.structs[s.name] = s
+			}
+			outputBuffer := bytes.NewBuffer([]byte{})
+			for i, s := range structs {
+				generated, err := compiler.compile(s)
+				if err != nil {
+					fmt.Println("! unable to render code:", err)
+					return []byte("// render failure")
+				}
+				if i > 0 {
+					_, _ = outputBuffer.Write([]byte("\n\n"))
+				}
+				_, _ = outputBuffer.Write(generated)
+			}
+			return outputBuffer.Bytes()
+		})
+	}
+	return
+}
@@ -0,0 +1,113 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// GenerateWriter generates the Writer variants of a complex write method
+// and of the ones that GenerateScalar and GenerateBitAligned output
+// alongside it. it is the counterpart of GenerateReader, so only the
+// ...Next variants are generated and each of them points the scratch
+// Buffer of the Writer at the bytes it produces before calling the Buffer
+// method it is a variant of on it. it is called by GenerateComplex with
+// the already-verified arguments of a magic comment and outputs functions
+// in this pattern:
+//
+// 	// Put<naming>Next writes a <integer type> to the output ...
+// 	func (b *Writer) Put<naming>Next(data <integer type>) {
+//
+// 		if w := b.window("Put<naming>Next", <number of bits / 8>); w != nil {
+//
+// 			w.Put<naming>(0, data)
+//
+// 		}
+//
+// 	}
+//
+// the bit-aligned methods use bitWindow instead of window
+func GenerateWriter(arguments []string, intType string, intBytes int) ([]byte, error) {
+	naming := strings.Join(arguments[2:5], "")
+	endianness := map[string]string{
+		"BE": "big-endian",
+		"LE": "little-endian",
+	}[arguments[4]]
+	article := "a"
+	if intType[0] == 'i' {
+		article = "an"
+	}
+
+	// delegate generates a call to the Buffer method named method on the
+	// scratch buffer, which is only made if there is room for the output
+	delegate := func(name, method string, bits bool, length, condition *jen.Statement, call []jen.Code) func(*jen.Group) {
+		return func(g *jen.Group) {
+			var view *jen.Statement
+			if bits {
+				view = jen.List(jen.Id("w"), jen.Id("bit")).Op(":=").Id("b").Dot("bitWindow").Call(jen.Lit(name), length)
+			} else {
+				view = jen.Id("w").Op(":=").Id("b").Dot("window").Call(jen.Lit(name), length)
+			}
+
+			check := jen.Id("w").Op("!=").Nil()
+			if condition != nil {
+				check = check.Op("&&").Add(condition)
+			}
+
+			g.If(view, check).Block(jen.Id("w").Dot(method).Call(call...))
+		}
+	}
+
+	slice := strings.Join([]string{"Write", naming}, "")
+	put := strings.Join([]string{"Put", naming}, "")
+	aligned := strings.Join([]string{"Put", naming, "Bits"}, "")
+
+	functions := []scalarFunction{
+		{
+			name: strings.Join([]string{slice, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{slice, "Next writes a slice of ", intType, "s to the output in"}, ""),
+				strings.Join([]string{endianness, " and moves the position forward the amount of bytes written"}, ""),
+			},
+			params: []jen.Code{jen.Id("data").Index().Id(intType)},
+			body: delegate(strings.Join([]string{slice, "Next"}, ""), slice, false, jen.Id("int64").Call(jen.Len(jen.Id("data"))).Op("*").Lit(intBytes),
+				jen.Len(jen.Id("data")).Op(">").Lit(0x00), []jen.Code{jen.Lit(0x00), jen.Id("data")}),
+		},
+		{
+			name: strings.Join([]string{put, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{put, "Next writes ", article, " ", intType, " to the output in"}, ""),
+				strings.Join([]string{endianness, " and moves the position forward the amount of bytes written"}, ""),
+			},
+			params: []jen.Code{jen.Id("data").Id(intType)},
+			body: delegate(strings.Join([]string{put, "Next"}, ""), put, false, jen.Lit(intBytes),
+				nil, []jen.Code{jen.Lit(0x00), jen.Id("data")}),
+		},
+		{
+			name: strings.Join([]string{aligned, "Next"}, ""),
+			comment: []string{
+				strings.Join([]string{aligned, "Next writes ", article, " ", intType, " to the output in"}, ""),
+				strings.Join([]string{endianness, " at the current bit position and moves it forward the amount of bits written"}, ""),
+			},
+			params: []jen.Code{jen.Id("data").Id(intType)},
+			body: delegate(strings.Join([]string{aligned, "Next"}, ""), aligned, true, jen.Lit(intBytes*8),
+				nil, []jen.Code{jen.Id("bit"), jen.Id("data")}),
+		},
+	}
+
+	// the magic comment is already surrounded by blank lines
+	generated, err := renderFunctions("Writer", functions)
+	return bytes.TrimLeft(generated, "\n"), err
+}
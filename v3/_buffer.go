@@ -11,7 +11,11 @@ file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
 package v3
 
-import "unsafe"
+import (
+	"hash"
+	"math"
+	"unsafe"
+)
 
 // Buffer implements a buffer type in go that handles multiple types
 // of data easily. it has overwrite/read checks for extra safety
@@ -21,6 +25,25 @@ type Buffer struct {
 	cap  int64
 	boff int64
 	bcap int64
+
+	sticky bool
+	err    error
+
+	grow bool
+	gmax int64
+
+	order BitOrder
+	cmode CursorMode
+
+	journal journal
+	slots   []*Placeholder
+
+	hash hash.Hash
+
+	// hstart and hend are the offsets of the first byte written to hash
+	// and of the one after the last
+	hstart int64
+	hend   int64
 }
 
 // NewBuffer initilaizes a new Buffer with the provided byte slice(s)
@@ -66,25 +89,90 @@ func NewBuffer(slices ...[]byte) (buf *Buffer) {
 
 /* internal use methods */
 
+// fail panics with the provided error or, if the buffer is in sticky
+// mode, records it so that every following call becomes a no-op
+func (b *Buffer) fail(err Error) {
+
+	if !b.sticky {
+
+		panic(err)
+
+	}
+	b.err = err
+
+}
+
+// reserve grows the buffer so that it is at least n bytes long if the
+// buffer is allowed to grow automatically, returning whether or not
+// it is long enough afterwards
+func (b *Buffer) reserve(n int64) bool {
+
+	if !b.grow || (b.gmax > 0x00 && n > b.gmax) {
+
+		return false
+
+	}
+
+	if n > b.cap {
+
+		b.Grow(n - b.cap)
+
+	}
+	return true
+
+}
+
+// readable reports whether n values of size bytes each starting at off
+// are inside of the buffer. off and n must not be negative, which lets
+// it avoid computing off + n*size, as that overflows on hostile input
+func (b *Buffer) readable(off, n, size int64) bool {
+
+	return n <= (b.cap-off)/size
+
+}
+
+// writable reports whether the n bytes starting at off are inside of
+// the buffer, growing it if it is allowed to. off and n must not be
+// negative
+func (b *Buffer) writable(off, n int64) bool {
+
+	if n <= (b.cap - off) {
+
+		return true
+
+	}
+	return n <= (math.MaxInt64-off) && b.reserve(off+n)
+
+}
+
 /* bitfield methods */
 
 // ReadBit returns the bit located at the specified offset without
 // modifying the internal offset value
-func (b *Buffer) ReadBit(off int64) byte {
+func (b *Buffer) ReadBit(off int64) (out byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
 
 	if off > (b.bcap - 1) {
 
-		panic(BufferOverreadError)
+		b.fail(BufferOverreadError.atBit("ReadBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.atBit("ReadBit", off, 1, b.bcap))
+		return
 
 	}
 
-	return (b.buf[off/8] >> (7 - uint64(off%8))) & 1
+	out = (b.buf[off/8] >> b.order.shift(off)) & 1
+	return
 
 }
 
@@ -102,19 +190,34 @@ func (b *Buffer) ReadBitNext() (out byte) {
 // modifying the internal offset value
 func (b *Buffer) ReadBits(off, n int64) (out uint64) {
 
-	i := int64(0)
+	if b.err != nil {
 
-	{
-	read_loop:
-		out = (out << uint64(1)) | uint64(b.ReadBit(off+i))
-		i++
-		if i < n {
+		return
 
-			goto read_loop
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidBitCountError.atBit("ReadBits", off, n, b.bcap))
+		return
 
-		}
 	}
 
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.atBit("ReadBits", off, n, b.bcap))
+		return
+
+	}
+
+	if n > (b.bcap - off) {
+
+		b.fail(BufferOverreadError.atBit("ReadBits", off, n, b.bcap))
+		return
+
+	}
+
+	out = readBits(b.buf, off, n, b.order)
 	return
 
 }
@@ -133,19 +236,28 @@ func (b *Buffer) ReadBitsNext(n int64) (out uint64) {
 // modifying the internal offset value
 func (b *Buffer) SetBit(off int64) {
 
-	if off > (b.bcap - 1) {
+	if b.err != nil {
 
-		panic(BufferOverwriteError)
+		return
+
+	}
+
+	if off > (b.bcap-1) && !b.reserve(off/8+1) {
+
+		b.fail(BufferOverwriteError.atBit("SetBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("SetBit", off, 1, b.bcap))
+		return
 
 	}
 
-	b.buf[off/8] |= (1 << uint(7-(off%8)))
+	b.saveBits(off, 1)
+	b.buf[off/8] |= (1 << b.order.shift(off))
 
 }
 
@@ -162,19 +274,28 @@ func (b *Buffer) SetBitNext() {
 // modifying the internal offset value
 func (b *Buffer) ClearBit(off int64) {
 
-	if off > (b.bcap - 1) {
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off > (b.bcap-1) && !b.reserve(off/8+1) {
 
-		panic(BufferOverwriteError)
+		b.fail(BufferOverwriteError.atBit("ClearBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("ClearBit", off, 1, b.bcap))
+		return
 
 	}
 
-	b.buf[off/8] &= ^(1 << uint(7-(off%8)))
+	b.saveBits(off, 1)
+	b.buf[off/8] &= ^(1 << b.order.shift(off))
 
 }
 
@@ -191,27 +312,36 @@ func (b *Buffer) ClearBitNext() {
 // modifying the internal offset value
 func (b *Buffer) SetBits(off int64, data uint64, n int64) {
 
-	i := int64(0)
+	if b.err != nil {
 
-	{
-	write_loop:
-		if byte((data>>uint64(n-i-1))&1) == 0 {
+		return
 
-			b.ClearBit(off + i)
+	}
 
-		} else {
+	if n < 0x00 {
 
-			b.SetBit(off + i)
+		b.fail(BufferInvalidBitCountError.atBit("SetBits", off, n, b.bcap))
+		return
 
-		}
-		i++
-		if i < n {
+	}
 
-			goto write_loop
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.atBit("SetBits", off, n, b.bcap))
+		return
+
+	}
+
+	if n > (b.bcap-off) && (n > (math.MaxInt64-7-off) || !b.reserve(off/8+(off%8+n+7)/8)) {
+
+		b.fail(BufferOverwriteError.atBit("SetBits", off, n, b.bcap))
+		return
 
-		}
 	}
 
+	b.saveBits(off, n)
+	writeBits(b.buf, off, data, n, b.order)
+
 }
 
 // SetBitsNext sets the next n bits from the current offset and moves
@@ -227,19 +357,28 @@ func (b *Buffer) SetBitsNext(data uint64, n int64) {
 // modifying the internal offset value
 func (b *Buffer) FlipBit(off int64) {
 
-	if off > (b.bcap - 1) {
+	if b.err != nil {
 
-		panic(BufferOverwriteError)
+		return
+
+	}
+
+	if off > (b.bcap-1) && !b.reserve(off/8+1) {
+
+		b.fail(BufferOverwriteError.atBit("FlipBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("FlipBit", off, 1, b.bcap))
+		return
 
 	}
 
-	b.buf[off/8] ^= (1 << uint(7-(off%8)))
+	b.saveBits(off, 1)
+	b.buf[off/8] ^= (1 << b.order.shift(off))
 
 }
 
@@ -255,6 +394,13 @@ func (b *Buffer) FlipBitNext() {
 // ClearAllBits sets all of the buffer's bits to 0
 func (b *Buffer) ClearAllBits() {
 
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.save(0x00, b.cap)
 	var (
 		i = int64(0)
 		n = int64(len(b.buf))
@@ -275,6 +421,13 @@ func (b *Buffer) ClearAllBits() {
 // SetAllBits sets all of the buffer's bits to 1
 func (b *Buffer) SetAllBits() {
 
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.save(0x00, b.cap)
 	var (
 		i = int64(0)
 		n = int64(len(b.buf))
@@ -295,6 +448,13 @@ func (b *Buffer) SetAllBits() {
 // FlipAllBits flips all of the buffer's bits
 func (b *Buffer) FlipAllBits() {
 
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.save(0x00, b.cap)
 	var (
 		i = int64(0)
 		n = int64(len(b.buf))
@@ -317,6 +477,12 @@ func (b *Buffer) FlipAllBits() {
 // the current position or exact
 func (b *Buffer) SeekBit(off int64, relative bool) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if relative {
 
 		b.boff += off
@@ -326,6 +492,8 @@ func (b *Buffer) SeekBit(off int64, relative bool) {
 		b.boff = off
 
 	}
+	b.syncByte()
+	b.follow()
 
 }
 
@@ -342,10 +510,12 @@ func (b *Buffer) AfterBit(off ...int64) int64 {
 
 }
 
-// AlignBit aligns the bit offset to the byte offset
+// AlignBit aligns the bit offset to the byte offset. if the cursors
+// are unified, this moves the position to the next byte boundary
 func (b *Buffer) AlignBit() {
 
 	b.boff = b.off * 8
+	b.follow()
 
 }
 
@@ -355,18 +525,27 @@ func (b *Buffer) AlignBit() {
 // without modifying the internal offset value
 func (b *Buffer) WriteBytes(off int64, data []byte) {
 
-	if (off + int64(len(data))) > b.cap {
+	if b.err != nil {
 
-		panic(BufferOverwriteError)
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
 
 	}
 
+	if !b.writable(off, int64(len(data))) {
+
+		b.fail(BufferOverwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	b.save(off, int64(len(data)))
 	copy(b.buf[off:], data)
 
 }
@@ -375,7 +554,7 @@ func (b *Buffer) WriteBytes(off int64, data []byte) {
 // and moves the offset forward the amount of bytes written
 func (b *Buffer) WriteBytesNext(data []byte) {
 
-	b.WriteBytes(b.off, data)
+	b.WriteBytes(b.cursor("WriteBytesNext"), data)
 	b.SeekByte(int64(len(data)), true)
 
 }
@@ -392,7 +571,7 @@ func (b *Buffer) WriteByte(off int64, data byte) {
 // offset and moves the offset forward the amount of bytes written
 func (b *Buffer) WriteByteNext(data byte) {
 
-	b.WriteBytes(b.off, []byte{data})
+	b.WriteBytes(b.cursor("WriteByteNext"), []byte{data})
 	b.SeekByte(1, true)
 
 }
@@ -401,10 +580,26 @@ func (b *Buffer) WriteByteNext(data byte) {
 
 //generator:complex Buffer Write U 16 BE
 
+//generator:complex Buffer Write U 24 LE
+
+//generator:complex Buffer Write U 24 BE
+
 //generator:complex Buffer Write U 32 LE
 
 //generator:complex Buffer Write U 32 BE
 
+//generator:complex Buffer Write U 40 LE
+
+//generator:complex Buffer Write U 40 BE
+
+//generator:complex Buffer Write U 48 LE
+
+//generator:complex Buffer Write U 48 BE
+
+//generator:complex Buffer Write U 56 LE
+
+//generator:complex Buffer Write U 56 BE
+
 //generator:complex Buffer Write U 64 LE
 
 //generator:complex Buffer Write U 64 BE
@@ -413,10 +608,26 @@ func (b *Buffer) WriteByteNext(data byte) {
 
 //generator:complex Buffer Write I 16 BE
 
+//generator:complex Buffer Write I 24 LE
+
+//generator:complex Buffer Write I 24 BE
+
 //generator:complex Buffer Write I 32 LE
 
 //generator:complex Buffer Write I 32 BE
 
+//generator:complex Buffer Write I 40 LE
+
+//generator:complex Buffer Write I 40 BE
+
+//generator:complex Buffer Write I 48 LE
+
+//generator:complex Buffer Write I 48 BE
+
+//generator:complex Buffer Write I 56 LE
+
+//generator:complex Buffer Write I 56 BE
+
 //generator:complex Buffer Write I 64 LE
 
 //generator:complex Buffer Write I 64 BE
@@ -431,21 +642,37 @@ func (b *Buffer) WriteByteNext(data byte) {
 
 // ReadBytes returns the next n bytes from the specified offset
 // without modifying the internal offset value
-func (b *Buffer) ReadBytes(off, n int64) []byte {
+func (b *Buffer) ReadBytes(off, n int64) (out []byte) {
+
+	if b.err != nil {
 
-	if (off + n) > b.cap {
+		return
+
+	}
+
+	if n < 0x00 {
 
-		panic(BufferOverreadError)
+		b.fail(BufferInvalidByteCountError.count("ReadBytes", n, b.cap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadBytes", off, n, b.cap))
+		return
 
 	}
 
-	return b.buf[off : off+n]
+	if n > (b.cap - off) {
+
+		b.fail(BufferOverreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	out = b.buf[off : off+n]
+	return
 
 }
 
@@ -453,7 +680,7 @@ func (b *Buffer) ReadBytes(off, n int64) []byte {
 // and moves the offset forward the amount of bytes read
 func (b *Buffer) ReadBytesNext(n int64) (out []byte) {
 
-	out = b.ReadBytes(b.off, n)
+	out = b.ReadBytes(b.cursor("ReadBytesNext"), n)
 	b.SeekByte(n, true)
 	return
 
@@ -461,9 +688,15 @@ func (b *Buffer) ReadBytesNext(n int64) (out []byte) {
 
 // ReadByte returns the next byte from the specified offset without
 // modifying the internal offset value
-func (b *Buffer) ReadByte(off int64) byte {
+func (b *Buffer) ReadByte(off int64) (out byte) {
 
-	return b.ReadBytes(off, 1)[0]
+	bytes := b.ReadBytes(off, 1)
+	if b.err == nil {
+
+		out = bytes[0]
+
+	}
+	return
 
 }
 
@@ -471,7 +704,7 @@ func (b *Buffer) ReadByte(off int64) byte {
 // moves the offset forward a byte
 func (b *Buffer) ReadByteNext() (out byte) {
 
-	out = b.ReadBytes(b.off, 1)[0]
+	out = b.ReadByte(b.cursor("ReadByteNext"))
 	b.SeekByte(1, true)
 	return
 
@@ -481,10 +714,26 @@ func (b *Buffer) ReadByteNext() (out byte) {
 
 //generator:complex Buffer Read U 16 BE
 
+//generator:complex Buffer Read U 24 LE
+
+//generator:complex Buffer Read U 24 BE
+
 //generator:complex Buffer Read U 32 LE
 
 //generator:complex Buffer Read U 32 BE
 
+//generator:complex Buffer Read U 40 LE
+
+//generator:complex Buffer Read U 40 BE
+
+//generator:complex Buffer Read U 48 LE
+
+//generator:complex Buffer Read U 48 BE
+
+//generator:complex Buffer Read U 56 LE
+
+//generator:complex Buffer Read U 56 BE
+
 //generator:complex Buffer Read U 64 LE
 
 //generator:complex Buffer Read U 64 BE
@@ -493,10 +742,26 @@ func (b *Buffer) ReadByteNext() (out byte) {
 
 //generator:complex Buffer Read I 16 BE
 
+//generator:complex Buffer Read I 24 LE
+
+//generator:complex Buffer Read I 24 BE
+
 //generator:complex Buffer Read I 32 LE
 
 //generator:complex Buffer Read I 32 BE
 
+//generator:complex Buffer Read I 40 LE
+
+//generator:complex Buffer Read I 40 BE
+
+//generator:complex Buffer Read I 48 LE
+
+//generator:complex Buffer Read I 48 BE
+
+//generator:complex Buffer Read I 56 LE
+
+//generator:complex Buffer Read I 56 BE
+
 //generator:complex Buffer Read I 64 LE
 
 //generator:complex Buffer Read I 64 BE
@@ -513,6 +778,12 @@ func (b *Buffer) ReadByteNext() (out byte) {
 // current position or exact
 func (b *Buffer) SeekByte(off int64, relative bool) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if relative {
 
 		b.off += off
@@ -522,6 +793,8 @@ func (b *Buffer) SeekByte(off int64, relative bool) {
 		b.off = off
 
 	}
+	b.syncBit()
+	b.follow()
 
 }
 
@@ -538,10 +811,19 @@ func (b *Buffer) AfterByte(off ...int64) int64 {
 
 }
 
-// AlignByte aligns the byte offset to the bit offset
+// AlignByte aligns the byte offset to the bit offset. if the cursors
+// are unified, the position is moved to the next byte boundary instead
 func (b *Buffer) AlignByte() {
 
+	if b.cmode != SeparateCursors {
+
+		b.syncBit()
+		b.follow()
+		return
+
+	}
 	b.off = b.boff / 8
+	b.follow()
 
 }
 
@@ -550,9 +832,16 @@ func (b *Buffer) AlignByte() {
 // TruncateLeft truncates the buffer on the left side
 func (b *Buffer) TruncateLeft(n int64) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if n < 0 {
 
-		panic(BufferInvalidByteCountError)
+		b.fail(BufferInvalidByteCountError.count("TruncateLeft", n, b.cap))
+		return
 
 	}
 
@@ -564,9 +853,16 @@ func (b *Buffer) TruncateLeft(n int64) {
 // TruncateRight truncates the buffer on the right side
 func (b *Buffer) TruncateRight(n int64) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if n < 0 {
 
-		panic(BufferInvalidByteCountError)
+		b.fail(BufferInvalidByteCountError.count("TruncateRight", n, b.cap))
+		return
 
 	}
 
@@ -578,9 +874,16 @@ func (b *Buffer) TruncateRight(n int64) {
 // Grow makes the buffer's capacity bigger by n bytes
 func (b *Buffer) Grow(n int64) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if n < 0 {
 
-		panic(BufferInvalidByteCountError)
+		b.fail(BufferInvalidByteCountError.count("Grow", n, b.cap))
+		return
 
 	}
 
@@ -591,7 +894,13 @@ func (b *Buffer) Grow(n int64) {
 		return
 
 	}
-	tmp := make([]byte, b.cap+n, (int64(cap(b.buf))+n)*2)
+	c := int64(cap(b.buf)) + n
+	if c <= math.MaxInt64/2 {
+
+		c *= 2
+
+	}
+	tmp := make([]byte, b.cap+n, c)
 	copy(tmp, b.buf)
 	b.buf = tmp
 	b.Refresh()
@@ -606,7 +915,58 @@ func (b *Buffer) Refresh() {
 
 }
 
-// Reset resets the entire buffer
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the buffer. it defaults to MSBFirst
+func (b *Buffer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// SetGrowth enables or disables automatic growth. while it is
+// enabled, writes past the end of the buffer grow it with Grow instead
+// of failing, as long as it would not become longer than max bytes. a
+// max of zero or less means that the buffer may grow without limit
+func (b *Buffer) SetGrowth(enabled bool, max int64) {
+
+	b.grow = enabled
+	b.gmax = max
+
+}
+
+// SetSticky enables or disables sticky mode. in sticky mode, the
+// first out-of-range operation records an error on the buffer instead
+// of panicking and every following call becomes a no-op that returns
+// zero values until the error is cleared
+func (b *Buffer) SetSticky(sticky bool) {
+
+	b.sticky = sticky
+
+}
+
+// ClearErr clears the error recorded by the buffer in sticky mode
+func (b *Buffer) ClearErr() {
+
+	b.err = nil
+
+}
+
+// Fail reports err the way the buffer reports its own errors, which is
+// by panicking with it or, in sticky mode, recording it unless an error
+// was recorded already. it is used by code generated outside of this
+// package to report errors that the buffer cannot detect by itself
+func (b *Buffer) Fail(err Error) {
+
+	if b.err == nil {
+
+		b.fail(err)
+
+	}
+
+}
+
+// Reset resets the entire buffer, releasing every mark on it and
+// abandoning every open placeholder
 func (b *Buffer) Reset() {
 
 	b.buf = b.buf[0:0]
@@ -614,6 +974,11 @@ func (b *Buffer) Reset() {
 	b.boff = 0x00
 	b.cap = 0
 	b.bcap = 0
+	b.err = nil
+	b.journal = journal{}
+	b.slots = nil
+	b.hstart = 0x00
+	b.hend = 0x00
 
 }
 
@@ -653,3 +1018,10 @@ func (b *Buffer) BitOffset() int64 {
 	return b.boff
 
 }
+
+// Err returns the error recorded by the buffer in sticky mode, if any
+func (b *Buffer) Err() error {
+
+	return b.err
+
+}
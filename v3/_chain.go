@@ -0,0 +1,1103 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"io"
+	"math"
+	"net"
+	"sort"
+)
+
+// ChainBuffer implements a buffer type in go that stores its data in
+// a list of separate byte slices instead of a single one, so that
+// data assembled from many pieces does not have to be copied into one
+// place. it has the same checks as Buffer, and operations that span
+// more than one slice work transparently. its byte and bit offsets are
+// always independent of each other, as they are in a Buffer using
+// SeparateCursors
+type ChainBuffer struct {
+	chunks [][]byte
+	starts []int64
+	off    int64
+	cap    int64
+	boff   int64
+	bcap   int64
+
+	sticky bool
+	err    error
+
+	grow bool
+	gmax int64
+
+	// owned is whether or not the last chunk was allocated by the
+	// buffer, which makes it safe to extend into its spare capacity
+	owned bool
+
+	order BitOrder
+
+	// scratch is the buffer the typed methods operate on. it views a
+	// chunk when the data is contained in one and spill otherwise
+	scratch Buffer
+	spill   []byte
+	spilled bool
+}
+
+// NewChainBuffer initializes a new ChainBuffer with the provided byte
+// slice(s) stored inside in the order provided. the slices are not
+// copied, so writes to the buffer are visible in them
+func NewChainBuffer(chunks ...[]byte) (buf *ChainBuffer) {
+
+	buf = &ChainBuffer{}
+	for _, chunk := range chunks {
+
+		buf.Append(chunk)
+
+	}
+	return
+
+}
+
+/* internal use methods */
+
+// fail panics with the provided error or, if the buffer is in sticky
+// mode, records it so that every following call becomes a no-op
+func (b *ChainBuffer) fail(err Error) {
+
+	if !b.sticky {
+
+		panic(err)
+
+	}
+	b.err = err
+
+}
+
+// reserve grows the buffer so that it is at least n bytes long if the
+// buffer is allowed to grow automatically, returning whether or not
+// it is long enough afterwards
+func (b *ChainBuffer) reserve(n int64) bool {
+
+	if !b.grow || (b.gmax > 0x00 && n > b.gmax) {
+
+		return false
+
+	}
+
+	if n > b.cap {
+
+		b.Grow(n - b.cap)
+
+	}
+	return true
+
+}
+
+// writable reports whether the n bytes starting at off are inside of
+// the buffer, growing it if it is allowed to. off and n must not be
+// negative
+func (b *ChainBuffer) writable(off, n int64) bool {
+
+	if n <= b.cap-off {
+
+		return true
+
+	}
+	return n <= math.MaxInt64-off && b.reserve(off+n)
+
+}
+
+// link adds a chunk to the end of the chain, updating the cached
+// statistics of the buffer for it alone instead of going over every
+// chunk again like Refresh
+func (b *ChainBuffer) link(chunk []byte) {
+
+	b.chunks = append(b.chunks, chunk)
+	b.starts = append(b.starts, b.cap)
+	b.cap += int64(len(chunk))
+	b.bcap = b.cap * 8
+
+}
+
+// locate returns the index of the chunk containing the byte at the
+// specified offset and the offset of the byte within it
+func (b *ChainBuffer) locate(off int64) (i int, inner int64) {
+
+	i = sort.Search(len(b.starts), func(i int) bool {
+
+		return b.starts[i] > off
+
+	}) - 1
+	return i, off - b.starts[i]
+
+}
+
+// gather copies the bytes of the buffer starting at the specified
+// offset into dst
+func (b *ChainBuffer) gather(dst []byte, off int64) {
+
+	if len(dst) == 0x00 {
+
+		return
+
+	}
+
+	i, inner := b.locate(off)
+	for n := 0; n < len(dst); i++ {
+
+		n += copy(dst[n:], b.chunks[i][inner:])
+		inner = 0x00
+
+	}
+
+}
+
+// scatter copies src into the bytes of the buffer starting at the
+// specified offset
+func (b *ChainBuffer) scatter(off int64, src []byte) {
+
+	if len(src) == 0x00 {
+
+		return
+
+	}
+
+	i, inner := b.locate(off)
+	for n := 0; n < len(src); i++ {
+
+		n += copy(b.chunks[i][inner:], src[n:])
+		inner = 0x00
+
+	}
+
+}
+
+// window points the scratch buffer at the n bytes of the buffer at the
+// specified offset, copying them into the spill slice if they span
+// more than one chunk
+func (b *ChainBuffer) window(off, n int64) *Buffer {
+
+	b.spilled = false
+	if n == 0x00 {
+
+		b.scratch.buf = b.spill[:0]
+
+	} else if i, inner := b.locate(off); inner+n <= int64(len(b.chunks[i])) {
+
+		b.scratch.buf = b.chunks[i][inner : inner+n : inner+n]
+
+	} else {
+
+		if int64(cap(b.spill)) < n {
+
+			b.spill = make([]byte, n)
+
+		}
+		b.scratch.buf = b.spill[:n]
+		b.spilled = true
+		b.gather(b.scratch.buf, off)
+
+	}
+
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+	return &b.scratch
+
+}
+
+// view returns the scratch buffer pointed at the n values of size bytes
+// each in the buffer at the specified offset, or nil if they are out of
+// bounds. if write is set, the buffer grows to fit them if it is
+// allowed to
+func (b *ChainBuffer) view(op string, off, n, size int64, write bool) *Buffer {
+
+	if b.err != nil {
+
+		return nil
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count(op, n, b.cap))
+		return nil
+
+	}
+
+	if write {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderwriteError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+		if n > math.MaxInt64/size || !b.writable(off, n*size) {
+
+			b.fail(BufferOverwriteError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+	} else {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderreadError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+		if n > (b.cap-off)/size {
+
+			b.fail(BufferOverreadError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+	}
+	return b.window(off, n*size)
+
+}
+
+// bitView is the bit-level variant of view. it returns the scratch
+// buffer pointed at the bytes containing the n bits of the buffer at
+// the specified offset, along with the offset of the first of them
+// within it
+func (b *ChainBuffer) bitView(op string, off, n int64, write bool) (*Buffer, int64) {
+
+	if b.err != nil {
+
+		return nil, 0x00
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidBitCountError.atBit(op, off, n, b.bcap))
+		return nil, 0x00
+
+	}
+
+	if write {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderwriteError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+		// the size in bytes is computed from off/8 so that it cannot
+		// overflow where (off+n+7)/8 would
+		if n > (b.bcap-off) && (n > (math.MaxInt64-7-off) || !b.reserve(off/8+(off%8+n+7)/8)) {
+
+			b.fail(BufferOverwriteError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+	} else {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderreadError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+		if n > (b.bcap - off) {
+
+			b.fail(BufferOverreadError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+	}
+	return b.window(off/8, (off+n+7)/8-off/8), off % 8
+
+}
+
+// store copies the scratch buffer back into the buffer at the
+// specified offset if it was spilled
+func (b *ChainBuffer) store(off int64) {
+
+	if b.spilled {
+
+		b.scatter(off, b.scratch.buf)
+
+	}
+
+}
+
+/* bitfield methods */
+
+// ReadBit returns the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) ReadBit(off int64) (out byte) {
+
+	if w, bit := b.bitView("ReadBit", off, 1, false); w != nil {
+
+		out = w.ReadBit(bit)
+
+	}
+	return
+
+}
+
+// ReadBitNext returns the next bit from the current offset and moves
+// the offset forward a bit
+func (b *ChainBuffer) ReadBitNext() (out byte) {
+
+	out = b.ReadBit(b.boff)
+	b.SeekBit(1, true)
+	return
+
+}
+
+// ReadBits returns the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) ReadBits(off, n int64) (out uint64) {
+
+	if w, bit := b.bitView("ReadBits", off, n, false); w != nil {
+
+		out = w.ReadBits(bit, n)
+
+	}
+	return
+
+}
+
+// ReadBitsNext returns the next n bits from the current offset and
+// moves the offset forward the amount of bits read
+func (b *ChainBuffer) ReadBitsNext(n int64) (out uint64) {
+
+	out = b.ReadBits(b.boff, n)
+	b.SeekBit(n, true)
+	return
+
+}
+
+// SetBit sets the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) SetBit(off int64) {
+
+	if w, bit := b.bitView("SetBit", off, 1, true); w != nil {
+
+		w.SetBit(bit)
+		b.store(off / 8)
+
+	}
+
+}
+
+// SetBitNext sets the next bit from the current offset and moves the
+// offset forward a bit
+func (b *ChainBuffer) SetBitNext() {
+
+	b.SetBit(b.boff)
+	b.SeekBit(1, true)
+
+}
+
+// ClearBit clears the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) ClearBit(off int64) {
+
+	if w, bit := b.bitView("ClearBit", off, 1, true); w != nil {
+
+		w.ClearBit(bit)
+		b.store(off / 8)
+
+	}
+
+}
+
+// ClearBitNext clears the next bit from the current offset and moves
+// the offset forward a bit
+func (b *ChainBuffer) ClearBitNext() {
+
+	b.ClearBit(b.boff)
+	b.SeekBit(1, true)
+
+}
+
+// SetBits sets the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) SetBits(off int64, data uint64, n int64) {
+
+	if w, bit := b.bitView("SetBits", off, n, true); w != nil {
+
+		w.SetBits(bit, data, n)
+		b.store(off / 8)
+
+	}
+
+}
+
+// SetBitsNext sets the next n bits from the current offset and moves
+// the offset forward the amount of bits set
+func (b *ChainBuffer) SetBitsNext(data uint64, n int64) {
+
+	b.SetBits(b.boff, data, n)
+	b.SeekBit(n, true)
+
+}
+
+// FlipBit flips the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) FlipBit(off int64) {
+
+	if w, bit := b.bitView("FlipBit", off, 1, true); w != nil {
+
+		w.FlipBit(bit)
+		b.store(off / 8)
+
+	}
+
+}
+
+// FlipBitNext flips the next bit from the current offset and moves
+// the offset forward a bit
+func (b *ChainBuffer) FlipBitNext() {
+
+	b.FlipBit(b.boff)
+	b.SeekBit(1, true)
+
+}
+
+// ClearAllBits sets all of the buffer's bits to 0
+func (b *ChainBuffer) ClearAllBits() {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	for _, chunk := range b.chunks {
+
+		for i := range chunk {
+
+			chunk[i] = 0x00
+
+		}
+
+	}
+
+}
+
+// SetAllBits sets all of the buffer's bits to 1
+func (b *ChainBuffer) SetAllBits() {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	for _, chunk := range b.chunks {
+
+		for i := range chunk {
+
+			chunk[i] = 0xff
+
+		}
+
+	}
+
+}
+
+// FlipAllBits flips all of the buffer's bits
+func (b *ChainBuffer) FlipAllBits() {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	for _, chunk := range b.chunks {
+
+		for i := range chunk {
+
+			chunk[i] = ^chunk[i]
+
+		}
+
+	}
+
+}
+
+// SeekBit seeks to bit at position off of the byte slice relative to
+// the current offset or to the beginning of the buffer, depending on
+// relative
+func (b *ChainBuffer) SeekBit(off int64, relative bool) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		b.boff += off
+
+	} else {
+
+		b.boff = off
+
+	}
+
+}
+
+// AfterBit returns the amount of bits located after the current
+// position or the specified one
+func (b *ChainBuffer) AfterBit(off ...int64) int64 {
+
+	if len(off) == 0 {
+
+		return b.bcap - b.boff - 1
+
+	}
+	return b.bcap - off[0] - 1
+
+}
+
+// AlignBit aligns the bit offset to the byte offset
+func (b *ChainBuffer) AlignBit() {
+
+	b.boff = b.off * 8
+
+}
+
+/* byte buffer methods */
+
+// WriteBytes writes bytes to the buffer at the specified offset
+// without modifying the internal offset value
+func (b *ChainBuffer) WriteBytes(off int64, data []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	if !b.writable(off, int64(len(data))) {
+
+		b.fail(BufferOverwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	b.scatter(off, data)
+
+}
+
+// WriteBytesNext writes bytes to the buffer at the current offset and
+// moves the offset forward the amount of bytes written
+func (b *ChainBuffer) WriteBytesNext(data []byte) {
+
+	b.WriteBytes(b.off, data)
+	b.SeekByte(int64(len(data)), true)
+
+}
+
+// PutByte writes a byte to the buffer at the specified offset without
+// modifying the internal offset value. it is not named WriteByte like
+// the Buffer method, as that is reserved for io.ByteWriter
+func (b *ChainBuffer) PutByte(off int64, data byte) {
+
+	b.WriteBytes(off, []byte{data})
+
+}
+
+// WriteByteNext writes a byte to the buffer at the current offset and
+// moves the offset forward the amount of bytes written
+func (b *ChainBuffer) WriteByteNext(data byte) {
+
+	b.WriteBytes(b.off, []byte{data})
+	b.SeekByte(1, true)
+
+}
+
+//generator:complex ChainBuffer Write U 16 LE
+
+//generator:complex ChainBuffer Write U 16 BE
+
+//generator:complex ChainBuffer Write U 24 LE
+
+//generator:complex ChainBuffer Write U 24 BE
+
+//generator:complex ChainBuffer Write U 32 LE
+
+//generator:complex ChainBuffer Write U 32 BE
+
+//generator:complex ChainBuffer Write U 40 LE
+
+//generator:complex ChainBuffer Write U 40 BE
+
+//generator:complex ChainBuffer Write U 48 LE
+
+//generator:complex ChainBuffer Write U 48 BE
+
+//generator:complex ChainBuffer Write U 56 LE
+
+//generator:complex ChainBuffer Write U 56 BE
+
+//generator:complex ChainBuffer Write U 64 LE
+
+//generator:complex ChainBuffer Write U 64 BE
+
+//generator:complex ChainBuffer Write I 16 LE
+
+//generator:complex ChainBuffer Write I 16 BE
+
+//generator:complex ChainBuffer Write I 24 LE
+
+//generator:complex ChainBuffer Write I 24 BE
+
+//generator:complex ChainBuffer Write I 32 LE
+
+//generator:complex ChainBuffer Write I 32 BE
+
+//generator:complex ChainBuffer Write I 40 LE
+
+//generator:complex ChainBuffer Write I 40 BE
+
+//generator:complex ChainBuffer Write I 48 LE
+
+//generator:complex ChainBuffer Write I 48 BE
+
+//generator:complex ChainBuffer Write I 56 LE
+
+//generator:complex ChainBuffer Write I 56 BE
+
+//generator:complex ChainBuffer Write I 64 LE
+
+//generator:complex ChainBuffer Write I 64 BE
+
+//generator:complex ChainBuffer Write F 32 LE
+
+//generator:complex ChainBuffer Write F 32 BE
+
+//generator:complex ChainBuffer Write F 64 LE
+
+//generator:complex ChainBuffer Write F 64 BE
+
+// ReadBytes returns the next n bytes from the specified offset
+// without modifying the internal offset value. the bytes are not
+// copied if they are contained in a single chunk, so the returned
+// slice only reflects later writes in that case
+func (b *ChainBuffer) ReadBytes(off, n int64) (out []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("ReadBytes", n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	if n > (b.cap - off) {
+
+		b.fail(BufferOverreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return []byte{}
+
+	}
+
+	if i, inner := b.locate(off); inner+n <= int64(len(b.chunks[i])) {
+
+		return b.chunks[i][inner : inner+n]
+
+	}
+
+	out = make([]byte, n)
+	b.gather(out, off)
+	return
+
+}
+
+// ReadBytesNext returns the next n bytes from the current offset and
+// moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadBytesNext(n int64) (out []byte) {
+
+	out = b.ReadBytes(b.off, n)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ReadByteAt returns the byte located at the specified offset without
+// modifying the internal offset value. it is not named ReadByte like
+// the Buffer method, as that is reserved for io.ByteReader
+func (b *ChainBuffer) ReadByteAt(off int64) (out byte) {
+
+	if w := b.view("ReadByteAt", off, 1, 1, false); w != nil {
+
+		out = w.buf[0]
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte from the current offset and
+// moves the offset forward a byte
+func (b *ChainBuffer) ReadByteNext() (out byte) {
+
+	out = b.ReadByteAt(b.off)
+	b.SeekByte(1, true)
+	return
+
+}
+
+//generator:complex ChainBuffer Read U 16 LE
+
+//generator:complex ChainBuffer Read U 16 BE
+
+//generator:complex ChainBuffer Read U 24 LE
+
+//generator:complex ChainBuffer Read U 24 BE
+
+//generator:complex ChainBuffer Read U 32 LE
+
+//generator:complex ChainBuffer Read U 32 BE
+
+//generator:complex ChainBuffer Read U 40 LE
+
+//generator:complex ChainBuffer Read U 40 BE
+
+//generator:complex ChainBuffer Read U 48 LE
+
+//generator:complex ChainBuffer Read U 48 BE
+
+//generator:complex ChainBuffer Read U 56 LE
+
+//generator:complex ChainBuffer Read U 56 BE
+
+//generator:complex ChainBuffer Read U 64 LE
+
+//generator:complex ChainBuffer Read U 64 BE
+
+//generator:complex ChainBuffer Read I 16 LE
+
+//generator:complex ChainBuffer Read I 16 BE
+
+//generator:complex ChainBuffer Read I 24 LE
+
+//generator:complex ChainBuffer Read I 24 BE
+
+//generator:complex ChainBuffer Read I 32 LE
+
+//generator:complex ChainBuffer Read I 32 BE
+
+//generator:complex ChainBuffer Read I 40 LE
+
+//generator:complex ChainBuffer Read I 40 BE
+
+//generator:complex ChainBuffer Read I 48 LE
+
+//generator:complex ChainBuffer Read I 48 BE
+
+//generator:complex ChainBuffer Read I 56 LE
+
+//generator:complex ChainBuffer Read I 56 BE
+
+//generator:complex ChainBuffer Read I 64 LE
+
+//generator:complex ChainBuffer Read I 64 BE
+
+//generator:complex ChainBuffer Read F 32 LE
+
+//generator:complex ChainBuffer Read F 32 BE
+
+//generator:complex ChainBuffer Read F 64 LE
+
+//generator:complex ChainBuffer Read F 64 BE
+
+// SeekByte seeks to position off of the byte slice relative to the
+// current offset or to the beginning of the buffer, depending on
+// relative
+func (b *ChainBuffer) SeekByte(off int64, relative bool) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		b.off += off
+
+	} else {
+
+		b.off = off
+
+	}
+
+}
+
+// AfterByte returns the amount of bytes located after the current
+// position or the specified one
+func (b *ChainBuffer) AfterByte(off ...int64) int64 {
+
+	if len(off) == 0 {
+
+		return b.cap - b.off - 1
+
+	}
+	return b.cap - off[0] - 1
+
+}
+
+// AlignByte aligns the byte offset to the bit offset
+func (b *ChainBuffer) AlignByte() {
+
+	b.off = b.boff / 8
+
+}
+
+// Append adds a chunk to the end of the buffer without copying it
+func (b *ChainBuffer) Append(chunk []byte) {
+
+	if b.err != nil || len(chunk) == 0x00 {
+
+		return
+
+	}
+
+	b.link(chunk)
+	b.owned = false
+
+}
+
+// Grow makes the buffer's capacity bigger by n bytes, adding a new
+// chunk to it if the last one was not allocated by the buffer or is
+// out of room
+func (b *ChainBuffer) Grow(n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0 {
+
+		b.fail(BufferInvalidByteCountError.count("Grow", n, b.cap))
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	if last := len(b.chunks) - 1; b.owned && n <= int64(cap(b.chunks[last])-len(b.chunks[last])) {
+
+		b.chunks[last] = b.chunks[last][:int64(len(b.chunks[last]))+n]
+		b.cap += n
+		b.bcap = b.cap * 8
+		return
+
+	}
+
+	b.link(make([]byte, n, b.cap+n))
+	b.owned = true
+
+}
+
+// Refresh updates the cached internal statistics of the buffer forcefully
+func (b *ChainBuffer) Refresh() {
+
+	b.starts = b.starts[:0]
+	b.cap = 0x00
+	for _, chunk := range b.chunks {
+
+		b.starts = append(b.starts, b.cap)
+		b.cap += int64(len(chunk))
+
+	}
+	b.bcap = b.cap * 8
+
+}
+
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the buffer. it defaults to MSBFirst
+func (b *ChainBuffer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// SetGrowth enables or disables automatic growth. while it is
+// enabled, writes past the end of the buffer grow it with Grow instead
+// of failing, as long as it would not become longer than max bytes. a
+// max of zero or less means that the buffer may grow without limit
+func (b *ChainBuffer) SetGrowth(enabled bool, max int64) {
+
+	b.grow = enabled
+	b.gmax = max
+
+}
+
+// SetSticky enables or disables sticky mode. in sticky mode, the
+// first out-of-range operation records an error on the buffer instead
+// of panicking and every following call becomes a no-op that returns
+// zero values until the error is cleared
+func (b *ChainBuffer) SetSticky(sticky bool) {
+
+	b.sticky = sticky
+
+}
+
+// ClearErr clears the error recorded by the buffer in sticky mode
+func (b *ChainBuffer) ClearErr() {
+
+	b.err = nil
+
+}
+
+// Reset removes every chunk from the buffer and resets its offsets
+func (b *ChainBuffer) Reset() {
+
+	b.chunks = b.chunks[:0]
+	b.owned = false
+	b.off = 0x00
+	b.boff = 0x00
+	b.err = nil
+	b.Refresh()
+
+}
+
+// Bytes returns the contents of the buffer copied into a single slice
+func (b *ChainBuffer) Bytes() []byte {
+
+	out := make([]byte, b.cap)
+	b.gather(out, 0x00)
+	return out
+
+}
+
+// Chunks returns the slices the buffer is made of. they are not copied
+func (b *ChainBuffer) Chunks() [][]byte {
+
+	return b.chunks
+
+}
+
+// Buffers returns the bytes from the current offset to the end of the
+// buffer as a net.Buffers without copying them
+func (b *ChainBuffer) Buffers() (out net.Buffers) {
+
+	if b.off < 0x00 || b.off >= b.cap {
+
+		return
+
+	}
+
+	i, inner := b.locate(b.off)
+	out = append(out, b.chunks[i][inner:])
+	return append(out, b.chunks[i+1:]...)
+
+}
+
+// WriteTo writes the bytes from the current offset to the end of the
+// buffer to w and moves the offset forward the amount of bytes
+// written. the chunks are written with a single vectored write if w
+// supports it, such as when it is a *net.TCPConn
+func (b *ChainBuffer) WriteTo(w io.Writer) (n int64, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderreadError.at("WriteTo", b.off, 0, b.cap)
+
+	}
+
+	buffers := b.Buffers()
+	n, err = buffers.WriteTo(w)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ByteCapacity returns the capacity of the buffer
+func (b *ChainBuffer) ByteCapacity() int64 {
+
+	return b.cap
+
+}
+
+// BitCapacity returns the bit capacity of the buffer
+func (b *ChainBuffer) BitCapacity() int64 {
+
+	return b.bcap
+
+}
+
+// ByteOffset returns the current offset of the buffer
+func (b *ChainBuffer) ByteOffset() int64 {
+
+	return b.off
+
+}
+
+// BitOffset returns the current bit offset of the buffer
+func (b *ChainBuffer) BitOffset() int64 {
+
+	return b.boff
+
+}
+
+// Err returns the error recorded by the buffer in sticky mode, if any
+func (b *ChainBuffer) Err() error {
+
+	return b.err
+
+}
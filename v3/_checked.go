@@ -0,0 +1,855 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+// CheckedBuffer implements a wrapper around Buffer that returns an
+// error from every operation instead of panicking when it falls
+// outside of the buffer. an error recorded by the wrapped buffer in
+// sticky mode is returned by every operation until it is cleared
+type CheckedBuffer struct {
+	buf *Buffer
+}
+
+// NewCheckedBuffer initializes a new CheckedBuffer with the provided
+// byte slice(s) stored inside in the order provided
+func NewCheckedBuffer(slices ...[]byte) *CheckedBuffer {
+
+	return &CheckedBuffer{
+		buf: NewBuffer(slices...),
+	}
+
+}
+
+// Checked returns a CheckedBuffer that operates on the same data and
+// offsets as the buffer
+func (b *Buffer) Checked() *CheckedBuffer {
+
+	return &CheckedBuffer{
+		buf: b,
+	}
+
+}
+
+/* bitfield methods */
+
+// ReadBit returns the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) ReadBit(off int64) (out byte, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if off > (b.buf.bcap - 1) {
+
+		err = BufferOverreadError.atBit("ReadBit", off, 1, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.atBit("ReadBit", off, 1, b.buf.bcap)
+		return
+
+	}
+
+	out = b.buf.ReadBit(off)
+	return
+
+}
+
+// ReadBitNext returns the next bit from the current offset and moves
+// the offset forward a bit
+func (b *CheckedBuffer) ReadBitNext() (out byte, err error) {
+
+	out, err = b.ReadBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// ReadBits returns the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) ReadBits(off, n int64) (out uint64, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > 64 {
+
+		err = BufferInvalidBitCountError.atBit("ReadBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.atBit("ReadBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n > (b.buf.bcap - off) {
+
+		err = BufferOverreadError.atBit("ReadBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	out = b.buf.ReadBits(off, n)
+	return
+
+}
+
+// ReadBitsNext returns the next n bits from the current offset and
+// moves the offset forward the amount of bits read
+func (b *CheckedBuffer) ReadBitsNext(n int64) (out uint64, err error) {
+
+	out, err = b.ReadBits(b.buf.boff, n)
+	if err == nil {
+
+		b.buf.SeekBit(n, true)
+
+	}
+	return
+
+}
+
+// SetBit sets the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) SetBit(off int64) (err error) {
+
+	err = b.checkBitWrite("SetBit", off)
+	if err == nil {
+
+		b.buf.SetBit(off)
+
+	}
+	return
+
+}
+
+// SetBitNext sets the next bit from the current offset and moves the
+// offset forward a bit
+func (b *CheckedBuffer) SetBitNext() (err error) {
+
+	err = b.SetBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// ClearBit clears the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) ClearBit(off int64) (err error) {
+
+	err = b.checkBitWrite("ClearBit", off)
+	if err == nil {
+
+		b.buf.ClearBit(off)
+
+	}
+	return
+
+}
+
+// ClearBitNext clears the next bit from the current offset and moves
+// the offset forward a bit
+func (b *CheckedBuffer) ClearBitNext() (err error) {
+
+	err = b.ClearBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// SetBits sets the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) SetBits(off int64, data uint64, n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > 64 {
+
+		err = BufferInvalidBitCountError.atBit("SetBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderwriteError.atBit("SetBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n > (b.buf.bcap-off) && !b.buf.reserve(off/8+(off%8+n+7)/8) {
+
+		err = BufferOverwriteError.atBit("SetBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	b.buf.SetBits(off, data, n)
+	return
+
+}
+
+// SetBitsNext sets the next n bits from the current offset and moves
+// the offset forward the amount of bits set
+func (b *CheckedBuffer) SetBitsNext(data uint64, n int64) (err error) {
+
+	err = b.SetBits(b.buf.boff, data, n)
+	if err == nil {
+
+		b.buf.SeekBit(n, true)
+
+	}
+	return
+
+}
+
+// FlipBit flips the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) FlipBit(off int64) (err error) {
+
+	err = b.checkBitWrite("FlipBit", off)
+	if err == nil {
+
+		b.buf.FlipBit(off)
+
+	}
+	return
+
+}
+
+// FlipBitNext flips the next bit from the current offset and moves
+// the offset forward a bit
+func (b *CheckedBuffer) FlipBitNext() (err error) {
+
+	err = b.FlipBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// ClearAllBits sets all of the buffer's bits to 0
+func (b *CheckedBuffer) ClearAllBits() {
+
+	if b.buf.cap != 0x00 {
+
+		b.buf.ClearAllBits()
+
+	}
+
+}
+
+// SetAllBits sets all of the buffer's bits to 1
+func (b *CheckedBuffer) SetAllBits() {
+
+	if b.buf.cap != 0x00 {
+
+		b.buf.SetAllBits()
+
+	}
+
+}
+
+// FlipAllBits flips all of the buffer's bits
+func (b *CheckedBuffer) FlipAllBits() {
+
+	if b.buf.cap != 0x00 {
+
+		b.buf.FlipAllBits()
+
+	}
+
+}
+
+// SeekBit seeks to bit position off of the the buffer relative to
+// the current position or exact. the offset is not moved if the new
+// position would be outside of the buffer
+func (b *CheckedBuffer) SeekBit(off int64, relative bool) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		off += b.buf.boff
+
+	}
+
+	if off > b.buf.bcap {
+
+		err = BufferOverseekError.atBit("SeekBit", off, 0, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderseekError.atBit("SeekBit", off, 0, b.buf.bcap)
+		return
+
+	}
+
+	b.buf.SeekBit(off, false)
+	return
+
+}
+
+// AfterBit returns the amount of bits located after the current bit
+// position or the specified one
+func (b *CheckedBuffer) AfterBit(off ...int64) int64 {
+
+	return b.buf.AfterBit(off...)
+
+}
+
+// AlignBit aligns the bit offset to the byte offset
+func (b *CheckedBuffer) AlignBit() {
+
+	b.buf.AlignBit()
+
+}
+
+/* byte buffer methods */
+
+// WriteBytes writes bytes to the buffer at the specified offset
+// without modifying the internal offset value
+func (b *CheckedBuffer) WriteBytes(off int64, data []byte) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderwriteError.at("WriteBytes", off, int64(len(data)), b.buf.cap)
+		return
+
+	}
+
+	if !b.buf.writable(off, int64(len(data))) {
+
+		err = BufferOverwriteError.at("WriteBytes", off, int64(len(data)), b.buf.cap)
+		return
+
+	}
+
+	b.buf.WriteBytes(off, data)
+	return
+
+}
+
+// WriteBytesNext writes bytes to the buffer at the current offset
+// and moves the offset forward the amount of bytes written
+func (b *CheckedBuffer) WriteBytesNext(data []byte) (err error) {
+
+	if err = b.buf.unaligned("WriteBytesNext"); err != nil {
+
+		return
+
+	}
+
+	err = b.WriteBytes(b.buf.off, data)
+	if err == nil {
+
+		b.buf.SeekByte(int64(len(data)), true)
+
+	}
+	return
+
+}
+
+// PutByte writes a byte to the buffer at the specified offset without
+// modifying the internal offset value. it is not named WriteByte like
+// the Buffer method, as that is reserved for io.ByteWriter
+func (b *CheckedBuffer) PutByte(off int64, data byte) error {
+
+	return b.WriteBytes(off, []byte{data})
+
+}
+
+// WriteByteNext writes a byte to the buffer at the current
+// offset and moves the offset forward the amount of bytes written
+func (b *CheckedBuffer) WriteByteNext(data byte) error {
+
+	return b.WriteBytesNext([]byte{data})
+
+}
+
+//generator:complex CheckedBuffer Write U 16 LE
+
+//generator:complex CheckedBuffer Write U 16 BE
+
+//generator:complex CheckedBuffer Write U 24 LE
+
+//generator:complex CheckedBuffer Write U 24 BE
+
+//generator:complex CheckedBuffer Write U 32 LE
+
+//generator:complex CheckedBuffer Write U 32 BE
+
+//generator:complex CheckedBuffer Write U 40 LE
+
+//generator:complex CheckedBuffer Write U 40 BE
+
+//generator:complex CheckedBuffer Write U 48 LE
+
+//generator:complex CheckedBuffer Write U 48 BE
+
+//generator:complex CheckedBuffer Write U 56 LE
+
+//generator:complex CheckedBuffer Write U 56 BE
+
+//generator:complex CheckedBuffer Write U 64 LE
+
+//generator:complex CheckedBuffer Write U 64 BE
+
+//generator:complex CheckedBuffer Write I 16 LE
+
+//generator:complex CheckedBuffer Write I 16 BE
+
+//generator:complex CheckedBuffer Write I 24 LE
+
+//generator:complex CheckedBuffer Write I 24 BE
+
+//generator:complex CheckedBuffer Write I 32 LE
+
+//generator:complex CheckedBuffer Write I 32 BE
+
+//generator:complex CheckedBuffer Write I 40 LE
+
+//generator:complex CheckedBuffer Write I 40 BE
+
+//generator:complex CheckedBuffer Write I 48 LE
+
+//generator:complex CheckedBuffer Write I 48 BE
+
+//generator:complex CheckedBuffer Write I 56 LE
+
+//generator:complex CheckedBuffer Write I 56 BE
+
+//generator:complex CheckedBuffer Write I 64 LE
+
+//generator:complex CheckedBuffer Write I 64 BE
+
+//generator:complex CheckedBuffer Write F 32 LE
+
+//generator:complex CheckedBuffer Write F 32 BE
+
+//generator:complex CheckedBuffer Write F 64 LE
+
+//generator:complex CheckedBuffer Write F 64 BE
+
+// ReadBytes returns the next n bytes from the specified offset
+// without modifying the internal offset value
+func (b *CheckedBuffer) ReadBytes(off, n int64) (out []byte, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		err = BufferInvalidByteCountError.at("ReadBytes", off, n, b.buf.cap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.at("ReadBytes", off, n, b.buf.cap)
+		return
+
+	}
+
+	if !b.buf.readable(off, n, 1) {
+
+		err = BufferOverreadError.at("ReadBytes", off, n, b.buf.cap)
+		return
+
+	}
+
+	out = b.buf.ReadBytes(off, n)
+	return
+
+}
+
+// ReadBytesNext returns the next n bytes from the current offset
+// and moves the offset forward the amount of bytes read
+func (b *CheckedBuffer) ReadBytesNext(n int64) (out []byte, err error) {
+
+	if err = b.buf.unaligned("ReadBytesNext"); err != nil {
+
+		return
+
+	}
+
+	out, err = b.ReadBytes(b.buf.off, n)
+	if err == nil {
+
+		b.buf.SeekByte(n, true)
+
+	}
+	return
+
+}
+
+// ReadByteAt returns the byte located at the specified offset without
+// modifying the internal offset value. it is not named ReadByte like
+// the Buffer method, as that is reserved for io.ByteReader
+func (b *CheckedBuffer) ReadByteAt(off int64) (out byte, err error) {
+
+	var bytes []byte
+	bytes, err = b.ReadBytes(off, 1)
+	if err == nil {
+
+		out = bytes[0]
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte from the current offset and
+// moves the offset forward a byte
+func (b *CheckedBuffer) ReadByteNext() (out byte, err error) {
+
+	if err = b.buf.unaligned("ReadByteNext"); err != nil {
+
+		return
+
+	}
+
+	out, err = b.ReadByteAt(b.buf.off)
+	if err == nil {
+
+		b.buf.SeekByte(1, true)
+
+	}
+	return
+
+}
+
+//generator:complex CheckedBuffer Read U 16 LE
+
+//generator:complex CheckedBuffer Read U 16 BE
+
+//generator:complex CheckedBuffer Read U 24 LE
+
+//generator:complex CheckedBuffer Read U 24 BE
+
+//generator:complex CheckedBuffer Read U 32 LE
+
+//generator:complex CheckedBuffer Read U 32 BE
+
+//generator:complex CheckedBuffer Read U 40 LE
+
+//generator:complex CheckedBuffer Read U 40 BE
+
+//generator:complex CheckedBuffer Read U 48 LE
+
+//generator:complex CheckedBuffer Read U 48 BE
+
+//generator:complex CheckedBuffer Read U 56 LE
+
+//generator:complex CheckedBuffer Read U 56 BE
+
+//generator:complex CheckedBuffer Read U 64 LE
+
+//generator:complex CheckedBuffer Read U 64 BE
+
+//generator:complex CheckedBuffer Read I 16 LE
+
+//generator:complex CheckedBuffer Read I 16 BE
+
+//generator:complex CheckedBuffer Read I 24 LE
+
+//generator:complex CheckedBuffer Read I 24 BE
+
+//generator:complex CheckedBuffer Read I 32 LE
+
+//generator:complex CheckedBuffer Read I 32 BE
+
+//generator:complex CheckedBuffer Read I 40 LE
+
+//generator:complex CheckedBuffer Read I 40 BE
+
+//generator:complex CheckedBuffer Read I 48 LE
+
+//generator:complex CheckedBuffer Read I 48 BE
+
+//generator:complex CheckedBuffer Read I 56 LE
+
+//generator:complex CheckedBuffer Read I 56 BE
+
+//generator:complex CheckedBuffer Read I 64 LE
+
+//generator:complex CheckedBuffer Read I 64 BE
+
+//generator:complex CheckedBuffer Read F 32 LE
+
+//generator:complex CheckedBuffer Read F 32 BE
+
+//generator:complex CheckedBuffer Read F 64 LE
+
+//generator:complex CheckedBuffer Read F 64 BE
+
+// SeekByte seeks to position off of the buffer relative to the
+// current position or exact. the offset is not moved if the new
+// position would be outside of the buffer
+func (b *CheckedBuffer) SeekByte(off int64, relative bool) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		off += b.buf.off
+
+	}
+
+	if off > b.buf.cap {
+
+		err = BufferOverseekError.at("SeekByte", off, 0, b.buf.cap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderseekError.at("SeekByte", off, 0, b.buf.cap)
+		return
+
+	}
+
+	b.buf.SeekByte(off, false)
+	return
+
+}
+
+// AfterByte returns the amount of bytes located after the current
+// position or the specified one
+func (b *CheckedBuffer) AfterByte(off ...int64) int64 {
+
+	return b.buf.AfterByte(off...)
+
+}
+
+// AlignByte aligns the byte offset to the bit offset
+func (b *CheckedBuffer) AlignByte() {
+
+	b.buf.AlignByte()
+
+}
+
+/* generic methods */
+
+// TruncateLeft truncates the buffer on the left side
+func (b *CheckedBuffer) TruncateLeft(n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > b.buf.cap {
+
+		err = BufferInvalidByteCountError.count("TruncateLeft", n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.TruncateLeft(n)
+	return
+
+}
+
+// TruncateRight truncates the buffer on the right side
+func (b *CheckedBuffer) TruncateRight(n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > b.buf.cap {
+
+		err = BufferInvalidByteCountError.count("TruncateRight", n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.TruncateRight(n)
+	return
+
+}
+
+// Grow makes the buffer's capacity bigger by n bytes
+func (b *CheckedBuffer) Grow(n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		err = BufferInvalidByteCountError.count("Grow", n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.Grow(n)
+	return
+
+}
+
+// Refresh updates the cached internal statistics of the buffer forcefully
+func (b *CheckedBuffer) Refresh() {
+
+	b.buf.Refresh()
+
+}
+
+// Reset resets the entire buffer
+func (b *CheckedBuffer) Reset() {
+
+	b.buf.Reset()
+
+}
+
+/* internal use methods */
+
+// checkBitWrite returns the error that a write to the bit at the
+// specified offset by op would cause, if any
+func (b *CheckedBuffer) checkBitWrite(op string, off int64) error {
+
+	if b.buf.err != nil {
+
+		return b.buf.err
+
+	}
+
+	if off > (b.buf.bcap-1) && !b.buf.reserve(off/8+1) {
+
+		return BufferOverwriteError.atBit(op, off, 1, b.buf.bcap)
+
+	}
+
+	if off < 0x00 {
+
+		return BufferUnderwriteError.atBit(op, off, 1, b.buf.bcap)
+
+	}
+
+	return nil
+
+}
+
+/* value retrieval */
+
+// Buffer returns the Buffer wrapped by the CheckedBuffer
+func (b *CheckedBuffer) Buffer() *Buffer {
+
+	return b.buf
+
+}
+
+// Bytes returns the internal byte slice of the buffer
+func (b *CheckedBuffer) Bytes() []byte {
+
+	return b.buf.Bytes()
+
+}
+
+// ByteCapacity returns the capacity of the buffer
+func (b *CheckedBuffer) ByteCapacity() int64 {
+
+	return b.buf.ByteCapacity()
+
+}
+
+// BitCapacity returns the bit capacity of the buffer
+func (b *CheckedBuffer) BitCapacity() int64 {
+
+	return b.buf.BitCapacity()
+
+}
+
+// ByteOffset returns the current offset of the buffer
+func (b *CheckedBuffer) ByteOffset() int64 {
+
+	return b.buf.ByteOffset()
+
+}
+
+// BitOffset returns the current bit offset of the buffer
+func (b *CheckedBuffer) BitOffset() int64 {
+
+	return b.buf.BitOffset()
+
+}
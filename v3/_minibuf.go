@@ -22,6 +22,11 @@ type MiniBuffer struct {
 	cap  int64
 	boff int64
 	bcap int64
+
+	grow bool
+	gmax int64
+
+	order BitOrder
 }
 
 // NewMiniBuffer initilaizes a new MiniBuffer with the provided byte
@@ -64,13 +69,33 @@ func NewMiniBuffer(out **MiniBuffer, slices ...[]byte) {
 
 }
 
+/* internal use methods */
+
+// reserve grows the buffer so that it is at least n bytes long if the
+// buffer is allowed to grow automatically
+func (b *MiniBuffer) reserve(n int64) {
+
+	if !b.grow || (b.gmax > 0x00 && n > b.gmax) {
+
+		return
+
+	}
+
+	if n > b.cap {
+
+		b.Grow(n - b.cap)
+
+	}
+
+}
+
 /* bitfield methods */
 
 // ReadBit stores the bit located at the specified offset without
 // modifying the internal offset value in out
 func (b *MiniBuffer) ReadBit(out *byte, off int64) {
 
-	*out = (b.buf[off/8] >> (7 - uint64(off%8))) & 1
+	*out = (b.buf[off/8] >> b.order.shift(off)) & 1
 
 }
 
@@ -87,22 +112,7 @@ func (b *MiniBuffer) ReadBitNext(out *byte) {
 // modifying the internal offset value in out
 func (b *MiniBuffer) ReadBits(out *uint64, off, n int64) {
 
-	var (
-		bout byte
-
-		i = int64(0)
-	)
-	{
-	read_loop:
-		b.ReadBit(&bout, off+i)
-		*out = (*out << uint64(1)) | uint64(bout)
-		i++
-		if i < n {
-
-			goto read_loop
-
-		}
-	}
+	*out = readBits(b.buf, off, n, b.order)
 
 }
 
@@ -119,7 +129,13 @@ func (b *MiniBuffer) ReadBitsNext(out *uint64, n int64) {
 // modifying the internal offset value
 func (b *MiniBuffer) SetBit(off int64) {
 
-	b.buf[off/8] |= (1 << uint(7-(off%8)))
+	if b.grow && off > (b.bcap-1) {
+
+		b.reserve(off/8 + 1)
+
+	}
+
+	b.buf[off/8] |= (1 << b.order.shift(off))
 
 }
 
@@ -136,7 +152,13 @@ func (b *MiniBuffer) SetBitNext() {
 // modifying the internal offset value
 func (b *MiniBuffer) ClearBit(off int64) {
 
-	b.buf[off/8] &= ^(1 << uint(7-(off%8)))
+	if b.grow && off > (b.bcap-1) {
+
+		b.reserve(off/8 + 1)
+
+	}
+
+	b.buf[off/8] &= ^(1 << b.order.shift(off))
 
 }
 
@@ -153,27 +175,14 @@ func (b *MiniBuffer) ClearBitNext() {
 // modifying the internal offset value
 func (b *MiniBuffer) SetBits(off int64, data uint64, n int64) {
 
-	i := int64(0)
-
-	{
-	write_loop:
-		if byte((data>>uint64(n-i-1))&1) == 0 {
+	if b.grow && (off+n) > b.bcap {
 
-			b.ClearBit(off + i)
+		b.reserve((off + n + 7) / 8)
 
-		} else {
-
-			b.SetBit(off + i)
-
-		}
-		i++
-		if i < n {
-
-			goto write_loop
-
-		}
 	}
 
+	writeBits(b.buf, off, data, n, b.order)
+
 }
 
 // SetBitsNext sets the next n bits from the current offset and moves
@@ -189,7 +198,13 @@ func (b *MiniBuffer) SetBitsNext(data uint64, n int64) {
 // modifying the internal offset value
 func (b *MiniBuffer) FlipBit(off int64) {
 
-	b.buf[off/8] ^= (1 << uint(7-(off%8)))
+	if b.grow && off > (b.bcap-1) {
+
+		b.reserve(off/8 + 1)
+
+	}
+
+	b.buf[off/8] ^= (1 << b.order.shift(off))
 
 }
 
@@ -306,6 +321,12 @@ func (b *MiniBuffer) AlignBit() {
 // without modifying the internal offset value
 func (b *MiniBuffer) WriteBytes(off int64, data []byte) {
 
+	if b.grow && (off+int64(len(data))) > b.cap {
+
+		b.reserve(off + int64(len(data)))
+
+	}
+
 	copy(b.buf[off:], data)
 
 }
@@ -323,10 +344,26 @@ func (b *MiniBuffer) WriteBytesNext(data []byte) {
 
 //generator:complex MiniBuffer Write U 16 BE
 
+//generator:complex MiniBuffer Write U 24 LE
+
+//generator:complex MiniBuffer Write U 24 BE
+
 //generator:complex MiniBuffer Write U 32 LE
 
 //generator:complex MiniBuffer Write U 32 BE
 
+//generator:complex MiniBuffer Write U 40 LE
+
+//generator:complex MiniBuffer Write U 40 BE
+
+//generator:complex MiniBuffer Write U 48 LE
+
+//generator:complex MiniBuffer Write U 48 BE
+
+//generator:complex MiniBuffer Write U 56 LE
+
+//generator:complex MiniBuffer Write U 56 BE
+
 //generator:complex MiniBuffer Write U 64 LE
 
 //generator:complex MiniBuffer Write U 64 BE
@@ -335,10 +372,26 @@ func (b *MiniBuffer) WriteBytesNext(data []byte) {
 
 //generator:complex MiniBuffer Write I 16 BE
 
+//generator:complex MiniBuffer Write I 24 LE
+
+//generator:complex MiniBuffer Write I 24 BE
+
 //generator:complex MiniBuffer Write I 32 LE
 
 //generator:complex MiniBuffer Write I 32 BE
 
+//generator:complex MiniBuffer Write I 40 LE
+
+//generator:complex MiniBuffer Write I 40 BE
+
+//generator:complex MiniBuffer Write I 48 LE
+
+//generator:complex MiniBuffer Write I 48 BE
+
+//generator:complex MiniBuffer Write I 56 LE
+
+//generator:complex MiniBuffer Write I 56 BE
+
 //generator:complex MiniBuffer Write I 64 LE
 
 //generator:complex MiniBuffer Write I 64 BE
@@ -372,10 +425,26 @@ func (b *MiniBuffer) ReadBytesNext(out *[]byte, n int64) {
 
 //generator:complex MiniBuffer Read U 16 BE
 
+//generator:complex MiniBuffer Read U 24 LE
+
+//generator:complex MiniBuffer Read U 24 BE
+
 //generator:complex MiniBuffer Read U 32 LE
 
 //generator:complex MiniBuffer Read U 32 BE
 
+//generator:complex MiniBuffer Read U 40 LE
+
+//generator:complex MiniBuffer Read U 40 BE
+
+//generator:complex MiniBuffer Read U 48 LE
+
+//generator:complex MiniBuffer Read U 48 BE
+
+//generator:complex MiniBuffer Read U 56 LE
+
+//generator:complex MiniBuffer Read U 56 BE
+
 //generator:complex MiniBuffer Read U 64 LE
 
 //generator:complex MiniBuffer Read U 64 BE
@@ -384,10 +453,26 @@ func (b *MiniBuffer) ReadBytesNext(out *[]byte, n int64) {
 
 //generator:complex MiniBuffer Read I 16 BE
 
+//generator:complex MiniBuffer Read I 24 LE
+
+//generator:complex MiniBuffer Read I 24 BE
+
 //generator:complex MiniBuffer Read I 32 LE
 
 //generator:complex MiniBuffer Read I 32 BE
 
+//generator:complex MiniBuffer Read I 40 LE
+
+//generator:complex MiniBuffer Read I 40 BE
+
+//generator:complex MiniBuffer Read I 48 LE
+
+//generator:complex MiniBuffer Read I 48 BE
+
+//generator:complex MiniBuffer Read I 56 LE
+
+//generator:complex MiniBuffer Read I 56 BE
+
 //generator:complex MiniBuffer Read I 64 LE
 
 //generator:complex MiniBuffer Read I 64 BE
@@ -473,6 +558,25 @@ func (b *MiniBuffer) Grow(n int64) {
 
 }
 
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the buffer. it defaults to MSBFirst
+func (b *MiniBuffer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// SetGrowth enables or disables automatic growth. while it is
+// enabled, writes past the end of the buffer grow it with Grow, as
+// long as it would not become longer than max bytes. a max of zero or
+// less means that the buffer may grow without limit
+func (b *MiniBuffer) SetGrowth(enabled bool, max int64) {
+
+	b.grow = enabled
+	b.gmax = max
+
+}
+
 // Refresh updates the cached internal statistics of the buffer forcefully
 func (b *MiniBuffer) Refresh() {
 
@@ -0,0 +1,544 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"io"
+	"math"
+)
+
+// defaultReaderSize is the size of the window of a Reader created with
+// NewReader
+const defaultReaderSize = 4096
+
+// Reader implements a streaming decoder in go that reads data from an
+// io.Reader through a window that is refilled on demand, so that input
+// of any length can be decoded using a bounded amount of memory. it
+// only moves forward, and its byte and bit operations share a single
+// position as they do in a Buffer using UnifiedCursor.
+//
+// instead of panicking, a Reader records the first error it runs into
+// and every following call becomes a no-op that returns zero values,
+// like a Buffer in sticky mode. running out of input before a value
+// starts records io.EOF, while running out partway through one records
+// io.ErrUnexpectedEOF. the window only grows as input arrives, so a
+// hostile length fails with an error once the input ends instead of
+// being allocated up front
+type Reader struct {
+	r io.Reader
+
+	// buf holds the window. the unread part of it is buf[head:tail]
+	buf  []byte
+	head int
+	tail int
+
+	// off is the amount of bytes consumed before the window and bit is
+	// the bit offset within the byte at head
+	off int64
+	bit int64
+
+	err error
+
+	order BitOrder
+
+	// scratch is the buffer the typed methods operate on
+	scratch Buffer
+}
+
+// NewReader initializes a new Reader that reads from r with a window of
+// the default size
+func NewReader(r io.Reader) *Reader {
+
+	return NewReaderSize(r, defaultReaderSize)
+
+}
+
+// NewReaderSize initializes a new Reader that reads from r with a
+// window of at least size bytes. the window only grows past size if a
+// single read needs more than that
+func NewReaderSize(r io.Reader, size int) *Reader {
+
+	if size < 16 {
+
+		size = 16
+
+	}
+
+	return &Reader{
+		r:   r,
+		buf: make([]byte, size),
+	}
+
+}
+
+/* internal use methods */
+
+// fill makes sure that at least n unread bytes are in the window,
+// recording an error if the input ends before it is possible
+func (b *Reader) fill(n int) bool {
+
+	if b.tail-b.head >= n {
+
+		return true
+
+	}
+
+	// move the unread bytes to the start of the window
+	b.tail = copy(b.buf, b.buf[b.head:b.tail])
+	b.off += int64(b.head)
+	b.head = 0x00
+
+	for b.tail < n {
+
+		// the window is doubled each time it fills up rather than grown
+		// to n at once, so that it never gets much larger than the input
+		if b.tail == len(b.buf) {
+
+			size := n
+			if len(b.buf) < n/2 {
+
+				size = len(b.buf) * 2
+
+			}
+
+			buf := make([]byte, size)
+			copy(buf, b.buf[:b.tail])
+			b.buf = buf
+
+		}
+
+		want := n
+		if want > len(b.buf) {
+
+			want = len(b.buf)
+
+		}
+
+		m, err := io.ReadAtLeast(b.r, b.buf[b.tail:], want-b.tail)
+		b.tail += m
+		if err != nil {
+
+			// the input ending within a value is always unexpected
+			if err == io.EOF && b.tail > 0x00 {
+
+				err = io.ErrUnexpectedEOF
+
+			}
+			b.err = err
+			return false
+
+		}
+
+	}
+	return true
+
+}
+
+// skip consumes the next n bytes of the input a window at a time,
+// returning whether or not they could be read
+func (b *Reader) skip(n int64) bool {
+
+	for n > 0x00 {
+
+		chunk := n
+		if chunk > int64(len(b.buf)) {
+
+			chunk = int64(len(b.buf))
+
+		}
+
+		if !b.fill(int(chunk)) {
+
+			return false
+
+		}
+		b.head += int(chunk)
+		n -= chunk
+
+	}
+	return true
+
+}
+
+// align moves the position to the next byte boundary if it is not on
+// one already
+func (b *Reader) align() {
+
+	if b.bit > 0x00 {
+
+		b.head++
+		b.bit = 0x00
+
+	}
+
+}
+
+// window consumes the next n values of size bytes each and returns the
+// scratch buffer pointed at them, or nil if they could not be read
+func (b *Reader) window(op string, n, size int64) *Buffer {
+
+	if b.err != nil {
+
+		return nil
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count(op, n, b.ByteOffset())
+		return nil
+
+	}
+
+	// a length that does not fit in an int could never be read anyway
+	if n > math.MaxInt/size {
+
+		b.err = BufferOverreadError.at(op, b.ByteOffset(), n, b.ByteOffset())
+		return nil
+
+	}
+	n *= size
+
+	b.align()
+	if !b.fill(int(n)) {
+
+		return nil
+
+	}
+
+	b.scratch.buf = b.buf[b.head : b.head+int(n) : b.head+int(n)]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+	b.head += int(n)
+	return &b.scratch
+
+}
+
+// bitWindow is the bit-level variant of window. it consumes the next n
+// bits of the input and returns the scratch buffer pointed at the
+// bytes containing them, along with the offset of the first of them
+// within it
+func (b *Reader) bitWindow(op string, n int64) (*Buffer, int64) {
+
+	if b.err != nil {
+
+		return nil, 0x00
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count(op, n, b.ByteOffset())
+		return nil, 0x00
+
+	}
+
+	if n/8 > math.MaxInt-1 {
+
+		b.err = BufferOverreadError.atBit(op, b.BitOffset(), n, b.BitOffset())
+		return nil, 0x00
+
+	}
+
+	// n is split up so that adding the bit offset to it cannot overflow
+	length := int(n/8 + (b.bit+n%8+7)/8)
+	if !b.fill(length) {
+
+		return nil, 0x00
+
+	}
+
+	b.scratch.buf = b.buf[b.head : b.head+length : b.head+length]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+
+	bit := b.bit
+	b.head += int(n/8 + (b.bit+n%8)/8)
+	b.bit = (b.bit + n%8) % 8
+	return &b.scratch, bit
+
+}
+
+/* bitfield methods */
+
+// ReadBitNext returns the next bit and moves the position forward a bit
+func (b *Reader) ReadBitNext() (out byte) {
+
+	if w, bit := b.bitWindow("ReadBitNext", 1); w != nil {
+
+		out = w.ReadBit(bit)
+
+	}
+	return
+
+}
+
+// ReadBitsNext returns the next n bits and moves the position forward
+// the amount of bits read
+func (b *Reader) ReadBitsNext(n int64) (out uint64) {
+
+	if w, bit := b.bitWindow("ReadBitsNext", n); w != nil {
+
+		out = w.ReadBits(bit, n)
+
+	}
+	return
+
+}
+
+// SkipBits moves the position forward n bits without reading them
+func (b *Reader) SkipBits(n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count("SkipBits", n, b.ByteOffset())
+		return
+
+	}
+
+	// n is split up so that adding the bit offset to it cannot overflow
+	bit := b.bit + n%8
+	b.bit = 0x00
+	if b.skip(n/8+bit/8) && bit%8 > 0x00 && b.fill(1) {
+
+		b.bit = bit % 8
+
+	}
+
+}
+
+// AlignByte moves the position forward to the next byte boundary if it
+// is not on one already
+func (b *Reader) AlignByte() {
+
+	if b.err != nil {
+
+		return
+
+	}
+	b.align()
+
+}
+
+/* byte buffer methods */
+
+// ReadBytesNext returns the next n bytes and moves the position forward
+// the amount of bytes read. the returned slice points into the window
+// of the reader, so it is only valid until the next call
+func (b *Reader) ReadBytesNext(n int64) (out []byte) {
+
+	if w := b.window("ReadBytesNext", n, 1); w != nil {
+
+		out = w.buf
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte and moves the position forward a
+// byte
+func (b *Reader) ReadByteNext() (out byte) {
+
+	if w := b.window("ReadByteNext", 1, 1); w != nil {
+
+		out = w.buf[0]
+
+	}
+	return
+
+}
+
+//generator:complex Reader Read U 16 LE
+
+//generator:complex Reader Read U 16 BE
+
+//generator:complex Reader Read U 24 LE
+
+//generator:complex Reader Read U 24 BE
+
+//generator:complex Reader Read U 32 LE
+
+//generator:complex Reader Read U 32 BE
+
+//generator:complex Reader Read U 40 LE
+
+//generator:complex Reader Read U 40 BE
+
+//generator:complex Reader Read U 48 LE
+
+//generator:complex Reader Read U 48 BE
+
+//generator:complex Reader Read U 56 LE
+
+//generator:complex Reader Read U 56 BE
+
+//generator:complex Reader Read U 64 LE
+
+//generator:complex Reader Read U 64 BE
+
+//generator:complex Reader Read I 16 LE
+
+//generator:complex Reader Read I 16 BE
+
+//generator:complex Reader Read I 24 LE
+
+//generator:complex Reader Read I 24 BE
+
+//generator:complex Reader Read I 32 LE
+
+//generator:complex Reader Read I 32 BE
+
+//generator:complex Reader Read I 40 LE
+
+//generator:complex Reader Read I 40 BE
+
+//generator:complex Reader Read I 48 LE
+
+//generator:complex Reader Read I 48 BE
+
+//generator:complex Reader Read I 56 LE
+
+//generator:complex Reader Read I 56 BE
+
+//generator:complex Reader Read I 64 LE
+
+//generator:complex Reader Read I 64 BE
+
+//generator:complex Reader Read F 32 LE
+
+//generator:complex Reader Read F 32 BE
+
+//generator:complex Reader Read F 64 LE
+
+//generator:complex Reader Read F 64 BE
+
+// SkipBytes moves the position forward n bytes, starting at the next
+// byte boundary, without reading them. the skipped bytes are discarded
+// from the window as they are read, so skipping does not grow it
+func (b *Reader) SkipBytes(n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count("SkipBytes", n, b.ByteOffset())
+		return
+
+	}
+
+	b.align()
+	b.skip(n)
+
+}
+
+// Read implements io.Reader. it reads up to len(p) bytes, starting at
+// the next byte boundary, into p and moves the position forward the
+// amount of bytes read
+func (b *Reader) Read(p []byte) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	b.align()
+	if b.head == b.tail {
+
+		// large reads skip the window entirely
+		if len(p) >= len(b.buf) {
+
+			n, err = b.r.Read(p)
+			b.off += int64(n)
+			return
+
+		}
+
+		if !b.fill(1) {
+
+			return 0, b.err
+
+		}
+
+	}
+
+	n = copy(p, b.buf[b.head:b.tail])
+	b.head += n
+	return
+
+}
+
+// Reset discards the state of the reader and makes it read from r
+func (b *Reader) Reset(r io.Reader) {
+
+	b.r = r
+	b.head = 0x00
+	b.tail = 0x00
+	b.off = 0x00
+	b.bit = 0x00
+	b.err = nil
+
+}
+
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the reader. it defaults to MSBFirst
+func (b *Reader) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// Buffered returns the amount of bytes that have been read from the
+// underlying io.Reader but not consumed yet
+func (b *Reader) Buffered() int {
+
+	return b.tail - b.head
+
+}
+
+// ByteOffset returns the amount of bytes consumed so far, counting a
+// partially consumed byte
+func (b *Reader) ByteOffset() int64 {
+
+	if b.bit > 0x00 {
+
+		return b.off + int64(b.head) + 1
+
+	}
+	return b.off + int64(b.head)
+
+}
+
+// BitOffset returns the amount of bits consumed so far
+func (b *Reader) BitOffset() int64 {
+
+	return (b.off+int64(b.head))*8 + b.bit
+
+}
+
+// Err returns the error recorded by the reader, if any
+func (b *Reader) Err() error {
+
+	return b.err
+
+}
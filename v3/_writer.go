@@ -0,0 +1,541 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"io"
+)
+
+// defaultWriterSize is the size of the window of a Writer created with
+// NewWriter
+const defaultWriterSize = 4096
+
+// Writer implements a streaming encoder in go that writes data to an
+// io.Writer through a window that is flushed whenever it fills up, so
+// that output of any length can be encoded using a bounded amount of
+// memory. it only moves forward, and its byte and bit operations share
+// a single position as they do in a Buffer using UnifiedCursor.
+//
+// a byte that has only been partially written by the bit methods stays
+// in the window until it is completed, so Flush only writes whole
+// bytes. AlignByte and Close pad it with zero bits instead.
+//
+// instead of panicking, a Writer records the first error it runs into
+// and every following call becomes a no-op, like a Buffer in sticky mode
+type Writer struct {
+	w io.Writer
+
+	// buf holds the window. the bytes that have not been flushed yet
+	// are buf[:tail], followed by the partially written byte at tail if
+	// bit is not zero
+	buf  []byte
+	tail int
+
+	// off is the amount of bytes flushed before the window and bit is
+	// the bit offset within the byte at tail
+	off int64
+	bit int64
+
+	err error
+
+	order BitOrder
+
+	// scratch is the buffer the typed methods operate on
+	scratch Buffer
+}
+
+// NewWriter initializes a new Writer that writes to w with a window of
+// the default size
+func NewWriter(w io.Writer) *Writer {
+
+	return NewWriterSize(w, defaultWriterSize)
+
+}
+
+// NewWriterSize initializes a new Writer that writes to w with a window
+// of at least size bytes. the window only grows past size if a single
+// value needs more than that
+func NewWriterSize(w io.Writer, size int) *Writer {
+
+	if size < 16 {
+
+		size = 16
+
+	}
+
+	return &Writer{
+		w:   w,
+		buf: make([]byte, size),
+	}
+
+}
+
+/* internal use methods */
+
+// output writes p to the underlying io.Writer, recording an error if it
+// could not be written in full
+func (b *Writer) output(p []byte) bool {
+
+	n, err := b.w.Write(p)
+	b.off += int64(n)
+	if err == nil && n < len(p) {
+
+		err = io.ErrShortWrite
+
+	}
+
+	if err != nil {
+
+		b.err = err
+		return false
+
+	}
+	return true
+
+}
+
+// flush writes the whole bytes in the window and moves the partially
+// written one, if any, to the start of it
+func (b *Writer) flush() bool {
+
+	if b.tail == 0x00 {
+
+		return true
+
+	}
+
+	if !b.output(b.buf[:b.tail]) {
+
+		return false
+
+	}
+
+	if b.bit > 0x00 {
+
+		b.buf[0] = b.buf[b.tail]
+
+	}
+	b.tail = 0x00
+	return true
+
+}
+
+// reserve makes sure that at least n bytes, counting the partially
+// written one, are available at the end of the window, flushing or
+// growing it if they are not
+func (b *Writer) reserve(n int) bool {
+
+	if b.tail+n <= len(b.buf) {
+
+		return true
+
+	}
+
+	if !b.flush() {
+
+		return false
+
+	}
+
+	if n > len(b.buf) {
+
+		buf := make([]byte, n)
+		buf[0] = b.buf[0]
+		b.buf = buf
+
+	}
+	return true
+
+}
+
+// align moves the position to the next byte boundary if it is not on
+// one already, padding the partially written byte with zero bits
+func (b *Writer) align() {
+
+	if b.bit > 0x00 {
+
+		b.tail++
+		b.bit = 0x00
+
+	}
+
+}
+
+// window produces the next n bytes of the output and returns the
+// scratch buffer pointed at them, or nil if there is no room for them
+func (b *Writer) window(op string, n int64) *Buffer {
+
+	if b.err != nil {
+
+		return nil
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count(op, n, b.ByteOffset())
+		return nil
+
+	}
+
+	b.align()
+	if !b.reserve(int(n)) {
+
+		return nil
+
+	}
+
+	b.scratch.buf = b.buf[b.tail : b.tail+int(n) : b.tail+int(n)]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+	b.tail += int(n)
+	return &b.scratch
+
+}
+
+// bitWindow is the bit-level variant of window. it produces the next n
+// bits of the output and returns the scratch buffer pointed at the
+// bytes containing them, along with the offset of the first of them
+// within it
+func (b *Writer) bitWindow(op string, n int64) (*Buffer, int64) {
+
+	if b.err != nil {
+
+		return nil, 0x00
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidBitCountError.count(op, n, b.BitOffset())
+		return nil, 0x00
+
+	}
+
+	length := int((b.bit + n + 7) / 8)
+	if !b.reserve(length) {
+
+		return nil, 0x00
+
+	}
+
+	// the bits that are not written yet are kept at zero, so that the
+	// last byte is padded with them
+	start := b.tail
+	if b.bit > 0x00 {
+
+		start++
+
+	}
+
+	for i := start; i < b.tail+length; i++ {
+
+		b.buf[i] = 0x00
+
+	}
+
+	b.scratch.buf = b.buf[b.tail : b.tail+length : b.tail+length]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+
+	bit := b.bit
+	b.tail += int((b.bit + n) / 8)
+	b.bit = (b.bit + n) % 8
+	return &b.scratch, bit
+
+}
+
+/* bitfield methods */
+
+// SetBitNext sets the next bit and moves the position forward a bit
+func (b *Writer) SetBitNext() {
+
+	if w, bit := b.bitWindow("SetBitNext", 1); w != nil {
+
+		w.SetBit(bit)
+
+	}
+
+}
+
+// ClearBitNext clears the next bit and moves the position forward a bit
+func (b *Writer) ClearBitNext() {
+
+	b.bitWindow("ClearBitNext", 1)
+
+}
+
+// SetBitsNext writes the last n bits of data and moves the position
+// forward the amount of bits written
+func (b *Writer) SetBitsNext(data uint64, n int64) {
+
+	if w, bit := b.bitWindow("SetBitsNext", n); w != nil {
+
+		w.SetBits(bit, data, n)
+
+	}
+
+}
+
+// AlignByte moves the position forward to the next byte boundary if it
+// is not on one already, padding the current byte with zero bits
+func (b *Writer) AlignByte() {
+
+	if b.err != nil {
+
+		return
+
+	}
+	b.align()
+
+}
+
+/* byte buffer methods */
+
+// WriteBytesNext writes data, starting at the next byte boundary, and
+// moves the position forward the amount of bytes written. slices at
+// least as large as the window are written to the underlying io.Writer
+// directly
+func (b *Writer) WriteBytesNext(data []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+	b.write(data)
+
+}
+
+// WriteByteNext writes a byte, starting at the next byte boundary, and
+// moves the position forward a byte
+func (b *Writer) WriteByteNext(data byte) {
+
+	if w := b.window("WriteByteNext", 1); w != nil {
+
+		w.buf[0] = data
+
+	}
+
+}
+
+//generator:complex Writer Write U 16 LE
+
+//generator:complex Writer Write U 16 BE
+
+//generator:complex Writer Write U 24 LE
+
+//generator:complex Writer Write U 24 BE
+
+//generator:complex Writer Write U 32 LE
+
+//generator:complex Writer Write U 32 BE
+
+//generator:complex Writer Write U 40 LE
+
+//generator:complex Writer Write U 40 BE
+
+//generator:complex Writer Write U 48 LE
+
+//generator:complex Writer Write U 48 BE
+
+//generator:complex Writer Write U 56 LE
+
+//generator:complex Writer Write U 56 BE
+
+//generator:complex Writer Write U 64 LE
+
+//generator:complex Writer Write U 64 BE
+
+//generator:complex Writer Write I 16 LE
+
+//generator:complex Writer Write I 16 BE
+
+//generator:complex Writer Write I 24 LE
+
+//generator:complex Writer Write I 24 BE
+
+//generator:complex Writer Write I 32 LE
+
+//generator:complex Writer Write I 32 BE
+
+//generator:complex Writer Write I 40 LE
+
+//generator:complex Writer Write I 40 BE
+
+//generator:complex Writer Write I 48 LE
+
+//generator:complex Writer Write I 48 BE
+
+//generator:complex Writer Write I 56 LE
+
+//generator:complex Writer Write I 56 BE
+
+//generator:complex Writer Write I 64 LE
+
+//generator:complex Writer Write I 64 BE
+
+//generator:complex Writer Write F 32 LE
+
+//generator:complex Writer Write F 32 BE
+
+//generator:complex Writer Write F 64 LE
+
+//generator:complex Writer Write F 64 BE
+
+// write writes p starting at the next byte boundary, bypassing the
+// window if p is at least as large as it, and returns the amount of
+// bytes written
+func (b *Writer) write(p []byte) int {
+
+	b.align()
+	if len(p) >= len(b.buf) {
+
+		if !b.flush() {
+
+			return 0x00
+
+		}
+
+		off := b.off
+		b.output(p)
+		return int(b.off - off)
+
+	}
+
+	if !b.reserve(len(p)) {
+
+		return 0x00
+
+	}
+
+	b.tail += copy(b.buf[b.tail:], p)
+	return len(p)
+
+}
+
+// Write implements io.Writer. it writes p, starting at the next byte
+// boundary, and moves the position forward the amount of bytes written
+func (b *Writer) Write(p []byte) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	n = b.write(p)
+	return n, b.err
+
+}
+
+// Flush writes the whole bytes in the window to the underlying
+// io.Writer. a partially written byte stays in the window until it is
+// completed, aligned or the writer is closed
+func (b *Writer) Flush() error {
+
+	if b.err != nil {
+
+		return b.err
+
+	}
+
+	b.flush()
+	return b.err
+
+}
+
+// Close pads the partially written byte, if any, with zero bits and
+// flushes the window. it does not close the underlying io.Writer, and
+// every following write records WriterClosedError
+func (b *Writer) Close() error {
+
+	if errors.Is(b.err, WriterClosedError) {
+
+		return nil
+
+	}
+
+	if b.err != nil {
+
+		return b.err
+
+	}
+
+	b.align()
+	if !b.flush() {
+
+		return b.err
+
+	}
+
+	b.err = WriterClosedError
+	return nil
+
+}
+
+// Reset discards the state of the writer, including anything that has
+// not been flushed, and makes it write to w
+func (b *Writer) Reset(w io.Writer) {
+
+	b.w = w
+	b.tail = 0x00
+	b.off = 0x00
+	b.bit = 0x00
+	b.err = nil
+
+}
+
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the writer. it defaults to MSBFirst
+func (b *Writer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// Buffered returns the amount of bytes that have been written to the
+// window but not flushed yet, counting a partially written byte
+func (b *Writer) Buffered() int {
+
+	if b.bit > 0x00 {
+
+		return b.tail + 1
+
+	}
+	return b.tail
+
+}
+
+// ByteOffset returns the amount of bytes written so far, counting a
+// partially written byte
+func (b *Writer) ByteOffset() int64 {
+
+	return b.off + int64(b.Buffered())
+
+}
+
+// BitOffset returns the amount of bits written so far
+func (b *Writer) BitOffset() int64 {
+
+	return (b.off+int64(b.tail))*8 + b.bit
+
+}
+
+// Err returns the error recorded by the writer, if any. it is
+// WriterClosedError once the writer has been closed
+func (b *Writer) Err() error {
+
+	return b.err
+
+}
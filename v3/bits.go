@@ -0,0 +1,363 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import "math/bits"
+
+// BitOrder specifies how the bits of a byte are numbered by the bit
+// methods of a buffer
+type BitOrder byte
+
+const (
+	// MSBFirst numbers the bits of a byte starting from the most
+	// significant one. multi-bit values are read and written with
+	// their most significant bit first. this is the default
+	MSBFirst BitOrder = iota
+
+	// LSBFirst numbers the bits of a byte starting from the least
+	// significant one. multi-bit values are read and written with
+	// their least significant bit first, like in DEFLATE
+	LSBFirst
+)
+
+// shift returns the amount of bits a byte has to be shifted right by
+// to move the bit at offset off into its least significant position
+func (o BitOrder) shift(off int64) uint {
+
+	if o == LSBFirst {
+
+		return uint(off % 8)
+
+	}
+	return uint(7 - off%8)
+
+}
+
+/* internal use functions */
+
+// loadWord returns the eight bytes of buf starting at i as a big-endian
+// uint64
+func loadWord(buf []byte, i int64) uint64 {
+
+	_ = buf[i+7]
+	return uint64(buf[i])<<56 | uint64(buf[i+1])<<48 | uint64(buf[i+2])<<40 | uint64(buf[i+3])<<32 |
+		uint64(buf[i+4])<<24 | uint64(buf[i+5])<<16 | uint64(buf[i+6])<<8 | uint64(buf[i+7])
+
+}
+
+// loadWordLE returns the eight bytes of buf starting at i as a
+// little-endian uint64
+func loadWordLE(buf []byte, i int64) uint64 {
+
+	_ = buf[i+7]
+	return uint64(buf[i]) | uint64(buf[i+1])<<8 | uint64(buf[i+2])<<16 | uint64(buf[i+3])<<24 |
+		uint64(buf[i+4])<<32 | uint64(buf[i+5])<<40 | uint64(buf[i+6])<<48 | uint64(buf[i+7])<<56
+
+}
+
+// storeWord writes w to the eight bytes of buf starting at i in
+// big-endian
+func storeWord(buf []byte, i int64, w uint64) {
+
+	_ = buf[i+7]
+	buf[i] = byte(w >> 56)
+	buf[i+1] = byte(w >> 48)
+	buf[i+2] = byte(w >> 40)
+	buf[i+3] = byte(w >> 32)
+	buf[i+4] = byte(w >> 24)
+	buf[i+5] = byte(w >> 16)
+	buf[i+6] = byte(w >> 8)
+	buf[i+7] = byte(w)
+
+}
+
+// storeWordLE writes w to the eight bytes of buf starting at i in
+// little-endian
+func storeWordLE(buf []byte, i int64, w uint64) {
+
+	_ = buf[i+7]
+	buf[i] = byte(w)
+	buf[i+1] = byte(w >> 8)
+	buf[i+2] = byte(w >> 16)
+	buf[i+3] = byte(w >> 24)
+	buf[i+4] = byte(w >> 32)
+	buf[i+5] = byte(w >> 40)
+	buf[i+6] = byte(w >> 48)
+	buf[i+7] = byte(w >> 56)
+
+}
+
+// readBits returns n bits of buf starting at the bit offset off, with
+// the first one as the most significant unless order is LSBFirst.
+// whenever possible, they are taken out of a single word instead of
+// being read one at a time. the caller is responsible for checking
+// the bounds of the read
+func readBits(buf []byte, off, n int64, order BitOrder) (out uint64) {
+
+	if n <= 0x00 {
+
+		return
+
+	}
+
+	if order == LSBFirst {
+
+		return readBitsLSB(buf, off, n)
+
+	}
+
+	// only the last 64 bits fit in the result
+	if n > 64 {
+
+		off += n - 64
+		n = 64
+
+	}
+
+	i, s := off/8, uint64(off%8)
+	if i+8 <= int64(len(buf)) {
+
+		out = loadWord(buf, i) << s >> (64 - uint64(n))
+		if s+uint64(n) > 64 {
+
+			// the bits spill over into a ninth byte
+			out |= uint64(buf[i+8]) >> (72 - s - uint64(n))
+
+		}
+		return
+
+	}
+
+	// near the end of the buffer, fall back to whole bytes
+	for n > 0x00 {
+
+		take := 8 - s
+		if take > uint64(n) {
+
+			take = uint64(n)
+
+		}
+
+		out = out<<take | uint64(buf[i]>>(8-s-take))&(1<<take-1)
+		n -= int64(take)
+		s = 0
+		i++
+
+	}
+	return
+
+}
+
+// writeBits writes the last n bits of data to buf starting at the bit
+// offset off, with the most significant one first unless order is
+// LSBFirst. like readBits, it operates on whole words whenever it can.
+// the caller is responsible for checking the bounds of the write
+func writeBits(buf []byte, off int64, data uint64, n int64, order BitOrder) {
+
+	if order == LSBFirst {
+
+		writeBitsLSB(buf, off, data, n)
+		return
+
+	}
+
+	// anything before the last 64 bits is zero
+	for n > 64 {
+
+		k := n - 64
+		if k > 64 {
+
+			k = 64
+
+		}
+
+		writeBits(buf, off, 0x00, k, MSBFirst)
+		off += k
+		n -= k
+
+	}
+
+	if n <= 0x00 {
+
+		return
+
+	}
+
+	i, s := off/8, uint64(off%8)
+	if i+8 <= int64(len(buf)) && s+uint64(n) <= 64 {
+
+		shift := 64 - s - uint64(n)
+		mask := (^uint64(0) >> (64 - uint64(n))) << shift
+		storeWord(buf, i, loadWord(buf, i)&^mask|(data<<shift)&mask)
+		return
+
+	}
+
+	for n > 0x00 {
+
+		take := 8 - s
+		if take > uint64(n) {
+
+			take = uint64(n)
+
+		}
+
+		shift := 8 - s - take
+		mask := byte(1<<take-1) << shift
+		buf[i] = buf[i]&^mask | byte(data>>uint64(n-int64(take)))<<shift&mask
+		n -= int64(take)
+		s = 0
+		i++
+
+	}
+
+}
+
+// swapBytes reverses the order of the last n bytes of v
+func swapBytes(v uint64, n int64) uint64 {
+
+	return bits.ReverseBytes64(v) >> uint64(64-n*8)
+
+}
+
+// readBitsBytes returns the n-byte integer stored in buf starting at
+// the bit offset off. each of its bytes is laid out in the bit order
+// order, and the bytes themselves are in little-endian if little is
+// true and in big-endian otherwise
+func readBitsBytes(buf []byte, off, n int64, order BitOrder, little bool) (out uint64) {
+
+	out = readBits(buf, off, n*8, order)
+	if (order == LSBFirst) != little {
+
+		out = swapBytes(out, n)
+
+	}
+	return
+
+}
+
+// writeBitsBytes is the counterpart of readBitsBytes that writes the
+// last n bytes of data to buf
+func writeBitsBytes(buf []byte, off int64, data uint64, n int64, order BitOrder, little bool) {
+
+	if (order == LSBFirst) != little {
+
+		data = swapBytes(data, n)
+
+	}
+	writeBits(buf, off, data, n*8, order)
+
+}
+
+// readBitsLSB is the LSBFirst variant of readBits
+func readBitsLSB(buf []byte, off, n int64) (out uint64) {
+
+	// only the first 64 bits fit in the result
+	if n > 64 {
+
+		n = 64
+
+	}
+
+	i, s := off/8, uint64(off%8)
+	if i+8 <= int64(len(buf)) {
+
+		out = loadWordLE(buf, i) >> s
+		if s+uint64(n) > 64 {
+
+			// the bits spill over into a ninth byte
+			out |= uint64(buf[i+8]) << (64 - s)
+
+		}
+
+		if n < 64 {
+
+			out &= 1<<uint64(n) - 1
+
+		}
+		return
+
+	}
+
+	got := uint64(0)
+	for n > 0x00 {
+
+		take := 8 - s
+		if take > uint64(n) {
+
+			take = uint64(n)
+
+		}
+
+		out |= uint64(buf[i]>>s&(1<<take-1)) << got
+		got += take
+		n -= int64(take)
+		s = 0
+		i++
+
+	}
+	return
+
+}
+
+// writeBitsLSB is the LSBFirst variant of writeBits
+func writeBitsLSB(buf []byte, off int64, data uint64, n int64) {
+
+	// anything after the first 64 bits is zero
+	for n > 64 {
+
+		k := n - 64
+		if k > 64 {
+
+			k = 64
+
+		}
+
+		writeBitsLSB(buf, off+n-k, 0x00, k)
+		n -= k
+
+	}
+
+	if n <= 0x00 {
+
+		return
+
+	}
+
+	i, s := off/8, uint64(off%8)
+	if i+8 <= int64(len(buf)) && s+uint64(n) <= 64 {
+
+		mask := (^uint64(0) >> (64 - uint64(n))) << s
+		storeWordLE(buf, i, loadWordLE(buf, i)&^mask|(data<<s)&mask)
+		return
+
+	}
+
+	for n > 0x00 {
+
+		take := 8 - s
+		if take > uint64(n) {
+
+			take = uint64(n)
+
+		}
+
+		mask := byte(1<<take-1) << s
+		buf[i] = buf[i]&^mask | byte(data)<<s&mask
+		data >>= take
+		n -= int64(take)
+		s = 0
+		i++
+
+	}
+
+}
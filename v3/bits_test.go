@@ -0,0 +1,484 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+// readBitsSlow reads n bits one at a time, which is how ReadBits used
+// to work
+func readBitsSlow(buf *Buffer, off, n int64) (out uint64) {
+
+	for i := int64(0); i < n; i++ {
+
+		out = (out << 1) | uint64(buf.ReadBit(off+i))
+
+	}
+	return
+
+}
+
+// setBitsSlow sets n bits one at a time, which is how SetBits used to
+// work
+func setBitsSlow(buf *Buffer, off int64, data uint64, n int64) {
+
+	for i := int64(0); i < n; i++ {
+
+		if (data>>uint64(n-i-1))&1 == 0 {
+
+			buf.ClearBit(off + i)
+
+		} else {
+
+			buf.SetBit(off + i)
+
+		}
+
+	}
+
+}
+
+// readBitsSlowLSB is the LSBFirst variant of readBitsSlow
+func readBitsSlowLSB(buf *Buffer, off, n int64) (out uint64) {
+
+	for i := int64(0); i < n && i < 64; i++ {
+
+		out |= uint64(buf.ReadBit(off+i)) << uint64(i)
+
+	}
+	return
+
+}
+
+// setBitsSlowLSB is the LSBFirst variant of setBitsSlow
+func setBitsSlowLSB(buf *Buffer, off int64, data uint64, n int64) {
+
+	for i := int64(0); i < n; i++ {
+
+		if i >= 64 || (data>>uint64(i))&1 == 0 {
+
+			buf.ClearBit(off + i)
+
+		} else {
+
+			buf.SetBit(off + i)
+
+		}
+
+	}
+
+}
+
+/*
+
+tests
+
+*/
+
+func TestReadBits(t *testing.T) {
+
+	r := rand.New(rand.NewSource(0x00))
+
+	data := make([]byte, 24)
+	r.Read(data)
+	buf := NewBuffer(data)
+
+	for off := int64(0); off < buf.BitCapacity(); off++ {
+
+		for n := int64(1); n <= 72 && off+n <= buf.BitCapacity(); n++ {
+
+			expected := readBitsSlow(buf, off, n)
+			out := buf.ReadBits(off, n)
+			if expected != out {
+
+				t.Fatalf("expected bits do not match the ones gotten at offset %d with length %d (got %#x, expected %#x)", off, n, out, expected)
+
+			}
+
+		}
+
+	}
+
+}
+
+func TestSetBits(t *testing.T) {
+
+	r := rand.New(rand.NewSource(0x00))
+
+	for off := int64(0); off < 128; off++ {
+
+		for n := int64(1); n <= 72 && off+n <= 192; n++ {
+
+			data := make([]byte, 24)
+			r.Read(data)
+			value := r.Uint64()
+
+			expected := NewBuffer(data)
+			setBitsSlow(expected, off, value, n)
+
+			out := NewBuffer(data)
+			out.SetBits(off, value, n)
+			if !cmp.Equal(expected.Bytes(), out.Bytes()) {
+
+				t.Fatalf("expected byte array does not match the one gotten at offset %d with length %d (got %#v, expected %#v)", off, n, out.Bytes(), expected.Bytes())
+
+			}
+
+		}
+
+	}
+
+}
+
+func TestReadBitsLSB(t *testing.T) {
+
+	r := rand.New(rand.NewSource(0x00))
+
+	data := make([]byte, 24)
+	r.Read(data)
+	buf := NewBuffer(data)
+	buf.SetBitOrder(LSBFirst)
+
+	for off := int64(0); off < buf.BitCapacity(); off++ {
+
+		for n := int64(1); n <= 72 && off+n <= buf.BitCapacity(); n++ {
+
+			expected := readBitsSlowLSB(buf, off, n)
+			out := buf.ReadBits(off, n)
+			if expected != out {
+
+				t.Fatalf("expected bits do not match the ones gotten at offset %d with length %d (got %#x, expected %#x)", off, n, out, expected)
+
+			}
+
+		}
+
+	}
+
+}
+
+func TestSetBitsLSB(t *testing.T) {
+
+	r := rand.New(rand.NewSource(0x00))
+
+	for off := int64(0); off < 128; off++ {
+
+		for n := int64(1); n <= 72 && off+n <= 192; n++ {
+
+			data := make([]byte, 24)
+			r.Read(data)
+			value := r.Uint64()
+
+			expected := NewBuffer(data)
+			expected.SetBitOrder(LSBFirst)
+			setBitsSlowLSB(expected, off, value, n)
+
+			out := NewBuffer(data)
+			out.SetBitOrder(LSBFirst)
+			out.SetBits(off, value, n)
+			if !cmp.Equal(expected.Bytes(), out.Bytes()) {
+
+				t.Fatalf("expected byte array does not match the one gotten at offset %d with length %d (got %#v, expected %#v)", off, n, out.Bytes(), expected.Bytes())
+
+			}
+
+		}
+
+	}
+
+}
+
+func TestBufferBitOrder(t *testing.T) {
+
+	buf := NewBuffer([]byte{0xb1, 0x00})
+	buf.SetBitOrder(LSBFirst)
+
+	if out := buf.ReadBitNext(); out != 1 {
+
+		t.Fatalf("expected bit does not match the one gotten (got %d, expected 1)", out)
+
+	}
+
+	if out := buf.ReadBits(0x04, 4); out != 0x0b {
+
+		t.Fatalf("expected bits do not match the ones gotten (got %#x, expected 0xb)", out)
+
+	}
+
+	buf.FlipBit(0x00)
+	buf.SetBit(0x09)
+	buf.SeekBit(0x0c, false)
+	buf.SetBitsNext(0x05, 3)
+	if !cmp.Equal([]byte{0xb0, 0x52}, buf.Bytes()) || buf.BitOffset() != 0x0f {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d, expected %#v at offset 15)", buf.Bytes(), buf.BitOffset(), []byte{0xb0, 0x52})
+
+	}
+
+}
+
+func TestMiniBufferBitOrder(t *testing.T) {
+
+	var out uint64
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0x00})
+	buf.SetBitOrder(LSBFirst)
+
+	buf.SetBits(0x03, 0x1ff, 9)
+	buf.ClearBit(0x04)
+	if !cmp.Equal([]byte{0xe8, 0x0f}, buf.buf) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.buf, []byte{0xe8, 0x0f})
+
+	}
+
+	buf.ReadBits(&out, 0x03, 9)
+	if out != 0x1fd {
+
+		t.Fatalf("expected uint64 does not match the one gotten (got %#x, expected 0x1fd)", out)
+
+	}
+
+}
+
+func TestBufferReadU32BEBits(t *testing.T) {
+
+	var expected uint32 = 0xdeadbeef
+
+	buf := NewBuffer(make([]byte, 6))
+
+	buf.SetBitsNext(0xabc, 12)
+	buf.PutU32BEBitsNext(expected)
+	if !cmp.Equal([]byte{0xab, 0xcd, 0xea, 0xdb, 0xee, 0xf0}, buf.Bytes()) || buf.BitOffset() != 44 {
+
+		t.Fatalf("unexpected byte array: %#v (bit offset %d)", buf.Bytes(), buf.BitOffset())
+
+	}
+
+	buf.SeekBit(0x00, false)
+	if buf.ReadBitsNext(12) != 0xabc {
+
+		t.Fatalf("expected bits do not match the ones gotten")
+
+	}
+
+	out := buf.ReadU32BEBitsNext()
+	if expected != out || buf.BitOffset() != 44 {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %#x at bit offset %d, expected %#x)", out, buf.BitOffset(), expected)
+
+	}
+
+}
+
+func TestBufferReadI24LEBits(t *testing.T) {
+
+	var expected int32 = -0x123456
+
+	buf := NewBuffer(make([]byte, 4))
+
+	buf.PutI24LEBits(0x05, expected)
+	if out := buf.ReadU24LEBits(0x05); out != uint32(expected)&0xffffff {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %#x, expected %#x)", out, uint32(expected)&0xffffff)
+
+	}
+
+	// the bytes are stored in little-endian, each of them in the bit order of the buffer
+	if out := buf.ReadBits(0x05, 8); out != uint64(uint32(expected)&0xff) {
+
+		t.Fatalf("expected first byte does not match the one gotten (got %#x, expected %#x)", out, uint32(expected)&0xff)
+
+	}
+
+	if out := buf.ReadI24LEBits(0x05); expected != out {
+
+		t.Fatalf("expected int32 does not match the one gotten (got %d, expected %d)", out, expected)
+
+	}
+
+}
+
+func TestBufferReadF64BEBitsLSB(t *testing.T) {
+
+	var expected = -1.25
+
+	buf := NewBuffer(make([]byte, 9))
+	buf.SetBitOrder(LSBFirst)
+
+	buf.SeekBit(0x03, false)
+	buf.PutF64BEBitsNext(expected)
+	if out := buf.ReadF64BEBits(0x03); expected != out || buf.BitOffset() != 67 {
+
+		t.Fatalf("expected float64 does not match the one gotten (got %v at bit offset %d, expected %v)", out, buf.BitOffset(), expected)
+
+	}
+
+	// the first two bytes of -1.25 are 0xbf and 0xf4
+	if out := buf.ReadU16LEBits(0x03); out != 0xf4bf {
+
+		t.Fatalf("expected uint16 does not match the one gotten (got %#x, expected 0xf4bf)", out)
+
+	}
+
+}
+
+func TestCheckedBufferBitAligned(t *testing.T) {
+
+	buf := NewCheckedBuffer(make([]byte, 2))
+
+	err := buf.PutU16LEBitsNext(0x1234)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	_, err = buf.ReadU16BEBits(0x01)
+	if !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+	err = buf.PutI16LEBitsNext(0x01)
+	if !errors.Is(err, BufferOverwriteError) || buf.BitOffset() != 16 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v at bit offset %d, expected %v)", err, buf.BitOffset(), BufferOverwriteError)
+
+	}
+
+}
+
+func TestMiniBufferReadU16LEBits(t *testing.T) {
+
+	var out uint16
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, make([]byte, 3))
+
+	buf.SeekBit(0x04, false)
+	buf.PutU16LEBitsNext(0xa1b2)
+	if !cmp.Equal([]byte{0x0b, 0x2a, 0x10}, buf.buf) || buf.boff != 20 {
+
+		t.Fatalf("unexpected byte array: %#v (bit offset %d)", buf.buf, buf.boff)
+
+	}
+
+	buf.ReadU16LEBits(&out, 0x04)
+	if out != 0xa1b2 {
+
+		t.Fatalf("expected uint16 does not match the one gotten (got %#x, expected 0xa1b2)", out)
+
+	}
+
+}
+
+func TestMiniBufferReadBitsWide(t *testing.T) {
+
+	var (
+		expected uint64 = 0x0fffffffffffffff
+		out      uint64 = 0xff
+	)
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00})
+
+	buf.SetBits(0x04, 0x00, 6)
+	buf.ReadBits(&out, 0x06, 64)
+	if expected != out {
+
+		t.Fatalf("expected uint64 does not match the one gotten (got %#x, expected %#x)", out, expected)
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkBufferReadBitsSlow(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 16))
+
+	var out uint64
+	for n := 0; n < b.N; n++ {
+
+		out = readBitsSlow(buf, 0x03, 61)
+
+	}
+
+	_ = out
+
+}
+
+func BenchmarkBufferReadBitsWide(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 16))
+
+	var out uint64
+	for n := 0; n < b.N; n++ {
+
+		out = buf.ReadBits(0x03, 61)
+
+	}
+
+	_ = out
+
+}
+
+func BenchmarkBufferSetBitsSlow(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 16))
+
+	for n := 0; n < b.N; n++ {
+
+		setBitsSlow(buf, 0x03, 0x0123456789abcdef, 61)
+
+	}
+
+}
+
+func BenchmarkBufferSetBitsWide(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 16))
+
+	for n := 0; n < b.N; n++ {
+
+		buf.SetBits(0x03, 0x0123456789abcdef, 61)
+
+	}
+
+}
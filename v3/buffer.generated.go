@@ -11,7 +11,11 @@ file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
 package v3
 
-import "unsafe"
+import (
+	"hash"
+	"math"
+	"unsafe"
+)
 
 // Buffer implements a buffer type in go that handles multiple types
 // of data easily. it has overwrite/read checks for extra safety
@@ -21,6 +25,25 @@ type Buffer struct {
 	cap  int64
 	boff int64
 	bcap int64
+
+	sticky bool
+	err    error
+
+	grow bool
+	gmax int64
+
+	order BitOrder
+	cmode CursorMode
+
+	journal journal
+	slots   []*Placeholder
+
+	hash hash.Hash
+
+	// hstart and hend are the offsets of the first byte written to hash
+	// and of the one after the last
+	hstart int64
+	hend   int64
 }
 
 // NewBuffer initilaizes a new Buffer with the provided byte slice(s)
@@ -66,25 +89,90 @@ func NewBuffer(slices ...[]byte) (buf *Buffer) {
 
 /* internal use methods */
 
+// fail panics with the provided error or, if the buffer is in sticky
+// mode, records it so that every following call becomes a no-op
+func (b *Buffer) fail(err Error) {
+
+	if !b.sticky {
+
+		panic(err)
+
+	}
+	b.err = err
+
+}
+
+// reserve grows the buffer so that it is at least n bytes long if the
+// buffer is allowed to grow automatically, returning whether or not
+// it is long enough afterwards
+func (b *Buffer) reserve(n int64) bool {
+
+	if !b.grow || (b.gmax > 0x00 && n > b.gmax) {
+
+		return false
+
+	}
+
+	if n > b.cap {
+
+		b.Grow(n - b.cap)
+
+	}
+	return true
+
+}
+
+// readable reports whether n values of size bytes each starting at off
+// are inside of the buffer. off and n must not be negative, which lets
+// it avoid computing off + n*size, as that overflows on hostile input
+func (b *Buffer) readable(off, n, size int64) bool {
+
+	return n <= (b.cap-off)/size
+
+}
+
+// writable reports whether the n bytes starting at off are inside of
+// the buffer, growing it if it is allowed to. off and n must not be
+// negative
+func (b *Buffer) writable(off, n int64) bool {
+
+	if n <= (b.cap - off) {
+
+		return true
+
+	}
+	return n <= (math.MaxInt64-off) && b.reserve(off+n)
+
+}
+
 /* bitfield methods */
 
 // ReadBit returns the bit located at the specified offset without
 // modifying the internal offset value
-func (b *Buffer) ReadBit(off int64) byte {
+func (b *Buffer) ReadBit(off int64) (out byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
 
 	if off > (b.bcap - 1) {
 
-		panic(BufferOverreadError)
+		b.fail(BufferOverreadError.atBit("ReadBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.atBit("ReadBit", off, 1, b.bcap))
+		return
 
 	}
 
-	return (b.buf[off/8] >> (7 - uint64(off%8))) & 1
+	out = (b.buf[off/8] >> b.order.shift(off)) & 1
+	return
 
 }
 
@@ -102,19 +190,34 @@ func (b *Buffer) ReadBitNext() (out byte) {
 // modifying the internal offset value
 func (b *Buffer) ReadBits(off, n int64) (out uint64) {
 
-	i := int64(0)
+	if b.err != nil {
 
-	{
-	read_loop:
-		out = (out << uint64(1)) | uint64(b.ReadBit(off+i))
-		i++
-		if i < n {
+		return
 
-			goto read_loop
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidBitCountError.atBit("ReadBits", off, n, b.bcap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.atBit("ReadBits", off, n, b.bcap))
+		return
+
+	}
+
+	if n > (b.bcap - off) {
+
+		b.fail(BufferOverreadError.atBit("ReadBits", off, n, b.bcap))
+		return
 
-		}
 	}
 
+	out = readBits(b.buf, off, n, b.order)
 	return
 
 }
@@ -133,19 +236,28 @@ func (b *Buffer) ReadBitsNext(n int64) (out uint64) {
 // modifying the internal offset value
 func (b *Buffer) SetBit(off int64) {
 
-	if off > (b.bcap - 1) {
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off > (b.bcap-1) && !b.reserve(off/8+1) {
 
-		panic(BufferOverwriteError)
+		b.fail(BufferOverwriteError.atBit("SetBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("SetBit", off, 1, b.bcap))
+		return
 
 	}
 
-	b.buf[off/8] |= (1 << uint(7-(off%8)))
+	b.saveBits(off, 1)
+	b.buf[off/8] |= (1 << b.order.shift(off))
 
 }
 
@@ -162,19 +274,28 @@ func (b *Buffer) SetBitNext() {
 // modifying the internal offset value
 func (b *Buffer) ClearBit(off int64) {
 
-	if off > (b.bcap - 1) {
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off > (b.bcap-1) && !b.reserve(off/8+1) {
 
-		panic(BufferOverwriteError)
+		b.fail(BufferOverwriteError.atBit("ClearBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("ClearBit", off, 1, b.bcap))
+		return
 
 	}
 
-	b.buf[off/8] &= ^(1 << uint(7-(off%8)))
+	b.saveBits(off, 1)
+	b.buf[off/8] &= ^(1 << b.order.shift(off))
 
 }
 
@@ -191,27 +312,36 @@ func (b *Buffer) ClearBitNext() {
 // modifying the internal offset value
 func (b *Buffer) SetBits(off int64, data uint64, n int64) {
 
-	i := int64(0)
+	if b.err != nil {
 
-	{
-	write_loop:
-		if byte((data>>uint64(n-i-1))&1) == 0 {
+		return
 
-			b.ClearBit(off + i)
+	}
 
-		} else {
+	if n < 0x00 {
 
-			b.SetBit(off + i)
+		b.fail(BufferInvalidBitCountError.atBit("SetBits", off, n, b.bcap))
+		return
 
-		}
-		i++
-		if i < n {
+	}
 
-			goto write_loop
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.atBit("SetBits", off, n, b.bcap))
+		return
+
+	}
+
+	if n > (b.bcap-off) && (n > (math.MaxInt64-7-off) || !b.reserve(off/8+(off%8+n+7)/8)) {
+
+		b.fail(BufferOverwriteError.atBit("SetBits", off, n, b.bcap))
+		return
 
-		}
 	}
 
+	b.saveBits(off, n)
+	writeBits(b.buf, off, data, n, b.order)
+
 }
 
 // SetBitsNext sets the next n bits from the current offset and moves
@@ -227,19 +357,28 @@ func (b *Buffer) SetBitsNext(data uint64, n int64) {
 // modifying the internal offset value
 func (b *Buffer) FlipBit(off int64) {
 
-	if off > (b.bcap - 1) {
+	if b.err != nil {
 
-		panic(BufferOverwriteError)
+		return
+
+	}
+
+	if off > (b.bcap-1) && !b.reserve(off/8+1) {
+
+		b.fail(BufferOverwriteError.atBit("FlipBit", off, 1, b.bcap))
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("FlipBit", off, 1, b.bcap))
+		return
 
 	}
 
-	b.buf[off/8] ^= (1 << uint(7-(off%8)))
+	b.saveBits(off, 1)
+	b.buf[off/8] ^= (1 << b.order.shift(off))
 
 }
 
@@ -255,6 +394,13 @@ func (b *Buffer) FlipBitNext() {
 // ClearAllBits sets all of the buffer's bits to 0
 func (b *Buffer) ClearAllBits() {
 
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.save(0x00, b.cap)
 	var (
 		i = int64(0)
 		n = int64(len(b.buf))
@@ -275,6 +421,13 @@ func (b *Buffer) ClearAllBits() {
 // SetAllBits sets all of the buffer's bits to 1
 func (b *Buffer) SetAllBits() {
 
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.save(0x00, b.cap)
 	var (
 		i = int64(0)
 		n = int64(len(b.buf))
@@ -295,6 +448,13 @@ func (b *Buffer) SetAllBits() {
 // FlipAllBits flips all of the buffer's bits
 func (b *Buffer) FlipAllBits() {
 
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.save(0x00, b.cap)
 	var (
 		i = int64(0)
 		n = int64(len(b.buf))
@@ -317,6 +477,12 @@ func (b *Buffer) FlipAllBits() {
 // the current position or exact
 func (b *Buffer) SeekBit(off int64, relative bool) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if relative {
 
 		b.boff += off
@@ -326,6 +492,8 @@ func (b *Buffer) SeekBit(off int64, relative bool) {
 		b.boff = off
 
 	}
+	b.syncByte()
+	b.follow()
 
 }
 
@@ -342,10 +510,12 @@ func (b *Buffer) AfterBit(off ...int64) int64 {
 
 }
 
-// AlignBit aligns the bit offset to the byte offset
+// AlignBit aligns the bit offset to the byte offset. if the cursors
+// are unified, this moves the position to the next byte boundary
 func (b *Buffer) AlignBit() {
 
 	b.boff = b.off * 8
+	b.follow()
 
 }
 
@@ -355,18 +525,27 @@ func (b *Buffer) AlignBit() {
 // without modifying the internal offset value
 func (b *Buffer) WriteBytes(off int64, data []byte) {
 
-	if (off + int64(len(data))) > b.cap {
+	if b.err != nil {
 
-		panic(BufferOverwriteError)
+		return
 
 	}
 
 	if off < 0x00 {
 
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	if !b.writable(off, int64(len(data))) {
+
+		b.fail(BufferOverwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
 
 	}
 
+	b.save(off, int64(len(data)))
 	copy(b.buf[off:], data)
 
 }
@@ -375,7 +554,7 @@ func (b *Buffer) WriteBytes(off int64, data []byte) {
 // and moves the offset forward the amount of bytes written
 func (b *Buffer) WriteBytesNext(data []byte) {
 
-	b.WriteBytes(b.off, data)
+	b.WriteBytes(b.cursor("WriteBytesNext"), data)
 	b.SeekByte(int64(len(data)), true)
 
 }
@@ -392,7 +571,7 @@ func (b *Buffer) WriteByte(off int64, data byte) {
 // offset and moves the offset forward the amount of bytes written
 func (b *Buffer) WriteByteNext(data byte) {
 
-	b.WriteBytes(b.off, []byte{data})
+	b.WriteBytes(b.cursor("WriteByteNext"), []byte{data})
 	b.SeekByte(1, true)
 
 }
@@ -401,12 +580,18 @@ func (b *Buffer) WriteByteNext(data byte) {
 // specified offset in little-endian without modifying the internal
 // offset value
 func (b *Buffer) WriteU16LE(off int64, data []uint16) {
-	if (off + int64(len(data))*2) > b.cap {
-		panic(BufferOverwriteError)
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU16LE", off, int64(len(data))*2, b.cap))
+		return
 	}
+	if !b.writable(off, int64(len(data))*2) {
+		b.fail(BufferOverwriteError.at("WriteU16LE", off, int64(len(data))*2, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*2)
 	i := 0
 	n := len(data)
 	{
@@ -424,20 +609,77 @@ func (b *Buffer) WriteU16LE(off int64, data []uint16) {
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
 func (b *Buffer) WriteU16LENext(data []uint16) {
-	b.WriteU16LE(b.off, data)
+	b.WriteU16LE(b.cursor("WriteU16LENext"), data)
 	b.SeekByte(int64(len(data))*2, true)
 }
 
+// PutU16LE writes a uint16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU16LE(off int64, data uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU16LE", off, 2, b.cap))
+		return
+	}
+	if !b.writable(off, 2) {
+		b.fail(BufferOverwriteError.at("PutU16LE", off, 2, b.cap))
+		return
+	}
+	b.save(off, 2)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+}
+
+// PutU16LENext writes a uint16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU16LENext(data uint16) {
+	b.PutU16LE(b.cursor("PutU16LENext"), data)
+	b.SeekByte(2, true)
+}
+
+// PutU16LEBits writes a uint16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU16LEBits(off int64, data uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU16LEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off && !b.reserve(off/8+(off%8+16+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU16LEBits", off, 16, b.bcap))
+		return
+	}
+	b.saveBits(off, 16)
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, true)
+}
+
+// PutU16LEBitsNext writes a uint16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU16LEBitsNext(data uint16) {
+	b.PutU16LEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
 // WriteU16BE writes a slice of uint16s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
 func (b *Buffer) WriteU16BE(off int64, data []uint16) {
-	if (off + int64(len(data))*2) > b.cap {
-		panic(BufferOverwriteError)
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU16BE", off, int64(len(data))*2, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*2) {
+		b.fail(BufferOverwriteError.at("WriteU16BE", off, int64(len(data))*2, b.cap))
+		return
 	}
+	b.save(off, int64(len(data))*2)
 	i := 0
 	n := len(data)
 	{
@@ -455,28 +697,84 @@ func (b *Buffer) WriteU16BE(off int64, data []uint16) {
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
 func (b *Buffer) WriteU16BENext(data []uint16) {
-	b.WriteU16BE(b.off, data)
+	b.WriteU16BE(b.cursor("WriteU16BENext"), data)
 	b.SeekByte(int64(len(data))*2, true)
 }
 
-// WriteU32LE writes a slice of uint32s to the buffer at the
+// PutU16BE writes a uint16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU16BE(off int64, data uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU16BE", off, 2, b.cap))
+		return
+	}
+	if !b.writable(off, 2) {
+		b.fail(BufferOverwriteError.at("PutU16BE", off, 2, b.cap))
+		return
+	}
+	b.save(off, 2)
+	b.buf[off] = byte(data >> 8)
+	b.buf[off+1] = byte(data)
+}
+
+// PutU16BENext writes a uint16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU16BENext(data uint16) {
+	b.PutU16BE(b.cursor("PutU16BENext"), data)
+	b.SeekByte(2, true)
+}
+
+// PutU16BEBits writes a uint16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU16BEBits(off int64, data uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU16BEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off && !b.reserve(off/8+(off%8+16+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU16BEBits", off, 16, b.bcap))
+		return
+	}
+	b.saveBits(off, 16)
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, false)
+}
+
+// PutU16BEBitsNext writes a uint16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU16BEBitsNext(data uint16) {
+	b.PutU16BEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteU24LE writes a slice of uint32s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *Buffer) WriteU32LE(off int64, data []uint32) {
-	if (off + int64(len(data))*4) > b.cap {
-		panic(BufferOverwriteError)
+func (b *Buffer) WriteU24LE(off int64, data []uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU24LE", off, int64(len(data))*3, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*3) {
+		b.fail(BufferOverwriteError.at("WriteU24LE", off, int64(len(data))*3, b.cap))
+		return
 	}
+	b.save(off, int64(len(data))*3)
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*4)] = byte(data[i])
-		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 8)
-		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 16)
-		b.buf[off+int64(3+(i*4))] = byte(data[i] >> 24)
+		b.buf[off+int64(i*3)] = byte(data[i])
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i] >> 16)
 		i++
 		if i < n {
 			goto write_loop
@@ -484,69 +782,89 @@ func (b *Buffer) WriteU32LE(off int64, data []uint32) {
 	}
 }
 
-// WriteU32LENext writes a slice of uint32s to the buffer at the
+// WriteU24LENext writes a slice of uint32s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) WriteU32LENext(data []uint32) {
-	b.WriteU32LE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+func (b *Buffer) WriteU24LENext(data []uint32) {
+	b.WriteU24LE(b.cursor("WriteU24LENext"), data)
+	b.SeekByte(int64(len(data))*3, true)
 }
 
-// WriteU32BE writes a slice of uint32s to the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteU32BE(off int64, data []uint32) {
-	if (off + int64(len(data))*4) > b.cap {
-		panic(BufferOverwriteError)
+// PutU24LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU24LE(off int64, data uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("PutU24LE", off, 3, b.cap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*4)] = byte(data[i] >> 24)
-		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 16)
-		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 8)
-		b.buf[off+int64(3+(i*4))] = byte(data[i])
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if !b.writable(off, 3) {
+		b.fail(BufferOverwriteError.at("PutU24LE", off, 3, b.cap))
+		return
 	}
+	b.save(off, 3)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
 }
 
-// WriteU32BENext writes a slice of uint32s to the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteU32BENext(data []uint32) {
-	b.WriteU32BE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+// PutU24LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU24LENext(data uint32) {
+	b.PutU24LE(b.cursor("PutU24LENext"), data)
+	b.SeekByte(3, true)
 }
 
-// WriteU64LE writes a slice of uint64s to the buffer at the
-// specified offset in little-endian without modifying the internal
+// PutU24LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU24LEBits(off int64, data uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU24LEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off && !b.reserve(off/8+(off%8+24+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU24LEBits", off, 24, b.bcap))
+		return
+	}
+	b.saveBits(off, 24)
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, true)
+}
+
+// PutU24LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU24LEBitsNext(data uint32) {
+	b.PutU24LEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteU24BE writes a slice of uint32s to the buffer at the
+// specified offset in big-endian without modifying the internal
 // offset value
-func (b *Buffer) WriteU64LE(off int64, data []uint64) {
-	if (off + int64(len(data))*8) > b.cap {
-		panic(BufferOverwriteError)
+func (b *Buffer) WriteU24BE(off int64, data []uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU24BE", off, int64(len(data))*3, b.cap))
+		return
 	}
+	if !b.writable(off, int64(len(data))*3) {
+		b.fail(BufferOverwriteError.at("WriteU24BE", off, int64(len(data))*3, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*3)
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*8)] = byte(data[i])
-		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 8)
-		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 16)
-		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 24)
-		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 32)
-		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 40)
-		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 48)
-		b.buf[off+int64(7+(i*8))] = byte(data[i] >> 56)
+		b.buf[off+int64(i*3)] = byte(data[i] >> 16)
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -554,67 +872,90 @@ func (b *Buffer) WriteU64LE(off int64, data []uint64) {
 	}
 }
 
-// WriteU64LENext writes a slice of uint64s to the buffer at the
-// current offset in little-endian and moves the offset forward the
+// WriteU24BENext writes a slice of uint32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) WriteU64LENext(data []uint64) {
-	b.WriteU64LE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+func (b *Buffer) WriteU24BENext(data []uint32) {
+	b.WriteU24BE(b.cursor("WriteU24BENext"), data)
+	b.SeekByte(int64(len(data))*3, true)
 }
 
-// WriteU64BE writes a slice of uint64s to the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteU64BE(off int64, data []uint64) {
-	if (off + int64(len(data))*8) > b.cap {
-		panic(BufferOverwriteError)
+// PutU24BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU24BE(off int64, data uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("PutU24BE", off, 3, b.cap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*8)] = byte(data[i] >> 56)
-		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 48)
-		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 40)
-		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 32)
-		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 24)
-		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 16)
-		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 8)
-		b.buf[off+int64(7+(i*8))] = byte(data[i])
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if !b.writable(off, 3) {
+		b.fail(BufferOverwriteError.at("PutU24BE", off, 3, b.cap))
+		return
 	}
+	b.save(off, 3)
+	b.buf[off] = byte(data >> 16)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data)
 }
 
-// WriteU64BENext writes a slice of uint64s to the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteU64BENext(data []uint64) {
-	b.WriteU64BE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+// PutU24BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU24BENext(data uint32) {
+	b.PutU24BE(b.cursor("PutU24BENext"), data)
+	b.SeekByte(3, true)
 }
 
-// WriteI16LE writes a slice of int16s to the buffer at the
+// PutU24BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU24BEBits(off int64, data uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU24BEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off && !b.reserve(off/8+(off%8+24+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU24BEBits", off, 24, b.bcap))
+		return
+	}
+	b.saveBits(off, 24)
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, false)
+}
+
+// PutU24BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU24BEBitsNext(data uint32) {
+	b.PutU24BEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteU32LE writes a slice of uint32s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *Buffer) WriteI16LE(off int64, data []int16) {
-	if (off + int64(len(data))*2) > b.cap {
-		panic(BufferOverwriteError)
+func (b *Buffer) WriteU32LE(off int64, data []uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU32LE", off, int64(len(data))*4, b.cap))
+		return
 	}
+	if !b.writable(off, int64(len(data))*4) {
+		b.fail(BufferOverwriteError.at("WriteU32LE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*4)
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*2)] = byte(data[i])
-		b.buf[off+int64(1+(i*2))] = byte(data[i] >> 8)
+		b.buf[off+int64(i*4)] = byte(data[i])
+		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*4))] = byte(data[i] >> 24)
 		i++
 		if i < n {
 			goto write_loop
@@ -622,88 +963,83 @@ func (b *Buffer) WriteI16LE(off int64, data []int16) {
 	}
 }
 
-// WriteI16LENext writes a slice of int16s to the buffer at the
+// WriteU32LENext writes a slice of uint32s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) WriteI16LENext(data []int16) {
-	b.WriteI16LE(b.off, data)
-	b.SeekByte(int64(len(data))*2, true)
+func (b *Buffer) WriteU32LENext(data []uint32) {
+	b.WriteU32LE(b.cursor("WriteU32LENext"), data)
+	b.SeekByte(int64(len(data))*4, true)
 }
 
-// WriteI16BE writes a slice of int16s to the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteI16BE(off int64, data []int16) {
-	if (off + int64(len(data))*2) > b.cap {
-		panic(BufferOverwriteError)
+// PutU32LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU32LE(off int64, data uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("PutU32LE", off, 4, b.cap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*2)] = byte(data[i] >> 8)
-		b.buf[off+int64(1+(i*2))] = byte(data[i])
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if !b.writable(off, 4) {
+		b.fail(BufferOverwriteError.at("PutU32LE", off, 4, b.cap))
+		return
 	}
+	b.save(off, 4)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
 }
 
-// WriteI16BENext writes a slice of int16s to the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteI16BENext(data []int16) {
-	b.WriteI16BE(b.off, data)
-	b.SeekByte(int64(len(data))*2, true)
+// PutU32LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU32LENext(data uint32) {
+	b.PutU32LE(b.cursor("PutU32LENext"), data)
+	b.SeekByte(4, true)
 }
 
-// WriteI32LE writes a slice of int32s to the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteI32LE(off int64, data []int32) {
-	if (off + int64(len(data))*4) > b.cap {
-		panic(BufferOverwriteError)
+// PutU32LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU32LEBits(off int64, data uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("PutU32LEBits", off, 32, b.bcap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*4)] = byte(data[i])
-		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 8)
-		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 16)
-		b.buf[off+int64(3+(i*4))] = byte(data[i] >> 24)
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if 32 > b.bcap-off && !b.reserve(off/8+(off%8+32+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU32LEBits", off, 32, b.bcap))
+		return
 	}
+	b.saveBits(off, 32)
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, true)
 }
 
-// WriteI32LENext writes a slice of int32s to the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteI32LENext(data []int32) {
-	b.WriteI32LE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+// PutU32LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU32LEBitsNext(data uint32) {
+	b.PutU32LEBits(b.boff, data)
+	b.SeekBit(32, true)
 }
 
-// WriteI32BE writes a slice of int32s to the buffer at the
+// WriteU32BE writes a slice of uint32s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *Buffer) WriteI32BE(off int64, data []int32) {
-	if (off + int64(len(data))*4) > b.cap {
-		panic(BufferOverwriteError)
+func (b *Buffer) WriteU32BE(off int64, data []uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU32BE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*4) {
+		b.fail(BufferOverwriteError.at("WriteU32BE", off, int64(len(data))*4, b.cap))
+		return
 	}
+	b.save(off, int64(len(data))*4)
 	i := 0
 	n := len(data)
 	{
@@ -719,106 +1055,92 @@ func (b *Buffer) WriteI32BE(off int64, data []int32) {
 	}
 }
 
-// WriteI32BENext writes a slice of int32s to the buffer at the
+// WriteU32BENext writes a slice of uint32s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) WriteI32BENext(data []int32) {
-	b.WriteI32BE(b.off, data)
+func (b *Buffer) WriteU32BENext(data []uint32) {
+	b.WriteU32BE(b.cursor("WriteU32BENext"), data)
 	b.SeekByte(int64(len(data))*4, true)
 }
 
-// WriteI64LE writes a slice of int64s to the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteI64LE(off int64, data []int64) {
-	if (off + int64(len(data))*8) > b.cap {
-		panic(BufferOverwriteError)
+// PutU32BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU32BE(off int64, data uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("PutU32BE", off, 4, b.cap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*8)] = byte(data[i])
-		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 8)
-		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 16)
-		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 24)
-		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 32)
-		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 40)
-		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 48)
-		b.buf[off+int64(7+(i*8))] = byte(data[i] >> 56)
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if !b.writable(off, 4) {
+		b.fail(BufferOverwriteError.at("PutU32BE", off, 4, b.cap))
+		return
 	}
+	b.save(off, 4)
+	b.buf[off] = byte(data >> 24)
+	b.buf[off+1] = byte(data >> 16)
+	b.buf[off+2] = byte(data >> 8)
+	b.buf[off+3] = byte(data)
 }
 
-// WriteI64LENext writes a slice of int64s to the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteI64LENext(data []int64) {
-	b.WriteI64LE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+// PutU32BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU32BENext(data uint32) {
+	b.PutU32BE(b.cursor("PutU32BENext"), data)
+	b.SeekByte(4, true)
 }
 
-// WriteI64BE writes a slice of int64s to the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteI64BE(off int64, data []int64) {
-	if (off + int64(len(data))*8) > b.cap {
-		panic(BufferOverwriteError)
+// PutU32BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU32BEBits(off int64, data uint32) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("PutU32BEBits", off, 32, b.bcap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*8)] = byte(data[i] >> 56)
-		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 48)
-		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 40)
-		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 32)
-		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 24)
-		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 16)
-		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 8)
-		b.buf[off+int64(7+(i*8))] = byte(data[i])
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if 32 > b.bcap-off && !b.reserve(off/8+(off%8+32+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU32BEBits", off, 32, b.bcap))
+		return
 	}
+	b.saveBits(off, 32)
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, false)
 }
 
-// WriteI64BENext writes a slice of int64s to the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteI64BENext(data []int64) {
-	b.WriteI64BE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+// PutU32BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU32BEBitsNext(data uint32) {
+	b.PutU32BEBits(b.boff, data)
+	b.SeekBit(32, true)
 }
 
-// WriteF32LE writes a slice of float32s to the buffer at the
+// WriteU40LE writes a slice of uint64s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *Buffer) WriteF32LE(off int64, data []float32) {
-	if (off + int64(len(data))*4) > b.cap {
-		panic(BufferOverwriteError)
+func (b *Buffer) WriteU40LE(off int64, data []uint64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU40LE", off, int64(len(data))*5, b.cap))
+		return
 	}
+	if !b.writable(off, int64(len(data))*5) {
+		b.fail(BufferOverwriteError.at("WriteU40LE", off, int64(len(data))*5, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*5)
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
-		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(i*5)] = byte(data[i])
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*5))] = byte(data[i] >> 32)
 		i++
 		if i < n {
 			goto write_loop
@@ -826,106 +1148,93 @@ func (b *Buffer) WriteF32LE(off int64, data []float32) {
 	}
 }
 
-// WriteF32LENext writes a slice of float32s to the buffer at the
+// WriteU40LENext writes a slice of uint64s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) WriteF32LENext(data []float32) {
-	b.WriteF32LE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+func (b *Buffer) WriteU40LENext(data []uint64) {
+	b.WriteU40LE(b.cursor("WriteU40LENext"), data)
+	b.SeekByte(int64(len(data))*5, true)
 }
 
-// WriteF32BE writes a slice of float32s to the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteF32BE(off int64, data []float32) {
-	if (off + int64(len(data))*4) > b.cap {
-		panic(BufferOverwriteError)
+// PutU40LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU40LE(off int64, data uint64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("PutU40LE", off, 5, b.cap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
-		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if !b.writable(off, 5) {
+		b.fail(BufferOverwriteError.at("PutU40LE", off, 5, b.cap))
+		return
 	}
+	b.save(off, 5)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
 }
 
-// WriteF32BENext writes a slice of float32s to the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteF32BENext(data []float32) {
-	b.WriteF32BE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+// PutU40LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU40LENext(data uint64) {
+	b.PutU40LE(b.cursor("PutU40LENext"), data)
+	b.SeekByte(5, true)
 }
 
-// WriteF64LE writes a slice of float64s to the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) WriteF64LE(off int64, data []float64) {
-	if (off + int64(len(data))*8) > b.cap {
-		panic(BufferOverwriteError)
+// PutU40LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU40LEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.atBit("PutU40LEBits", off, 40, b.bcap))
+		return
 	}
-	i := 0
-	n := len(data)
-	{
-	write_loop:
-		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
-		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
-		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
-		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
-		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
-		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
-		i++
-		if i < n {
-			goto write_loop
-		}
+	if 40 > b.bcap-off && !b.reserve(off/8+(off%8+40+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU40LEBits", off, 40, b.bcap))
+		return
 	}
+	b.saveBits(off, 40)
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, true)
 }
 
-// WriteF64LENext writes a slice of float64s to the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) WriteF64LENext(data []float64) {
-	b.WriteF64LE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+// PutU40LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU40LEBitsNext(data uint64) {
+	b.PutU40LEBits(b.boff, data)
+	b.SeekBit(40, true)
 }
 
-// WriteF64BE writes a slice of float64s to the buffer at the
+// WriteU40BE writes a slice of uint64s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *Buffer) WriteF64BE(off int64, data []float64) {
-	if (off + int64(len(data))*8) > b.cap {
-		panic(BufferOverwriteError)
+func (b *Buffer) WriteU40BE(off int64, data []uint64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderwriteError)
+		b.fail(BufferUnderwriteError.at("WriteU40BE", off, int64(len(data))*5, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*5) {
+		b.fail(BufferOverwriteError.at("WriteU40BE", off, int64(len(data))*5, b.cap))
+		return
 	}
+	b.save(off, int64(len(data))*5)
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
-		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
-		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
-		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
-		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
-		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(i*5)] = byte(data[i] >> 32)
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(4+(i*5))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -933,109 +1242,5483 @@ func (b *Buffer) WriteF64BE(off int64, data []float64) {
 	}
 }
 
-// WriteF64BENext writes a slice of float64s to the buffer at the
+// WriteU40BENext writes a slice of uint64s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) WriteF64BENext(data []float64) {
-	b.WriteF64BE(b.off, data)
+func (b *Buffer) WriteU40BENext(data []uint64) {
+	b.WriteU40BE(b.cursor("WriteU40BENext"), data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutU40BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU40BE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU40BE", off, 5, b.cap))
+		return
+	}
+	if !b.writable(off, 5) {
+		b.fail(BufferOverwriteError.at("PutU40BE", off, 5, b.cap))
+		return
+	}
+	b.save(off, 5)
+	b.buf[off] = byte(data >> 32)
+	b.buf[off+1] = byte(data >> 24)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 8)
+	b.buf[off+4] = byte(data)
+}
+
+// PutU40BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU40BENext(data uint64) {
+	b.PutU40BE(b.cursor("PutU40BENext"), data)
+	b.SeekByte(5, true)
+}
+
+// PutU40BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU40BEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU40BEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off && !b.reserve(off/8+(off%8+40+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU40BEBits", off, 40, b.bcap))
+		return
+	}
+	b.saveBits(off, 40)
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, false)
+}
+
+// PutU40BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU40BEBitsNext(data uint64) {
+	b.PutU40BEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteU48LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteU48LE(off int64, data []uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteU48LE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*6) {
+		b.fail(BufferOverwriteError.at("WriteU48LE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*6)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*6)] = byte(data[i])
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*6))] = byte(data[i] >> 40)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU48LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteU48LENext(data []uint64) {
+	b.WriteU48LE(b.cursor("WriteU48LENext"), data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutU48LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU48LE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU48LE", off, 6, b.cap))
+		return
+	}
+	if !b.writable(off, 6) {
+		b.fail(BufferOverwriteError.at("PutU48LE", off, 6, b.cap))
+		return
+	}
+	b.save(off, 6)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+}
+
+// PutU48LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU48LENext(data uint64) {
+	b.PutU48LE(b.cursor("PutU48LENext"), data)
+	b.SeekByte(6, true)
+}
+
+// PutU48LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU48LEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU48LEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off && !b.reserve(off/8+(off%8+48+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU48LEBits", off, 48, b.bcap))
+		return
+	}
+	b.saveBits(off, 48)
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, true)
+}
+
+// PutU48LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU48LEBitsNext(data uint64) {
+	b.PutU48LEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteU48BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteU48BE(off int64, data []uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteU48BE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*6) {
+		b.fail(BufferOverwriteError.at("WriteU48BE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*6)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*6)] = byte(data[i] >> 40)
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(5+(i*6))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU48BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteU48BENext(data []uint64) {
+	b.WriteU48BE(b.cursor("WriteU48BENext"), data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutU48BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU48BE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU48BE", off, 6, b.cap))
+		return
+	}
+	if !b.writable(off, 6) {
+		b.fail(BufferOverwriteError.at("PutU48BE", off, 6, b.cap))
+		return
+	}
+	b.save(off, 6)
+	b.buf[off] = byte(data >> 40)
+	b.buf[off+1] = byte(data >> 32)
+	b.buf[off+2] = byte(data >> 24)
+	b.buf[off+3] = byte(data >> 16)
+	b.buf[off+4] = byte(data >> 8)
+	b.buf[off+5] = byte(data)
+}
+
+// PutU48BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU48BENext(data uint64) {
+	b.PutU48BE(b.cursor("PutU48BENext"), data)
+	b.SeekByte(6, true)
+}
+
+// PutU48BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU48BEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU48BEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off && !b.reserve(off/8+(off%8+48+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU48BEBits", off, 48, b.bcap))
+		return
+	}
+	b.saveBits(off, 48)
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, false)
+}
+
+// PutU48BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU48BEBitsNext(data uint64) {
+	b.PutU48BEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteU56LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteU56LE(off int64, data []uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteU56LE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*7) {
+		b.fail(BufferOverwriteError.at("WriteU56LE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*7)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*7)] = byte(data[i])
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*7))] = byte(data[i] >> 48)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU56LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteU56LENext(data []uint64) {
+	b.WriteU56LE(b.cursor("WriteU56LENext"), data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutU56LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU56LE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU56LE", off, 7, b.cap))
+		return
+	}
+	if !b.writable(off, 7) {
+		b.fail(BufferOverwriteError.at("PutU56LE", off, 7, b.cap))
+		return
+	}
+	b.save(off, 7)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+}
+
+// PutU56LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU56LENext(data uint64) {
+	b.PutU56LE(b.cursor("PutU56LENext"), data)
+	b.SeekByte(7, true)
+}
+
+// PutU56LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU56LEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU56LEBits", off, 56, b.bcap))
+		return
+	}
+	if 56 > b.bcap-off && !b.reserve(off/8+(off%8+56+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU56LEBits", off, 56, b.bcap))
+		return
+	}
+	b.saveBits(off, 56)
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, true)
+}
+
+// PutU56LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU56LEBitsNext(data uint64) {
+	b.PutU56LEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteU56BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteU56BE(off int64, data []uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteU56BE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*7) {
+		b.fail(BufferOverwriteError.at("WriteU56BE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*7)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*7)] = byte(data[i] >> 48)
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(6+(i*7))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU56BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteU56BENext(data []uint64) {
+	b.WriteU56BE(b.cursor("WriteU56BENext"), data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutU56BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU56BE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU56BE", off, 7, b.cap))
+		return
+	}
+	if !b.writable(off, 7) {
+		b.fail(BufferOverwriteError.at("PutU56BE", off, 7, b.cap))
+		return
+	}
+	b.save(off, 7)
+	b.buf[off] = byte(data >> 48)
+	b.buf[off+1] = byte(data >> 40)
+	b.buf[off+2] = byte(data >> 32)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 16)
+	b.buf[off+5] = byte(data >> 8)
+	b.buf[off+6] = byte(data)
+}
+
+// PutU56BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU56BENext(data uint64) {
+	b.PutU56BE(b.cursor("PutU56BENext"), data)
+	b.SeekByte(7, true)
+}
+
+// PutU56BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU56BEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU56BEBits", off, 56, b.bcap))
+		return
+	}
+	if 56 > b.bcap-off && !b.reserve(off/8+(off%8+56+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU56BEBits", off, 56, b.bcap))
+		return
+	}
+	b.saveBits(off, 56)
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, false)
+}
+
+// PutU56BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU56BEBitsNext(data uint64) {
+	b.PutU56BEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteU64LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteU64LE(off int64, data []uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteU64LE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*8) {
+		b.fail(BufferOverwriteError.at("WriteU64LE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*8)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(data[i])
+		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 48)
+		b.buf[off+int64(7+(i*8))] = byte(data[i] >> 56)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU64LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteU64LENext(data []uint64) {
+	b.WriteU64LE(b.cursor("WriteU64LENext"), data)
 	b.SeekByte(int64(len(data))*8, true)
 }
 
-// ReadBytes returns the next n bytes from the specified offset
-// without modifying the internal offset value
-func (b *Buffer) ReadBytes(off, n int64) []byte {
+// PutU64LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutU64LE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU64LE", off, 8, b.cap))
+		return
+	}
+	if !b.writable(off, 8) {
+		b.fail(BufferOverwriteError.at("PutU64LE", off, 8, b.cap))
+		return
+	}
+	b.save(off, 8)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+	b.buf[off+7] = byte(data >> 56)
+}
+
+// PutU64LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU64LENext(data uint64) {
+	b.PutU64LE(b.cursor("PutU64LENext"), data)
+	b.SeekByte(8, true)
+}
+
+// PutU64LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutU64LEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU64LEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off && !b.reserve(off/8+(off%8+64+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU64LEBits", off, 64, b.bcap))
+		return
+	}
+	b.saveBits(off, 64)
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, true)
+}
+
+// PutU64LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU64LEBitsNext(data uint64) {
+	b.PutU64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteU64BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteU64BE(off int64, data []uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteU64BE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*8) {
+		b.fail(BufferOverwriteError.at("WriteU64BE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*8)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(data[i] >> 56)
+		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 48)
+		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 40)
+		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 32)
+		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 24)
+		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 16)
+		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 8)
+		b.buf[off+int64(7+(i*8))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU64BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteU64BENext(data []uint64) {
+	b.WriteU64BE(b.cursor("WriteU64BENext"), data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutU64BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutU64BE(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutU64BE", off, 8, b.cap))
+		return
+	}
+	if !b.writable(off, 8) {
+		b.fail(BufferOverwriteError.at("PutU64BE", off, 8, b.cap))
+		return
+	}
+	b.save(off, 8)
+	b.buf[off] = byte(data >> 56)
+	b.buf[off+1] = byte(data >> 48)
+	b.buf[off+2] = byte(data >> 40)
+	b.buf[off+3] = byte(data >> 32)
+	b.buf[off+4] = byte(data >> 24)
+	b.buf[off+5] = byte(data >> 16)
+	b.buf[off+6] = byte(data >> 8)
+	b.buf[off+7] = byte(data)
+}
+
+// PutU64BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutU64BENext(data uint64) {
+	b.PutU64BE(b.cursor("PutU64BENext"), data)
+	b.SeekByte(8, true)
+}
+
+// PutU64BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutU64BEBits(off int64, data uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutU64BEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off && !b.reserve(off/8+(off%8+64+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutU64BEBits", off, 64, b.bcap))
+		return
+	}
+	b.saveBits(off, 64)
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, false)
+}
+
+// PutU64BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutU64BEBitsNext(data uint64) {
+	b.PutU64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteI16LE writes a slice of int16s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI16LE(off int64, data []int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI16LE", off, int64(len(data))*2, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*2) {
+		b.fail(BufferOverwriteError.at("WriteI16LE", off, int64(len(data))*2, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*2)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*2)] = byte(data[i])
+		b.buf[off+int64(1+(i*2))] = byte(data[i] >> 8)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI16LENext writes a slice of int16s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI16LENext(data []int16) {
+	b.WriteI16LE(b.cursor("WriteI16LENext"), data)
+	b.SeekByte(int64(len(data))*2, true)
+}
+
+// PutI16LE writes an int16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI16LE(off int64, data int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI16LE", off, 2, b.cap))
+		return
+	}
+	if !b.writable(off, 2) {
+		b.fail(BufferOverwriteError.at("PutI16LE", off, 2, b.cap))
+		return
+	}
+	b.save(off, 2)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+}
+
+// PutI16LENext writes an int16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI16LENext(data int16) {
+	b.PutI16LE(b.cursor("PutI16LENext"), data)
+	b.SeekByte(2, true)
+}
+
+// PutI16LEBits writes an int16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI16LEBits(off int64, data int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI16LEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off && !b.reserve(off/8+(off%8+16+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI16LEBits", off, 16, b.bcap))
+		return
+	}
+	b.saveBits(off, 16)
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, true)
+}
+
+// PutI16LEBitsNext writes an int16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI16LEBitsNext(data int16) {
+	b.PutI16LEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteI16BE writes a slice of int16s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI16BE(off int64, data []int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI16BE", off, int64(len(data))*2, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*2) {
+		b.fail(BufferOverwriteError.at("WriteI16BE", off, int64(len(data))*2, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*2)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*2)] = byte(data[i] >> 8)
+		b.buf[off+int64(1+(i*2))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI16BENext writes a slice of int16s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI16BENext(data []int16) {
+	b.WriteI16BE(b.cursor("WriteI16BENext"), data)
+	b.SeekByte(int64(len(data))*2, true)
+}
+
+// PutI16BE writes an int16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI16BE(off int64, data int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI16BE", off, 2, b.cap))
+		return
+	}
+	if !b.writable(off, 2) {
+		b.fail(BufferOverwriteError.at("PutI16BE", off, 2, b.cap))
+		return
+	}
+	b.save(off, 2)
+	b.buf[off] = byte(data >> 8)
+	b.buf[off+1] = byte(data)
+}
+
+// PutI16BENext writes an int16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI16BENext(data int16) {
+	b.PutI16BE(b.cursor("PutI16BENext"), data)
+	b.SeekByte(2, true)
+}
+
+// PutI16BEBits writes an int16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI16BEBits(off int64, data int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI16BEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off && !b.reserve(off/8+(off%8+16+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI16BEBits", off, 16, b.bcap))
+		return
+	}
+	b.saveBits(off, 16)
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, false)
+}
+
+// PutI16BEBitsNext writes an int16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI16BEBitsNext(data int16) {
+	b.PutI16BEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteI24LE writes a slice of int32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI24LE(off int64, data []int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI24LE", off, int64(len(data))*3, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*3) {
+		b.fail(BufferOverwriteError.at("WriteI24LE", off, int64(len(data))*3, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*3)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*3)] = byte(data[i])
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i] >> 16)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI24LENext writes a slice of int32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI24LENext(data []int32) {
+	b.WriteI24LE(b.cursor("WriteI24LENext"), data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutI24LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI24LE(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI24LE", off, 3, b.cap))
+		return
+	}
+	if !b.writable(off, 3) {
+		b.fail(BufferOverwriteError.at("PutI24LE", off, 3, b.cap))
+		return
+	}
+	b.save(off, 3)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+}
+
+// PutI24LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI24LENext(data int32) {
+	b.PutI24LE(b.cursor("PutI24LENext"), data)
+	b.SeekByte(3, true)
+}
+
+// PutI24LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI24LEBits(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI24LEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off && !b.reserve(off/8+(off%8+24+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI24LEBits", off, 24, b.bcap))
+		return
+	}
+	b.saveBits(off, 24)
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, true)
+}
+
+// PutI24LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI24LEBitsNext(data int32) {
+	b.PutI24LEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteI24BE writes a slice of int32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI24BE(off int64, data []int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI24BE", off, int64(len(data))*3, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*3) {
+		b.fail(BufferOverwriteError.at("WriteI24BE", off, int64(len(data))*3, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*3)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*3)] = byte(data[i] >> 16)
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI24BENext writes a slice of int32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI24BENext(data []int32) {
+	b.WriteI24BE(b.cursor("WriteI24BENext"), data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutI24BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI24BE(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI24BE", off, 3, b.cap))
+		return
+	}
+	if !b.writable(off, 3) {
+		b.fail(BufferOverwriteError.at("PutI24BE", off, 3, b.cap))
+		return
+	}
+	b.save(off, 3)
+	b.buf[off] = byte(data >> 16)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data)
+}
+
+// PutI24BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI24BENext(data int32) {
+	b.PutI24BE(b.cursor("PutI24BENext"), data)
+	b.SeekByte(3, true)
+}
+
+// PutI24BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI24BEBits(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI24BEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off && !b.reserve(off/8+(off%8+24+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI24BEBits", off, 24, b.bcap))
+		return
+	}
+	b.saveBits(off, 24)
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, false)
+}
+
+// PutI24BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI24BEBitsNext(data int32) {
+	b.PutI24BEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteI32LE writes a slice of int32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI32LE(off int64, data []int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI32LE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*4) {
+		b.fail(BufferOverwriteError.at("WriteI32LE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*4)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(data[i])
+		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*4))] = byte(data[i] >> 24)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI32LENext writes a slice of int32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI32LENext(data []int32) {
+	b.WriteI32LE(b.cursor("WriteI32LENext"), data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutI32LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI32LE(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI32LE", off, 4, b.cap))
+		return
+	}
+	if !b.writable(off, 4) {
+		b.fail(BufferOverwriteError.at("PutI32LE", off, 4, b.cap))
+		return
+	}
+	b.save(off, 4)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+}
+
+// PutI32LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI32LENext(data int32) {
+	b.PutI32LE(b.cursor("PutI32LENext"), data)
+	b.SeekByte(4, true)
+}
+
+// PutI32LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI32LEBits(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI32LEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off && !b.reserve(off/8+(off%8+32+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI32LEBits", off, 32, b.bcap))
+		return
+	}
+	b.saveBits(off, 32)
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, true)
+}
+
+// PutI32LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI32LEBitsNext(data int32) {
+	b.PutI32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteI32BE writes a slice of int32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI32BE(off int64, data []int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI32BE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*4) {
+		b.fail(BufferOverwriteError.at("WriteI32BE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*4)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(data[i] >> 24)
+		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 16)
+		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 8)
+		b.buf[off+int64(3+(i*4))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI32BENext writes a slice of int32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI32BENext(data []int32) {
+	b.WriteI32BE(b.cursor("WriteI32BENext"), data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutI32BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI32BE(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI32BE", off, 4, b.cap))
+		return
+	}
+	if !b.writable(off, 4) {
+		b.fail(BufferOverwriteError.at("PutI32BE", off, 4, b.cap))
+		return
+	}
+	b.save(off, 4)
+	b.buf[off] = byte(data >> 24)
+	b.buf[off+1] = byte(data >> 16)
+	b.buf[off+2] = byte(data >> 8)
+	b.buf[off+3] = byte(data)
+}
+
+// PutI32BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI32BENext(data int32) {
+	b.PutI32BE(b.cursor("PutI32BENext"), data)
+	b.SeekByte(4, true)
+}
+
+// PutI32BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI32BEBits(off int64, data int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI32BEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off && !b.reserve(off/8+(off%8+32+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI32BEBits", off, 32, b.bcap))
+		return
+	}
+	b.saveBits(off, 32)
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, false)
+}
+
+// PutI32BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI32BEBitsNext(data int32) {
+	b.PutI32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteI40LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI40LE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI40LE", off, int64(len(data))*5, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*5) {
+		b.fail(BufferOverwriteError.at("WriteI40LE", off, int64(len(data))*5, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*5)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*5)] = byte(data[i])
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*5))] = byte(data[i] >> 32)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI40LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI40LENext(data []int64) {
+	b.WriteI40LE(b.cursor("WriteI40LENext"), data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutI40LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI40LE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI40LE", off, 5, b.cap))
+		return
+	}
+	if !b.writable(off, 5) {
+		b.fail(BufferOverwriteError.at("PutI40LE", off, 5, b.cap))
+		return
+	}
+	b.save(off, 5)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+}
+
+// PutI40LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI40LENext(data int64) {
+	b.PutI40LE(b.cursor("PutI40LENext"), data)
+	b.SeekByte(5, true)
+}
+
+// PutI40LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI40LEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI40LEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off && !b.reserve(off/8+(off%8+40+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI40LEBits", off, 40, b.bcap))
+		return
+	}
+	b.saveBits(off, 40)
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, true)
+}
+
+// PutI40LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI40LEBitsNext(data int64) {
+	b.PutI40LEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteI40BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI40BE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI40BE", off, int64(len(data))*5, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*5) {
+		b.fail(BufferOverwriteError.at("WriteI40BE", off, int64(len(data))*5, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*5)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*5)] = byte(data[i] >> 32)
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(4+(i*5))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI40BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI40BENext(data []int64) {
+	b.WriteI40BE(b.cursor("WriteI40BENext"), data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutI40BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI40BE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI40BE", off, 5, b.cap))
+		return
+	}
+	if !b.writable(off, 5) {
+		b.fail(BufferOverwriteError.at("PutI40BE", off, 5, b.cap))
+		return
+	}
+	b.save(off, 5)
+	b.buf[off] = byte(data >> 32)
+	b.buf[off+1] = byte(data >> 24)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 8)
+	b.buf[off+4] = byte(data)
+}
+
+// PutI40BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI40BENext(data int64) {
+	b.PutI40BE(b.cursor("PutI40BENext"), data)
+	b.SeekByte(5, true)
+}
+
+// PutI40BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI40BEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI40BEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off && !b.reserve(off/8+(off%8+40+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI40BEBits", off, 40, b.bcap))
+		return
+	}
+	b.saveBits(off, 40)
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, false)
+}
+
+// PutI40BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI40BEBitsNext(data int64) {
+	b.PutI40BEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteI48LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI48LE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI48LE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*6) {
+		b.fail(BufferOverwriteError.at("WriteI48LE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*6)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*6)] = byte(data[i])
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*6))] = byte(data[i] >> 40)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI48LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI48LENext(data []int64) {
+	b.WriteI48LE(b.cursor("WriteI48LENext"), data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutI48LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI48LE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI48LE", off, 6, b.cap))
+		return
+	}
+	if !b.writable(off, 6) {
+		b.fail(BufferOverwriteError.at("PutI48LE", off, 6, b.cap))
+		return
+	}
+	b.save(off, 6)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+}
+
+// PutI48LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI48LENext(data int64) {
+	b.PutI48LE(b.cursor("PutI48LENext"), data)
+	b.SeekByte(6, true)
+}
+
+// PutI48LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI48LEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI48LEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off && !b.reserve(off/8+(off%8+48+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI48LEBits", off, 48, b.bcap))
+		return
+	}
+	b.saveBits(off, 48)
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, true)
+}
+
+// PutI48LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI48LEBitsNext(data int64) {
+	b.PutI48LEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteI48BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI48BE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI48BE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*6) {
+		b.fail(BufferOverwriteError.at("WriteI48BE", off, int64(len(data))*6, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*6)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*6)] = byte(data[i] >> 40)
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(5+(i*6))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI48BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI48BENext(data []int64) {
+	b.WriteI48BE(b.cursor("WriteI48BENext"), data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutI48BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI48BE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI48BE", off, 6, b.cap))
+		return
+	}
+	if !b.writable(off, 6) {
+		b.fail(BufferOverwriteError.at("PutI48BE", off, 6, b.cap))
+		return
+	}
+	b.save(off, 6)
+	b.buf[off] = byte(data >> 40)
+	b.buf[off+1] = byte(data >> 32)
+	b.buf[off+2] = byte(data >> 24)
+	b.buf[off+3] = byte(data >> 16)
+	b.buf[off+4] = byte(data >> 8)
+	b.buf[off+5] = byte(data)
+}
+
+// PutI48BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI48BENext(data int64) {
+	b.PutI48BE(b.cursor("PutI48BENext"), data)
+	b.SeekByte(6, true)
+}
+
+// PutI48BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI48BEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI48BEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off && !b.reserve(off/8+(off%8+48+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI48BEBits", off, 48, b.bcap))
+		return
+	}
+	b.saveBits(off, 48)
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, false)
+}
+
+// PutI48BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI48BEBitsNext(data int64) {
+	b.PutI48BEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteI56LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI56LE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI56LE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*7) {
+		b.fail(BufferOverwriteError.at("WriteI56LE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*7)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*7)] = byte(data[i])
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*7))] = byte(data[i] >> 48)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI56LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI56LENext(data []int64) {
+	b.WriteI56LE(b.cursor("WriteI56LENext"), data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutI56LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI56LE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI56LE", off, 7, b.cap))
+		return
+	}
+	if !b.writable(off, 7) {
+		b.fail(BufferOverwriteError.at("PutI56LE", off, 7, b.cap))
+		return
+	}
+	b.save(off, 7)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+}
+
+// PutI56LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI56LENext(data int64) {
+	b.PutI56LE(b.cursor("PutI56LENext"), data)
+	b.SeekByte(7, true)
+}
+
+// PutI56LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI56LEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI56LEBits", off, 56, b.bcap))
+		return
+	}
+	if 56 > b.bcap-off && !b.reserve(off/8+(off%8+56+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI56LEBits", off, 56, b.bcap))
+		return
+	}
+	b.saveBits(off, 56)
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, true)
+}
+
+// PutI56LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI56LEBitsNext(data int64) {
+	b.PutI56LEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteI56BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI56BE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI56BE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*7) {
+		b.fail(BufferOverwriteError.at("WriteI56BE", off, int64(len(data))*7, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*7)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*7)] = byte(data[i] >> 48)
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(6+(i*7))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI56BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI56BENext(data []int64) {
+	b.WriteI56BE(b.cursor("WriteI56BENext"), data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutI56BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI56BE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI56BE", off, 7, b.cap))
+		return
+	}
+	if !b.writable(off, 7) {
+		b.fail(BufferOverwriteError.at("PutI56BE", off, 7, b.cap))
+		return
+	}
+	b.save(off, 7)
+	b.buf[off] = byte(data >> 48)
+	b.buf[off+1] = byte(data >> 40)
+	b.buf[off+2] = byte(data >> 32)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 16)
+	b.buf[off+5] = byte(data >> 8)
+	b.buf[off+6] = byte(data)
+}
+
+// PutI56BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI56BENext(data int64) {
+	b.PutI56BE(b.cursor("PutI56BENext"), data)
+	b.SeekByte(7, true)
+}
+
+// PutI56BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI56BEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI56BEBits", off, 56, b.bcap))
+		return
+	}
+	if 56 > b.bcap-off && !b.reserve(off/8+(off%8+56+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI56BEBits", off, 56, b.bcap))
+		return
+	}
+	b.saveBits(off, 56)
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, false)
+}
+
+// PutI56BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI56BEBitsNext(data int64) {
+	b.PutI56BEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteI64LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI64LE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI64LE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*8) {
+		b.fail(BufferOverwriteError.at("WriteI64LE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*8)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(data[i])
+		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 48)
+		b.buf[off+int64(7+(i*8))] = byte(data[i] >> 56)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI64LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI64LENext(data []int64) {
+	b.WriteI64LE(b.cursor("WriteI64LENext"), data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutI64LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutI64LE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI64LE", off, 8, b.cap))
+		return
+	}
+	if !b.writable(off, 8) {
+		b.fail(BufferOverwriteError.at("PutI64LE", off, 8, b.cap))
+		return
+	}
+	b.save(off, 8)
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+	b.buf[off+7] = byte(data >> 56)
+}
+
+// PutI64LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI64LENext(data int64) {
+	b.PutI64LE(b.cursor("PutI64LENext"), data)
+	b.SeekByte(8, true)
+}
+
+// PutI64LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutI64LEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI64LEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off && !b.reserve(off/8+(off%8+64+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI64LEBits", off, 64, b.bcap))
+		return
+	}
+	b.saveBits(off, 64)
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, true)
+}
+
+// PutI64LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI64LEBitsNext(data int64) {
+	b.PutI64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteI64BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteI64BE(off int64, data []int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteI64BE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*8) {
+		b.fail(BufferOverwriteError.at("WriteI64BE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*8)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(data[i] >> 56)
+		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 48)
+		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 40)
+		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 32)
+		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 24)
+		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 16)
+		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 8)
+		b.buf[off+int64(7+(i*8))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI64BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteI64BENext(data []int64) {
+	b.WriteI64BE(b.cursor("WriteI64BENext"), data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutI64BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutI64BE(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutI64BE", off, 8, b.cap))
+		return
+	}
+	if !b.writable(off, 8) {
+		b.fail(BufferOverwriteError.at("PutI64BE", off, 8, b.cap))
+		return
+	}
+	b.save(off, 8)
+	b.buf[off] = byte(data >> 56)
+	b.buf[off+1] = byte(data >> 48)
+	b.buf[off+2] = byte(data >> 40)
+	b.buf[off+3] = byte(data >> 32)
+	b.buf[off+4] = byte(data >> 24)
+	b.buf[off+5] = byte(data >> 16)
+	b.buf[off+6] = byte(data >> 8)
+	b.buf[off+7] = byte(data)
+}
+
+// PutI64BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutI64BENext(data int64) {
+	b.PutI64BE(b.cursor("PutI64BENext"), data)
+	b.SeekByte(8, true)
+}
+
+// PutI64BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutI64BEBits(off int64, data int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutI64BEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off && !b.reserve(off/8+(off%8+64+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutI64BEBits", off, 64, b.bcap))
+		return
+	}
+	b.saveBits(off, 64)
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, false)
+}
+
+// PutI64BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutI64BEBitsNext(data int64) {
+	b.PutI64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteF32LE writes a slice of float32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteF32LE(off int64, data []float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteF32LE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*4) {
+		b.fail(BufferOverwriteError.at("WriteF32LE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*4)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF32LENext writes a slice of float32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteF32LENext(data []float32) {
+	b.WriteF32LE(b.cursor("WriteF32LENext"), data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutF32LE writes a float32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutF32LE(off int64, data float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutF32LE", off, 4, b.cap))
+		return
+	}
+	if !b.writable(off, 4) {
+		b.fail(BufferOverwriteError.at("PutF32LE", off, 4, b.cap))
+		return
+	}
+	b.save(off, 4)
+	u := *(*uint32)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u)
+	b.buf[off+1] = byte(u >> 8)
+	b.buf[off+2] = byte(u >> 16)
+	b.buf[off+3] = byte(u >> 24)
+}
+
+// PutF32LENext writes a float32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutF32LENext(data float32) {
+	b.PutF32LE(b.cursor("PutF32LENext"), data)
+	b.SeekByte(4, true)
+}
+
+// PutF32LEBits writes a float32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutF32LEBits(off int64, data float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutF32LEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off && !b.reserve(off/8+(off%8+32+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutF32LEBits", off, 32, b.bcap))
+		return
+	}
+	b.saveBits(off, 32)
+	writeBitsBytes(b.buf, off, uint64(*(*uint32)(unsafe.Pointer(&data))), 4, b.order, true)
+}
+
+// PutF32LEBitsNext writes a float32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutF32LEBitsNext(data float32) {
+	b.PutF32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteF32BE writes a slice of float32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteF32BE(off int64, data []float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteF32BE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*4) {
+		b.fail(BufferOverwriteError.at("WriteF32BE", off, int64(len(data))*4, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*4)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF32BENext writes a slice of float32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteF32BENext(data []float32) {
+	b.WriteF32BE(b.cursor("WriteF32BENext"), data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutF32BE writes a float32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutF32BE(off int64, data float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutF32BE", off, 4, b.cap))
+		return
+	}
+	if !b.writable(off, 4) {
+		b.fail(BufferOverwriteError.at("PutF32BE", off, 4, b.cap))
+		return
+	}
+	b.save(off, 4)
+	u := *(*uint32)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u >> 24)
+	b.buf[off+1] = byte(u >> 16)
+	b.buf[off+2] = byte(u >> 8)
+	b.buf[off+3] = byte(u)
+}
+
+// PutF32BENext writes a float32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutF32BENext(data float32) {
+	b.PutF32BE(b.cursor("PutF32BENext"), data)
+	b.SeekByte(4, true)
+}
+
+// PutF32BEBits writes a float32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutF32BEBits(off int64, data float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutF32BEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off && !b.reserve(off/8+(off%8+32+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutF32BEBits", off, 32, b.bcap))
+		return
+	}
+	b.saveBits(off, 32)
+	writeBitsBytes(b.buf, off, uint64(*(*uint32)(unsafe.Pointer(&data))), 4, b.order, false)
+}
+
+// PutF32BEBitsNext writes a float32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutF32BEBitsNext(data float32) {
+	b.PutF32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteF64LE writes a slice of float64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteF64LE(off int64, data []float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteF64LE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*8) {
+		b.fail(BufferOverwriteError.at("WriteF64LE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*8)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
+		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
+		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
+		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF64LENext writes a slice of float64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteF64LENext(data []float64) {
+	b.WriteF64LE(b.cursor("WriteF64LENext"), data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutF64LE writes a float64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) PutF64LE(off int64, data float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutF64LE", off, 8, b.cap))
+		return
+	}
+	if !b.writable(off, 8) {
+		b.fail(BufferOverwriteError.at("PutF64LE", off, 8, b.cap))
+		return
+	}
+	b.save(off, 8)
+	u := *(*uint64)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u)
+	b.buf[off+1] = byte(u >> 8)
+	b.buf[off+2] = byte(u >> 16)
+	b.buf[off+3] = byte(u >> 24)
+	b.buf[off+4] = byte(u >> 32)
+	b.buf[off+5] = byte(u >> 40)
+	b.buf[off+6] = byte(u >> 48)
+	b.buf[off+7] = byte(u >> 56)
+}
+
+// PutF64LENext writes a float64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutF64LENext(data float64) {
+	b.PutF64LE(b.cursor("PutF64LENext"), data)
+	b.SeekByte(8, true)
+}
+
+// PutF64LEBits writes a float64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) PutF64LEBits(off int64, data float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutF64LEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off && !b.reserve(off/8+(off%8+64+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutF64LEBits", off, 64, b.bcap))
+		return
+	}
+	b.saveBits(off, 64)
+	writeBitsBytes(b.buf, off, uint64(*(*uint64)(unsafe.Pointer(&data))), 8, b.order, true)
+}
+
+// PutF64LEBitsNext writes a float64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutF64LEBitsNext(data float64) {
+	b.PutF64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteF64BE writes a slice of float64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) WriteF64BE(off int64, data []float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("WriteF64BE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	if !b.writable(off, int64(len(data))*8) {
+		b.fail(BufferOverwriteError.at("WriteF64BE", off, int64(len(data))*8, b.cap))
+		return
+	}
+	b.save(off, int64(len(data))*8)
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
+		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
+		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
+		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
+		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF64BENext writes a slice of float64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteF64BENext(data []float64) {
+	b.WriteF64BE(b.cursor("WriteF64BENext"), data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutF64BE writes a float64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) PutF64BE(off int64, data float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.at("PutF64BE", off, 8, b.cap))
+		return
+	}
+	if !b.writable(off, 8) {
+		b.fail(BufferOverwriteError.at("PutF64BE", off, 8, b.cap))
+		return
+	}
+	b.save(off, 8)
+	u := *(*uint64)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u >> 56)
+	b.buf[off+1] = byte(u >> 48)
+	b.buf[off+2] = byte(u >> 40)
+	b.buf[off+3] = byte(u >> 32)
+	b.buf[off+4] = byte(u >> 24)
+	b.buf[off+5] = byte(u >> 16)
+	b.buf[off+6] = byte(u >> 8)
+	b.buf[off+7] = byte(u)
+}
+
+// PutF64BENext writes a float64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *Buffer) PutF64BENext(data float64) {
+	b.PutF64BE(b.cursor("PutF64BENext"), data)
+	b.SeekByte(8, true)
+}
+
+// PutF64BEBits writes a float64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) PutF64BEBits(off int64, data float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderwriteError.atBit("PutF64BEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off && !b.reserve(off/8+(off%8+64+7)/8) {
+		b.fail(BufferOverwriteError.atBit("PutF64BEBits", off, 64, b.bcap))
+		return
+	}
+	b.saveBits(off, 64)
+	writeBitsBytes(b.buf, off, uint64(*(*uint64)(unsafe.Pointer(&data))), 8, b.order, false)
+}
+
+// PutF64BEBitsNext writes a float64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *Buffer) PutF64BEBitsNext(data float64) {
+	b.PutF64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// ReadBytes returns the next n bytes from the specified offset
+// without modifying the internal offset value
+func (b *Buffer) ReadBytes(off, n int64) (out []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("ReadBytes", n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	if n > (b.cap - off) {
+
+		b.fail(BufferOverreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	out = b.buf[off : off+n]
+	return
+
+}
+
+// ReadBytesNext returns the next n bytes from the current offset
+// and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadBytesNext(n int64) (out []byte) {
+
+	out = b.ReadBytes(b.cursor("ReadBytesNext"), n)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ReadByte returns the next byte from the specified offset without
+// modifying the internal offset value
+func (b *Buffer) ReadByte(off int64) (out byte) {
+
+	bytes := b.ReadBytes(off, 1)
+	if b.err == nil {
+
+		out = bytes[0]
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte from the current offset and
+// moves the offset forward a byte
+func (b *Buffer) ReadByteNext() (out byte) {
+
+	out = b.ReadByte(b.cursor("ReadByteNext"))
+	b.SeekByte(1, true)
+	return
+
+}
+
+// ReadU16LE reads a slice of uint16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU16LE(off, n int64) (out []uint16) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU16LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU16LE", off, n*2, b.cap))
+		return
+	}
+	if !b.readable(off, n, 2) {
+		b.fail(BufferOverreadError.at("ReadU16LE", off, n*2, b.cap))
+		return
+	}
+	out = make([]uint16, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint16(b.buf[off+(i*2)]) | uint16(b.buf[off+(1+(i*2))])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU16LENext reads a slice of uint16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU16LENext(n int64) (out []uint16) {
+	out = b.ReadU16LE(b.cursor("ReadU16LENext"), n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadU16LEAt reads a uint16 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU16LEAt(off int64) (out uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU16LEAt", off, 2, b.cap))
+		return
+	}
+	if 2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU16LEAt", off, 2, b.cap))
+		return
+	}
+	out = uint16(b.buf[off]) | uint16(b.buf[off+1])<<8
+	return
+}
+
+// ReadU16LEAtNext reads a uint16 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU16LEAtNext() (out uint16) {
+	out = b.ReadU16LEAt(b.cursor("ReadU16LEAtNext"))
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadU16LEInto reads len(dst) uint16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU16LEInto(dst []uint16, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU16LEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	if int64(len(dst))*2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU16LEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = uint16(b.buf[o]) | uint16(b.buf[o+1])<<8
+	}
+}
+
+// ReadU16LEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU16LEIntoNext(dst []uint16) {
+	b.ReadU16LEInto(dst, b.cursor("ReadU16LEIntoNext"))
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadU16LEBits reads a uint16 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU16LEBits(off int64) (out uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU16LEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU16LEBits", off, 16, b.bcap))
+		return
+	}
+	out = uint16(readBitsBytes(b.buf, off, 2, b.order, true))
+	return
+}
+
+// ReadU16LEBitsNext reads a uint16 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU16LEBitsNext() (out uint16) {
+	out = b.ReadU16LEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadU16BE reads a slice of uint16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU16BE(off, n int64) (out []uint16) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU16BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU16BE", off, n*2, b.cap))
+		return
+	}
+	if !b.readable(off, n, 2) {
+		b.fail(BufferOverreadError.at("ReadU16BE", off, n*2, b.cap))
+		return
+	}
+	out = make([]uint16, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint16(b.buf[off+(1+(i*2))]) | uint16(b.buf[off+(i*2)])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU16BENext reads a slice of uint16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU16BENext(n int64) (out []uint16) {
+	out = b.ReadU16BE(b.cursor("ReadU16BENext"), n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadU16BEAt reads a uint16 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU16BEAt(off int64) (out uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU16BEAt", off, 2, b.cap))
+		return
+	}
+	if 2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU16BEAt", off, 2, b.cap))
+		return
+	}
+	out = uint16(b.buf[off])<<8 | uint16(b.buf[off+1])
+	return
+}
+
+// ReadU16BEAtNext reads a uint16 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU16BEAtNext() (out uint16) {
+	out = b.ReadU16BEAt(b.cursor("ReadU16BEAtNext"))
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadU16BEInto reads len(dst) uint16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU16BEInto(dst []uint16, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU16BEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	if int64(len(dst))*2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU16BEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = uint16(b.buf[o])<<8 | uint16(b.buf[o+1])
+	}
+}
+
+// ReadU16BEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU16BEIntoNext(dst []uint16) {
+	b.ReadU16BEInto(dst, b.cursor("ReadU16BEIntoNext"))
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadU16BEBits reads a uint16 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU16BEBits(off int64) (out uint16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU16BEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU16BEBits", off, 16, b.bcap))
+		return
+	}
+	out = uint16(readBitsBytes(b.buf, off, 2, b.order, false))
+	return
+}
+
+// ReadU16BEBitsNext reads a uint16 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU16BEBitsNext() (out uint16) {
+	out = b.ReadU16BEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadU24LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU24LE(off, n int64) (out []uint32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU24LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU24LE", off, n*3, b.cap))
+		return
+	}
+	if !b.readable(off, n, 3) {
+		b.fail(BufferOverreadError.at("ReadU24LE", off, n*3, b.cap))
+		return
+	}
+	out = make([]uint32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint32(b.buf[off+(i*3)]) | uint32(b.buf[off+(1+(i*3))])<<8 | uint32(b.buf[off+(2+(i*3))])<<16
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU24LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU24LENext(n int64) (out []uint32) {
+	out = b.ReadU24LE(b.cursor("ReadU24LENext"), n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadU24LEAt reads a uint32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU24LEAt(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU24LEAt", off, 3, b.cap))
+		return
+	}
+	if 3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU24LEAt", off, 3, b.cap))
+		return
+	}
+	out = uint32(b.buf[off]) | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])<<16
+	return
+}
+
+// ReadU24LEAtNext reads a uint32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU24LEAtNext() (out uint32) {
+	out = b.ReadU24LEAt(b.cursor("ReadU24LEAtNext"))
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadU24LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU24LEInto(dst []uint32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU24LEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	if int64(len(dst))*3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU24LEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = uint32(b.buf[o]) | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])<<16
+	}
+}
+
+// ReadU24LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU24LEIntoNext(dst []uint32) {
+	b.ReadU24LEInto(dst, b.cursor("ReadU24LEIntoNext"))
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadU24LEBits reads a uint32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU24LEBits(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU24LEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU24LEBits", off, 24, b.bcap))
+		return
+	}
+	out = uint32(readBitsBytes(b.buf, off, 3, b.order, true))
+	return
+}
+
+// ReadU24LEBitsNext reads a uint32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU24LEBitsNext() (out uint32) {
+	out = b.ReadU24LEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadU24BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU24BE(off, n int64) (out []uint32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU24BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU24BE", off, n*3, b.cap))
+		return
+	}
+	if !b.readable(off, n, 3) {
+		b.fail(BufferOverreadError.at("ReadU24BE", off, n*3, b.cap))
+		return
+	}
+	out = make([]uint32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint32(b.buf[off+(2+(i*3))]) | uint32(b.buf[off+(1+(i*3))])<<8 | uint32(b.buf[off+(i*3)])<<16
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU24BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU24BENext(n int64) (out []uint32) {
+	out = b.ReadU24BE(b.cursor("ReadU24BENext"), n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadU24BEAt reads a uint32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU24BEAt(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU24BEAt", off, 3, b.cap))
+		return
+	}
+	if 3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU24BEAt", off, 3, b.cap))
+		return
+	}
+	out = uint32(b.buf[off])<<16 | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])
+	return
+}
+
+// ReadU24BEAtNext reads a uint32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU24BEAtNext() (out uint32) {
+	out = b.ReadU24BEAt(b.cursor("ReadU24BEAtNext"))
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadU24BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU24BEInto(dst []uint32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU24BEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	if int64(len(dst))*3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU24BEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = uint32(b.buf[o])<<16 | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])
+	}
+}
+
+// ReadU24BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU24BEIntoNext(dst []uint32) {
+	b.ReadU24BEInto(dst, b.cursor("ReadU24BEIntoNext"))
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadU24BEBits reads a uint32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU24BEBits(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU24BEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU24BEBits", off, 24, b.bcap))
+		return
+	}
+	out = uint32(readBitsBytes(b.buf, off, 3, b.order, false))
+	return
+}
+
+// ReadU24BEBitsNext reads a uint32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU24BEBitsNext() (out uint32) {
+	out = b.ReadU24BEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadU32LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU32LE(off, n int64) (out []uint32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU32LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU32LE", off, n*4, b.cap))
+		return
+	}
+	if !b.readable(off, n, 4) {
+		b.fail(BufferOverreadError.at("ReadU32LE", off, n*4, b.cap))
+		return
+	}
+	out = make([]uint32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint32(b.buf[off+(i*4)]) | uint32(b.buf[off+(1+(i*4))])<<8 | uint32(b.buf[off+(2+(i*4))])<<16 | uint32(b.buf[off+(3+(i*4))])<<24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU32LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU32LENext(n int64) (out []uint32) {
+	out = b.ReadU32LE(b.cursor("ReadU32LENext"), n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadU32LEAt reads a uint32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU32LEAt(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU32LEAt", off, 4, b.cap))
+		return
+	}
+	if 4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU32LEAt", off, 4, b.cap))
+		return
+	}
+	out = uint32(b.buf[off]) | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])<<16 | uint32(b.buf[off+3])<<24
+	return
+}
+
+// ReadU32LEAtNext reads a uint32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU32LEAtNext() (out uint32) {
+	out = b.ReadU32LEAt(b.cursor("ReadU32LEAtNext"))
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadU32LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU32LEInto(dst []uint32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU32LEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	if int64(len(dst))*4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU32LEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = uint32(b.buf[o]) | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])<<16 | uint32(b.buf[o+3])<<24
+	}
+}
+
+// ReadU32LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU32LEIntoNext(dst []uint32) {
+	b.ReadU32LEInto(dst, b.cursor("ReadU32LEIntoNext"))
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadU32LEBits reads a uint32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU32LEBits(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU32LEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU32LEBits", off, 32, b.bcap))
+		return
+	}
+	out = uint32(readBitsBytes(b.buf, off, 4, b.order, true))
+	return
+}
+
+// ReadU32LEBitsNext reads a uint32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU32LEBitsNext() (out uint32) {
+	out = b.ReadU32LEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadU32BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU32BE(off, n int64) (out []uint32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU32BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU32BE", off, n*4, b.cap))
+		return
+	}
+	if !b.readable(off, n, 4) {
+		b.fail(BufferOverreadError.at("ReadU32BE", off, n*4, b.cap))
+		return
+	}
+	out = make([]uint32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint32(b.buf[off+(3+(i*4))]) | uint32(b.buf[off+(2+(i*4))])<<8 | uint32(b.buf[off+(1+(i*4))])<<16 | uint32(b.buf[off+(i*4)])<<24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU32BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU32BENext(n int64) (out []uint32) {
+	out = b.ReadU32BE(b.cursor("ReadU32BENext"), n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadU32BEAt reads a uint32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU32BEAt(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU32BEAt", off, 4, b.cap))
+		return
+	}
+	if 4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU32BEAt", off, 4, b.cap))
+		return
+	}
+	out = uint32(b.buf[off])<<24 | uint32(b.buf[off+1])<<16 | uint32(b.buf[off+2])<<8 | uint32(b.buf[off+3])
+	return
+}
+
+// ReadU32BEAtNext reads a uint32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU32BEAtNext() (out uint32) {
+	out = b.ReadU32BEAt(b.cursor("ReadU32BEAtNext"))
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadU32BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU32BEInto(dst []uint32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU32BEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	if int64(len(dst))*4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU32BEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = uint32(b.buf[o])<<24 | uint32(b.buf[o+1])<<16 | uint32(b.buf[o+2])<<8 | uint32(b.buf[o+3])
+	}
+}
+
+// ReadU32BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU32BEIntoNext(dst []uint32) {
+	b.ReadU32BEInto(dst, b.cursor("ReadU32BEIntoNext"))
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadU32BEBits reads a uint32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU32BEBits(off int64) (out uint32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU32BEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU32BEBits", off, 32, b.bcap))
+		return
+	}
+	out = uint32(readBitsBytes(b.buf, off, 4, b.order, false))
+	return
+}
+
+// ReadU32BEBitsNext reads a uint32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU32BEBitsNext() (out uint32) {
+	out = b.ReadU32BEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadU40LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU40LE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU40LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU40LE", off, n*5, b.cap))
+		return
+	}
+	if !b.readable(off, n, 5) {
+		b.fail(BufferOverreadError.at("ReadU40LE", off, n*5, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(i*5)]) | uint64(b.buf[off+(1+(i*5))])<<8 | uint64(b.buf[off+(2+(i*5))])<<16 | uint64(b.buf[off+(3+(i*5))])<<24 | uint64(b.buf[off+(4+(i*5))])<<32
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU40LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU40LENext(n int64) (out []uint64) {
+	out = b.ReadU40LE(b.cursor("ReadU40LENext"), n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadU40LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU40LEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU40LEAt", off, 5, b.cap))
+		return
+	}
+	if 5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU40LEAt", off, 5, b.cap))
+		return
+	}
+	out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32
+	return
+}
+
+// ReadU40LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU40LEAtNext() (out uint64) {
+	out = b.ReadU40LEAt(b.cursor("ReadU40LEAtNext"))
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadU40LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU40LEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU40LEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	if int64(len(dst))*5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU40LEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32
+	}
+}
+
+// ReadU40LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU40LEIntoNext(dst []uint64) {
+	b.ReadU40LEInto(dst, b.cursor("ReadU40LEIntoNext"))
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadU40LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU40LEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU40LEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU40LEBits", off, 40, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 5, b.order, true))
+	return
+}
+
+// ReadU40LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU40LEBitsNext() (out uint64) {
+	out = b.ReadU40LEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
+
+// ReadU40BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU40BE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU40BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU40BE", off, n*5, b.cap))
+		return
+	}
+	if !b.readable(off, n, 5) {
+		b.fail(BufferOverreadError.at("ReadU40BE", off, n*5, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(4+(i*5))]) | uint64(b.buf[off+(3+(i*5))])<<8 | uint64(b.buf[off+(2+(i*5))])<<16 | uint64(b.buf[off+(1+(i*5))])<<24 | uint64(b.buf[off+(i*5)])<<32
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU40BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU40BENext(n int64) (out []uint64) {
+	out = b.ReadU40BE(b.cursor("ReadU40BENext"), n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadU40BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU40BEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU40BEAt", off, 5, b.cap))
+		return
+	}
+	if 5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU40BEAt", off, 5, b.cap))
+		return
+	}
+	out = uint64(b.buf[off])<<32 | uint64(b.buf[off+1])<<24 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<8 | uint64(b.buf[off+4])
+	return
+}
+
+// ReadU40BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU40BEAtNext() (out uint64) {
+	out = b.ReadU40BEAt(b.cursor("ReadU40BEAtNext"))
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadU40BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU40BEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU40BEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	if int64(len(dst))*5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU40BEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = uint64(b.buf[o])<<32 | uint64(b.buf[o+1])<<24 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<8 | uint64(b.buf[o+4])
+	}
+}
+
+// ReadU40BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU40BEIntoNext(dst []uint64) {
+	b.ReadU40BEInto(dst, b.cursor("ReadU40BEIntoNext"))
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadU40BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU40BEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU40BEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU40BEBits", off, 40, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 5, b.order, false))
+	return
+}
+
+// ReadU40BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU40BEBitsNext() (out uint64) {
+	out = b.ReadU40BEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
+
+// ReadU48LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU48LE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU48LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU48LE", off, n*6, b.cap))
+		return
+	}
+	if !b.readable(off, n, 6) {
+		b.fail(BufferOverreadError.at("ReadU48LE", off, n*6, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(i*6)]) | uint64(b.buf[off+(1+(i*6))])<<8 | uint64(b.buf[off+(2+(i*6))])<<16 | uint64(b.buf[off+(3+(i*6))])<<24 | uint64(b.buf[off+(4+(i*6))])<<32 | uint64(b.buf[off+(5+(i*6))])<<40
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU48LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU48LENext(n int64) (out []uint64) {
+	out = b.ReadU48LE(b.cursor("ReadU48LENext"), n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadU48LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU48LEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU48LEAt", off, 6, b.cap))
+		return
+	}
+	if 6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU48LEAt", off, 6, b.cap))
+		return
+	}
+	out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40
+	return
+}
+
+// ReadU48LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU48LEAtNext() (out uint64) {
+	out = b.ReadU48LEAt(b.cursor("ReadU48LEAtNext"))
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadU48LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU48LEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU48LEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	if int64(len(dst))*6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU48LEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40
+	}
+}
+
+// ReadU48LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU48LEIntoNext(dst []uint64) {
+	b.ReadU48LEInto(dst, b.cursor("ReadU48LEIntoNext"))
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadU48LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU48LEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU48LEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU48LEBits", off, 48, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 6, b.order, true))
+	return
+}
+
+// ReadU48LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU48LEBitsNext() (out uint64) {
+	out = b.ReadU48LEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadU48BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU48BE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU48BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU48BE", off, n*6, b.cap))
+		return
+	}
+	if !b.readable(off, n, 6) {
+		b.fail(BufferOverreadError.at("ReadU48BE", off, n*6, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(5+(i*6))]) | uint64(b.buf[off+(4+(i*6))])<<8 | uint64(b.buf[off+(3+(i*6))])<<16 | uint64(b.buf[off+(2+(i*6))])<<24 | uint64(b.buf[off+(1+(i*6))])<<32 | uint64(b.buf[off+(i*6)])<<40
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU48BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU48BENext(n int64) (out []uint64) {
+	out = b.ReadU48BE(b.cursor("ReadU48BENext"), n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadU48BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU48BEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU48BEAt", off, 6, b.cap))
+		return
+	}
+	if 6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU48BEAt", off, 6, b.cap))
+		return
+	}
+	out = uint64(b.buf[off])<<40 | uint64(b.buf[off+1])<<32 | uint64(b.buf[off+2])<<24 | uint64(b.buf[off+3])<<16 | uint64(b.buf[off+4])<<8 | uint64(b.buf[off+5])
+	return
+}
+
+// ReadU48BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU48BEAtNext() (out uint64) {
+	out = b.ReadU48BEAt(b.cursor("ReadU48BEAtNext"))
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadU48BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU48BEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU48BEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	if int64(len(dst))*6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU48BEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = uint64(b.buf[o])<<40 | uint64(b.buf[o+1])<<32 | uint64(b.buf[o+2])<<24 | uint64(b.buf[o+3])<<16 | uint64(b.buf[o+4])<<8 | uint64(b.buf[o+5])
+	}
+}
+
+// ReadU48BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU48BEIntoNext(dst []uint64) {
+	b.ReadU48BEInto(dst, b.cursor("ReadU48BEIntoNext"))
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadU48BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU48BEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU48BEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU48BEBits", off, 48, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 6, b.order, false))
+	return
+}
+
+// ReadU48BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU48BEBitsNext() (out uint64) {
+	out = b.ReadU48BEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadU56LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU56LE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU56LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU56LE", off, n*7, b.cap))
+		return
+	}
+	if !b.readable(off, n, 7) {
+		b.fail(BufferOverreadError.at("ReadU56LE", off, n*7, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(i*7)]) | uint64(b.buf[off+(1+(i*7))])<<8 | uint64(b.buf[off+(2+(i*7))])<<16 | uint64(b.buf[off+(3+(i*7))])<<24 | uint64(b.buf[off+(4+(i*7))])<<32 | uint64(b.buf[off+(5+(i*7))])<<40 | uint64(b.buf[off+(6+(i*7))])<<48
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU56LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU56LENext(n int64) (out []uint64) {
+	out = b.ReadU56LE(b.cursor("ReadU56LENext"), n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadU56LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU56LEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU56LEAt", off, 7, b.cap))
+		return
+	}
+	if 7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU56LEAt", off, 7, b.cap))
+		return
+	}
+	out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40 | uint64(b.buf[off+6])<<48
+	return
+}
+
+// ReadU56LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU56LEAtNext() (out uint64) {
+	out = b.ReadU56LEAt(b.cursor("ReadU56LEAtNext"))
+	b.SeekByte(7, true)
+	return
+}
+
+// ReadU56LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU56LEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU56LEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	if int64(len(dst))*7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU56LEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40 | uint64(b.buf[o+6])<<48
+	}
+}
+
+// ReadU56LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU56LEIntoNext(dst []uint64) {
+	b.ReadU56LEInto(dst, b.cursor("ReadU56LEIntoNext"))
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadU56LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU56LEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU56LEBits", off, 56, b.bcap))
+		return
+	}
+	if 56 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU56LEBits", off, 56, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 7, b.order, true))
+	return
+}
+
+// ReadU56LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU56LEBitsNext() (out uint64) {
+	out = b.ReadU56LEBits(b.boff)
+	b.SeekBit(56, true)
+	return
+}
+
+// ReadU56BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU56BE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU56BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU56BE", off, n*7, b.cap))
+		return
+	}
+	if !b.readable(off, n, 7) {
+		b.fail(BufferOverreadError.at("ReadU56BE", off, n*7, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(6+(i*7))]) | uint64(b.buf[off+(5+(i*7))])<<8 | uint64(b.buf[off+(4+(i*7))])<<16 | uint64(b.buf[off+(3+(i*7))])<<24 | uint64(b.buf[off+(2+(i*7))])<<32 | uint64(b.buf[off+(1+(i*7))])<<40 | uint64(b.buf[off+(i*7)])<<48
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU56BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU56BENext(n int64) (out []uint64) {
+	out = b.ReadU56BE(b.cursor("ReadU56BENext"), n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadU56BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU56BEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU56BEAt", off, 7, b.cap))
+		return
+	}
+	if 7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU56BEAt", off, 7, b.cap))
+		return
+	}
+	out = uint64(b.buf[off])<<48 | uint64(b.buf[off+1])<<40 | uint64(b.buf[off+2])<<32 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<16 | uint64(b.buf[off+5])<<8 | uint64(b.buf[off+6])
+	return
+}
+
+// ReadU56BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU56BEAtNext() (out uint64) {
+	out = b.ReadU56BEAt(b.cursor("ReadU56BEAtNext"))
+	b.SeekByte(7, true)
+	return
+}
+
+// ReadU56BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU56BEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU56BEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	if int64(len(dst))*7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU56BEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = uint64(b.buf[o])<<48 | uint64(b.buf[o+1])<<40 | uint64(b.buf[o+2])<<32 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<16 | uint64(b.buf[o+5])<<8 | uint64(b.buf[o+6])
+	}
+}
+
+// ReadU56BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU56BEIntoNext(dst []uint64) {
+	b.ReadU56BEInto(dst, b.cursor("ReadU56BEIntoNext"))
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadU56BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU56BEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU56BEBits", off, 56, b.bcap))
+		return
+	}
+	if 56 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU56BEBits", off, 56, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 7, b.order, false))
+	return
+}
+
+// ReadU56BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU56BEBitsNext() (out uint64) {
+	out = b.ReadU56BEBits(b.boff)
+	b.SeekBit(56, true)
+	return
+}
+
+// ReadU64LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU64LE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU64LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU64LE", off, n*8, b.cap))
+		return
+	}
+	if !b.readable(off, n, 8) {
+		b.fail(BufferOverreadError.at("ReadU64LE", off, n*8, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(i*8)]) | uint64(b.buf[off+(1+(i*8))])<<8 | uint64(b.buf[off+(2+(i*8))])<<16 | uint64(b.buf[off+(3+(i*8))])<<24 | uint64(b.buf[off+(4+(i*8))])<<32 | uint64(b.buf[off+(5+(i*8))])<<40 | uint64(b.buf[off+(6+(i*8))])<<48 | uint64(b.buf[off+(7+(i*8))])<<56
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU64LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU64LENext(n int64) (out []uint64) {
+	out = b.ReadU64LE(b.cursor("ReadU64LENext"), n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadU64LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadU64LEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU64LEAt", off, 8, b.cap))
+		return
+	}
+	if 8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU64LEAt", off, 8, b.cap))
+		return
+	}
+	out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40 | uint64(b.buf[off+6])<<48 | uint64(b.buf[off+7])<<56
+	return
+}
+
+// ReadU64LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU64LEAtNext() (out uint64) {
+	out = b.ReadU64LEAt(b.cursor("ReadU64LEAtNext"))
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadU64LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU64LEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU64LEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	if int64(len(dst))*8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU64LEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40 | uint64(b.buf[o+6])<<48 | uint64(b.buf[o+7])<<56
+	}
+}
+
+// ReadU64LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU64LEIntoNext(dst []uint64) {
+	b.ReadU64LEInto(dst, b.cursor("ReadU64LEIntoNext"))
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadU64LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU64LEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU64LEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU64LEBits", off, 64, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 8, b.order, true))
+	return
+}
+
+// ReadU64LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU64LEBitsNext() (out uint64) {
+	out = b.ReadU64LEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadU64BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadU64BE(off, n int64) (out []uint64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadU64BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU64BE", off, n*8, b.cap))
+		return
+	}
+	if !b.readable(off, n, 8) {
+		b.fail(BufferOverreadError.at("ReadU64BE", off, n*8, b.cap))
+		return
+	}
+	out = make([]uint64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = uint64(b.buf[off+(7+(i*8))]) | uint64(b.buf[off+(6+(i*8))])<<8 | uint64(b.buf[off+(5+(i*8))])<<16 | uint64(b.buf[off+(4+(i*8))])<<24 | uint64(b.buf[off+(3+(i*8))])<<32 | uint64(b.buf[off+(2+(i*8))])<<40 | uint64(b.buf[off+(1+(i*8))])<<48 | uint64(b.buf[off+(i*8)])<<56
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadU64BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadU64BENext(n int64) (out []uint64) {
+	out = b.ReadU64BE(b.cursor("ReadU64BENext"), n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadU64BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadU64BEAt(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU64BEAt", off, 8, b.cap))
+		return
+	}
+	if 8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU64BEAt", off, 8, b.cap))
+		return
+	}
+	out = uint64(b.buf[off])<<56 | uint64(b.buf[off+1])<<48 | uint64(b.buf[off+2])<<40 | uint64(b.buf[off+3])<<32 | uint64(b.buf[off+4])<<24 | uint64(b.buf[off+5])<<16 | uint64(b.buf[off+6])<<8 | uint64(b.buf[off+7])
+	return
+}
+
+// ReadU64BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU64BEAtNext() (out uint64) {
+	out = b.ReadU64BEAt(b.cursor("ReadU64BEAtNext"))
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadU64BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadU64BEInto(dst []uint64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadU64BEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	if int64(len(dst))*8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadU64BEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = uint64(b.buf[o])<<56 | uint64(b.buf[o+1])<<48 | uint64(b.buf[o+2])<<40 | uint64(b.buf[o+3])<<32 | uint64(b.buf[o+4])<<24 | uint64(b.buf[o+5])<<16 | uint64(b.buf[o+6])<<8 | uint64(b.buf[o+7])
+	}
+}
+
+// ReadU64BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadU64BEIntoNext(dst []uint64) {
+	b.ReadU64BEInto(dst, b.cursor("ReadU64BEIntoNext"))
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadU64BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadU64BEBits(off int64) (out uint64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadU64BEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadU64BEBits", off, 64, b.bcap))
+		return
+	}
+	out = uint64(readBitsBytes(b.buf, off, 8, b.order, false))
+	return
+}
+
+// ReadU64BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadU64BEBitsNext() (out uint64) {
+	out = b.ReadU64BEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadI16LE reads a slice of int16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI16LE(off, n int64) (out []int16) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI16LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI16LE", off, n*2, b.cap))
+		return
+	}
+	if !b.readable(off, n, 2) {
+		b.fail(BufferOverreadError.at("ReadI16LE", off, n*2, b.cap))
+		return
+	}
+	out = make([]int16, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int16(b.buf[off+(i*2)]) | int16(b.buf[off+(1+(i*2))])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI16LENext reads a slice of int16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI16LENext(n int64) (out []int16) {
+	out = b.ReadI16LE(b.cursor("ReadI16LENext"), n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadI16LEAt reads an int16 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI16LEAt(off int64) (out int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI16LEAt", off, 2, b.cap))
+		return
+	}
+	if 2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI16LEAt", off, 2, b.cap))
+		return
+	}
+	out = int16(b.buf[off]) | int16(b.buf[off+1])<<8
+	return
+}
+
+// ReadI16LEAtNext reads an int16 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI16LEAtNext() (out int16) {
+	out = b.ReadI16LEAt(b.cursor("ReadI16LEAtNext"))
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadI16LEInto reads len(dst) int16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI16LEInto(dst []int16, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI16LEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	if int64(len(dst))*2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI16LEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = int16(b.buf[o]) | int16(b.buf[o+1])<<8
+	}
+}
+
+// ReadI16LEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI16LEIntoNext(dst []int16) {
+	b.ReadI16LEInto(dst, b.cursor("ReadI16LEIntoNext"))
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadI16LEBits reads an int16 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI16LEBits(off int64) (out int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI16LEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI16LEBits", off, 16, b.bcap))
+		return
+	}
+	out = int16(readBitsBytes(b.buf, off, 2, b.order, true))
+	return
+}
+
+// ReadI16LEBitsNext reads an int16 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI16LEBitsNext() (out int16) {
+	out = b.ReadI16LEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadI16BE reads a slice of int16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI16BE(off, n int64) (out []int16) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI16BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI16BE", off, n*2, b.cap))
+		return
+	}
+	if !b.readable(off, n, 2) {
+		b.fail(BufferOverreadError.at("ReadI16BE", off, n*2, b.cap))
+		return
+	}
+	out = make([]int16, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int16(b.buf[off+(1+(i*2))]) | int16(b.buf[off+(i*2)])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI16BENext reads a slice of int16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI16BENext(n int64) (out []int16) {
+	out = b.ReadI16BE(b.cursor("ReadI16BENext"), n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadI16BEAt reads an int16 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI16BEAt(off int64) (out int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI16BEAt", off, 2, b.cap))
+		return
+	}
+	if 2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI16BEAt", off, 2, b.cap))
+		return
+	}
+	out = int16(b.buf[off])<<8 | int16(b.buf[off+1])
+	return
+}
+
+// ReadI16BEAtNext reads an int16 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI16BEAtNext() (out int16) {
+	out = b.ReadI16BEAt(b.cursor("ReadI16BEAtNext"))
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadI16BEInto reads len(dst) int16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI16BEInto(dst []int16, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI16BEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	if int64(len(dst))*2 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI16BEInto", off, int64(len(dst))*2, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = int16(b.buf[o])<<8 | int16(b.buf[o+1])
+	}
+}
+
+// ReadI16BEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI16BEIntoNext(dst []int16) {
+	b.ReadI16BEInto(dst, b.cursor("ReadI16BEIntoNext"))
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadI16BEBits reads an int16 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI16BEBits(off int64) (out int16) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI16BEBits", off, 16, b.bcap))
+		return
+	}
+	if 16 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI16BEBits", off, 16, b.bcap))
+		return
+	}
+	out = int16(readBitsBytes(b.buf, off, 2, b.order, false))
+	return
+}
+
+// ReadI16BEBitsNext reads an int16 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI16BEBitsNext() (out int16) {
+	out = b.ReadI16BEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadI24LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI24LE(off, n int64) (out []int32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI24LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI24LE", off, n*3, b.cap))
+		return
+	}
+	if !b.readable(off, n, 3) {
+		b.fail(BufferOverreadError.at("ReadI24LE", off, n*3, b.cap))
+		return
+	}
+	out = make([]int32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int32(b.buf[off+(i*3)]) | int32(b.buf[off+(1+(i*3))])<<8 | int32(b.buf[off+(2+(i*3))])<<16
+		out[i] = out[i] << 8 >> 8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI24LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI24LENext(n int64) (out []int32) {
+	out = b.ReadI24LE(b.cursor("ReadI24LENext"), n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadI24LEAt reads an int32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI24LEAt(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI24LEAt", off, 3, b.cap))
+		return
+	}
+	if 3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI24LEAt", off, 3, b.cap))
+		return
+	}
+	out = int32(b.buf[off]) | int32(b.buf[off+1])<<8 | int32(b.buf[off+2])<<16
+	out = out << 8 >> 8
+	return
+}
+
+// ReadI24LEAtNext reads an int32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI24LEAtNext() (out int32) {
+	out = b.ReadI24LEAt(b.cursor("ReadI24LEAtNext"))
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadI24LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI24LEInto(dst []int32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI24LEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	if int64(len(dst))*3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI24LEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = int32(b.buf[o]) | int32(b.buf[o+1])<<8 | int32(b.buf[o+2])<<16
+		dst[i] = dst[i] << 8 >> 8
+	}
+}
+
+// ReadI24LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI24LEIntoNext(dst []int32) {
+	b.ReadI24LEInto(dst, b.cursor("ReadI24LEIntoNext"))
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadI24LEBits reads an int32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI24LEBits(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI24LEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI24LEBits", off, 24, b.bcap))
+		return
+	}
+	out = int32(readBitsBytes(b.buf, off, 3, b.order, true))
+	out = out << 8 >> 8
+	return
+}
+
+// ReadI24LEBitsNext reads an int32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI24LEBitsNext() (out int32) {
+	out = b.ReadI24LEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadI24BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI24BE(off, n int64) (out []int32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI24BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI24BE", off, n*3, b.cap))
+		return
+	}
+	if !b.readable(off, n, 3) {
+		b.fail(BufferOverreadError.at("ReadI24BE", off, n*3, b.cap))
+		return
+	}
+	out = make([]int32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int32(b.buf[off+(2+(i*3))]) | int32(b.buf[off+(1+(i*3))])<<8 | int32(b.buf[off+(i*3)])<<16
+		out[i] = out[i] << 8 >> 8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI24BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI24BENext(n int64) (out []int32) {
+	out = b.ReadI24BE(b.cursor("ReadI24BENext"), n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadI24BEAt reads an int32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI24BEAt(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI24BEAt", off, 3, b.cap))
+		return
+	}
+	if 3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI24BEAt", off, 3, b.cap))
+		return
+	}
+	out = int32(b.buf[off])<<16 | int32(b.buf[off+1])<<8 | int32(b.buf[off+2])
+	out = out << 8 >> 8
+	return
+}
+
+// ReadI24BEAtNext reads an int32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI24BEAtNext() (out int32) {
+	out = b.ReadI24BEAt(b.cursor("ReadI24BEAtNext"))
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadI24BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI24BEInto(dst []int32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI24BEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	if int64(len(dst))*3 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI24BEInto", off, int64(len(dst))*3, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = int32(b.buf[o])<<16 | int32(b.buf[o+1])<<8 | int32(b.buf[o+2])
+		dst[i] = dst[i] << 8 >> 8
+	}
+}
+
+// ReadI24BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI24BEIntoNext(dst []int32) {
+	b.ReadI24BEInto(dst, b.cursor("ReadI24BEIntoNext"))
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadI24BEBits reads an int32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI24BEBits(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI24BEBits", off, 24, b.bcap))
+		return
+	}
+	if 24 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI24BEBits", off, 24, b.bcap))
+		return
+	}
+	out = int32(readBitsBytes(b.buf, off, 3, b.order, false))
+	out = out << 8 >> 8
+	return
+}
+
+// ReadI24BEBitsNext reads an int32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI24BEBitsNext() (out int32) {
+	out = b.ReadI24BEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadI32LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI32LE(off, n int64) (out []int32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI32LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI32LE", off, n*4, b.cap))
+		return
+	}
+	if !b.readable(off, n, 4) {
+		b.fail(BufferOverreadError.at("ReadI32LE", off, n*4, b.cap))
+		return
+	}
+	out = make([]int32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int32(b.buf[off+(i*4)]) | int32(b.buf[off+(1+(i*4))])<<8 | int32(b.buf[off+(2+(i*4))])<<16 | int32(b.buf[off+(3+(i*4))])<<24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI32LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI32LENext(n int64) (out []int32) {
+	out = b.ReadI32LE(b.cursor("ReadI32LENext"), n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadI32LEAt reads an int32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI32LEAt(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI32LEAt", off, 4, b.cap))
+		return
+	}
+	if 4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI32LEAt", off, 4, b.cap))
+		return
+	}
+	out = int32(b.buf[off]) | int32(b.buf[off+1])<<8 | int32(b.buf[off+2])<<16 | int32(b.buf[off+3])<<24
+	return
+}
+
+// ReadI32LEAtNext reads an int32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI32LEAtNext() (out int32) {
+	out = b.ReadI32LEAt(b.cursor("ReadI32LEAtNext"))
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadI32LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI32LEInto(dst []int32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI32LEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	if int64(len(dst))*4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI32LEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = int32(b.buf[o]) | int32(b.buf[o+1])<<8 | int32(b.buf[o+2])<<16 | int32(b.buf[o+3])<<24
+	}
+}
+
+// ReadI32LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI32LEIntoNext(dst []int32) {
+	b.ReadI32LEInto(dst, b.cursor("ReadI32LEIntoNext"))
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadI32LEBits reads an int32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI32LEBits(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI32LEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI32LEBits", off, 32, b.bcap))
+		return
+	}
+	out = int32(readBitsBytes(b.buf, off, 4, b.order, true))
+	return
+}
+
+// ReadI32LEBitsNext reads an int32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI32LEBitsNext() (out int32) {
+	out = b.ReadI32LEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadI32BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI32BE(off, n int64) (out []int32) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI32BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI32BE", off, n*4, b.cap))
+		return
+	}
+	if !b.readable(off, n, 4) {
+		b.fail(BufferOverreadError.at("ReadI32BE", off, n*4, b.cap))
+		return
+	}
+	out = make([]int32, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int32(b.buf[off+(3+(i*4))]) | int32(b.buf[off+(2+(i*4))])<<8 | int32(b.buf[off+(1+(i*4))])<<16 | int32(b.buf[off+(i*4)])<<24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI32BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI32BENext(n int64) (out []int32) {
+	out = b.ReadI32BE(b.cursor("ReadI32BENext"), n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadI32BEAt reads an int32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI32BEAt(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI32BEAt", off, 4, b.cap))
+		return
+	}
+	if 4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI32BEAt", off, 4, b.cap))
+		return
+	}
+	out = int32(b.buf[off])<<24 | int32(b.buf[off+1])<<16 | int32(b.buf[off+2])<<8 | int32(b.buf[off+3])
+	return
+}
+
+// ReadI32BEAtNext reads an int32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI32BEAtNext() (out int32) {
+	out = b.ReadI32BEAt(b.cursor("ReadI32BEAtNext"))
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadI32BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI32BEInto(dst []int32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI32BEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	if int64(len(dst))*4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI32BEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = int32(b.buf[o])<<24 | int32(b.buf[o+1])<<16 | int32(b.buf[o+2])<<8 | int32(b.buf[o+3])
+	}
+}
+
+// ReadI32BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI32BEIntoNext(dst []int32) {
+	b.ReadI32BEInto(dst, b.cursor("ReadI32BEIntoNext"))
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadI32BEBits reads an int32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI32BEBits(off int64) (out int32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI32BEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI32BEBits", off, 32, b.bcap))
+		return
+	}
+	out = int32(readBitsBytes(b.buf, off, 4, b.order, false))
+	return
+}
+
+// ReadI32BEBitsNext reads an int32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI32BEBitsNext() (out int32) {
+	out = b.ReadI32BEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadI40LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI40LE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI40LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI40LE", off, n*5, b.cap))
+		return
+	}
+	if !b.readable(off, n, 5) {
+		b.fail(BufferOverreadError.at("ReadI40LE", off, n*5, b.cap))
+		return
+	}
+	out = make([]int64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int64(b.buf[off+(i*5)]) | int64(b.buf[off+(1+(i*5))])<<8 | int64(b.buf[off+(2+(i*5))])<<16 | int64(b.buf[off+(3+(i*5))])<<24 | int64(b.buf[off+(4+(i*5))])<<32
+		out[i] = out[i] << 24 >> 24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI40LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI40LENext(n int64) (out []int64) {
+	out = b.ReadI40LE(b.cursor("ReadI40LENext"), n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadI40LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI40LEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI40LEAt", off, 5, b.cap))
+		return
+	}
+	if 5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI40LEAt", off, 5, b.cap))
+		return
+	}
+	out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32
+	out = out << 24 >> 24
+	return
+}
+
+// ReadI40LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI40LEAtNext() (out int64) {
+	out = b.ReadI40LEAt(b.cursor("ReadI40LEAtNext"))
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadI40LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI40LEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI40LEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	if int64(len(dst))*5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI40LEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32
+		dst[i] = dst[i] << 24 >> 24
+	}
+}
+
+// ReadI40LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI40LEIntoNext(dst []int64) {
+	b.ReadI40LEInto(dst, b.cursor("ReadI40LEIntoNext"))
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadI40LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI40LEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI40LEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI40LEBits", off, 40, b.bcap))
+		return
+	}
+	out = int64(readBitsBytes(b.buf, off, 5, b.order, true))
+	out = out << 24 >> 24
+	return
+}
+
+// ReadI40LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI40LEBitsNext() (out int64) {
+	out = b.ReadI40LEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
 
-	if (off + n) > b.cap {
+// ReadI40BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI40BE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI40BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI40BE", off, n*5, b.cap))
+		return
+	}
+	if !b.readable(off, n, 5) {
+		b.fail(BufferOverreadError.at("ReadI40BE", off, n*5, b.cap))
+		return
+	}
+	out = make([]int64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int64(b.buf[off+(4+(i*5))]) | int64(b.buf[off+(3+(i*5))])<<8 | int64(b.buf[off+(2+(i*5))])<<16 | int64(b.buf[off+(1+(i*5))])<<24 | int64(b.buf[off+(i*5)])<<32
+		out[i] = out[i] << 24 >> 24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
 
-		panic(BufferOverreadError)
+// ReadI40BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI40BENext(n int64) (out []int64) {
+	out = b.ReadI40BE(b.cursor("ReadI40BENext"), n)
+	b.SeekByte(n*5, true)
+	return
+}
 
+// ReadI40BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI40BEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI40BEAt", off, 5, b.cap))
+		return
+	}
+	if 5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI40BEAt", off, 5, b.cap))
+		return
 	}
+	out = int64(b.buf[off])<<32 | int64(b.buf[off+1])<<24 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<8 | int64(b.buf[off+4])
+	out = out << 24 >> 24
+	return
+}
 
-	if off < 0x00 {
+// ReadI40BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI40BEAtNext() (out int64) {
+	out = b.ReadI40BEAt(b.cursor("ReadI40BEAtNext"))
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadI40BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI40BEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI40BEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	if int64(len(dst))*5 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI40BEInto", off, int64(len(dst))*5, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = int64(b.buf[o])<<32 | int64(b.buf[o+1])<<24 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<8 | int64(b.buf[o+4])
+		dst[i] = dst[i] << 24 >> 24
+	}
+}
 
-		panic(BufferUnderreadError)
+// ReadI40BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI40BEIntoNext(dst []int64) {
+	b.ReadI40BEInto(dst, b.cursor("ReadI40BEIntoNext"))
+	b.SeekByte(int64(len(dst))*5, true)
+}
 
+// ReadI40BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI40BEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI40BEBits", off, 40, b.bcap))
+		return
+	}
+	if 40 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI40BEBits", off, 40, b.bcap))
+		return
 	}
+	out = int64(readBitsBytes(b.buf, off, 5, b.order, false))
+	out = out << 24 >> 24
+	return
+}
+
+// ReadI40BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI40BEBitsNext() (out int64) {
+	out = b.ReadI40BEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
 
-	return b.buf[off : off+n]
+// ReadI48LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI48LE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI48LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI48LE", off, n*6, b.cap))
+		return
+	}
+	if !b.readable(off, n, 6) {
+		b.fail(BufferOverreadError.at("ReadI48LE", off, n*6, b.cap))
+		return
+	}
+	out = make([]int64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int64(b.buf[off+(i*6)]) | int64(b.buf[off+(1+(i*6))])<<8 | int64(b.buf[off+(2+(i*6))])<<16 | int64(b.buf[off+(3+(i*6))])<<24 | int64(b.buf[off+(4+(i*6))])<<32 | int64(b.buf[off+(5+(i*6))])<<40
+		out[i] = out[i] << 16 >> 16
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
 
+// ReadI48LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI48LENext(n int64) (out []int64) {
+	out = b.ReadI48LE(b.cursor("ReadI48LENext"), n)
+	b.SeekByte(n*6, true)
+	return
 }
 
-// ReadBytesNext returns the next n bytes from the current offset
-// and moves the offset forward the amount of bytes read
-func (b *Buffer) ReadBytesNext(n int64) (out []byte) {
+// ReadI48LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI48LEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI48LEAt", off, 6, b.cap))
+		return
+	}
+	if 6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI48LEAt", off, 6, b.cap))
+		return
+	}
+	out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32 | int64(b.buf[off+5])<<40
+	out = out << 16 >> 16
+	return
+}
 
-	out = b.ReadBytes(b.off, n)
-	b.SeekByte(n, true)
+// ReadI48LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI48LEAtNext() (out int64) {
+	out = b.ReadI48LEAt(b.cursor("ReadI48LEAtNext"))
+	b.SeekByte(6, true)
 	return
+}
 
+// ReadI48LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI48LEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI48LEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	if int64(len(dst))*6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI48LEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32 | int64(b.buf[o+5])<<40
+		dst[i] = dst[i] << 16 >> 16
+	}
 }
 
-// ReadByte returns the next byte from the specified offset without
-// modifying the internal offset value
-func (b *Buffer) ReadByte(off int64) byte {
+// ReadI48LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI48LEIntoNext(dst []int64) {
+	b.ReadI48LEInto(dst, b.cursor("ReadI48LEIntoNext"))
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadI48LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI48LEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI48LEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI48LEBits", off, 48, b.bcap))
+		return
+	}
+	out = int64(readBitsBytes(b.buf, off, 6, b.order, true))
+	out = out << 16 >> 16
+	return
+}
+
+// ReadI48LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI48LEBitsNext() (out int64) {
+	out = b.ReadI48LEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadI48BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI48BE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI48BE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI48BE", off, n*6, b.cap))
+		return
+	}
+	if !b.readable(off, n, 6) {
+		b.fail(BufferOverreadError.at("ReadI48BE", off, n*6, b.cap))
+		return
+	}
+	out = make([]int64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int64(b.buf[off+(5+(i*6))]) | int64(b.buf[off+(4+(i*6))])<<8 | int64(b.buf[off+(3+(i*6))])<<16 | int64(b.buf[off+(2+(i*6))])<<24 | int64(b.buf[off+(1+(i*6))])<<32 | int64(b.buf[off+(i*6)])<<40
+		out[i] = out[i] << 16 >> 16
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI48BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI48BENext(n int64) (out []int64) {
+	out = b.ReadI48BE(b.cursor("ReadI48BENext"), n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadI48BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI48BEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI48BEAt", off, 6, b.cap))
+		return
+	}
+	if 6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI48BEAt", off, 6, b.cap))
+		return
+	}
+	out = int64(b.buf[off])<<40 | int64(b.buf[off+1])<<32 | int64(b.buf[off+2])<<24 | int64(b.buf[off+3])<<16 | int64(b.buf[off+4])<<8 | int64(b.buf[off+5])
+	out = out << 16 >> 16
+	return
+}
+
+// ReadI48BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI48BEAtNext() (out int64) {
+	out = b.ReadI48BEAt(b.cursor("ReadI48BEAtNext"))
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadI48BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI48BEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI48BEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	if int64(len(dst))*6 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI48BEInto", off, int64(len(dst))*6, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = int64(b.buf[o])<<40 | int64(b.buf[o+1])<<32 | int64(b.buf[o+2])<<24 | int64(b.buf[o+3])<<16 | int64(b.buf[o+4])<<8 | int64(b.buf[o+5])
+		dst[i] = dst[i] << 16 >> 16
+	}
+}
+
+// ReadI48BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI48BEIntoNext(dst []int64) {
+	b.ReadI48BEInto(dst, b.cursor("ReadI48BEIntoNext"))
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadI48BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI48BEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadI48BEBits", off, 48, b.bcap))
+		return
+	}
+	if 48 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI48BEBits", off, 48, b.bcap))
+		return
+	}
+	out = int64(readBitsBytes(b.buf, off, 6, b.order, false))
+	out = out << 16 >> 16
+	return
+}
+
+// ReadI48BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI48BEBitsNext() (out int64) {
+	out = b.ReadI48BEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadI56LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *Buffer) ReadI56LE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI56LE", n, b.cap))
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI56LE", off, n*7, b.cap))
+		return
+	}
+	if !b.readable(off, n, 7) {
+		b.fail(BufferOverreadError.at("ReadI56LE", off, n*7, b.cap))
+		return
+	}
+	out = make([]int64, n)
+	i := int64(0)
+	{
+	read_loop:
+		out[i] = int64(b.buf[off+(i*7)]) | int64(b.buf[off+(1+(i*7))])<<8 | int64(b.buf[off+(2+(i*7))])<<16 | int64(b.buf[off+(3+(i*7))])<<24 | int64(b.buf[off+(4+(i*7))])<<32 | int64(b.buf[off+(5+(i*7))])<<40 | int64(b.buf[off+(6+(i*7))])<<48
+		out[i] = out[i] << 8 >> 8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+	return
+}
+
+// ReadI56LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) ReadI56LENext(n int64) (out []int64) {
+	out = b.ReadI56LE(b.cursor("ReadI56LENext"), n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadI56LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI56LEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI56LEAt", off, 7, b.cap))
+		return
+	}
+	if 7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI56LEAt", off, 7, b.cap))
+		return
+	}
+	out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32 | int64(b.buf[off+5])<<40 | int64(b.buf[off+6])<<48
+	out = out << 8 >> 8
+	return
+}
 
-	return b.ReadBytes(off, 1)[0]
+// ReadI56LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI56LEAtNext() (out int64) {
+	out = b.ReadI56LEAt(b.cursor("ReadI56LEAtNext"))
+	b.SeekByte(7, true)
+	return
+}
 
+// ReadI56LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI56LEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI56LEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	if int64(len(dst))*7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI56LEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32 | int64(b.buf[o+5])<<40 | int64(b.buf[o+6])<<48
+		dst[i] = dst[i] << 8 >> 8
+	}
 }
 
-// ReadByteNext returns the next byte from the current offset and
-// moves the offset forward a byte
-func (b *Buffer) ReadByteNext() (out byte) {
-
-	out = b.ReadBytes(b.off, 1)[0]
-	b.SeekByte(1, true)
-	return
-
+// ReadI56LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI56LEIntoNext(dst []int64) {
+	b.ReadI56LEInto(dst, b.cursor("ReadI56LEIntoNext"))
+	b.SeekByte(int64(len(dst))*7, true)
 }
 
-// ReadU16LE reads a slice of uint16s from the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadU16LE(off, n int64) (out []uint16) {
-	if (off + n*2) > b.cap {
-		panic(BufferOverreadError)
+// ReadI56LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI56LEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.atBit("ReadI56LEBits", off, 56, b.bcap))
+		return
 	}
-	out = make([]uint16, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = uint16(b.buf[off+(i*2)]) | uint16(b.buf[off+(1+(i*2))])<<8
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 56 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI56LEBits", off, 56, b.bcap))
+		return
 	}
+	out = int64(readBitsBytes(b.buf, off, 7, b.order, true))
+	out = out << 8 >> 8
 	return
 }
 
-// ReadU16LENext reads a slice of uint16s from the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadU16LENext(n int64) (out []uint16) {
-	out = b.ReadU16LE(b.off, n)
-	b.SeekByte(n*2, true)
+// ReadI56LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI56LEBitsNext() (out int64) {
+	out = b.ReadI56LEBits(b.boff)
+	b.SeekBit(56, true)
 	return
 }
 
-// ReadU16BE reads a slice of uint16s from the buffer at the
+// ReadI56BE reads a slice of int64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *Buffer) ReadU16BE(off, n int64) (out []uint16) {
-	if (off + n*2) > b.cap {
-		panic(BufferOverreadError)
+func (b *Buffer) ReadI56BE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI56BE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI56BE", off, n*7, b.cap))
+		return
 	}
-	out = make([]uint16, n)
+	if !b.readable(off, n, 7) {
+		b.fail(BufferOverreadError.at("ReadI56BE", off, n*7, b.cap))
+		return
+	}
+	out = make([]int64, n)
 	i := int64(0)
 	{
 	read_loop:
-		out[i] = uint16(b.buf[off+(1+(i*2))]) | uint16(b.buf[off+(i*2)])<<8
+		out[i] = int64(b.buf[off+(6+(i*7))]) | int64(b.buf[off+(5+(i*7))])<<8 | int64(b.buf[off+(4+(i*7))])<<16 | int64(b.buf[off+(3+(i*7))])<<24 | int64(b.buf[off+(2+(i*7))])<<32 | int64(b.buf[off+(1+(i*7))])<<40 | int64(b.buf[off+(i*7)])<<48
+		out[i] = out[i] << 8 >> 8
 		i++
 		if i < n {
 			goto read_loop
@@ -1044,126 +6727,121 @@ func (b *Buffer) ReadU16BE(off, n int64) (out []uint16) {
 	return
 }
 
-// ReadU16BENext reads a slice of uint16s from the buffer at the
+// ReadI56BENext reads a slice of int64s from the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) ReadU16BENext(n int64) (out []uint16) {
-	out = b.ReadU16BE(b.off, n)
-	b.SeekByte(n*2, true)
+func (b *Buffer) ReadI56BENext(n int64) (out []int64) {
+	out = b.ReadI56BE(b.cursor("ReadI56BENext"), n)
+	b.SeekByte(n*7, true)
 	return
 }
 
-// ReadU32LE reads a slice of uint32s from the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadU32LE(off, n int64) (out []uint32) {
-	if (off + n*4) > b.cap {
-		panic(BufferOverreadError)
+// ReadI56BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI56BEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI56BEAt", off, 7, b.cap))
+		return
 	}
-	out = make([]uint32, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = uint32(b.buf[off+(i*4)]) | uint32(b.buf[off+(1+(i*4))])<<8 | uint32(b.buf[off+(2+(i*4))])<<16 | uint32(b.buf[off+(3+(i*4))])<<24
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI56BEAt", off, 7, b.cap))
+		return
 	}
+	out = int64(b.buf[off])<<48 | int64(b.buf[off+1])<<40 | int64(b.buf[off+2])<<32 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<16 | int64(b.buf[off+5])<<8 | int64(b.buf[off+6])
+	out = out << 8 >> 8
 	return
 }
 
-// ReadU32LENext reads a slice of uint32s from the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadU32LENext(n int64) (out []uint32) {
-	out = b.ReadU32LE(b.off, n)
-	b.SeekByte(n*4, true)
+// ReadI56BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI56BEAtNext() (out int64) {
+	out = b.ReadI56BEAt(b.cursor("ReadI56BEAtNext"))
+	b.SeekByte(7, true)
 	return
 }
 
-// ReadU32BE reads a slice of uint32s from the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadU32BE(off, n int64) (out []uint32) {
-	if (off + n*4) > b.cap {
-		panic(BufferOverreadError)
+// ReadI56BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI56BEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI56BEInto", off, int64(len(dst))*7, b.cap))
+		return
 	}
-	out = make([]uint32, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = uint32(b.buf[off+(3+(i*4))]) | uint32(b.buf[off+(2+(i*4))])<<8 | uint32(b.buf[off+(1+(i*4))])<<16 | uint32(b.buf[off+(i*4)])<<24
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if int64(len(dst))*7 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI56BEInto", off, int64(len(dst))*7, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = int64(b.buf[o])<<48 | int64(b.buf[o+1])<<40 | int64(b.buf[o+2])<<32 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<16 | int64(b.buf[o+5])<<8 | int64(b.buf[o+6])
+		dst[i] = dst[i] << 8 >> 8
 	}
-	return
 }
 
-// ReadU32BENext reads a slice of uint32s from the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadU32BENext(n int64) (out []uint32) {
-	out = b.ReadU32BE(b.off, n)
-	b.SeekByte(n*4, true)
-	return
+// ReadI56BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI56BEIntoNext(dst []int64) {
+	b.ReadI56BEInto(dst, b.cursor("ReadI56BEIntoNext"))
+	b.SeekByte(int64(len(dst))*7, true)
 }
 
-// ReadU64LE reads a slice of uint64s from the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadU64LE(off, n int64) (out []uint64) {
-	if (off + n*8) > b.cap {
-		panic(BufferOverreadError)
+// ReadI56BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI56BEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.atBit("ReadI56BEBits", off, 56, b.bcap))
+		return
 	}
-	out = make([]uint64, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = uint64(b.buf[off+(i*8)]) | uint64(b.buf[off+(1+(i*8))])<<8 | uint64(b.buf[off+(2+(i*8))])<<16 | uint64(b.buf[off+(3+(i*8))])<<24 | uint64(b.buf[off+(4+(i*8))])<<32 | uint64(b.buf[off+(5+(i*8))])<<40 | uint64(b.buf[off+(6+(i*8))])<<48 | uint64(b.buf[off+(7+(i*8))])<<56
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 56 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI56BEBits", off, 56, b.bcap))
+		return
 	}
+	out = int64(readBitsBytes(b.buf, off, 7, b.order, false))
+	out = out << 8 >> 8
 	return
 }
 
-// ReadU64LENext reads a slice of uint64s from the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadU64LENext(n int64) (out []uint64) {
-	out = b.ReadU64LE(b.off, n)
-	b.SeekByte(n*8, true)
+// ReadI56BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI56BEBitsNext() (out int64) {
+	out = b.ReadI56BEBits(b.boff)
+	b.SeekBit(56, true)
 	return
 }
 
-// ReadU64BE reads a slice of uint64s from the buffer at the
-// specified offset in big-endian without modifying the internal
+// ReadI64LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
 // offset value
-func (b *Buffer) ReadU64BE(off, n int64) (out []uint64) {
-	if (off + n*8) > b.cap {
-		panic(BufferOverreadError)
+func (b *Buffer) ReadI64LE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI64LE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI64LE", off, n*8, b.cap))
+		return
 	}
-	out = make([]uint64, n)
+	if !b.readable(off, n, 8) {
+		b.fail(BufferOverreadError.at("ReadI64LE", off, n*8, b.cap))
+		return
+	}
+	out = make([]int64, n)
 	i := int64(0)
 	{
 	read_loop:
-		out[i] = uint64(b.buf[off+(7+(i*8))]) | uint64(b.buf[off+(6+(i*8))])<<8 | uint64(b.buf[off+(5+(i*8))])<<16 | uint64(b.buf[off+(4+(i*8))])<<24 | uint64(b.buf[off+(3+(i*8))])<<32 | uint64(b.buf[off+(2+(i*8))])<<40 | uint64(b.buf[off+(1+(i*8))])<<48 | uint64(b.buf[off+(i*8)])<<56
+		out[i] = int64(b.buf[off+(i*8)]) | int64(b.buf[off+(1+(i*8))])<<8 | int64(b.buf[off+(2+(i*8))])<<16 | int64(b.buf[off+(3+(i*8))])<<24 | int64(b.buf[off+(4+(i*8))])<<32 | int64(b.buf[off+(5+(i*8))])<<40 | int64(b.buf[off+(6+(i*8))])<<48 | int64(b.buf[off+(7+(i*8))])<<56
 		i++
 		if i < n {
 			goto read_loop
@@ -1172,126 +6850,118 @@ func (b *Buffer) ReadU64BE(off, n int64) (out []uint64) {
 	return
 }
 
-// ReadU64BENext reads a slice of uint64s from the buffer at the
-// current offset in big-endian and moves the offset forward the
+// ReadI64LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) ReadU64BENext(n int64) (out []uint64) {
-	out = b.ReadU64BE(b.off, n)
+func (b *Buffer) ReadI64LENext(n int64) (out []int64) {
+	out = b.ReadI64LE(b.cursor("ReadI64LENext"), n)
 	b.SeekByte(n*8, true)
 	return
 }
 
-// ReadI16LE reads a slice of int16s from the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadI16LE(off, n int64) (out []int16) {
-	if (off + n*2) > b.cap {
-		panic(BufferOverreadError)
+// ReadI64LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadI64LEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI64LEAt", off, 8, b.cap))
+		return
 	}
-	out = make([]int16, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = int16(b.buf[off+(i*2)]) | int16(b.buf[off+(1+(i*2))])<<8
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI64LEAt", off, 8, b.cap))
+		return
 	}
+	out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32 | int64(b.buf[off+5])<<40 | int64(b.buf[off+6])<<48 | int64(b.buf[off+7])<<56
 	return
 }
 
-// ReadI16LENext reads a slice of int16s from the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadI16LENext(n int64) (out []int16) {
-	out = b.ReadI16LE(b.off, n)
-	b.SeekByte(n*2, true)
+// ReadI64LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI64LEAtNext() (out int64) {
+	out = b.ReadI64LEAt(b.cursor("ReadI64LEAtNext"))
+	b.SeekByte(8, true)
 	return
 }
 
-// ReadI16BE reads a slice of int16s from the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadI16BE(off, n int64) (out []int16) {
-	if (off + n*2) > b.cap {
-		panic(BufferOverreadError)
+// ReadI64LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI64LEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI64LEInto", off, int64(len(dst))*8, b.cap))
+		return
 	}
-	out = make([]int16, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = int16(b.buf[off+(1+(i*2))]) | int16(b.buf[off+(i*2)])<<8
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if int64(len(dst))*8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI64LEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32 | int64(b.buf[o+5])<<40 | int64(b.buf[o+6])<<48 | int64(b.buf[o+7])<<56
 	}
-	return
 }
 
-// ReadI16BENext reads a slice of int16s from the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadI16BENext(n int64) (out []int16) {
-	out = b.ReadI16BE(b.off, n)
-	b.SeekByte(n*2, true)
-	return
+// ReadI64LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI64LEIntoNext(dst []int64) {
+	b.ReadI64LEInto(dst, b.cursor("ReadI64LEIntoNext"))
+	b.SeekByte(int64(len(dst))*8, true)
 }
 
-// ReadI32LE reads a slice of int32s from the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadI32LE(off, n int64) (out []int32) {
-	if (off + n*4) > b.cap {
-		panic(BufferOverreadError)
+// ReadI64LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI64LEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.atBit("ReadI64LEBits", off, 64, b.bcap))
+		return
 	}
-	out = make([]int32, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = int32(b.buf[off+(i*4)]) | int32(b.buf[off+(1+(i*4))])<<8 | int32(b.buf[off+(2+(i*4))])<<16 | int32(b.buf[off+(3+(i*4))])<<24
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 64 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI64LEBits", off, 64, b.bcap))
+		return
 	}
+	out = int64(readBitsBytes(b.buf, off, 8, b.order, true))
 	return
 }
 
-// ReadI32LENext reads a slice of int32s from the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadI32LENext(n int64) (out []int32) {
-	out = b.ReadI32LE(b.off, n)
-	b.SeekByte(n*4, true)
+// ReadI64LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI64LEBitsNext() (out int64) {
+	out = b.ReadI64LEBits(b.boff)
+	b.SeekBit(64, true)
 	return
 }
 
-// ReadI32BE reads a slice of int32s from the buffer at the
+// ReadI64BE reads a slice of int64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *Buffer) ReadI32BE(off, n int64) (out []int32) {
-	if (off + n*4) > b.cap {
-		panic(BufferOverreadError)
+func (b *Buffer) ReadI64BE(off, n int64) (out []int64) {
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadI64BE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI64BE", off, n*8, b.cap))
+		return
 	}
-	out = make([]int32, n)
+	if !b.readable(off, n, 8) {
+		b.fail(BufferOverreadError.at("ReadI64BE", off, n*8, b.cap))
+		return
+	}
+	out = make([]int64, n)
 	i := int64(0)
 	{
 	read_loop:
-		out[i] = int32(b.buf[off+(3+(i*4))]) | int32(b.buf[off+(2+(i*4))])<<8 | int32(b.buf[off+(1+(i*4))])<<16 | int32(b.buf[off+(i*4)])<<24
+		out[i] = int64(b.buf[off+(7+(i*8))]) | int64(b.buf[off+(6+(i*8))])<<8 | int64(b.buf[off+(5+(i*8))])<<16 | int64(b.buf[off+(4+(i*8))])<<24 | int64(b.buf[off+(3+(i*8))])<<32 | int64(b.buf[off+(2+(i*8))])<<40 | int64(b.buf[off+(1+(i*8))])<<48 | int64(b.buf[off+(i*8)])<<56
 		i++
 		if i < n {
 			goto read_loop
@@ -1300,76 +6970,91 @@ func (b *Buffer) ReadI32BE(off, n int64) (out []int32) {
 	return
 }
 
-// ReadI32BENext reads a slice of int32s from the buffer at the
+// ReadI64BENext reads a slice of int64s from the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *Buffer) ReadI32BENext(n int64) (out []int32) {
-	out = b.ReadI32BE(b.off, n)
-	b.SeekByte(n*4, true)
+func (b *Buffer) ReadI64BENext(n int64) (out []int64) {
+	out = b.ReadI64BE(b.cursor("ReadI64BENext"), n)
+	b.SeekByte(n*8, true)
 	return
 }
 
-// ReadI64LE reads a slice of int64s from the buffer at the
-// specified offset in little-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadI64LE(off, n int64) (out []int64) {
-	if (off + n*8) > b.cap {
-		panic(BufferOverreadError)
+// ReadI64BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadI64BEAt(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadI64BEAt", off, 8, b.cap))
+		return
 	}
-	out = make([]int64, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = int64(b.buf[off+(i*8)]) | int64(b.buf[off+(1+(i*8))])<<8 | int64(b.buf[off+(2+(i*8))])<<16 | int64(b.buf[off+(3+(i*8))])<<24 | int64(b.buf[off+(4+(i*8))])<<32 | int64(b.buf[off+(5+(i*8))])<<40 | int64(b.buf[off+(6+(i*8))])<<48 | int64(b.buf[off+(7+(i*8))])<<56
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI64BEAt", off, 8, b.cap))
+		return
 	}
+	out = int64(b.buf[off])<<56 | int64(b.buf[off+1])<<48 | int64(b.buf[off+2])<<40 | int64(b.buf[off+3])<<32 | int64(b.buf[off+4])<<24 | int64(b.buf[off+5])<<16 | int64(b.buf[off+6])<<8 | int64(b.buf[off+7])
 	return
 }
 
-// ReadI64LENext reads a slice of int64s from the buffer at the
-// current offset in little-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadI64LENext(n int64) (out []int64) {
-	out = b.ReadI64LE(b.off, n)
-	b.SeekByte(n*8, true)
+// ReadI64BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI64BEAtNext() (out int64) {
+	out = b.ReadI64BEAt(b.cursor("ReadI64BEAtNext"))
+	b.SeekByte(8, true)
 	return
 }
 
-// ReadI64BE reads a slice of int64s from the buffer at the
-// specified offset in big-endian without modifying the internal
-// offset value
-func (b *Buffer) ReadI64BE(off, n int64) (out []int64) {
-	if (off + n*8) > b.cap {
-		panic(BufferOverreadError)
+// ReadI64BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadI64BEInto(dst []int64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadI64BEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	if int64(len(dst))*8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadI64BEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = int64(b.buf[o])<<56 | int64(b.buf[o+1])<<48 | int64(b.buf[o+2])<<40 | int64(b.buf[o+3])<<32 | int64(b.buf[o+4])<<24 | int64(b.buf[o+5])<<16 | int64(b.buf[o+6])<<8 | int64(b.buf[o+7])
+	}
+}
+
+// ReadI64BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadI64BEIntoNext(dst []int64) {
+	b.ReadI64BEInto(dst, b.cursor("ReadI64BEIntoNext"))
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadI64BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadI64BEBits(off int64) (out int64) {
+	if b.err != nil {
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.atBit("ReadI64BEBits", off, 64, b.bcap))
+		return
 	}
-	out = make([]int64, n)
-	i := int64(0)
-	{
-	read_loop:
-		out[i] = int64(b.buf[off+(7+(i*8))]) | int64(b.buf[off+(6+(i*8))])<<8 | int64(b.buf[off+(5+(i*8))])<<16 | int64(b.buf[off+(4+(i*8))])<<24 | int64(b.buf[off+(3+(i*8))])<<32 | int64(b.buf[off+(2+(i*8))])<<40 | int64(b.buf[off+(1+(i*8))])<<48 | int64(b.buf[off+(i*8)])<<56
-		i++
-		if i < n {
-			goto read_loop
-		}
+	if 64 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadI64BEBits", off, 64, b.bcap))
+		return
 	}
+	out = int64(readBitsBytes(b.buf, off, 8, b.order, false))
 	return
 }
 
-// ReadI64BENext reads a slice of int64s from the buffer at the
-// current offset in big-endian and moves the offset forward the
-// amount of bytes written
-func (b *Buffer) ReadI64BENext(n int64) (out []int64) {
-	out = b.ReadI64BE(b.off, n)
-	b.SeekByte(n*8, true)
+// ReadI64BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadI64BEBitsNext() (out int64) {
+	out = b.ReadI64BEBits(b.boff)
+	b.SeekBit(64, true)
 	return
 }
 
@@ -1377,11 +7062,20 @@ func (b *Buffer) ReadI64BENext(n int64) (out []int64) {
 // specified offset in little-endian without modifying the internal
 // offset value
 func (b *Buffer) ReadF32LE(off, n int64) (out []float32) {
-	if (off + n*4) > b.cap {
-		panic(BufferOverreadError)
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadF32LE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadF32LE", off, n*4, b.cap))
+		return
+	}
+	if !b.readable(off, n, 4) {
+		b.fail(BufferOverreadError.at("ReadF32LE", off, n*4, b.cap))
+		return
 	}
 	out = make([]float32, n)
 	i := int64(0)
@@ -1402,20 +7096,111 @@ func (b *Buffer) ReadF32LE(off, n int64) (out []float32) {
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
 func (b *Buffer) ReadF32LENext(n int64) (out []float32) {
-	out = b.ReadF32LE(b.off, n)
+	out = b.ReadF32LE(b.cursor("ReadF32LENext"), n)
 	b.SeekByte(n*4, true)
 	return
 }
 
+// ReadF32LEAt reads a float32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadF32LEAt(off int64) (out float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF32LEAt", off, 4, b.cap))
+		return
+	}
+	if 4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF32LEAt", off, 4, b.cap))
+		return
+	}
+	u := uint32(b.buf[off]) | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])<<16 | uint32(b.buf[off+3])<<24
+	out = *(*float32)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF32LEAtNext reads a float32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF32LEAtNext() (out float32) {
+	out = b.ReadF32LEAt(b.cursor("ReadF32LEAtNext"))
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadF32LEInto reads len(dst) float32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadF32LEInto(dst []float32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF32LEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	if int64(len(dst))*4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF32LEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*4
+		u := uint32(b.buf[o]) | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])<<16 | uint32(b.buf[o+3])<<24
+		dst[i] = *(*float32)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF32LEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF32LEIntoNext(dst []float32) {
+	b.ReadF32LEInto(dst, b.cursor("ReadF32LEIntoNext"))
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadF32LEBits reads a float32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadF32LEBits(off int64) (out float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadF32LEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadF32LEBits", off, 32, b.bcap))
+		return
+	}
+	u := uint32(readBitsBytes(b.buf, off, 4, b.order, true))
+	out = *(*float32)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF32LEBitsNext reads a float32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadF32LEBitsNext() (out float32) {
+	out = b.ReadF32LEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
 // ReadF32BE reads a slice of float32s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
 func (b *Buffer) ReadF32BE(off, n int64) (out []float32) {
-	if (off + n*4) > b.cap {
-		panic(BufferOverreadError)
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadF32BE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadF32BE", off, n*4, b.cap))
+		return
+	}
+	if !b.readable(off, n, 4) {
+		b.fail(BufferOverreadError.at("ReadF32BE", off, n*4, b.cap))
+		return
 	}
 	out = make([]float32, n)
 	i := int64(0)
@@ -1436,20 +7221,111 @@ func (b *Buffer) ReadF32BE(off, n int64) (out []float32) {
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
 func (b *Buffer) ReadF32BENext(n int64) (out []float32) {
-	out = b.ReadF32BE(b.off, n)
+	out = b.ReadF32BE(b.cursor("ReadF32BENext"), n)
 	b.SeekByte(n*4, true)
 	return
 }
 
+// ReadF32BEAt reads a float32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadF32BEAt(off int64) (out float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF32BEAt", off, 4, b.cap))
+		return
+	}
+	if 4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF32BEAt", off, 4, b.cap))
+		return
+	}
+	u := uint32(b.buf[off])<<24 | uint32(b.buf[off+1])<<16 | uint32(b.buf[off+2])<<8 | uint32(b.buf[off+3])
+	out = *(*float32)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF32BEAtNext reads a float32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF32BEAtNext() (out float32) {
+	out = b.ReadF32BEAt(b.cursor("ReadF32BEAtNext"))
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadF32BEInto reads len(dst) float32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadF32BEInto(dst []float32, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF32BEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	if int64(len(dst))*4 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF32BEInto", off, int64(len(dst))*4, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*4
+		u := uint32(b.buf[o])<<24 | uint32(b.buf[o+1])<<16 | uint32(b.buf[o+2])<<8 | uint32(b.buf[o+3])
+		dst[i] = *(*float32)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF32BEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF32BEIntoNext(dst []float32) {
+	b.ReadF32BEInto(dst, b.cursor("ReadF32BEIntoNext"))
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadF32BEBits reads a float32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadF32BEBits(off int64) (out float32) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadF32BEBits", off, 32, b.bcap))
+		return
+	}
+	if 32 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadF32BEBits", off, 32, b.bcap))
+		return
+	}
+	u := uint32(readBitsBytes(b.buf, off, 4, b.order, false))
+	out = *(*float32)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF32BEBitsNext reads a float32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadF32BEBitsNext() (out float32) {
+	out = b.ReadF32BEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
 // ReadF64LE reads a slice of float64s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
 func (b *Buffer) ReadF64LE(off, n int64) (out []float64) {
-	if (off + n*8) > b.cap {
-		panic(BufferOverreadError)
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadF64LE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadF64LE", off, n*8, b.cap))
+		return
+	}
+	if !b.readable(off, n, 8) {
+		b.fail(BufferOverreadError.at("ReadF64LE", off, n*8, b.cap))
+		return
 	}
 	out = make([]float64, n)
 	i := int64(0)
@@ -1470,20 +7346,111 @@ func (b *Buffer) ReadF64LE(off, n int64) (out []float64) {
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
 func (b *Buffer) ReadF64LENext(n int64) (out []float64) {
-	out = b.ReadF64LE(b.off, n)
+	out = b.ReadF64LE(b.cursor("ReadF64LENext"), n)
 	b.SeekByte(n*8, true)
 	return
 }
 
+// ReadF64LEAt reads a float64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *Buffer) ReadF64LEAt(off int64) (out float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF64LEAt", off, 8, b.cap))
+		return
+	}
+	if 8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF64LEAt", off, 8, b.cap))
+		return
+	}
+	u := uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40 | uint64(b.buf[off+6])<<48 | uint64(b.buf[off+7])<<56
+	out = *(*float64)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF64LEAtNext reads a float64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF64LEAtNext() (out float64) {
+	out = b.ReadF64LEAt(b.cursor("ReadF64LEAtNext"))
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadF64LEInto reads len(dst) float64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadF64LEInto(dst []float64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF64LEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	if int64(len(dst))*8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF64LEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*8
+		u := uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40 | uint64(b.buf[o+6])<<48 | uint64(b.buf[o+7])<<56
+		dst[i] = *(*float64)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF64LEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF64LEIntoNext(dst []float64) {
+	b.ReadF64LEInto(dst, b.cursor("ReadF64LEIntoNext"))
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadF64LEBits reads a float64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *Buffer) ReadF64LEBits(off int64) (out float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadF64LEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadF64LEBits", off, 64, b.bcap))
+		return
+	}
+	u := uint64(readBitsBytes(b.buf, off, 8, b.order, true))
+	out = *(*float64)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF64LEBitsNext reads a float64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadF64LEBitsNext() (out float64) {
+	out = b.ReadF64LEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
 // ReadF64BE reads a slice of float64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
 func (b *Buffer) ReadF64BE(off, n int64) (out []float64) {
-	if (off + n*8) > b.cap {
-		panic(BufferOverreadError)
+	if b.err != nil {
+		return
+	}
+	if n < 0 {
+		b.fail(BufferInvalidByteCountError.count("ReadF64BE", n, b.cap))
+		return
 	}
 	if off < 0 {
-		panic(BufferUnderreadError)
+		b.fail(BufferUnderreadError.at("ReadF64BE", off, n*8, b.cap))
+		return
+	}
+	if !b.readable(off, n, 8) {
+		b.fail(BufferOverreadError.at("ReadF64BE", off, n*8, b.cap))
+		return
 	}
 	out = make([]float64, n)
 	i := int64(0)
@@ -1504,15 +7471,103 @@ func (b *Buffer) ReadF64BE(off, n int64) (out []float64) {
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
 func (b *Buffer) ReadF64BENext(n int64) (out []float64) {
-	out = b.ReadF64BE(b.off, n)
+	out = b.ReadF64BE(b.cursor("ReadF64BENext"), n)
 	b.SeekByte(n*8, true)
 	return
 }
 
+// ReadF64BEAt reads a float64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *Buffer) ReadF64BEAt(off int64) (out float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF64BEAt", off, 8, b.cap))
+		return
+	}
+	if 8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF64BEAt", off, 8, b.cap))
+		return
+	}
+	u := uint64(b.buf[off])<<56 | uint64(b.buf[off+1])<<48 | uint64(b.buf[off+2])<<40 | uint64(b.buf[off+3])<<32 | uint64(b.buf[off+4])<<24 | uint64(b.buf[off+5])<<16 | uint64(b.buf[off+6])<<8 | uint64(b.buf[off+7])
+	out = *(*float64)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF64BEAtNext reads a float64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF64BEAtNext() (out float64) {
+	out = b.ReadF64BEAt(b.cursor("ReadF64BEAtNext"))
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadF64BEInto reads len(dst) float64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *Buffer) ReadF64BEInto(dst []float64, off int64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.at("ReadF64BEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	if int64(len(dst))*8 > b.cap-off {
+		b.fail(BufferOverreadError.at("ReadF64BEInto", off, int64(len(dst))*8, b.cap))
+		return
+	}
+	for i := range dst {
+		o := off + int64(i)*8
+		u := uint64(b.buf[o])<<56 | uint64(b.buf[o+1])<<48 | uint64(b.buf[o+2])<<40 | uint64(b.buf[o+3])<<32 | uint64(b.buf[o+4])<<24 | uint64(b.buf[o+5])<<16 | uint64(b.buf[o+6])<<8 | uint64(b.buf[o+7])
+		dst[i] = *(*float64)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF64BEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadF64BEIntoNext(dst []float64) {
+	b.ReadF64BEInto(dst, b.cursor("ReadF64BEIntoNext"))
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadF64BEBits reads a float64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *Buffer) ReadF64BEBits(off int64) (out float64) {
+	if b.err != nil {
+		return
+	}
+	if off < 0 {
+		b.fail(BufferUnderreadError.atBit("ReadF64BEBits", off, 64, b.bcap))
+		return
+	}
+	if 64 > b.bcap-off {
+		b.fail(BufferOverreadError.atBit("ReadF64BEBits", off, 64, b.bcap))
+		return
+	}
+	u := uint64(readBitsBytes(b.buf, off, 8, b.order, false))
+	out = *(*float64)(unsafe.Pointer(&u))
+	return
+}
+
+// ReadF64BEBitsNext reads a float64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *Buffer) ReadF64BEBitsNext() (out float64) {
+	out = b.ReadF64BEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
 // SeekByte seeks to position off of the buffer relative to the
 // current position or exact
 func (b *Buffer) SeekByte(off int64, relative bool) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if relative {
 
 		b.off += off
@@ -1522,6 +7577,8 @@ func (b *Buffer) SeekByte(off int64, relative bool) {
 		b.off = off
 
 	}
+	b.syncBit()
+	b.follow()
 
 }
 
@@ -1538,10 +7595,19 @@ func (b *Buffer) AfterByte(off ...int64) int64 {
 
 }
 
-// AlignByte aligns the byte offset to the bit offset
+// AlignByte aligns the byte offset to the bit offset. if the cursors
+// are unified, the position is moved to the next byte boundary instead
 func (b *Buffer) AlignByte() {
 
+	if b.cmode != SeparateCursors {
+
+		b.syncBit()
+		b.follow()
+		return
+
+	}
 	b.off = b.boff / 8
+	b.follow()
 
 }
 
@@ -1550,9 +7616,16 @@ func (b *Buffer) AlignByte() {
 // TruncateLeft truncates the buffer on the left side
 func (b *Buffer) TruncateLeft(n int64) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if n < 0 {
 
-		panic(BufferInvalidByteCountError)
+		b.fail(BufferInvalidByteCountError.count("TruncateLeft", n, b.cap))
+		return
 
 	}
 
@@ -1564,9 +7637,16 @@ func (b *Buffer) TruncateLeft(n int64) {
 // TruncateRight truncates the buffer on the right side
 func (b *Buffer) TruncateRight(n int64) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if n < 0 {
 
-		panic(BufferInvalidByteCountError)
+		b.fail(BufferInvalidByteCountError.count("TruncateRight", n, b.cap))
+		return
 
 	}
 
@@ -1578,9 +7658,16 @@ func (b *Buffer) TruncateRight(n int64) {
 // Grow makes the buffer's capacity bigger by n bytes
 func (b *Buffer) Grow(n int64) {
 
+	if b.err != nil {
+
+		return
+
+	}
+
 	if n < 0 {
 
-		panic(BufferInvalidByteCountError)
+		b.fail(BufferInvalidByteCountError.count("Grow", n, b.cap))
+		return
 
 	}
 
@@ -1591,7 +7678,13 @@ func (b *Buffer) Grow(n int64) {
 		return
 
 	}
-	tmp := make([]byte, b.cap+n, (int64(cap(b.buf))+n)*2)
+	c := int64(cap(b.buf)) + n
+	if c <= math.MaxInt64/2 {
+
+		c *= 2
+
+	}
+	tmp := make([]byte, b.cap+n, c)
 	copy(tmp, b.buf)
 	b.buf = tmp
 	b.Refresh()
@@ -1606,7 +7699,58 @@ func (b *Buffer) Refresh() {
 
 }
 
-// Reset resets the entire buffer
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the buffer. it defaults to MSBFirst
+func (b *Buffer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// SetGrowth enables or disables automatic growth. while it is
+// enabled, writes past the end of the buffer grow it with Grow instead
+// of failing, as long as it would not become longer than max bytes. a
+// max of zero or less means that the buffer may grow without limit
+func (b *Buffer) SetGrowth(enabled bool, max int64) {
+
+	b.grow = enabled
+	b.gmax = max
+
+}
+
+// SetSticky enables or disables sticky mode. in sticky mode, the
+// first out-of-range operation records an error on the buffer instead
+// of panicking and every following call becomes a no-op that returns
+// zero values until the error is cleared
+func (b *Buffer) SetSticky(sticky bool) {
+
+	b.sticky = sticky
+
+}
+
+// ClearErr clears the error recorded by the buffer in sticky mode
+func (b *Buffer) ClearErr() {
+
+	b.err = nil
+
+}
+
+// Fail reports err the way the buffer reports its own errors, which is
+// by panicking with it or, in sticky mode, recording it unless an error
+// was recorded already. it is used by code generated outside of this
+// package to report errors that the buffer cannot detect by itself
+func (b *Buffer) Fail(err Error) {
+
+	if b.err == nil {
+
+		b.fail(err)
+
+	}
+
+}
+
+// Reset resets the entire buffer, releasing every mark on it and
+// abandoning every open placeholder
 func (b *Buffer) Reset() {
 
 	b.buf = b.buf[0:0]
@@ -1614,6 +7758,11 @@ func (b *Buffer) Reset() {
 	b.boff = 0x00
 	b.cap = 0
 	b.bcap = 0
+	b.err = nil
+	b.journal = journal{}
+	b.slots = nil
+	b.hstart = 0x00
+	b.hend = 0x00
 
 }
 
@@ -1653,3 +7802,10 @@ func (b *Buffer) BitOffset() int64 {
 	return b.boff
 
 }
+
+// Err returns the error recorded by the buffer in sticky mode, if any
+func (b *Buffer) Err() error {
+
+	return b.err
+
+}
@@ -12,6 +12,8 @@ file, You can obtain one at https://mozilla.org/MPL/2.0/.
 package v3
 
 import (
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -2345,6 +2347,341 @@ func TestBufferTruncateRightPanic(t *testing.T) {
 
 }
 
+func TestBufferSticky(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01, 0x02, 0x03, 0x04, 0x05})
+	buf.SetSticky(true)
+
+	out1 := buf.ReadU16BENext(1)
+	if !cmp.Equal(out1, []uint16{0x102}) {
+
+		t.Fatalf("expected uint16 array does not match the one gotten (got %#v, expected %#v)", out1, []uint16{0x102})
+
+	}
+
+	out2 := buf.ReadU32LENext(1)
+	if out2 != nil {
+
+		t.Fatalf("expected a nil slice from a failed read (got %#v)", out2)
+
+	}
+
+	if !errors.Is(buf.Err(), BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverreadError)
+
+	}
+
+	// every following call should be a no-op, even if it would succeed
+	out3 := buf.ReadByteNext()
+	if out3 != 0x00 {
+
+		t.Fatalf("expected a zero byte from a no-op read (got %d)", out3)
+
+	}
+
+	buf.WriteBytesNext([]byte{0xff})
+	buf.SetBit(0x00)
+	if buf.ByteOffset() != 2 || buf.buf[2] != 0x03 || buf.buf[0] != 0x01 {
+
+		t.Fatalf("buffer was modified after an error (offset %d, bytes %#v)", buf.ByteOffset(), buf.buf)
+
+	}
+
+	buf.ClearErr()
+	out3 = buf.ReadByteNext()
+	if out3 != 0x03 || buf.Err() != nil {
+
+		t.Fatalf("expected read to succeed after clearing the error (got %d, %v)", out3, buf.Err())
+
+	}
+
+}
+
+func TestBufferStickyBits(t *testing.T) {
+
+	buf := NewBuffer([]byte{0xff})
+	buf.SetSticky(true)
+
+	buf.SetBits(0x04, 0x00, 5)
+	if !errors.Is(buf.Err(), BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverwriteError)
+
+	}
+
+	if buf.buf[0] != 0xff {
+
+		t.Fatalf("buffer was partially written by a failed call (got %#v)", buf.buf)
+
+	}
+
+	out := buf.ReadBitsNext(1)
+	if out != 0 || buf.BitOffset() != 0 {
+
+		t.Fatalf("expected a no-op read (got %d at bit offset %d)", out, buf.BitOffset())
+
+	}
+
+	buf.Reset()
+	if buf.Err() != nil {
+
+		t.Fatalf("expected reset to clear the error (got %v)", buf.Err())
+
+	}
+
+}
+
+func TestBufferFail(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00})
+	buf.SetSticky(true)
+
+	// only the first error is kept
+	buf.Fail(MarshalInvalidLengthError)
+	buf.Fail(BufferOverreadError)
+	if !errors.Is(buf.Err(), MarshalInvalidLengthError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), MarshalInvalidLengthError)
+
+	}
+
+	buf.SetSticky(false)
+	buf.ClearErr()
+
+	defer panicChecker(t, BufferOverreadError)
+	buf.Fail(BufferOverreadError)
+
+}
+
+func TestBufferGrowth(t *testing.T) {
+
+	var expected = []byte{0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x03, 0x04, 0x80}
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	buf.WriteU32LENext([]uint32{0x01, 0x02})
+	buf.WriteBytesNext([]byte{0x03, 0x04})
+	buf.SetBit(0x50)
+
+	if !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	if buf.ByteCapacity() != 11 || buf.BitCapacity() != 88 {
+
+		t.Fatalf("incorrect capacity: %d, %d", buf.ByteCapacity(), buf.BitCapacity())
+
+	}
+
+}
+
+func TestBufferGrowthPanic(t *testing.T) {
+
+	defer panicChecker(t, BufferOverwriteError)
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 4)
+
+	buf.WriteU16BENext([]uint16{0x01, 0x02})
+	buf.WriteByteNext(0x03)
+
+}
+
+func TestBufferReadU32LEAt(t *testing.T) {
+
+	var expected uint32 = 0x04030201
+
+	buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03, 0x04})
+
+	out := buf.ReadU32LEAt(0x01)
+	if expected != out {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+	buf.SeekByte(0x01, false)
+	out = buf.ReadU32LEAtNext()
+	if expected != out || buf.ByteOffset() != 5 {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %#v at offset %d, expected %#v at offset 5)", out, buf.ByteOffset(), expected)
+
+	}
+
+}
+
+func TestBufferReadI16BEInto(t *testing.T) {
+
+	var expected = []int16{-2, 0x0102}
+
+	buf := NewBuffer([]byte{0xff, 0xfe, 0x01, 0x02})
+
+	out := make([]int16, 2)
+	buf.ReadI16BEIntoNext(out)
+	if !cmp.Equal(expected, out) || buf.ByteOffset() != 4 {
+
+		t.Fatalf("expected int16 array does not match the one gotten (got %#v at offset %d, expected %#v at offset 4)", out, buf.ByteOffset(), expected)
+
+	}
+
+}
+
+func TestBufferPutF64BE(t *testing.T) {
+
+	var expected = []byte{0x3f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+	buf := NewBuffer(make([]byte, 16))
+
+	buf.PutF64BE(0x00, 1.5)
+	buf.SeekByte(0x08, false)
+	buf.PutF64BENext(-2.5)
+	if !cmp.Equal(expected, buf.Bytes()) || buf.ByteOffset() != 16 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d, expected %#v at offset 16)", buf.Bytes(), buf.ByteOffset(), expected)
+
+	}
+
+	if out := buf.ReadF64BEAt(0x08); out != -2.5 {
+
+		t.Fatalf("expected float64 does not match the one gotten (got %#v, expected %#v)", out, -2.5)
+
+	}
+
+}
+
+func TestBufferScalarErrors(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+	buf.SetSticky(true)
+
+	buf.PutU16LE(0x03, 0x01)
+	if !errors.Is(buf.Err(), BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverwriteError)
+
+	}
+
+	buf.ClearErr()
+	buf.ReadU32BEInto(make([]uint32, 2), 0x00)
+	if !errors.Is(buf.Err(), BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverreadError)
+
+	}
+
+}
+
+func TestBufferStickyOverflow(t *testing.T) {
+
+	// arguments that are negative or overflow off + n are recorded in
+	// sticky mode instead of panicking
+	for i, c := range []struct {
+		op       func(*Buffer)
+		expected Error
+	}{
+		{func(b *Buffer) { b.ReadBytes(0x00, -1) }, BufferInvalidByteCountError},
+		{func(b *Buffer) { b.ReadBytes(0x01, math.MaxInt64) }, BufferOverreadError},
+		{func(b *Buffer) { b.ReadU16BEAt(math.MaxInt64) }, BufferOverreadError},
+		{func(b *Buffer) { b.ReadU32LE(0x00, -1) }, BufferInvalidByteCountError},
+		{func(b *Buffer) { b.ReadU64BE(0x01, math.MaxInt64/4) }, BufferOverreadError},
+		{func(b *Buffer) { b.ReadU16LEBits(math.MaxInt64) }, BufferOverreadError},
+		{func(b *Buffer) { b.ReadBits(math.MaxInt64, 8) }, BufferOverreadError},
+		{func(b *Buffer) { b.ReadBits(0x00, -1) }, BufferInvalidBitCountError},
+		{func(b *Buffer) { b.PutU32LE(math.MaxInt64-1, 0x01) }, BufferOverwriteError},
+		{func(b *Buffer) { b.WriteU16BE(math.MaxInt64, []uint16{0x01}) }, BufferOverwriteError},
+		{func(b *Buffer) { b.WriteBytes(math.MaxInt64, []byte{0x01}) }, BufferOverwriteError},
+		{func(b *Buffer) { b.PutU16BEBits(math.MaxInt64-4, 0x01) }, BufferOverwriteError},
+		{func(b *Buffer) { b.SetBits(math.MaxInt64-1, 0x00, 8) }, BufferOverwriteError},
+		{func(b *Buffer) { b.SetBits(0x00, 0x00, -1) }, BufferInvalidBitCountError},
+	} {
+
+		buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+		buf.SetGrowth(true, 64)
+		buf.SetSticky(true)
+
+		c.op(buf)
+		if !errors.Is(buf.Err(), c.expected) || buf.ByteCapacity() != 4 {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, buf.Err(), c.expected)
+
+		}
+
+	}
+
+}
+
+func TestBufferWriteI24LE(t *testing.T) {
+
+	var expected = []byte{0xfe, 0xff, 0xff, 0x03, 0x02, 0x01}
+
+	buf := NewBuffer(make([]byte, 6))
+
+	buf.WriteI24LENext([]int32{-2, 0x010203})
+	if !cmp.Equal(expected, buf.Bytes()) || buf.ByteOffset() != 6 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d, expected %#v at offset 6)", buf.Bytes(), buf.ByteOffset(), expected)
+
+	}
+
+}
+
+func TestBufferReadI24LE(t *testing.T) {
+
+	var expected = []int32{-2, 0x010203}
+
+	buf := NewBuffer([]byte{0xfe, 0xff, 0xff, 0x03, 0x02, 0x01})
+
+	out := buf.ReadI24LENext(2)
+	if !cmp.Equal(expected, out) || buf.ByteOffset() != 6 {
+
+		t.Fatalf("expected int32 array does not match the one gotten (got %#v at offset %d, expected %#v at offset 6)", out, buf.ByteOffset(), expected)
+
+	}
+
+}
+
+func TestBufferReadU48BE(t *testing.T) {
+
+	var expected = []uint64{0xffeeddccbbaa}
+
+	buf := NewBuffer([]byte{0xff, 0xee, 0xdd, 0xcc, 0xbb, 0xaa})
+
+	out := buf.ReadU48BE(0x00, 1)
+	if !cmp.Equal(expected, out) {
+
+		t.Fatalf("expected uint64 array does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+	buf.PutU48BE(0x00, 0x010203040506)
+	if scalar := buf.ReadU48BEAt(0x00); scalar != 0x010203040506 {
+
+		t.Fatalf("expected uint64 does not match the one gotten (got %#v, expected %#v)", scalar, uint64(0x010203040506))
+
+	}
+
+}
+
+func TestBufferReadI56BEAt(t *testing.T) {
+
+	var expected int64 = -0x0102
+
+	buf := NewBuffer(make([]byte, 7))
+
+	buf.PutI56BE(0x00, expected)
+	out := buf.ReadI56BEAt(0x00)
+	if expected != out {
+
+		t.Fatalf("expected int64 does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+}
+
 /*
 
 benchmarks
@@ -2483,3 +2820,51 @@ func BenchmarkBufferGrow(b *testing.B) {
 	}
 
 }
+
+func BenchmarkBufferPutU32LE(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+
+	for n := 0; n < b.N; n++ {
+
+		buf.PutU32LE(0x00, 0x01)
+		buf.PutU32LE(0x04, 0x02)
+
+	}
+
+}
+
+func BenchmarkBufferReadU32LEAt(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+
+	var out uint32
+	for n := 0; n < b.N; n++ {
+
+		out = buf.ReadU32LEAt(0x00)
+		out = buf.ReadU32LEAt(0x04)
+
+	}
+
+	_ = out
+
+}
+
+func BenchmarkBufferReadU32LEInto(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+
+	out := make([]uint32, 2)
+	for n := 0; n < b.N; n++ {
+
+		buf.ReadU32LEInto(out, 0x00)
+
+	}
+
+}
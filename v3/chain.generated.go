@@ -0,0 +1,4751 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"io"
+	"math"
+	"net"
+	"sort"
+)
+
+// ChainBuffer implements a buffer type in go that stores its data in
+// a list of separate byte slices instead of a single one, so that
+// data assembled from many pieces does not have to be copied into one
+// place. it has the same checks as Buffer, and operations that span
+// more than one slice work transparently. its byte and bit offsets are
+// always independent of each other, as they are in a Buffer using
+// SeparateCursors
+type ChainBuffer struct {
+	chunks [][]byte
+	starts []int64
+	off    int64
+	cap    int64
+	boff   int64
+	bcap   int64
+
+	sticky bool
+	err    error
+
+	grow bool
+	gmax int64
+
+	// owned is whether or not the last chunk was allocated by the
+	// buffer, which makes it safe to extend into its spare capacity
+	owned bool
+
+	order BitOrder
+
+	// scratch is the buffer the typed methods operate on. it views a
+	// chunk when the data is contained in one and spill otherwise
+	scratch Buffer
+	spill   []byte
+	spilled bool
+}
+
+// NewChainBuffer initializes a new ChainBuffer with the provided byte
+// slice(s) stored inside in the order provided. the slices are not
+// copied, so writes to the buffer are visible in them
+func NewChainBuffer(chunks ...[]byte) (buf *ChainBuffer) {
+
+	buf = &ChainBuffer{}
+	for _, chunk := range chunks {
+
+		buf.Append(chunk)
+
+	}
+	return
+
+}
+
+/* internal use methods */
+
+// fail panics with the provided error or, if the buffer is in sticky
+// mode, records it so that every following call becomes a no-op
+func (b *ChainBuffer) fail(err Error) {
+
+	if !b.sticky {
+
+		panic(err)
+
+	}
+	b.err = err
+
+}
+
+// reserve grows the buffer so that it is at least n bytes long if the
+// buffer is allowed to grow automatically, returning whether or not
+// it is long enough afterwards
+func (b *ChainBuffer) reserve(n int64) bool {
+
+	if !b.grow || (b.gmax > 0x00 && n > b.gmax) {
+
+		return false
+
+	}
+
+	if n > b.cap {
+
+		b.Grow(n - b.cap)
+
+	}
+	return true
+
+}
+
+// writable reports whether the n bytes starting at off are inside of
+// the buffer, growing it if it is allowed to. off and n must not be
+// negative
+func (b *ChainBuffer) writable(off, n int64) bool {
+
+	if n <= b.cap-off {
+
+		return true
+
+	}
+	return n <= math.MaxInt64-off && b.reserve(off+n)
+
+}
+
+// link adds a chunk to the end of the chain, updating the cached
+// statistics of the buffer for it alone instead of going over every
+// chunk again like Refresh
+func (b *ChainBuffer) link(chunk []byte) {
+
+	b.chunks = append(b.chunks, chunk)
+	b.starts = append(b.starts, b.cap)
+	b.cap += int64(len(chunk))
+	b.bcap = b.cap * 8
+
+}
+
+// locate returns the index of the chunk containing the byte at the
+// specified offset and the offset of the byte within it
+func (b *ChainBuffer) locate(off int64) (i int, inner int64) {
+
+	i = sort.Search(len(b.starts), func(i int) bool {
+
+		return b.starts[i] > off
+
+	}) - 1
+	return i, off - b.starts[i]
+
+}
+
+// gather copies the bytes of the buffer starting at the specified
+// offset into dst
+func (b *ChainBuffer) gather(dst []byte, off int64) {
+
+	if len(dst) == 0x00 {
+
+		return
+
+	}
+
+	i, inner := b.locate(off)
+	for n := 0; n < len(dst); i++ {
+
+		n += copy(dst[n:], b.chunks[i][inner:])
+		inner = 0x00
+
+	}
+
+}
+
+// scatter copies src into the bytes of the buffer starting at the
+// specified offset
+func (b *ChainBuffer) scatter(off int64, src []byte) {
+
+	if len(src) == 0x00 {
+
+		return
+
+	}
+
+	i, inner := b.locate(off)
+	for n := 0; n < len(src); i++ {
+
+		n += copy(b.chunks[i][inner:], src[n:])
+		inner = 0x00
+
+	}
+
+}
+
+// window points the scratch buffer at the n bytes of the buffer at the
+// specified offset, copying them into the spill slice if they span
+// more than one chunk
+func (b *ChainBuffer) window(off, n int64) *Buffer {
+
+	b.spilled = false
+	if n == 0x00 {
+
+		b.scratch.buf = b.spill[:0]
+
+	} else if i, inner := b.locate(off); inner+n <= int64(len(b.chunks[i])) {
+
+		b.scratch.buf = b.chunks[i][inner : inner+n : inner+n]
+
+	} else {
+
+		if int64(cap(b.spill)) < n {
+
+			b.spill = make([]byte, n)
+
+		}
+		b.scratch.buf = b.spill[:n]
+		b.spilled = true
+		b.gather(b.scratch.buf, off)
+
+	}
+
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+	return &b.scratch
+
+}
+
+// view returns the scratch buffer pointed at the n values of size bytes
+// each in the buffer at the specified offset, or nil if they are out of
+// bounds. if write is set, the buffer grows to fit them if it is
+// allowed to
+func (b *ChainBuffer) view(op string, off, n, size int64, write bool) *Buffer {
+
+	if b.err != nil {
+
+		return nil
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count(op, n, b.cap))
+		return nil
+
+	}
+
+	if write {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderwriteError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+		if n > math.MaxInt64/size || !b.writable(off, n*size) {
+
+			b.fail(BufferOverwriteError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+	} else {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderreadError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+		if n > (b.cap-off)/size {
+
+			b.fail(BufferOverreadError.at(op, off, n*size, b.cap))
+			return nil
+
+		}
+
+	}
+	return b.window(off, n*size)
+
+}
+
+// bitView is the bit-level variant of view. it returns the scratch
+// buffer pointed at the bytes containing the n bits of the buffer at
+// the specified offset, along with the offset of the first of them
+// within it
+func (b *ChainBuffer) bitView(op string, off, n int64, write bool) (*Buffer, int64) {
+
+	if b.err != nil {
+
+		return nil, 0x00
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidBitCountError.atBit(op, off, n, b.bcap))
+		return nil, 0x00
+
+	}
+
+	if write {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderwriteError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+		// the size in bytes is computed from off/8 so that it cannot
+		// overflow where (off+n+7)/8 would
+		if n > (b.bcap-off) && (n > (math.MaxInt64-7-off) || !b.reserve(off/8+(off%8+n+7)/8)) {
+
+			b.fail(BufferOverwriteError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+	} else {
+
+		if off < 0x00 {
+
+			b.fail(BufferUnderreadError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+		if n > (b.bcap - off) {
+
+			b.fail(BufferOverreadError.atBit(op, off, n, b.bcap))
+			return nil, 0x00
+
+		}
+
+	}
+	return b.window(off/8, (off+n+7)/8-off/8), off % 8
+
+}
+
+// store copies the scratch buffer back into the buffer at the
+// specified offset if it was spilled
+func (b *ChainBuffer) store(off int64) {
+
+	if b.spilled {
+
+		b.scatter(off, b.scratch.buf)
+
+	}
+
+}
+
+/* bitfield methods */
+
+// ReadBit returns the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) ReadBit(off int64) (out byte) {
+
+	if w, bit := b.bitView("ReadBit", off, 1, false); w != nil {
+
+		out = w.ReadBit(bit)
+
+	}
+	return
+
+}
+
+// ReadBitNext returns the next bit from the current offset and moves
+// the offset forward a bit
+func (b *ChainBuffer) ReadBitNext() (out byte) {
+
+	out = b.ReadBit(b.boff)
+	b.SeekBit(1, true)
+	return
+
+}
+
+// ReadBits returns the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) ReadBits(off, n int64) (out uint64) {
+
+	if w, bit := b.bitView("ReadBits", off, n, false); w != nil {
+
+		out = w.ReadBits(bit, n)
+
+	}
+	return
+
+}
+
+// ReadBitsNext returns the next n bits from the current offset and
+// moves the offset forward the amount of bits read
+func (b *ChainBuffer) ReadBitsNext(n int64) (out uint64) {
+
+	out = b.ReadBits(b.boff, n)
+	b.SeekBit(n, true)
+	return
+
+}
+
+// SetBit sets the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) SetBit(off int64) {
+
+	if w, bit := b.bitView("SetBit", off, 1, true); w != nil {
+
+		w.SetBit(bit)
+		b.store(off / 8)
+
+	}
+
+}
+
+// SetBitNext sets the next bit from the current offset and moves the
+// offset forward a bit
+func (b *ChainBuffer) SetBitNext() {
+
+	b.SetBit(b.boff)
+	b.SeekBit(1, true)
+
+}
+
+// ClearBit clears the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) ClearBit(off int64) {
+
+	if w, bit := b.bitView("ClearBit", off, 1, true); w != nil {
+
+		w.ClearBit(bit)
+		b.store(off / 8)
+
+	}
+
+}
+
+// ClearBitNext clears the next bit from the current offset and moves
+// the offset forward a bit
+func (b *ChainBuffer) ClearBitNext() {
+
+	b.ClearBit(b.boff)
+	b.SeekBit(1, true)
+
+}
+
+// SetBits sets the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) SetBits(off int64, data uint64, n int64) {
+
+	if w, bit := b.bitView("SetBits", off, n, true); w != nil {
+
+		w.SetBits(bit, data, n)
+		b.store(off / 8)
+
+	}
+
+}
+
+// SetBitsNext sets the next n bits from the current offset and moves
+// the offset forward the amount of bits set
+func (b *ChainBuffer) SetBitsNext(data uint64, n int64) {
+
+	b.SetBits(b.boff, data, n)
+	b.SeekBit(n, true)
+
+}
+
+// FlipBit flips the bit located at the specified offset without
+// modifying the internal offset value
+func (b *ChainBuffer) FlipBit(off int64) {
+
+	if w, bit := b.bitView("FlipBit", off, 1, true); w != nil {
+
+		w.FlipBit(bit)
+		b.store(off / 8)
+
+	}
+
+}
+
+// FlipBitNext flips the next bit from the current offset and moves
+// the offset forward a bit
+func (b *ChainBuffer) FlipBitNext() {
+
+	b.FlipBit(b.boff)
+	b.SeekBit(1, true)
+
+}
+
+// ClearAllBits sets all of the buffer's bits to 0
+func (b *ChainBuffer) ClearAllBits() {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	for _, chunk := range b.chunks {
+
+		for i := range chunk {
+
+			chunk[i] = 0x00
+
+		}
+
+	}
+
+}
+
+// SetAllBits sets all of the buffer's bits to 1
+func (b *ChainBuffer) SetAllBits() {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	for _, chunk := range b.chunks {
+
+		for i := range chunk {
+
+			chunk[i] = 0xff
+
+		}
+
+	}
+
+}
+
+// FlipAllBits flips all of the buffer's bits
+func (b *ChainBuffer) FlipAllBits() {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	for _, chunk := range b.chunks {
+
+		for i := range chunk {
+
+			chunk[i] = ^chunk[i]
+
+		}
+
+	}
+
+}
+
+// SeekBit seeks to bit at position off of the byte slice relative to
+// the current offset or to the beginning of the buffer, depending on
+// relative
+func (b *ChainBuffer) SeekBit(off int64, relative bool) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		b.boff += off
+
+	} else {
+
+		b.boff = off
+
+	}
+
+}
+
+// AfterBit returns the amount of bits located after the current
+// position or the specified one
+func (b *ChainBuffer) AfterBit(off ...int64) int64 {
+
+	if len(off) == 0 {
+
+		return b.bcap - b.boff - 1
+
+	}
+	return b.bcap - off[0] - 1
+
+}
+
+// AlignBit aligns the bit offset to the byte offset
+func (b *ChainBuffer) AlignBit() {
+
+	b.boff = b.off * 8
+
+}
+
+/* byte buffer methods */
+
+// WriteBytes writes bytes to the buffer at the specified offset
+// without modifying the internal offset value
+func (b *ChainBuffer) WriteBytes(off int64, data []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	if !b.writable(off, int64(len(data))) {
+
+		b.fail(BufferOverwriteError.at("WriteBytes", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	b.scatter(off, data)
+
+}
+
+// WriteBytesNext writes bytes to the buffer at the current offset and
+// moves the offset forward the amount of bytes written
+func (b *ChainBuffer) WriteBytesNext(data []byte) {
+
+	b.WriteBytes(b.off, data)
+	b.SeekByte(int64(len(data)), true)
+
+}
+
+// PutByte writes a byte to the buffer at the specified offset without
+// modifying the internal offset value. it is not named WriteByte like
+// the Buffer method, as that is reserved for io.ByteWriter
+func (b *ChainBuffer) PutByte(off int64, data byte) {
+
+	b.WriteBytes(off, []byte{data})
+
+}
+
+// WriteByteNext writes a byte to the buffer at the current offset and
+// moves the offset forward the amount of bytes written
+func (b *ChainBuffer) WriteByteNext(data byte) {
+
+	b.WriteBytes(b.off, []byte{data})
+	b.SeekByte(1, true)
+
+}
+
+// WriteU16LE writes a slice of uint16s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU16LE(off int64, data []uint16) {
+	if w := b.view("WriteU16LE", off, int64(len(data)), 2, true); w != nil && len(data) > 0 {
+		w.WriteU16LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU16LENext writes a slice of uint16s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU16LENext(data []uint16) {
+	b.WriteU16LE(b.off, data)
+	b.SeekByte(int64(len(data))*2, true)
+}
+
+// PutU16LE writes a uint16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU16LE(off int64, data uint16) {
+	if w := b.view("PutU16LE", off, 1, 2, true); w != nil {
+		w.PutU16LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU16LENext writes a uint16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU16LENext(data uint16) {
+	b.PutU16LE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutU16LEBits writes a uint16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU16LEBits(off int64, data uint16) {
+	if w, bit := b.bitView("PutU16LEBits", off, 16, true); w != nil {
+		w.PutU16LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU16LEBitsNext writes a uint16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU16LEBitsNext(data uint16) {
+	b.PutU16LEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteU16BE writes a slice of uint16s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU16BE(off int64, data []uint16) {
+	if w := b.view("WriteU16BE", off, int64(len(data)), 2, true); w != nil && len(data) > 0 {
+		w.WriteU16BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU16BENext writes a slice of uint16s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU16BENext(data []uint16) {
+	b.WriteU16BE(b.off, data)
+	b.SeekByte(int64(len(data))*2, true)
+}
+
+// PutU16BE writes a uint16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU16BE(off int64, data uint16) {
+	if w := b.view("PutU16BE", off, 1, 2, true); w != nil {
+		w.PutU16BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU16BENext writes a uint16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU16BENext(data uint16) {
+	b.PutU16BE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutU16BEBits writes a uint16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU16BEBits(off int64, data uint16) {
+	if w, bit := b.bitView("PutU16BEBits", off, 16, true); w != nil {
+		w.PutU16BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU16BEBitsNext writes a uint16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU16BEBitsNext(data uint16) {
+	b.PutU16BEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteU24LE writes a slice of uint32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU24LE(off int64, data []uint32) {
+	if w := b.view("WriteU24LE", off, int64(len(data)), 3, true); w != nil && len(data) > 0 {
+		w.WriteU24LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU24LENext writes a slice of uint32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU24LENext(data []uint32) {
+	b.WriteU24LE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutU24LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU24LE(off int64, data uint32) {
+	if w := b.view("PutU24LE", off, 1, 3, true); w != nil {
+		w.PutU24LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU24LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU24LENext(data uint32) {
+	b.PutU24LE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutU24LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU24LEBits(off int64, data uint32) {
+	if w, bit := b.bitView("PutU24LEBits", off, 24, true); w != nil {
+		w.PutU24LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU24LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU24LEBitsNext(data uint32) {
+	b.PutU24LEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteU24BE writes a slice of uint32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU24BE(off int64, data []uint32) {
+	if w := b.view("WriteU24BE", off, int64(len(data)), 3, true); w != nil && len(data) > 0 {
+		w.WriteU24BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU24BENext writes a slice of uint32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU24BENext(data []uint32) {
+	b.WriteU24BE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutU24BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU24BE(off int64, data uint32) {
+	if w := b.view("PutU24BE", off, 1, 3, true); w != nil {
+		w.PutU24BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU24BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU24BENext(data uint32) {
+	b.PutU24BE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutU24BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU24BEBits(off int64, data uint32) {
+	if w, bit := b.bitView("PutU24BEBits", off, 24, true); w != nil {
+		w.PutU24BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU24BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU24BEBitsNext(data uint32) {
+	b.PutU24BEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteU32LE writes a slice of uint32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU32LE(off int64, data []uint32) {
+	if w := b.view("WriteU32LE", off, int64(len(data)), 4, true); w != nil && len(data) > 0 {
+		w.WriteU32LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU32LENext writes a slice of uint32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU32LENext(data []uint32) {
+	b.WriteU32LE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutU32LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU32LE(off int64, data uint32) {
+	if w := b.view("PutU32LE", off, 1, 4, true); w != nil {
+		w.PutU32LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU32LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU32LENext(data uint32) {
+	b.PutU32LE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutU32LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU32LEBits(off int64, data uint32) {
+	if w, bit := b.bitView("PutU32LEBits", off, 32, true); w != nil {
+		w.PutU32LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU32LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU32LEBitsNext(data uint32) {
+	b.PutU32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteU32BE writes a slice of uint32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU32BE(off int64, data []uint32) {
+	if w := b.view("WriteU32BE", off, int64(len(data)), 4, true); w != nil && len(data) > 0 {
+		w.WriteU32BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU32BENext writes a slice of uint32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU32BENext(data []uint32) {
+	b.WriteU32BE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutU32BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU32BE(off int64, data uint32) {
+	if w := b.view("PutU32BE", off, 1, 4, true); w != nil {
+		w.PutU32BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU32BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU32BENext(data uint32) {
+	b.PutU32BE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutU32BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU32BEBits(off int64, data uint32) {
+	if w, bit := b.bitView("PutU32BEBits", off, 32, true); w != nil {
+		w.PutU32BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU32BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU32BEBitsNext(data uint32) {
+	b.PutU32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteU40LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU40LE(off int64, data []uint64) {
+	if w := b.view("WriteU40LE", off, int64(len(data)), 5, true); w != nil && len(data) > 0 {
+		w.WriteU40LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU40LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU40LENext(data []uint64) {
+	b.WriteU40LE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutU40LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU40LE(off int64, data uint64) {
+	if w := b.view("PutU40LE", off, 1, 5, true); w != nil {
+		w.PutU40LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU40LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU40LENext(data uint64) {
+	b.PutU40LE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutU40LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU40LEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU40LEBits", off, 40, true); w != nil {
+		w.PutU40LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU40LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU40LEBitsNext(data uint64) {
+	b.PutU40LEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteU40BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU40BE(off int64, data []uint64) {
+	if w := b.view("WriteU40BE", off, int64(len(data)), 5, true); w != nil && len(data) > 0 {
+		w.WriteU40BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU40BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU40BENext(data []uint64) {
+	b.WriteU40BE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutU40BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU40BE(off int64, data uint64) {
+	if w := b.view("PutU40BE", off, 1, 5, true); w != nil {
+		w.PutU40BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU40BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU40BENext(data uint64) {
+	b.PutU40BE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutU40BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU40BEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU40BEBits", off, 40, true); w != nil {
+		w.PutU40BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU40BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU40BEBitsNext(data uint64) {
+	b.PutU40BEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteU48LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU48LE(off int64, data []uint64) {
+	if w := b.view("WriteU48LE", off, int64(len(data)), 6, true); w != nil && len(data) > 0 {
+		w.WriteU48LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU48LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU48LENext(data []uint64) {
+	b.WriteU48LE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutU48LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU48LE(off int64, data uint64) {
+	if w := b.view("PutU48LE", off, 1, 6, true); w != nil {
+		w.PutU48LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU48LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU48LENext(data uint64) {
+	b.PutU48LE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutU48LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU48LEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU48LEBits", off, 48, true); w != nil {
+		w.PutU48LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU48LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU48LEBitsNext(data uint64) {
+	b.PutU48LEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteU48BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU48BE(off int64, data []uint64) {
+	if w := b.view("WriteU48BE", off, int64(len(data)), 6, true); w != nil && len(data) > 0 {
+		w.WriteU48BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU48BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU48BENext(data []uint64) {
+	b.WriteU48BE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutU48BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU48BE(off int64, data uint64) {
+	if w := b.view("PutU48BE", off, 1, 6, true); w != nil {
+		w.PutU48BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU48BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU48BENext(data uint64) {
+	b.PutU48BE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutU48BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU48BEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU48BEBits", off, 48, true); w != nil {
+		w.PutU48BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU48BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU48BEBitsNext(data uint64) {
+	b.PutU48BEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteU56LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU56LE(off int64, data []uint64) {
+	if w := b.view("WriteU56LE", off, int64(len(data)), 7, true); w != nil && len(data) > 0 {
+		w.WriteU56LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU56LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU56LENext(data []uint64) {
+	b.WriteU56LE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutU56LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU56LE(off int64, data uint64) {
+	if w := b.view("PutU56LE", off, 1, 7, true); w != nil {
+		w.PutU56LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU56LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU56LENext(data uint64) {
+	b.PutU56LE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutU56LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU56LEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU56LEBits", off, 56, true); w != nil {
+		w.PutU56LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU56LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU56LEBitsNext(data uint64) {
+	b.PutU56LEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteU56BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU56BE(off int64, data []uint64) {
+	if w := b.view("WriteU56BE", off, int64(len(data)), 7, true); w != nil && len(data) > 0 {
+		w.WriteU56BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU56BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU56BENext(data []uint64) {
+	b.WriteU56BE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutU56BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU56BE(off int64, data uint64) {
+	if w := b.view("PutU56BE", off, 1, 7, true); w != nil {
+		w.PutU56BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU56BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU56BENext(data uint64) {
+	b.PutU56BE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutU56BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU56BEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU56BEBits", off, 56, true); w != nil {
+		w.PutU56BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU56BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU56BEBitsNext(data uint64) {
+	b.PutU56BEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteU64LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU64LE(off int64, data []uint64) {
+	if w := b.view("WriteU64LE", off, int64(len(data)), 8, true); w != nil && len(data) > 0 {
+		w.WriteU64LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU64LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU64LENext(data []uint64) {
+	b.WriteU64LE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutU64LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU64LE(off int64, data uint64) {
+	if w := b.view("PutU64LE", off, 1, 8, true); w != nil {
+		w.PutU64LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU64LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU64LENext(data uint64) {
+	b.PutU64LE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutU64LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU64LEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU64LEBits", off, 64, true); w != nil {
+		w.PutU64LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU64LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU64LEBitsNext(data uint64) {
+	b.PutU64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteU64BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteU64BE(off int64, data []uint64) {
+	if w := b.view("WriteU64BE", off, int64(len(data)), 8, true); w != nil && len(data) > 0 {
+		w.WriteU64BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteU64BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteU64BENext(data []uint64) {
+	b.WriteU64BE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutU64BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutU64BE(off int64, data uint64) {
+	if w := b.view("PutU64BE", off, 1, 8, true); w != nil {
+		w.PutU64BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutU64BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutU64BENext(data uint64) {
+	b.PutU64BE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutU64BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutU64BEBits(off int64, data uint64) {
+	if w, bit := b.bitView("PutU64BEBits", off, 64, true); w != nil {
+		w.PutU64BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutU64BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutU64BEBitsNext(data uint64) {
+	b.PutU64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteI16LE writes a slice of int16s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI16LE(off int64, data []int16) {
+	if w := b.view("WriteI16LE", off, int64(len(data)), 2, true); w != nil && len(data) > 0 {
+		w.WriteI16LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI16LENext writes a slice of int16s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI16LENext(data []int16) {
+	b.WriteI16LE(b.off, data)
+	b.SeekByte(int64(len(data))*2, true)
+}
+
+// PutI16LE writes an int16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI16LE(off int64, data int16) {
+	if w := b.view("PutI16LE", off, 1, 2, true); w != nil {
+		w.PutI16LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI16LENext writes an int16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI16LENext(data int16) {
+	b.PutI16LE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutI16LEBits writes an int16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI16LEBits(off int64, data int16) {
+	if w, bit := b.bitView("PutI16LEBits", off, 16, true); w != nil {
+		w.PutI16LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI16LEBitsNext writes an int16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI16LEBitsNext(data int16) {
+	b.PutI16LEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteI16BE writes a slice of int16s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI16BE(off int64, data []int16) {
+	if w := b.view("WriteI16BE", off, int64(len(data)), 2, true); w != nil && len(data) > 0 {
+		w.WriteI16BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI16BENext writes a slice of int16s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI16BENext(data []int16) {
+	b.WriteI16BE(b.off, data)
+	b.SeekByte(int64(len(data))*2, true)
+}
+
+// PutI16BE writes an int16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI16BE(off int64, data int16) {
+	if w := b.view("PutI16BE", off, 1, 2, true); w != nil {
+		w.PutI16BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI16BENext writes an int16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI16BENext(data int16) {
+	b.PutI16BE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutI16BEBits writes an int16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI16BEBits(off int64, data int16) {
+	if w, bit := b.bitView("PutI16BEBits", off, 16, true); w != nil {
+		w.PutI16BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI16BEBitsNext writes an int16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI16BEBitsNext(data int16) {
+	b.PutI16BEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteI24LE writes a slice of int32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI24LE(off int64, data []int32) {
+	if w := b.view("WriteI24LE", off, int64(len(data)), 3, true); w != nil && len(data) > 0 {
+		w.WriteI24LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI24LENext writes a slice of int32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI24LENext(data []int32) {
+	b.WriteI24LE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutI24LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI24LE(off int64, data int32) {
+	if w := b.view("PutI24LE", off, 1, 3, true); w != nil {
+		w.PutI24LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI24LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI24LENext(data int32) {
+	b.PutI24LE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutI24LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI24LEBits(off int64, data int32) {
+	if w, bit := b.bitView("PutI24LEBits", off, 24, true); w != nil {
+		w.PutI24LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI24LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI24LEBitsNext(data int32) {
+	b.PutI24LEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteI24BE writes a slice of int32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI24BE(off int64, data []int32) {
+	if w := b.view("WriteI24BE", off, int64(len(data)), 3, true); w != nil && len(data) > 0 {
+		w.WriteI24BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI24BENext writes a slice of int32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI24BENext(data []int32) {
+	b.WriteI24BE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutI24BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI24BE(off int64, data int32) {
+	if w := b.view("PutI24BE", off, 1, 3, true); w != nil {
+		w.PutI24BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI24BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI24BENext(data int32) {
+	b.PutI24BE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutI24BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI24BEBits(off int64, data int32) {
+	if w, bit := b.bitView("PutI24BEBits", off, 24, true); w != nil {
+		w.PutI24BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI24BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI24BEBitsNext(data int32) {
+	b.PutI24BEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteI32LE writes a slice of int32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI32LE(off int64, data []int32) {
+	if w := b.view("WriteI32LE", off, int64(len(data)), 4, true); w != nil && len(data) > 0 {
+		w.WriteI32LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI32LENext writes a slice of int32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI32LENext(data []int32) {
+	b.WriteI32LE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutI32LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI32LE(off int64, data int32) {
+	if w := b.view("PutI32LE", off, 1, 4, true); w != nil {
+		w.PutI32LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI32LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI32LENext(data int32) {
+	b.PutI32LE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutI32LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI32LEBits(off int64, data int32) {
+	if w, bit := b.bitView("PutI32LEBits", off, 32, true); w != nil {
+		w.PutI32LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI32LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI32LEBitsNext(data int32) {
+	b.PutI32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteI32BE writes a slice of int32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI32BE(off int64, data []int32) {
+	if w := b.view("WriteI32BE", off, int64(len(data)), 4, true); w != nil && len(data) > 0 {
+		w.WriteI32BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI32BENext writes a slice of int32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI32BENext(data []int32) {
+	b.WriteI32BE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutI32BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI32BE(off int64, data int32) {
+	if w := b.view("PutI32BE", off, 1, 4, true); w != nil {
+		w.PutI32BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI32BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI32BENext(data int32) {
+	b.PutI32BE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutI32BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI32BEBits(off int64, data int32) {
+	if w, bit := b.bitView("PutI32BEBits", off, 32, true); w != nil {
+		w.PutI32BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI32BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI32BEBitsNext(data int32) {
+	b.PutI32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteI40LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI40LE(off int64, data []int64) {
+	if w := b.view("WriteI40LE", off, int64(len(data)), 5, true); w != nil && len(data) > 0 {
+		w.WriteI40LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI40LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI40LENext(data []int64) {
+	b.WriteI40LE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutI40LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI40LE(off int64, data int64) {
+	if w := b.view("PutI40LE", off, 1, 5, true); w != nil {
+		w.PutI40LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI40LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI40LENext(data int64) {
+	b.PutI40LE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutI40LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI40LEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI40LEBits", off, 40, true); w != nil {
+		w.PutI40LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI40LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI40LEBitsNext(data int64) {
+	b.PutI40LEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteI40BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI40BE(off int64, data []int64) {
+	if w := b.view("WriteI40BE", off, int64(len(data)), 5, true); w != nil && len(data) > 0 {
+		w.WriteI40BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI40BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI40BENext(data []int64) {
+	b.WriteI40BE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutI40BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI40BE(off int64, data int64) {
+	if w := b.view("PutI40BE", off, 1, 5, true); w != nil {
+		w.PutI40BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI40BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI40BENext(data int64) {
+	b.PutI40BE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutI40BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI40BEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI40BEBits", off, 40, true); w != nil {
+		w.PutI40BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI40BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI40BEBitsNext(data int64) {
+	b.PutI40BEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteI48LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI48LE(off int64, data []int64) {
+	if w := b.view("WriteI48LE", off, int64(len(data)), 6, true); w != nil && len(data) > 0 {
+		w.WriteI48LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI48LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI48LENext(data []int64) {
+	b.WriteI48LE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutI48LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI48LE(off int64, data int64) {
+	if w := b.view("PutI48LE", off, 1, 6, true); w != nil {
+		w.PutI48LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI48LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI48LENext(data int64) {
+	b.PutI48LE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutI48LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI48LEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI48LEBits", off, 48, true); w != nil {
+		w.PutI48LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI48LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI48LEBitsNext(data int64) {
+	b.PutI48LEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteI48BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI48BE(off int64, data []int64) {
+	if w := b.view("WriteI48BE", off, int64(len(data)), 6, true); w != nil && len(data) > 0 {
+		w.WriteI48BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI48BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI48BENext(data []int64) {
+	b.WriteI48BE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutI48BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI48BE(off int64, data int64) {
+	if w := b.view("PutI48BE", off, 1, 6, true); w != nil {
+		w.PutI48BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI48BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI48BENext(data int64) {
+	b.PutI48BE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutI48BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI48BEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI48BEBits", off, 48, true); w != nil {
+		w.PutI48BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI48BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI48BEBitsNext(data int64) {
+	b.PutI48BEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteI56LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI56LE(off int64, data []int64) {
+	if w := b.view("WriteI56LE", off, int64(len(data)), 7, true); w != nil && len(data) > 0 {
+		w.WriteI56LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI56LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI56LENext(data []int64) {
+	b.WriteI56LE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutI56LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI56LE(off int64, data int64) {
+	if w := b.view("PutI56LE", off, 1, 7, true); w != nil {
+		w.PutI56LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI56LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI56LENext(data int64) {
+	b.PutI56LE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutI56LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI56LEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI56LEBits", off, 56, true); w != nil {
+		w.PutI56LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI56LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI56LEBitsNext(data int64) {
+	b.PutI56LEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteI56BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI56BE(off int64, data []int64) {
+	if w := b.view("WriteI56BE", off, int64(len(data)), 7, true); w != nil && len(data) > 0 {
+		w.WriteI56BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI56BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI56BENext(data []int64) {
+	b.WriteI56BE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutI56BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI56BE(off int64, data int64) {
+	if w := b.view("PutI56BE", off, 1, 7, true); w != nil {
+		w.PutI56BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI56BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI56BENext(data int64) {
+	b.PutI56BE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutI56BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI56BEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI56BEBits", off, 56, true); w != nil {
+		w.PutI56BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI56BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI56BEBitsNext(data int64) {
+	b.PutI56BEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteI64LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI64LE(off int64, data []int64) {
+	if w := b.view("WriteI64LE", off, int64(len(data)), 8, true); w != nil && len(data) > 0 {
+		w.WriteI64LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI64LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI64LENext(data []int64) {
+	b.WriteI64LE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutI64LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI64LE(off int64, data int64) {
+	if w := b.view("PutI64LE", off, 1, 8, true); w != nil {
+		w.PutI64LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI64LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI64LENext(data int64) {
+	b.PutI64LE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutI64LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI64LEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI64LEBits", off, 64, true); w != nil {
+		w.PutI64LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI64LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI64LEBitsNext(data int64) {
+	b.PutI64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteI64BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteI64BE(off int64, data []int64) {
+	if w := b.view("WriteI64BE", off, int64(len(data)), 8, true); w != nil && len(data) > 0 {
+		w.WriteI64BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteI64BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteI64BENext(data []int64) {
+	b.WriteI64BE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutI64BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutI64BE(off int64, data int64) {
+	if w := b.view("PutI64BE", off, 1, 8, true); w != nil {
+		w.PutI64BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutI64BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutI64BENext(data int64) {
+	b.PutI64BE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutI64BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutI64BEBits(off int64, data int64) {
+	if w, bit := b.bitView("PutI64BEBits", off, 64, true); w != nil {
+		w.PutI64BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutI64BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutI64BEBitsNext(data int64) {
+	b.PutI64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteF32LE writes a slice of float32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteF32LE(off int64, data []float32) {
+	if w := b.view("WriteF32LE", off, int64(len(data)), 4, true); w != nil && len(data) > 0 {
+		w.WriteF32LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteF32LENext writes a slice of float32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteF32LENext(data []float32) {
+	b.WriteF32LE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutF32LE writes a float32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutF32LE(off int64, data float32) {
+	if w := b.view("PutF32LE", off, 1, 4, true); w != nil {
+		w.PutF32LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutF32LENext writes a float32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutF32LENext(data float32) {
+	b.PutF32LE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutF32LEBits writes a float32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutF32LEBits(off int64, data float32) {
+	if w, bit := b.bitView("PutF32LEBits", off, 32, true); w != nil {
+		w.PutF32LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutF32LEBitsNext writes a float32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutF32LEBitsNext(data float32) {
+	b.PutF32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteF32BE writes a slice of float32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteF32BE(off int64, data []float32) {
+	if w := b.view("WriteF32BE", off, int64(len(data)), 4, true); w != nil && len(data) > 0 {
+		w.WriteF32BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteF32BENext writes a slice of float32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteF32BENext(data []float32) {
+	b.WriteF32BE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutF32BE writes a float32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutF32BE(off int64, data float32) {
+	if w := b.view("PutF32BE", off, 1, 4, true); w != nil {
+		w.PutF32BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutF32BENext writes a float32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutF32BENext(data float32) {
+	b.PutF32BE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutF32BEBits writes a float32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutF32BEBits(off int64, data float32) {
+	if w, bit := b.bitView("PutF32BEBits", off, 32, true); w != nil {
+		w.PutF32BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutF32BEBitsNext writes a float32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutF32BEBitsNext(data float32) {
+	b.PutF32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteF64LE writes a slice of float64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteF64LE(off int64, data []float64) {
+	if w := b.view("WriteF64LE", off, int64(len(data)), 8, true); w != nil && len(data) > 0 {
+		w.WriteF64LE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteF64LENext writes a slice of float64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteF64LENext(data []float64) {
+	b.WriteF64LE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutF64LE writes a float64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) PutF64LE(off int64, data float64) {
+	if w := b.view("PutF64LE", off, 1, 8, true); w != nil {
+		w.PutF64LE(0, data)
+		b.store(off)
+	}
+}
+
+// PutF64LENext writes a float64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutF64LENext(data float64) {
+	b.PutF64LE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutF64LEBits writes a float64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutF64LEBits(off int64, data float64) {
+	if w, bit := b.bitView("PutF64LEBits", off, 64, true); w != nil {
+		w.PutF64LEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutF64LEBitsNext writes a float64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutF64LEBitsNext(data float64) {
+	b.PutF64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteF64BE writes a slice of float64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) WriteF64BE(off int64, data []float64) {
+	if w := b.view("WriteF64BE", off, int64(len(data)), 8, true); w != nil && len(data) > 0 {
+		w.WriteF64BE(0, data)
+		b.store(off)
+	}
+}
+
+// WriteF64BENext writes a slice of float64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *ChainBuffer) WriteF64BENext(data []float64) {
+	b.WriteF64BE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutF64BE writes a float64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) PutF64BE(off int64, data float64) {
+	if w := b.view("PutF64BE", off, 1, 8, true); w != nil {
+		w.PutF64BE(0, data)
+		b.store(off)
+	}
+}
+
+// PutF64BENext writes a float64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *ChainBuffer) PutF64BENext(data float64) {
+	b.PutF64BE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutF64BEBits writes a float64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) PutF64BEBits(off int64, data float64) {
+	if w, bit := b.bitView("PutF64BEBits", off, 64, true); w != nil {
+		w.PutF64BEBits(bit, data)
+		b.store(off / 8)
+	}
+}
+
+// PutF64BEBitsNext writes a float64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *ChainBuffer) PutF64BEBitsNext(data float64) {
+	b.PutF64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// ReadBytes returns the next n bytes from the specified offset
+// without modifying the internal offset value. the bytes are not
+// copied if they are contained in a single chunk, so the returned
+// slice only reflects later writes in that case
+func (b *ChainBuffer) ReadBytes(off, n int64) (out []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("ReadBytes", n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	if n > (b.cap - off) {
+
+		b.fail(BufferOverreadError.at("ReadBytes", off, n, b.cap))
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return []byte{}
+
+	}
+
+	if i, inner := b.locate(off); inner+n <= int64(len(b.chunks[i])) {
+
+		return b.chunks[i][inner : inner+n]
+
+	}
+
+	out = make([]byte, n)
+	b.gather(out, off)
+	return
+
+}
+
+// ReadBytesNext returns the next n bytes from the current offset and
+// moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadBytesNext(n int64) (out []byte) {
+
+	out = b.ReadBytes(b.off, n)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ReadByteAt returns the byte located at the specified offset without
+// modifying the internal offset value. it is not named ReadByte like
+// the Buffer method, as that is reserved for io.ByteReader
+func (b *ChainBuffer) ReadByteAt(off int64) (out byte) {
+
+	if w := b.view("ReadByteAt", off, 1, 1, false); w != nil {
+
+		out = w.buf[0]
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte from the current offset and
+// moves the offset forward a byte
+func (b *ChainBuffer) ReadByteNext() (out byte) {
+
+	out = b.ReadByteAt(b.off)
+	b.SeekByte(1, true)
+	return
+
+}
+
+// ReadU16LE reads a slice of uint16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU16LE(off, n int64) (out []uint16) {
+	if w := b.view("ReadU16LE", off, n, 2, false); w != nil && n > 0 {
+		out = w.ReadU16LE(0, n)
+	}
+	return
+}
+
+// ReadU16LENext reads a slice of uint16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU16LENext(n int64) (out []uint16) {
+	out = b.ReadU16LE(b.off, n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadU16LEAt reads a uint16 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU16LEAt(off int64) (out uint16) {
+	if w := b.view("ReadU16LEAt", off, 1, 2, false); w != nil {
+		out = w.ReadU16LEAt(0)
+	}
+	return
+}
+
+// ReadU16LEAtNext reads a uint16 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU16LEAtNext() (out uint16) {
+	out = b.ReadU16LEAt(b.off)
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadU16LEInto reads len(dst) uint16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU16LEInto(dst []uint16, off int64) {
+	if w := b.view("ReadU16LEInto", off, int64(len(dst)), 2, false); w != nil {
+		w.ReadU16LEInto(dst, 0)
+	}
+}
+
+// ReadU16LEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU16LEIntoNext(dst []uint16) {
+	b.ReadU16LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadU16LEBits reads a uint16 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU16LEBits(off int64) (out uint16) {
+	if w, bit := b.bitView("ReadU16LEBits", off, 16, false); w != nil {
+		out = w.ReadU16LEBits(bit)
+	}
+	return
+}
+
+// ReadU16LEBitsNext reads a uint16 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU16LEBitsNext() (out uint16) {
+	out = b.ReadU16LEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadU16BE reads a slice of uint16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU16BE(off, n int64) (out []uint16) {
+	if w := b.view("ReadU16BE", off, n, 2, false); w != nil && n > 0 {
+		out = w.ReadU16BE(0, n)
+	}
+	return
+}
+
+// ReadU16BENext reads a slice of uint16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU16BENext(n int64) (out []uint16) {
+	out = b.ReadU16BE(b.off, n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadU16BEAt reads a uint16 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU16BEAt(off int64) (out uint16) {
+	if w := b.view("ReadU16BEAt", off, 1, 2, false); w != nil {
+		out = w.ReadU16BEAt(0)
+	}
+	return
+}
+
+// ReadU16BEAtNext reads a uint16 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU16BEAtNext() (out uint16) {
+	out = b.ReadU16BEAt(b.off)
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadU16BEInto reads len(dst) uint16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU16BEInto(dst []uint16, off int64) {
+	if w := b.view("ReadU16BEInto", off, int64(len(dst)), 2, false); w != nil {
+		w.ReadU16BEInto(dst, 0)
+	}
+}
+
+// ReadU16BEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU16BEIntoNext(dst []uint16) {
+	b.ReadU16BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadU16BEBits reads a uint16 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU16BEBits(off int64) (out uint16) {
+	if w, bit := b.bitView("ReadU16BEBits", off, 16, false); w != nil {
+		out = w.ReadU16BEBits(bit)
+	}
+	return
+}
+
+// ReadU16BEBitsNext reads a uint16 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU16BEBitsNext() (out uint16) {
+	out = b.ReadU16BEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadU24LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU24LE(off, n int64) (out []uint32) {
+	if w := b.view("ReadU24LE", off, n, 3, false); w != nil && n > 0 {
+		out = w.ReadU24LE(0, n)
+	}
+	return
+}
+
+// ReadU24LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU24LENext(n int64) (out []uint32) {
+	out = b.ReadU24LE(b.off, n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadU24LEAt reads a uint32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU24LEAt(off int64) (out uint32) {
+	if w := b.view("ReadU24LEAt", off, 1, 3, false); w != nil {
+		out = w.ReadU24LEAt(0)
+	}
+	return
+}
+
+// ReadU24LEAtNext reads a uint32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU24LEAtNext() (out uint32) {
+	out = b.ReadU24LEAt(b.off)
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadU24LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU24LEInto(dst []uint32, off int64) {
+	if w := b.view("ReadU24LEInto", off, int64(len(dst)), 3, false); w != nil {
+		w.ReadU24LEInto(dst, 0)
+	}
+}
+
+// ReadU24LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU24LEIntoNext(dst []uint32) {
+	b.ReadU24LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadU24LEBits reads a uint32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU24LEBits(off int64) (out uint32) {
+	if w, bit := b.bitView("ReadU24LEBits", off, 24, false); w != nil {
+		out = w.ReadU24LEBits(bit)
+	}
+	return
+}
+
+// ReadU24LEBitsNext reads a uint32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU24LEBitsNext() (out uint32) {
+	out = b.ReadU24LEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadU24BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU24BE(off, n int64) (out []uint32) {
+	if w := b.view("ReadU24BE", off, n, 3, false); w != nil && n > 0 {
+		out = w.ReadU24BE(0, n)
+	}
+	return
+}
+
+// ReadU24BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU24BENext(n int64) (out []uint32) {
+	out = b.ReadU24BE(b.off, n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadU24BEAt reads a uint32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU24BEAt(off int64) (out uint32) {
+	if w := b.view("ReadU24BEAt", off, 1, 3, false); w != nil {
+		out = w.ReadU24BEAt(0)
+	}
+	return
+}
+
+// ReadU24BEAtNext reads a uint32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU24BEAtNext() (out uint32) {
+	out = b.ReadU24BEAt(b.off)
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadU24BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU24BEInto(dst []uint32, off int64) {
+	if w := b.view("ReadU24BEInto", off, int64(len(dst)), 3, false); w != nil {
+		w.ReadU24BEInto(dst, 0)
+	}
+}
+
+// ReadU24BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU24BEIntoNext(dst []uint32) {
+	b.ReadU24BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadU24BEBits reads a uint32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU24BEBits(off int64) (out uint32) {
+	if w, bit := b.bitView("ReadU24BEBits", off, 24, false); w != nil {
+		out = w.ReadU24BEBits(bit)
+	}
+	return
+}
+
+// ReadU24BEBitsNext reads a uint32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU24BEBitsNext() (out uint32) {
+	out = b.ReadU24BEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadU32LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU32LE(off, n int64) (out []uint32) {
+	if w := b.view("ReadU32LE", off, n, 4, false); w != nil && n > 0 {
+		out = w.ReadU32LE(0, n)
+	}
+	return
+}
+
+// ReadU32LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU32LENext(n int64) (out []uint32) {
+	out = b.ReadU32LE(b.off, n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadU32LEAt reads a uint32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU32LEAt(off int64) (out uint32) {
+	if w := b.view("ReadU32LEAt", off, 1, 4, false); w != nil {
+		out = w.ReadU32LEAt(0)
+	}
+	return
+}
+
+// ReadU32LEAtNext reads a uint32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU32LEAtNext() (out uint32) {
+	out = b.ReadU32LEAt(b.off)
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadU32LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU32LEInto(dst []uint32, off int64) {
+	if w := b.view("ReadU32LEInto", off, int64(len(dst)), 4, false); w != nil {
+		w.ReadU32LEInto(dst, 0)
+	}
+}
+
+// ReadU32LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU32LEIntoNext(dst []uint32) {
+	b.ReadU32LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadU32LEBits reads a uint32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU32LEBits(off int64) (out uint32) {
+	if w, bit := b.bitView("ReadU32LEBits", off, 32, false); w != nil {
+		out = w.ReadU32LEBits(bit)
+	}
+	return
+}
+
+// ReadU32LEBitsNext reads a uint32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU32LEBitsNext() (out uint32) {
+	out = b.ReadU32LEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadU32BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU32BE(off, n int64) (out []uint32) {
+	if w := b.view("ReadU32BE", off, n, 4, false); w != nil && n > 0 {
+		out = w.ReadU32BE(0, n)
+	}
+	return
+}
+
+// ReadU32BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU32BENext(n int64) (out []uint32) {
+	out = b.ReadU32BE(b.off, n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadU32BEAt reads a uint32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU32BEAt(off int64) (out uint32) {
+	if w := b.view("ReadU32BEAt", off, 1, 4, false); w != nil {
+		out = w.ReadU32BEAt(0)
+	}
+	return
+}
+
+// ReadU32BEAtNext reads a uint32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU32BEAtNext() (out uint32) {
+	out = b.ReadU32BEAt(b.off)
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadU32BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU32BEInto(dst []uint32, off int64) {
+	if w := b.view("ReadU32BEInto", off, int64(len(dst)), 4, false); w != nil {
+		w.ReadU32BEInto(dst, 0)
+	}
+}
+
+// ReadU32BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU32BEIntoNext(dst []uint32) {
+	b.ReadU32BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadU32BEBits reads a uint32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU32BEBits(off int64) (out uint32) {
+	if w, bit := b.bitView("ReadU32BEBits", off, 32, false); w != nil {
+		out = w.ReadU32BEBits(bit)
+	}
+	return
+}
+
+// ReadU32BEBitsNext reads a uint32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU32BEBitsNext() (out uint32) {
+	out = b.ReadU32BEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadU40LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU40LE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU40LE", off, n, 5, false); w != nil && n > 0 {
+		out = w.ReadU40LE(0, n)
+	}
+	return
+}
+
+// ReadU40LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU40LENext(n int64) (out []uint64) {
+	out = b.ReadU40LE(b.off, n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadU40LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU40LEAt(off int64) (out uint64) {
+	if w := b.view("ReadU40LEAt", off, 1, 5, false); w != nil {
+		out = w.ReadU40LEAt(0)
+	}
+	return
+}
+
+// ReadU40LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU40LEAtNext() (out uint64) {
+	out = b.ReadU40LEAt(b.off)
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadU40LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU40LEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU40LEInto", off, int64(len(dst)), 5, false); w != nil {
+		w.ReadU40LEInto(dst, 0)
+	}
+}
+
+// ReadU40LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU40LEIntoNext(dst []uint64) {
+	b.ReadU40LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadU40LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU40LEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU40LEBits", off, 40, false); w != nil {
+		out = w.ReadU40LEBits(bit)
+	}
+	return
+}
+
+// ReadU40LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU40LEBitsNext() (out uint64) {
+	out = b.ReadU40LEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
+
+// ReadU40BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU40BE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU40BE", off, n, 5, false); w != nil && n > 0 {
+		out = w.ReadU40BE(0, n)
+	}
+	return
+}
+
+// ReadU40BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU40BENext(n int64) (out []uint64) {
+	out = b.ReadU40BE(b.off, n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadU40BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU40BEAt(off int64) (out uint64) {
+	if w := b.view("ReadU40BEAt", off, 1, 5, false); w != nil {
+		out = w.ReadU40BEAt(0)
+	}
+	return
+}
+
+// ReadU40BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU40BEAtNext() (out uint64) {
+	out = b.ReadU40BEAt(b.off)
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadU40BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU40BEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU40BEInto", off, int64(len(dst)), 5, false); w != nil {
+		w.ReadU40BEInto(dst, 0)
+	}
+}
+
+// ReadU40BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU40BEIntoNext(dst []uint64) {
+	b.ReadU40BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadU40BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU40BEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU40BEBits", off, 40, false); w != nil {
+		out = w.ReadU40BEBits(bit)
+	}
+	return
+}
+
+// ReadU40BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU40BEBitsNext() (out uint64) {
+	out = b.ReadU40BEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
+
+// ReadU48LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU48LE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU48LE", off, n, 6, false); w != nil && n > 0 {
+		out = w.ReadU48LE(0, n)
+	}
+	return
+}
+
+// ReadU48LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU48LENext(n int64) (out []uint64) {
+	out = b.ReadU48LE(b.off, n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadU48LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU48LEAt(off int64) (out uint64) {
+	if w := b.view("ReadU48LEAt", off, 1, 6, false); w != nil {
+		out = w.ReadU48LEAt(0)
+	}
+	return
+}
+
+// ReadU48LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU48LEAtNext() (out uint64) {
+	out = b.ReadU48LEAt(b.off)
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadU48LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU48LEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU48LEInto", off, int64(len(dst)), 6, false); w != nil {
+		w.ReadU48LEInto(dst, 0)
+	}
+}
+
+// ReadU48LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU48LEIntoNext(dst []uint64) {
+	b.ReadU48LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadU48LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU48LEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU48LEBits", off, 48, false); w != nil {
+		out = w.ReadU48LEBits(bit)
+	}
+	return
+}
+
+// ReadU48LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU48LEBitsNext() (out uint64) {
+	out = b.ReadU48LEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadU48BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU48BE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU48BE", off, n, 6, false); w != nil && n > 0 {
+		out = w.ReadU48BE(0, n)
+	}
+	return
+}
+
+// ReadU48BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU48BENext(n int64) (out []uint64) {
+	out = b.ReadU48BE(b.off, n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadU48BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU48BEAt(off int64) (out uint64) {
+	if w := b.view("ReadU48BEAt", off, 1, 6, false); w != nil {
+		out = w.ReadU48BEAt(0)
+	}
+	return
+}
+
+// ReadU48BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU48BEAtNext() (out uint64) {
+	out = b.ReadU48BEAt(b.off)
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadU48BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU48BEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU48BEInto", off, int64(len(dst)), 6, false); w != nil {
+		w.ReadU48BEInto(dst, 0)
+	}
+}
+
+// ReadU48BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU48BEIntoNext(dst []uint64) {
+	b.ReadU48BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadU48BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU48BEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU48BEBits", off, 48, false); w != nil {
+		out = w.ReadU48BEBits(bit)
+	}
+	return
+}
+
+// ReadU48BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU48BEBitsNext() (out uint64) {
+	out = b.ReadU48BEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadU56LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU56LE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU56LE", off, n, 7, false); w != nil && n > 0 {
+		out = w.ReadU56LE(0, n)
+	}
+	return
+}
+
+// ReadU56LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU56LENext(n int64) (out []uint64) {
+	out = b.ReadU56LE(b.off, n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadU56LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU56LEAt(off int64) (out uint64) {
+	if w := b.view("ReadU56LEAt", off, 1, 7, false); w != nil {
+		out = w.ReadU56LEAt(0)
+	}
+	return
+}
+
+// ReadU56LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU56LEAtNext() (out uint64) {
+	out = b.ReadU56LEAt(b.off)
+	b.SeekByte(7, true)
+	return
+}
+
+// ReadU56LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU56LEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU56LEInto", off, int64(len(dst)), 7, false); w != nil {
+		w.ReadU56LEInto(dst, 0)
+	}
+}
+
+// ReadU56LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU56LEIntoNext(dst []uint64) {
+	b.ReadU56LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadU56LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU56LEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU56LEBits", off, 56, false); w != nil {
+		out = w.ReadU56LEBits(bit)
+	}
+	return
+}
+
+// ReadU56LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU56LEBitsNext() (out uint64) {
+	out = b.ReadU56LEBits(b.boff)
+	b.SeekBit(56, true)
+	return
+}
+
+// ReadU56BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU56BE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU56BE", off, n, 7, false); w != nil && n > 0 {
+		out = w.ReadU56BE(0, n)
+	}
+	return
+}
+
+// ReadU56BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU56BENext(n int64) (out []uint64) {
+	out = b.ReadU56BE(b.off, n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadU56BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU56BEAt(off int64) (out uint64) {
+	if w := b.view("ReadU56BEAt", off, 1, 7, false); w != nil {
+		out = w.ReadU56BEAt(0)
+	}
+	return
+}
+
+// ReadU56BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU56BEAtNext() (out uint64) {
+	out = b.ReadU56BEAt(b.off)
+	b.SeekByte(7, true)
+	return
+}
+
+// ReadU56BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU56BEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU56BEInto", off, int64(len(dst)), 7, false); w != nil {
+		w.ReadU56BEInto(dst, 0)
+	}
+}
+
+// ReadU56BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU56BEIntoNext(dst []uint64) {
+	b.ReadU56BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadU56BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU56BEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU56BEBits", off, 56, false); w != nil {
+		out = w.ReadU56BEBits(bit)
+	}
+	return
+}
+
+// ReadU56BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU56BEBitsNext() (out uint64) {
+	out = b.ReadU56BEBits(b.boff)
+	b.SeekBit(56, true)
+	return
+}
+
+// ReadU64LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU64LE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU64LE", off, n, 8, false); w != nil && n > 0 {
+		out = w.ReadU64LE(0, n)
+	}
+	return
+}
+
+// ReadU64LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU64LENext(n int64) (out []uint64) {
+	out = b.ReadU64LE(b.off, n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadU64LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU64LEAt(off int64) (out uint64) {
+	if w := b.view("ReadU64LEAt", off, 1, 8, false); w != nil {
+		out = w.ReadU64LEAt(0)
+	}
+	return
+}
+
+// ReadU64LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU64LEAtNext() (out uint64) {
+	out = b.ReadU64LEAt(b.off)
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadU64LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU64LEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU64LEInto", off, int64(len(dst)), 8, false); w != nil {
+		w.ReadU64LEInto(dst, 0)
+	}
+}
+
+// ReadU64LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU64LEIntoNext(dst []uint64) {
+	b.ReadU64LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadU64LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU64LEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU64LEBits", off, 64, false); w != nil {
+		out = w.ReadU64LEBits(bit)
+	}
+	return
+}
+
+// ReadU64LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU64LEBitsNext() (out uint64) {
+	out = b.ReadU64LEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadU64BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadU64BE(off, n int64) (out []uint64) {
+	if w := b.view("ReadU64BE", off, n, 8, false); w != nil && n > 0 {
+		out = w.ReadU64BE(0, n)
+	}
+	return
+}
+
+// ReadU64BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadU64BENext(n int64) (out []uint64) {
+	out = b.ReadU64BE(b.off, n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadU64BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadU64BEAt(off int64) (out uint64) {
+	if w := b.view("ReadU64BEAt", off, 1, 8, false); w != nil {
+		out = w.ReadU64BEAt(0)
+	}
+	return
+}
+
+// ReadU64BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU64BEAtNext() (out uint64) {
+	out = b.ReadU64BEAt(b.off)
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadU64BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadU64BEInto(dst []uint64, off int64) {
+	if w := b.view("ReadU64BEInto", off, int64(len(dst)), 8, false); w != nil {
+		w.ReadU64BEInto(dst, 0)
+	}
+}
+
+// ReadU64BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadU64BEIntoNext(dst []uint64) {
+	b.ReadU64BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadU64BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadU64BEBits(off int64) (out uint64) {
+	if w, bit := b.bitView("ReadU64BEBits", off, 64, false); w != nil {
+		out = w.ReadU64BEBits(bit)
+	}
+	return
+}
+
+// ReadU64BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadU64BEBitsNext() (out uint64) {
+	out = b.ReadU64BEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadI16LE reads a slice of int16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI16LE(off, n int64) (out []int16) {
+	if w := b.view("ReadI16LE", off, n, 2, false); w != nil && n > 0 {
+		out = w.ReadI16LE(0, n)
+	}
+	return
+}
+
+// ReadI16LENext reads a slice of int16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI16LENext(n int64) (out []int16) {
+	out = b.ReadI16LE(b.off, n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadI16LEAt reads an int16 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI16LEAt(off int64) (out int16) {
+	if w := b.view("ReadI16LEAt", off, 1, 2, false); w != nil {
+		out = w.ReadI16LEAt(0)
+	}
+	return
+}
+
+// ReadI16LEAtNext reads an int16 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI16LEAtNext() (out int16) {
+	out = b.ReadI16LEAt(b.off)
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadI16LEInto reads len(dst) int16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI16LEInto(dst []int16, off int64) {
+	if w := b.view("ReadI16LEInto", off, int64(len(dst)), 2, false); w != nil {
+		w.ReadI16LEInto(dst, 0)
+	}
+}
+
+// ReadI16LEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI16LEIntoNext(dst []int16) {
+	b.ReadI16LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadI16LEBits reads an int16 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI16LEBits(off int64) (out int16) {
+	if w, bit := b.bitView("ReadI16LEBits", off, 16, false); w != nil {
+		out = w.ReadI16LEBits(bit)
+	}
+	return
+}
+
+// ReadI16LEBitsNext reads an int16 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI16LEBitsNext() (out int16) {
+	out = b.ReadI16LEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadI16BE reads a slice of int16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI16BE(off, n int64) (out []int16) {
+	if w := b.view("ReadI16BE", off, n, 2, false); w != nil && n > 0 {
+		out = w.ReadI16BE(0, n)
+	}
+	return
+}
+
+// ReadI16BENext reads a slice of int16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI16BENext(n int64) (out []int16) {
+	out = b.ReadI16BE(b.off, n)
+	b.SeekByte(n*2, true)
+	return
+}
+
+// ReadI16BEAt reads an int16 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI16BEAt(off int64) (out int16) {
+	if w := b.view("ReadI16BEAt", off, 1, 2, false); w != nil {
+		out = w.ReadI16BEAt(0)
+	}
+	return
+}
+
+// ReadI16BEAtNext reads an int16 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI16BEAtNext() (out int16) {
+	out = b.ReadI16BEAt(b.off)
+	b.SeekByte(2, true)
+	return
+}
+
+// ReadI16BEInto reads len(dst) int16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI16BEInto(dst []int16, off int64) {
+	if w := b.view("ReadI16BEInto", off, int64(len(dst)), 2, false); w != nil {
+		w.ReadI16BEInto(dst, 0)
+	}
+}
+
+// ReadI16BEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI16BEIntoNext(dst []int16) {
+	b.ReadI16BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadI16BEBits reads an int16 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI16BEBits(off int64) (out int16) {
+	if w, bit := b.bitView("ReadI16BEBits", off, 16, false); w != nil {
+		out = w.ReadI16BEBits(bit)
+	}
+	return
+}
+
+// ReadI16BEBitsNext reads an int16 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI16BEBitsNext() (out int16) {
+	out = b.ReadI16BEBits(b.boff)
+	b.SeekBit(16, true)
+	return
+}
+
+// ReadI24LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI24LE(off, n int64) (out []int32) {
+	if w := b.view("ReadI24LE", off, n, 3, false); w != nil && n > 0 {
+		out = w.ReadI24LE(0, n)
+	}
+	return
+}
+
+// ReadI24LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI24LENext(n int64) (out []int32) {
+	out = b.ReadI24LE(b.off, n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadI24LEAt reads an int32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI24LEAt(off int64) (out int32) {
+	if w := b.view("ReadI24LEAt", off, 1, 3, false); w != nil {
+		out = w.ReadI24LEAt(0)
+	}
+	return
+}
+
+// ReadI24LEAtNext reads an int32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI24LEAtNext() (out int32) {
+	out = b.ReadI24LEAt(b.off)
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadI24LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI24LEInto(dst []int32, off int64) {
+	if w := b.view("ReadI24LEInto", off, int64(len(dst)), 3, false); w != nil {
+		w.ReadI24LEInto(dst, 0)
+	}
+}
+
+// ReadI24LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI24LEIntoNext(dst []int32) {
+	b.ReadI24LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadI24LEBits reads an int32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI24LEBits(off int64) (out int32) {
+	if w, bit := b.bitView("ReadI24LEBits", off, 24, false); w != nil {
+		out = w.ReadI24LEBits(bit)
+	}
+	return
+}
+
+// ReadI24LEBitsNext reads an int32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI24LEBitsNext() (out int32) {
+	out = b.ReadI24LEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadI24BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI24BE(off, n int64) (out []int32) {
+	if w := b.view("ReadI24BE", off, n, 3, false); w != nil && n > 0 {
+		out = w.ReadI24BE(0, n)
+	}
+	return
+}
+
+// ReadI24BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI24BENext(n int64) (out []int32) {
+	out = b.ReadI24BE(b.off, n)
+	b.SeekByte(n*3, true)
+	return
+}
+
+// ReadI24BEAt reads an int32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI24BEAt(off int64) (out int32) {
+	if w := b.view("ReadI24BEAt", off, 1, 3, false); w != nil {
+		out = w.ReadI24BEAt(0)
+	}
+	return
+}
+
+// ReadI24BEAtNext reads an int32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI24BEAtNext() (out int32) {
+	out = b.ReadI24BEAt(b.off)
+	b.SeekByte(3, true)
+	return
+}
+
+// ReadI24BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI24BEInto(dst []int32, off int64) {
+	if w := b.view("ReadI24BEInto", off, int64(len(dst)), 3, false); w != nil {
+		w.ReadI24BEInto(dst, 0)
+	}
+}
+
+// ReadI24BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI24BEIntoNext(dst []int32) {
+	b.ReadI24BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadI24BEBits reads an int32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI24BEBits(off int64) (out int32) {
+	if w, bit := b.bitView("ReadI24BEBits", off, 24, false); w != nil {
+		out = w.ReadI24BEBits(bit)
+	}
+	return
+}
+
+// ReadI24BEBitsNext reads an int32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI24BEBitsNext() (out int32) {
+	out = b.ReadI24BEBits(b.boff)
+	b.SeekBit(24, true)
+	return
+}
+
+// ReadI32LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI32LE(off, n int64) (out []int32) {
+	if w := b.view("ReadI32LE", off, n, 4, false); w != nil && n > 0 {
+		out = w.ReadI32LE(0, n)
+	}
+	return
+}
+
+// ReadI32LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI32LENext(n int64) (out []int32) {
+	out = b.ReadI32LE(b.off, n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadI32LEAt reads an int32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI32LEAt(off int64) (out int32) {
+	if w := b.view("ReadI32LEAt", off, 1, 4, false); w != nil {
+		out = w.ReadI32LEAt(0)
+	}
+	return
+}
+
+// ReadI32LEAtNext reads an int32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI32LEAtNext() (out int32) {
+	out = b.ReadI32LEAt(b.off)
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadI32LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI32LEInto(dst []int32, off int64) {
+	if w := b.view("ReadI32LEInto", off, int64(len(dst)), 4, false); w != nil {
+		w.ReadI32LEInto(dst, 0)
+	}
+}
+
+// ReadI32LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI32LEIntoNext(dst []int32) {
+	b.ReadI32LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadI32LEBits reads an int32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI32LEBits(off int64) (out int32) {
+	if w, bit := b.bitView("ReadI32LEBits", off, 32, false); w != nil {
+		out = w.ReadI32LEBits(bit)
+	}
+	return
+}
+
+// ReadI32LEBitsNext reads an int32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI32LEBitsNext() (out int32) {
+	out = b.ReadI32LEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadI32BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI32BE(off, n int64) (out []int32) {
+	if w := b.view("ReadI32BE", off, n, 4, false); w != nil && n > 0 {
+		out = w.ReadI32BE(0, n)
+	}
+	return
+}
+
+// ReadI32BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI32BENext(n int64) (out []int32) {
+	out = b.ReadI32BE(b.off, n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadI32BEAt reads an int32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI32BEAt(off int64) (out int32) {
+	if w := b.view("ReadI32BEAt", off, 1, 4, false); w != nil {
+		out = w.ReadI32BEAt(0)
+	}
+	return
+}
+
+// ReadI32BEAtNext reads an int32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI32BEAtNext() (out int32) {
+	out = b.ReadI32BEAt(b.off)
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadI32BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI32BEInto(dst []int32, off int64) {
+	if w := b.view("ReadI32BEInto", off, int64(len(dst)), 4, false); w != nil {
+		w.ReadI32BEInto(dst, 0)
+	}
+}
+
+// ReadI32BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI32BEIntoNext(dst []int32) {
+	b.ReadI32BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadI32BEBits reads an int32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI32BEBits(off int64) (out int32) {
+	if w, bit := b.bitView("ReadI32BEBits", off, 32, false); w != nil {
+		out = w.ReadI32BEBits(bit)
+	}
+	return
+}
+
+// ReadI32BEBitsNext reads an int32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI32BEBitsNext() (out int32) {
+	out = b.ReadI32BEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadI40LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI40LE(off, n int64) (out []int64) {
+	if w := b.view("ReadI40LE", off, n, 5, false); w != nil && n > 0 {
+		out = w.ReadI40LE(0, n)
+	}
+	return
+}
+
+// ReadI40LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI40LENext(n int64) (out []int64) {
+	out = b.ReadI40LE(b.off, n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadI40LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI40LEAt(off int64) (out int64) {
+	if w := b.view("ReadI40LEAt", off, 1, 5, false); w != nil {
+		out = w.ReadI40LEAt(0)
+	}
+	return
+}
+
+// ReadI40LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI40LEAtNext() (out int64) {
+	out = b.ReadI40LEAt(b.off)
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadI40LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI40LEInto(dst []int64, off int64) {
+	if w := b.view("ReadI40LEInto", off, int64(len(dst)), 5, false); w != nil {
+		w.ReadI40LEInto(dst, 0)
+	}
+}
+
+// ReadI40LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI40LEIntoNext(dst []int64) {
+	b.ReadI40LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadI40LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI40LEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI40LEBits", off, 40, false); w != nil {
+		out = w.ReadI40LEBits(bit)
+	}
+	return
+}
+
+// ReadI40LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI40LEBitsNext() (out int64) {
+	out = b.ReadI40LEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
+
+// ReadI40BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI40BE(off, n int64) (out []int64) {
+	if w := b.view("ReadI40BE", off, n, 5, false); w != nil && n > 0 {
+		out = w.ReadI40BE(0, n)
+	}
+	return
+}
+
+// ReadI40BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI40BENext(n int64) (out []int64) {
+	out = b.ReadI40BE(b.off, n)
+	b.SeekByte(n*5, true)
+	return
+}
+
+// ReadI40BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI40BEAt(off int64) (out int64) {
+	if w := b.view("ReadI40BEAt", off, 1, 5, false); w != nil {
+		out = w.ReadI40BEAt(0)
+	}
+	return
+}
+
+// ReadI40BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI40BEAtNext() (out int64) {
+	out = b.ReadI40BEAt(b.off)
+	b.SeekByte(5, true)
+	return
+}
+
+// ReadI40BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI40BEInto(dst []int64, off int64) {
+	if w := b.view("ReadI40BEInto", off, int64(len(dst)), 5, false); w != nil {
+		w.ReadI40BEInto(dst, 0)
+	}
+}
+
+// ReadI40BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI40BEIntoNext(dst []int64) {
+	b.ReadI40BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadI40BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI40BEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI40BEBits", off, 40, false); w != nil {
+		out = w.ReadI40BEBits(bit)
+	}
+	return
+}
+
+// ReadI40BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI40BEBitsNext() (out int64) {
+	out = b.ReadI40BEBits(b.boff)
+	b.SeekBit(40, true)
+	return
+}
+
+// ReadI48LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI48LE(off, n int64) (out []int64) {
+	if w := b.view("ReadI48LE", off, n, 6, false); w != nil && n > 0 {
+		out = w.ReadI48LE(0, n)
+	}
+	return
+}
+
+// ReadI48LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI48LENext(n int64) (out []int64) {
+	out = b.ReadI48LE(b.off, n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadI48LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI48LEAt(off int64) (out int64) {
+	if w := b.view("ReadI48LEAt", off, 1, 6, false); w != nil {
+		out = w.ReadI48LEAt(0)
+	}
+	return
+}
+
+// ReadI48LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI48LEAtNext() (out int64) {
+	out = b.ReadI48LEAt(b.off)
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadI48LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI48LEInto(dst []int64, off int64) {
+	if w := b.view("ReadI48LEInto", off, int64(len(dst)), 6, false); w != nil {
+		w.ReadI48LEInto(dst, 0)
+	}
+}
+
+// ReadI48LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI48LEIntoNext(dst []int64) {
+	b.ReadI48LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadI48LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI48LEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI48LEBits", off, 48, false); w != nil {
+		out = w.ReadI48LEBits(bit)
+	}
+	return
+}
+
+// ReadI48LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI48LEBitsNext() (out int64) {
+	out = b.ReadI48LEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadI48BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI48BE(off, n int64) (out []int64) {
+	if w := b.view("ReadI48BE", off, n, 6, false); w != nil && n > 0 {
+		out = w.ReadI48BE(0, n)
+	}
+	return
+}
+
+// ReadI48BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI48BENext(n int64) (out []int64) {
+	out = b.ReadI48BE(b.off, n)
+	b.SeekByte(n*6, true)
+	return
+}
+
+// ReadI48BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI48BEAt(off int64) (out int64) {
+	if w := b.view("ReadI48BEAt", off, 1, 6, false); w != nil {
+		out = w.ReadI48BEAt(0)
+	}
+	return
+}
+
+// ReadI48BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI48BEAtNext() (out int64) {
+	out = b.ReadI48BEAt(b.off)
+	b.SeekByte(6, true)
+	return
+}
+
+// ReadI48BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI48BEInto(dst []int64, off int64) {
+	if w := b.view("ReadI48BEInto", off, int64(len(dst)), 6, false); w != nil {
+		w.ReadI48BEInto(dst, 0)
+	}
+}
+
+// ReadI48BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI48BEIntoNext(dst []int64) {
+	b.ReadI48BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadI48BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI48BEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI48BEBits", off, 48, false); w != nil {
+		out = w.ReadI48BEBits(bit)
+	}
+	return
+}
+
+// ReadI48BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI48BEBitsNext() (out int64) {
+	out = b.ReadI48BEBits(b.boff)
+	b.SeekBit(48, true)
+	return
+}
+
+// ReadI56LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI56LE(off, n int64) (out []int64) {
+	if w := b.view("ReadI56LE", off, n, 7, false); w != nil && n > 0 {
+		out = w.ReadI56LE(0, n)
+	}
+	return
+}
+
+// ReadI56LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI56LENext(n int64) (out []int64) {
+	out = b.ReadI56LE(b.off, n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadI56LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI56LEAt(off int64) (out int64) {
+	if w := b.view("ReadI56LEAt", off, 1, 7, false); w != nil {
+		out = w.ReadI56LEAt(0)
+	}
+	return
+}
+
+// ReadI56LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI56LEAtNext() (out int64) {
+	out = b.ReadI56LEAt(b.off)
+	b.SeekByte(7, true)
+	return
+}
+
+// ReadI56LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI56LEInto(dst []int64, off int64) {
+	if w := b.view("ReadI56LEInto", off, int64(len(dst)), 7, false); w != nil {
+		w.ReadI56LEInto(dst, 0)
+	}
+}
+
+// ReadI56LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI56LEIntoNext(dst []int64) {
+	b.ReadI56LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadI56LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI56LEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI56LEBits", off, 56, false); w != nil {
+		out = w.ReadI56LEBits(bit)
+	}
+	return
+}
+
+// ReadI56LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI56LEBitsNext() (out int64) {
+	out = b.ReadI56LEBits(b.boff)
+	b.SeekBit(56, true)
+	return
+}
+
+// ReadI56BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI56BE(off, n int64) (out []int64) {
+	if w := b.view("ReadI56BE", off, n, 7, false); w != nil && n > 0 {
+		out = w.ReadI56BE(0, n)
+	}
+	return
+}
+
+// ReadI56BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI56BENext(n int64) (out []int64) {
+	out = b.ReadI56BE(b.off, n)
+	b.SeekByte(n*7, true)
+	return
+}
+
+// ReadI56BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI56BEAt(off int64) (out int64) {
+	if w := b.view("ReadI56BEAt", off, 1, 7, false); w != nil {
+		out = w.ReadI56BEAt(0)
+	}
+	return
+}
+
+// ReadI56BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI56BEAtNext() (out int64) {
+	out = b.ReadI56BEAt(b.off)
+	b.SeekByte(7, true)
+	return
+}
+
+// ReadI56BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI56BEInto(dst []int64, off int64) {
+	if w := b.view("ReadI56BEInto", off, int64(len(dst)), 7, false); w != nil {
+		w.ReadI56BEInto(dst, 0)
+	}
+}
+
+// ReadI56BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI56BEIntoNext(dst []int64) {
+	b.ReadI56BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadI56BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI56BEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI56BEBits", off, 56, false); w != nil {
+		out = w.ReadI56BEBits(bit)
+	}
+	return
+}
+
+// ReadI56BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI56BEBitsNext() (out int64) {
+	out = b.ReadI56BEBits(b.boff)
+	b.SeekBit(56, true)
+	return
+}
+
+// ReadI64LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI64LE(off, n int64) (out []int64) {
+	if w := b.view("ReadI64LE", off, n, 8, false); w != nil && n > 0 {
+		out = w.ReadI64LE(0, n)
+	}
+	return
+}
+
+// ReadI64LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI64LENext(n int64) (out []int64) {
+	out = b.ReadI64LE(b.off, n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadI64LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI64LEAt(off int64) (out int64) {
+	if w := b.view("ReadI64LEAt", off, 1, 8, false); w != nil {
+		out = w.ReadI64LEAt(0)
+	}
+	return
+}
+
+// ReadI64LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI64LEAtNext() (out int64) {
+	out = b.ReadI64LEAt(b.off)
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadI64LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI64LEInto(dst []int64, off int64) {
+	if w := b.view("ReadI64LEInto", off, int64(len(dst)), 8, false); w != nil {
+		w.ReadI64LEInto(dst, 0)
+	}
+}
+
+// ReadI64LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI64LEIntoNext(dst []int64) {
+	b.ReadI64LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadI64LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI64LEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI64LEBits", off, 64, false); w != nil {
+		out = w.ReadI64LEBits(bit)
+	}
+	return
+}
+
+// ReadI64LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI64LEBitsNext() (out int64) {
+	out = b.ReadI64LEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadI64BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadI64BE(off, n int64) (out []int64) {
+	if w := b.view("ReadI64BE", off, n, 8, false); w != nil && n > 0 {
+		out = w.ReadI64BE(0, n)
+	}
+	return
+}
+
+// ReadI64BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadI64BENext(n int64) (out []int64) {
+	out = b.ReadI64BE(b.off, n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadI64BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadI64BEAt(off int64) (out int64) {
+	if w := b.view("ReadI64BEAt", off, 1, 8, false); w != nil {
+		out = w.ReadI64BEAt(0)
+	}
+	return
+}
+
+// ReadI64BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI64BEAtNext() (out int64) {
+	out = b.ReadI64BEAt(b.off)
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadI64BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadI64BEInto(dst []int64, off int64) {
+	if w := b.view("ReadI64BEInto", off, int64(len(dst)), 8, false); w != nil {
+		w.ReadI64BEInto(dst, 0)
+	}
+}
+
+// ReadI64BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadI64BEIntoNext(dst []int64) {
+	b.ReadI64BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadI64BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadI64BEBits(off int64) (out int64) {
+	if w, bit := b.bitView("ReadI64BEBits", off, 64, false); w != nil {
+		out = w.ReadI64BEBits(bit)
+	}
+	return
+}
+
+// ReadI64BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadI64BEBitsNext() (out int64) {
+	out = b.ReadI64BEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadF32LE reads a slice of float32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadF32LE(off, n int64) (out []float32) {
+	if w := b.view("ReadF32LE", off, n, 4, false); w != nil && n > 0 {
+		out = w.ReadF32LE(0, n)
+	}
+	return
+}
+
+// ReadF32LENext reads a slice of float32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadF32LENext(n int64) (out []float32) {
+	out = b.ReadF32LE(b.off, n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadF32LEAt reads a float32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadF32LEAt(off int64) (out float32) {
+	if w := b.view("ReadF32LEAt", off, 1, 4, false); w != nil {
+		out = w.ReadF32LEAt(0)
+	}
+	return
+}
+
+// ReadF32LEAtNext reads a float32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF32LEAtNext() (out float32) {
+	out = b.ReadF32LEAt(b.off)
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadF32LEInto reads len(dst) float32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadF32LEInto(dst []float32, off int64) {
+	if w := b.view("ReadF32LEInto", off, int64(len(dst)), 4, false); w != nil {
+		w.ReadF32LEInto(dst, 0)
+	}
+}
+
+// ReadF32LEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF32LEIntoNext(dst []float32) {
+	b.ReadF32LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadF32LEBits reads a float32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadF32LEBits(off int64) (out float32) {
+	if w, bit := b.bitView("ReadF32LEBits", off, 32, false); w != nil {
+		out = w.ReadF32LEBits(bit)
+	}
+	return
+}
+
+// ReadF32LEBitsNext reads a float32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadF32LEBitsNext() (out float32) {
+	out = b.ReadF32LEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadF32BE reads a slice of float32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadF32BE(off, n int64) (out []float32) {
+	if w := b.view("ReadF32BE", off, n, 4, false); w != nil && n > 0 {
+		out = w.ReadF32BE(0, n)
+	}
+	return
+}
+
+// ReadF32BENext reads a slice of float32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadF32BENext(n int64) (out []float32) {
+	out = b.ReadF32BE(b.off, n)
+	b.SeekByte(n*4, true)
+	return
+}
+
+// ReadF32BEAt reads a float32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadF32BEAt(off int64) (out float32) {
+	if w := b.view("ReadF32BEAt", off, 1, 4, false); w != nil {
+		out = w.ReadF32BEAt(0)
+	}
+	return
+}
+
+// ReadF32BEAtNext reads a float32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF32BEAtNext() (out float32) {
+	out = b.ReadF32BEAt(b.off)
+	b.SeekByte(4, true)
+	return
+}
+
+// ReadF32BEInto reads len(dst) float32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadF32BEInto(dst []float32, off int64) {
+	if w := b.view("ReadF32BEInto", off, int64(len(dst)), 4, false); w != nil {
+		w.ReadF32BEInto(dst, 0)
+	}
+}
+
+// ReadF32BEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF32BEIntoNext(dst []float32) {
+	b.ReadF32BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadF32BEBits reads a float32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadF32BEBits(off int64) (out float32) {
+	if w, bit := b.bitView("ReadF32BEBits", off, 32, false); w != nil {
+		out = w.ReadF32BEBits(bit)
+	}
+	return
+}
+
+// ReadF32BEBitsNext reads a float32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadF32BEBitsNext() (out float32) {
+	out = b.ReadF32BEBits(b.boff)
+	b.SeekBit(32, true)
+	return
+}
+
+// ReadF64LE reads a slice of float64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadF64LE(off, n int64) (out []float64) {
+	if w := b.view("ReadF64LE", off, n, 8, false); w != nil && n > 0 {
+		out = w.ReadF64LE(0, n)
+	}
+	return
+}
+
+// ReadF64LENext reads a slice of float64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadF64LENext(n int64) (out []float64) {
+	out = b.ReadF64LE(b.off, n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadF64LEAt reads a float64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadF64LEAt(off int64) (out float64) {
+	if w := b.view("ReadF64LEAt", off, 1, 8, false); w != nil {
+		out = w.ReadF64LEAt(0)
+	}
+	return
+}
+
+// ReadF64LEAtNext reads a float64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF64LEAtNext() (out float64) {
+	out = b.ReadF64LEAt(b.off)
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadF64LEInto reads len(dst) float64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadF64LEInto(dst []float64, off int64) {
+	if w := b.view("ReadF64LEInto", off, int64(len(dst)), 8, false); w != nil {
+		w.ReadF64LEInto(dst, 0)
+	}
+}
+
+// ReadF64LEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF64LEIntoNext(dst []float64) {
+	b.ReadF64LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadF64LEBits reads a float64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadF64LEBits(off int64) (out float64) {
+	if w, bit := b.bitView("ReadF64LEBits", off, 64, false); w != nil {
+		out = w.ReadF64LEBits(bit)
+	}
+	return
+}
+
+// ReadF64LEBitsNext reads a float64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadF64LEBitsNext() (out float64) {
+	out = b.ReadF64LEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// ReadF64BE reads a slice of float64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *ChainBuffer) ReadF64BE(off, n int64) (out []float64) {
+	if w := b.view("ReadF64BE", off, n, 8, false); w != nil && n > 0 {
+		out = w.ReadF64BE(0, n)
+	}
+	return
+}
+
+// ReadF64BENext reads a slice of float64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read
+func (b *ChainBuffer) ReadF64BENext(n int64) (out []float64) {
+	out = b.ReadF64BE(b.off, n)
+	b.SeekByte(n*8, true)
+	return
+}
+
+// ReadF64BEAt reads a float64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *ChainBuffer) ReadF64BEAt(off int64) (out float64) {
+	if w := b.view("ReadF64BEAt", off, 1, 8, false); w != nil {
+		out = w.ReadF64BEAt(0)
+	}
+	return
+}
+
+// ReadF64BEAtNext reads a float64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF64BEAtNext() (out float64) {
+	out = b.ReadF64BEAt(b.off)
+	b.SeekByte(8, true)
+	return
+}
+
+// ReadF64BEInto reads len(dst) float64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *ChainBuffer) ReadF64BEInto(dst []float64, off int64) {
+	if w := b.view("ReadF64BEInto", off, int64(len(dst)), 8, false); w != nil {
+		w.ReadF64BEInto(dst, 0)
+	}
+}
+
+// ReadF64BEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *ChainBuffer) ReadF64BEIntoNext(dst []float64) {
+	b.ReadF64BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadF64BEBits reads a float64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *ChainBuffer) ReadF64BEBits(off int64) (out float64) {
+	if w, bit := b.bitView("ReadF64BEBits", off, 64, false); w != nil {
+		out = w.ReadF64BEBits(bit)
+	}
+	return
+}
+
+// ReadF64BEBitsNext reads a float64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *ChainBuffer) ReadF64BEBitsNext() (out float64) {
+	out = b.ReadF64BEBits(b.boff)
+	b.SeekBit(64, true)
+	return
+}
+
+// SeekByte seeks to position off of the byte slice relative to the
+// current offset or to the beginning of the buffer, depending on
+// relative
+func (b *ChainBuffer) SeekByte(off int64, relative bool) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		b.off += off
+
+	} else {
+
+		b.off = off
+
+	}
+
+}
+
+// AfterByte returns the amount of bytes located after the current
+// position or the specified one
+func (b *ChainBuffer) AfterByte(off ...int64) int64 {
+
+	if len(off) == 0 {
+
+		return b.cap - b.off - 1
+
+	}
+	return b.cap - off[0] - 1
+
+}
+
+// AlignByte aligns the byte offset to the bit offset
+func (b *ChainBuffer) AlignByte() {
+
+	b.off = b.boff / 8
+
+}
+
+// Append adds a chunk to the end of the buffer without copying it
+func (b *ChainBuffer) Append(chunk []byte) {
+
+	if b.err != nil || len(chunk) == 0x00 {
+
+		return
+
+	}
+
+	b.link(chunk)
+	b.owned = false
+
+}
+
+// Grow makes the buffer's capacity bigger by n bytes, adding a new
+// chunk to it if the last one was not allocated by the buffer or is
+// out of room
+func (b *ChainBuffer) Grow(n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0 {
+
+		b.fail(BufferInvalidByteCountError.count("Grow", n, b.cap))
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	if last := len(b.chunks) - 1; b.owned && n <= int64(cap(b.chunks[last])-len(b.chunks[last])) {
+
+		b.chunks[last] = b.chunks[last][:int64(len(b.chunks[last]))+n]
+		b.cap += n
+		b.bcap = b.cap * 8
+		return
+
+	}
+
+	b.link(make([]byte, n, b.cap+n))
+	b.owned = true
+
+}
+
+// Refresh updates the cached internal statistics of the buffer forcefully
+func (b *ChainBuffer) Refresh() {
+
+	b.starts = b.starts[:0]
+	b.cap = 0x00
+	for _, chunk := range b.chunks {
+
+		b.starts = append(b.starts, b.cap)
+		b.cap += int64(len(chunk))
+
+	}
+	b.bcap = b.cap * 8
+
+}
+
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the buffer. it defaults to MSBFirst
+func (b *ChainBuffer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// SetGrowth enables or disables automatic growth. while it is
+// enabled, writes past the end of the buffer grow it with Grow instead
+// of failing, as long as it would not become longer than max bytes. a
+// max of zero or less means that the buffer may grow without limit
+func (b *ChainBuffer) SetGrowth(enabled bool, max int64) {
+
+	b.grow = enabled
+	b.gmax = max
+
+}
+
+// SetSticky enables or disables sticky mode. in sticky mode, the
+// first out-of-range operation records an error on the buffer instead
+// of panicking and every following call becomes a no-op that returns
+// zero values until the error is cleared
+func (b *ChainBuffer) SetSticky(sticky bool) {
+
+	b.sticky = sticky
+
+}
+
+// ClearErr clears the error recorded by the buffer in sticky mode
+func (b *ChainBuffer) ClearErr() {
+
+	b.err = nil
+
+}
+
+// Reset removes every chunk from the buffer and resets its offsets
+func (b *ChainBuffer) Reset() {
+
+	b.chunks = b.chunks[:0]
+	b.owned = false
+	b.off = 0x00
+	b.boff = 0x00
+	b.err = nil
+	b.Refresh()
+
+}
+
+// Bytes returns the contents of the buffer copied into a single slice
+func (b *ChainBuffer) Bytes() []byte {
+
+	out := make([]byte, b.cap)
+	b.gather(out, 0x00)
+	return out
+
+}
+
+// Chunks returns the slices the buffer is made of. they are not copied
+func (b *ChainBuffer) Chunks() [][]byte {
+
+	return b.chunks
+
+}
+
+// Buffers returns the bytes from the current offset to the end of the
+// buffer as a net.Buffers without copying them
+func (b *ChainBuffer) Buffers() (out net.Buffers) {
+
+	if b.off < 0x00 || b.off >= b.cap {
+
+		return
+
+	}
+
+	i, inner := b.locate(b.off)
+	out = append(out, b.chunks[i][inner:])
+	return append(out, b.chunks[i+1:]...)
+
+}
+
+// WriteTo writes the bytes from the current offset to the end of the
+// buffer to w and moves the offset forward the amount of bytes
+// written. the chunks are written with a single vectored write if w
+// supports it, such as when it is a *net.TCPConn
+func (b *ChainBuffer) WriteTo(w io.Writer) (n int64, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderreadError.at("WriteTo", b.off, 0, b.cap)
+
+	}
+
+	buffers := b.Buffers()
+	n, err = buffers.WriteTo(w)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ByteCapacity returns the capacity of the buffer
+func (b *ChainBuffer) ByteCapacity() int64 {
+
+	return b.cap
+
+}
+
+// BitCapacity returns the bit capacity of the buffer
+func (b *ChainBuffer) BitCapacity() int64 {
+
+	return b.bcap
+
+}
+
+// ByteOffset returns the current offset of the buffer
+func (b *ChainBuffer) ByteOffset() int64 {
+
+	return b.off
+
+}
+
+// BitOffset returns the current bit offset of the buffer
+func (b *ChainBuffer) BitOffset() int64 {
+
+	return b.boff
+
+}
+
+// Err returns the error recorded by the buffer in sticky mode, if any
+func (b *ChainBuffer) Err() error {
+
+	return b.err
+
+}
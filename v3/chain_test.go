@@ -0,0 +1,396 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+// splitBytes splits data into chunks at the provided offsets
+func splitBytes(data []byte, offsets ...int) (chunks [][]byte) {
+
+	last := 0
+	for _, off := range offsets {
+
+		chunks = append(chunks, data[last:off])
+		last = off
+
+	}
+	return append(chunks, data[last:])
+
+}
+
+/*
+
+tests
+
+*/
+
+func TestNewChainBuffer(t *testing.T) {
+
+	var (
+		header = []byte{0x01, 0x02}
+		body   = []byte{0x03, 0x04, 0x05}
+	)
+
+	buf := NewChainBuffer(header, []byte{}, body)
+	if buf.ByteCapacity() != 5 || buf.BitCapacity() != 40 || len(buf.Chunks()) != 2 {
+
+		t.Fatalf("unexpected capacity (got %d bytes in %d chunks, expected 5 bytes in 2 chunks)", buf.ByteCapacity(), len(buf.Chunks()))
+
+	}
+
+	if expected := []byte{0x01, 0x02, 0x03, 0x04, 0x05}; !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	// the chunks are not copied
+	buf.WriteBytes(0x01, []byte{0xaa, 0xbb})
+	if header[1] != 0xaa || body[0] != 0xbb {
+
+		t.Fatalf("writes were not visible in the chunks (got %#v and %#v)", header, body)
+
+	}
+
+}
+
+func TestChainBufferSpanning(t *testing.T) {
+
+	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	buf := NewChainBuffer(splitBytes(append([]byte{}, data...), 1, 3, 6)...)
+	ref := NewBuffer(data)
+
+	for off := int64(0); off <= 4; off++ {
+
+		if out, expected := buf.ReadU32BEAt(off), ref.ReadU32BEAt(off); out != expected {
+
+			t.Fatalf("offset %d: expected value does not match the one gotten (got %#v, expected %#v)", off, out, expected)
+
+		}
+
+		if out, expected := buf.ReadI24LE(off, 1), ref.ReadI24LE(off, 1); !cmp.Equal(expected, out) {
+
+			t.Fatalf("offset %d: expected values do not match the ones gotten (got %#v, expected %#v)", off, out, expected)
+
+		}
+
+		if out, expected := buf.ReadU24LEBits(off*8+3), ref.ReadU24LEBits(off*8+3); out != expected {
+
+			t.Fatalf("offset %d: expected value does not match the one gotten (got %#v, expected %#v)", off, out, expected)
+
+		}
+
+	}
+
+	if out := buf.ReadU16BE(0x00, 4); !cmp.Equal([]uint16{0x0102, 0x0304, 0x0506, 0x0708}, out) {
+
+		t.Fatalf("expected values do not match the ones gotten (got %#v)", out)
+
+	}
+
+	if out := buf.ReadBytes(0x02, 3); !cmp.Equal([]byte{0x03, 0x04, 0x05}, out) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v)", out)
+
+	}
+
+	if out := buf.ReadBits(0x05, 8); out != 0x20 {
+
+		t.Fatalf("expected bits do not match the ones gotten (got %#v, expected %#v)", out, 0x20)
+
+	}
+
+}
+
+func TestChainBufferWrite(t *testing.T) {
+
+	buf := NewChainBuffer(splitBytes(make([]byte, 16), 1, 2, 5, 11)...)
+	ref := NewBuffer(make([]byte, 16))
+
+	for _, b := range []interface {
+		PutU32LE(int64, uint32)
+		PutI48BE(int64, int64)
+		WriteF64LE(int64, []float64)
+		PutU16BEBits(int64, uint16)
+		SetBits(int64, uint64, int64)
+		FlipBit(int64)
+	}{buf, ref} {
+
+		b.PutU32LE(0x00, 0xdeadbeef)
+		b.PutI48BE(0x03, -2)
+		b.WriteF64LE(0x08, []float64{1.5})
+		b.PutU16BEBits(0x07, 0xabcd)
+		b.SetBits(0x2e, 0x1ff, 9)
+		b.FlipBit(0x7f)
+
+	}
+
+	if !cmp.Equal(ref.Bytes(), buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), ref.Bytes())
+
+	}
+
+}
+
+func TestChainBufferNext(t *testing.T) {
+
+	buf := NewChainBuffer(make([]byte, 3), make([]byte, 3))
+
+	buf.PutU16LENext(0x0102)
+	buf.PutU24BENext(0x030405)
+	// the bit offset is independent, so these overwrite the first bytes
+	buf.SetBitsNext(0x05, 3)
+	buf.PutU16BEBitsNext(0xffff)
+	if buf.ByteOffset() != 5 || buf.BitOffset() != 19 {
+
+		t.Fatalf("incorrect offsets (got %d and %d, expected 5 and 19)", buf.ByteOffset(), buf.BitOffset())
+
+	}
+
+	buf.SeekByte(0x00, false)
+	if a, b := buf.ReadU16LEAtNext(), buf.ReadU24BENext(1); a != 0xffbf || !cmp.Equal([]uint32{0xe30405}, b) {
+
+		t.Fatalf("expected values do not match the ones gotten (got %#v and %#v)", a, b)
+
+	}
+
+}
+
+func TestChainBufferGrowth(t *testing.T) {
+
+	backing := []byte{0x01, 0x02, 0xff, 0xff}
+
+	buf := NewChainBuffer(backing[:2])
+	buf.SetGrowth(true, 0)
+	buf.SeekByte(0x02, false)
+
+	buf.WriteByteNext(0x03)
+	buf.WriteByteNext(0x04)
+	buf.PutU24BENext(0x050607)
+	if expected := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}; !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	// the spare capacity of a chunk that was not allocated by the buffer
+	// is left alone, while the buffer's own ones are reused
+	if !cmp.Equal([]byte{0x01, 0x02, 0xff, 0xff}, backing) || len(buf.Chunks()) != 3 || len(buf.Chunks()[1]) != 2 {
+
+		t.Fatalf("unexpected chunks (got %#v with backing array %#v)", buf.Chunks(), backing)
+
+	}
+
+	buf.SetGrowth(true, 8)
+	buf.SetSticky(true)
+	buf.WriteBytesNext([]byte{0x08, 0x09})
+	if !errors.Is(buf.Err(), BufferOverwriteError) || buf.ByteCapacity() != 7 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverwriteError)
+
+	}
+
+}
+
+func TestChainBufferAppend(t *testing.T) {
+
+	data := countingBytes(400)
+
+	// chunks appended by hand and allocated by the buffer, which the
+	// second write extends, are mixed so that the cached offsets of all
+	// of them are checked
+	buf := NewChainBuffer()
+	buf.SetGrowth(true, 0)
+	for i := 0; i < len(data); i += 4 {
+
+		buf.Append(data[i : i+2])
+		buf.SeekByte(buf.ByteCapacity(), false)
+		buf.WriteByteNext(data[i+2])
+		buf.WriteByteNext(data[i+3])
+
+	}
+
+	if buf.ByteCapacity() != 400 || buf.BitCapacity() != 3200 || len(buf.Chunks()) != 200 {
+
+		t.Fatalf("unexpected capacity (got %d bytes in %d chunks, expected 400 bytes in 200 chunks)", buf.ByteCapacity(), len(buf.Chunks()))
+
+	}
+
+	for i := range data {
+
+		if out := buf.ReadByteAt(int64(i)); out != data[i] {
+
+			t.Fatalf("expected byte does not match the one gotten at offset %d (got %#v, expected %#v)", i, out, data[i])
+
+		}
+
+	}
+
+}
+
+func TestChainBufferErrors(t *testing.T) {
+
+	for i, c := range []struct {
+		op       func(*ChainBuffer)
+		expected Error
+	}{
+		{func(b *ChainBuffer) { b.ReadU32LEAt(0x01) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.ReadU16BE(-0x01, 1) }, BufferUnderreadError},
+		{func(b *ChainBuffer) { b.ReadU16BE(0x00, -1) }, BufferInvalidByteCountError},
+		{func(b *ChainBuffer) { b.PutU64BE(0x00, 0x00) }, BufferOverwriteError},
+		{func(b *ChainBuffer) { b.ReadBits(0x1e, 3) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.SetBit(-0x01) }, BufferUnderwriteError},
+		{func(b *ChainBuffer) { b.ReadBytes(0x03, 2) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.ReadBytes(0x00, -1) }, BufferInvalidByteCountError},
+		{func(b *ChainBuffer) { b.ReadBytes(0x01, math.MaxInt64) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.ReadU16BEAt(math.MaxInt64) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.ReadU32LE(0x00, 1<<62) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.ReadBits(0x01, math.MaxInt64) }, BufferOverreadError},
+		{func(b *ChainBuffer) { b.ReadBits(0x00, -1) }, BufferInvalidBitCountError},
+		{func(b *ChainBuffer) { b.WriteBytes(math.MaxInt64, []byte{0x00}) }, BufferOverwriteError},
+		{func(b *ChainBuffer) { b.PutU32LE(math.MaxInt64-1, 0x00) }, BufferOverwriteError},
+		{func(b *ChainBuffer) { b.SetBits(math.MaxInt64-1, 0x00, 8) }, BufferOverwriteError},
+	} {
+
+		buf := NewChainBuffer([]byte{0x00, 0x00}, []byte{0x00, 0x00})
+		buf.SetSticky(true)
+
+		c.op(buf)
+		if !errors.Is(buf.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, buf.Err(), c.expected)
+
+		}
+
+	}
+
+}
+
+func TestChainBufferPanic(t *testing.T) {
+
+	defer panicChecker(t, BufferOverreadError.at("ReadU32BEAt", 0x01, 4, 4))
+
+	buf := NewChainBuffer([]byte{0x00, 0x00}, []byte{0x00, 0x00})
+	buf.ReadU32BEAt(0x01)
+
+}
+
+func TestChainBufferWriteTo(t *testing.T) {
+
+	var (
+		header = []byte{0x01, 0x02}
+		body   = []byte{0x03, 0x04, 0x05}
+		out    bytes.Buffer
+	)
+
+	buf := NewChainBuffer(header, body)
+	buf.SeekByte(0x01, false)
+
+	if expected := [][]byte{{0x02}, {0x03, 0x04, 0x05}}; !cmp.Equal(expected, [][]byte(buf.Buffers())) {
+
+		t.Fatalf("expected buffers do not match the ones gotten (got %#v, expected %#v)", buf.Buffers(), expected)
+
+	}
+
+	n, err := buf.WriteTo(&out)
+	if err != nil || n != 4 || buf.ByteOffset() != 5 {
+
+		t.Fatalf("unexpected result (got %d bytes and %v at offset %d)", n, err, buf.ByteOffset())
+
+	}
+
+	if expected := []byte{0x02, 0x03, 0x04, 0x05}; !cmp.Equal(expected, out.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out.Bytes(), expected)
+
+	}
+
+	// the chunks themselves are left alone
+	if len(buf.Chunks()) != 2 || len(buf.Chunks()[1]) != 3 {
+
+		t.Fatalf("chunks were modified: %#v", buf.Chunks())
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkChainBufferReadU32BEAt(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewChainBuffer([]byte{0x00, 0x00}, []byte{0x00, 0x00})
+
+	var out uint32
+	for n := 0; n < b.N; n++ {
+
+		out = buf.ReadU32BEAt(0x00)
+
+	}
+
+	_ = out
+
+}
+
+func BenchmarkChainBufferWriteTo(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewChainBuffer(make([]byte, 64), make([]byte, 1024))
+
+	var out bytes.Buffer
+	for n := 0; n < b.N; n++ {
+
+		out.Reset()
+		buf.SeekByte(0x00, false)
+		_, _ = buf.WriteTo(&out)
+
+	}
+
+}
+
+func BenchmarkChainBufferAppend(b *testing.B) {
+
+	b.ReportAllocs()
+
+	chunk := make([]byte, 16)
+	for n := 0; n < b.N; n++ {
+
+		buf := NewChainBuffer()
+		for i := 0; i < 1024; i++ {
+
+			buf.Append(chunk)
+
+		}
+
+	}
+
+}
@@ -0,0 +1,8279 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+// CheckedBuffer implements a wrapper around Buffer that returns an
+// error from every operation instead of panicking when it falls
+// outside of the buffer. an error recorded by the wrapped buffer in
+// sticky mode is returned by every operation until it is cleared
+type CheckedBuffer struct {
+	buf *Buffer
+}
+
+// NewCheckedBuffer initializes a new CheckedBuffer with the provided
+// byte slice(s) stored inside in the order provided
+func NewCheckedBuffer(slices ...[]byte) *CheckedBuffer {
+
+	return &CheckedBuffer{
+		buf: NewBuffer(slices...),
+	}
+
+}
+
+// Checked returns a CheckedBuffer that operates on the same data and
+// offsets as the buffer
+func (b *Buffer) Checked() *CheckedBuffer {
+
+	return &CheckedBuffer{
+		buf: b,
+	}
+
+}
+
+/* bitfield methods */
+
+// ReadBit returns the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) ReadBit(off int64) (out byte, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if off > (b.buf.bcap - 1) {
+
+		err = BufferOverreadError.atBit("ReadBit", off, 1, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.atBit("ReadBit", off, 1, b.buf.bcap)
+		return
+
+	}
+
+	out = b.buf.ReadBit(off)
+	return
+
+}
+
+// ReadBitNext returns the next bit from the current offset and moves
+// the offset forward a bit
+func (b *CheckedBuffer) ReadBitNext() (out byte, err error) {
+
+	out, err = b.ReadBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// ReadBits returns the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) ReadBits(off, n int64) (out uint64, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > 64 {
+
+		err = BufferInvalidBitCountError.atBit("ReadBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.atBit("ReadBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n > (b.buf.bcap - off) {
+
+		err = BufferOverreadError.atBit("ReadBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	out = b.buf.ReadBits(off, n)
+	return
+
+}
+
+// ReadBitsNext returns the next n bits from the current offset and
+// moves the offset forward the amount of bits read
+func (b *CheckedBuffer) ReadBitsNext(n int64) (out uint64, err error) {
+
+	out, err = b.ReadBits(b.buf.boff, n)
+	if err == nil {
+
+		b.buf.SeekBit(n, true)
+
+	}
+	return
+
+}
+
+// SetBit sets the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) SetBit(off int64) (err error) {
+
+	err = b.checkBitWrite("SetBit", off)
+	if err == nil {
+
+		b.buf.SetBit(off)
+
+	}
+	return
+
+}
+
+// SetBitNext sets the next bit from the current offset and moves the
+// offset forward a bit
+func (b *CheckedBuffer) SetBitNext() (err error) {
+
+	err = b.SetBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// ClearBit clears the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) ClearBit(off int64) (err error) {
+
+	err = b.checkBitWrite("ClearBit", off)
+	if err == nil {
+
+		b.buf.ClearBit(off)
+
+	}
+	return
+
+}
+
+// ClearBitNext clears the next bit from the current offset and moves
+// the offset forward a bit
+func (b *CheckedBuffer) ClearBitNext() (err error) {
+
+	err = b.ClearBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// SetBits sets the next n bits from the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) SetBits(off int64, data uint64, n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > 64 {
+
+		err = BufferInvalidBitCountError.atBit("SetBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderwriteError.atBit("SetBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n > (b.buf.bcap-off) && !b.buf.reserve(off/8+(off%8+n+7)/8) {
+
+		err = BufferOverwriteError.atBit("SetBits", off, n, b.buf.bcap)
+		return
+
+	}
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	b.buf.SetBits(off, data, n)
+	return
+
+}
+
+// SetBitsNext sets the next n bits from the current offset and moves
+// the offset forward the amount of bits set
+func (b *CheckedBuffer) SetBitsNext(data uint64, n int64) (err error) {
+
+	err = b.SetBits(b.buf.boff, data, n)
+	if err == nil {
+
+		b.buf.SeekBit(n, true)
+
+	}
+	return
+
+}
+
+// FlipBit flips the bit located at the specified offset without
+// modifying the internal offset value
+func (b *CheckedBuffer) FlipBit(off int64) (err error) {
+
+	err = b.checkBitWrite("FlipBit", off)
+	if err == nil {
+
+		b.buf.FlipBit(off)
+
+	}
+	return
+
+}
+
+// FlipBitNext flips the next bit from the current offset and moves
+// the offset forward a bit
+func (b *CheckedBuffer) FlipBitNext() (err error) {
+
+	err = b.FlipBit(b.buf.boff)
+	if err == nil {
+
+		b.buf.SeekBit(1, true)
+
+	}
+	return
+
+}
+
+// ClearAllBits sets all of the buffer's bits to 0
+func (b *CheckedBuffer) ClearAllBits() {
+
+	if b.buf.cap != 0x00 {
+
+		b.buf.ClearAllBits()
+
+	}
+
+}
+
+// SetAllBits sets all of the buffer's bits to 1
+func (b *CheckedBuffer) SetAllBits() {
+
+	if b.buf.cap != 0x00 {
+
+		b.buf.SetAllBits()
+
+	}
+
+}
+
+// FlipAllBits flips all of the buffer's bits
+func (b *CheckedBuffer) FlipAllBits() {
+
+	if b.buf.cap != 0x00 {
+
+		b.buf.FlipAllBits()
+
+	}
+
+}
+
+// SeekBit seeks to bit position off of the the buffer relative to
+// the current position or exact. the offset is not moved if the new
+// position would be outside of the buffer
+func (b *CheckedBuffer) SeekBit(off int64, relative bool) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		off += b.buf.boff
+
+	}
+
+	if off > b.buf.bcap {
+
+		err = BufferOverseekError.atBit("SeekBit", off, 0, b.buf.bcap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderseekError.atBit("SeekBit", off, 0, b.buf.bcap)
+		return
+
+	}
+
+	b.buf.SeekBit(off, false)
+	return
+
+}
+
+// AfterBit returns the amount of bits located after the current bit
+// position or the specified one
+func (b *CheckedBuffer) AfterBit(off ...int64) int64 {
+
+	return b.buf.AfterBit(off...)
+
+}
+
+// AlignBit aligns the bit offset to the byte offset
+func (b *CheckedBuffer) AlignBit() {
+
+	b.buf.AlignBit()
+
+}
+
+/* byte buffer methods */
+
+// WriteBytes writes bytes to the buffer at the specified offset
+// without modifying the internal offset value
+func (b *CheckedBuffer) WriteBytes(off int64, data []byte) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderwriteError.at("WriteBytes", off, int64(len(data)), b.buf.cap)
+		return
+
+	}
+
+	if !b.buf.writable(off, int64(len(data))) {
+
+		err = BufferOverwriteError.at("WriteBytes", off, int64(len(data)), b.buf.cap)
+		return
+
+	}
+
+	b.buf.WriteBytes(off, data)
+	return
+
+}
+
+// WriteBytesNext writes bytes to the buffer at the current offset
+// and moves the offset forward the amount of bytes written
+func (b *CheckedBuffer) WriteBytesNext(data []byte) (err error) {
+
+	if err = b.buf.unaligned("WriteBytesNext"); err != nil {
+
+		return
+
+	}
+
+	err = b.WriteBytes(b.buf.off, data)
+	if err == nil {
+
+		b.buf.SeekByte(int64(len(data)), true)
+
+	}
+	return
+
+}
+
+// PutByte writes a byte to the buffer at the specified offset without
+// modifying the internal offset value. it is not named WriteByte like
+// the Buffer method, as that is reserved for io.ByteWriter
+func (b *CheckedBuffer) PutByte(off int64, data byte) error {
+
+	return b.WriteBytes(off, []byte{data})
+
+}
+
+// WriteByteNext writes a byte to the buffer at the current
+// offset and moves the offset forward the amount of bytes written
+func (b *CheckedBuffer) WriteByteNext(data byte) error {
+
+	return b.WriteBytesNext([]byte{data})
+
+}
+
+// WriteU16LE writes a slice of uint16s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU16LE(off int64, data []uint16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU16LE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*2) {
+		err = BufferOverwriteError.at("WriteU16LE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU16LE(off, data)
+	return
+}
+
+// WriteU16LENext writes a slice of uint16s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU16LENext(data []uint16) (err error) {
+	if err = b.buf.unaligned("WriteU16LENext"); err != nil {
+		return
+	}
+	err = b.WriteU16LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*2, true)
+	}
+	return
+}
+
+// PutU16LE writes a uint16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16LE(off int64, data uint16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU16LE", off, 2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 2) {
+		err = BufferOverwriteError.at("PutU16LE", off, 2, b.buf.cap)
+		return
+	}
+	b.buf.PutU16LE(off, data)
+	return
+}
+
+// PutU16LENext writes a uint16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16LENext(data uint16) (err error) {
+	if err = b.buf.unaligned("PutU16LENext"); err != nil {
+		return
+	}
+	err = b.PutU16LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// PutU16LEBits writes a uint16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16LEBits(off int64, data uint16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+16+7)/8) {
+		err = BufferOverwriteError.atBit("PutU16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	b.buf.PutU16LEBits(off, data)
+	return
+}
+
+// PutU16LEBitsNext writes a uint16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16LEBitsNext(data uint16) (err error) {
+	err = b.PutU16LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// WriteU16BE writes a slice of uint16s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU16BE(off int64, data []uint16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU16BE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*2) {
+		err = BufferOverwriteError.at("WriteU16BE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU16BE(off, data)
+	return
+}
+
+// WriteU16BENext writes a slice of uint16s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU16BENext(data []uint16) (err error) {
+	if err = b.buf.unaligned("WriteU16BENext"); err != nil {
+		return
+	}
+	err = b.WriteU16BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*2, true)
+	}
+	return
+}
+
+// PutU16BE writes a uint16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16BE(off int64, data uint16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU16BE", off, 2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 2) {
+		err = BufferOverwriteError.at("PutU16BE", off, 2, b.buf.cap)
+		return
+	}
+	b.buf.PutU16BE(off, data)
+	return
+}
+
+// PutU16BENext writes a uint16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16BENext(data uint16) (err error) {
+	if err = b.buf.unaligned("PutU16BENext"); err != nil {
+		return
+	}
+	err = b.PutU16BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// PutU16BEBits writes a uint16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16BEBits(off int64, data uint16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+16+7)/8) {
+		err = BufferOverwriteError.atBit("PutU16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	b.buf.PutU16BEBits(off, data)
+	return
+}
+
+// PutU16BEBitsNext writes a uint16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU16BEBitsNext(data uint16) (err error) {
+	err = b.PutU16BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// WriteU24LE writes a slice of uint32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU24LE(off int64, data []uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU24LE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*3) {
+		err = BufferOverwriteError.at("WriteU24LE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU24LE(off, data)
+	return
+}
+
+// WriteU24LENext writes a slice of uint32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU24LENext(data []uint32) (err error) {
+	if err = b.buf.unaligned("WriteU24LENext"); err != nil {
+		return
+	}
+	err = b.WriteU24LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*3, true)
+	}
+	return
+}
+
+// PutU24LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24LE(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU24LE", off, 3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 3) {
+		err = BufferOverwriteError.at("PutU24LE", off, 3, b.buf.cap)
+		return
+	}
+	b.buf.PutU24LE(off, data)
+	return
+}
+
+// PutU24LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24LENext(data uint32) (err error) {
+	if err = b.buf.unaligned("PutU24LENext"); err != nil {
+		return
+	}
+	err = b.PutU24LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// PutU24LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24LEBits(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+24+7)/8) {
+		err = BufferOverwriteError.atBit("PutU24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	b.buf.PutU24LEBits(off, data)
+	return
+}
+
+// PutU24LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24LEBitsNext(data uint32) (err error) {
+	err = b.PutU24LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// WriteU24BE writes a slice of uint32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU24BE(off int64, data []uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU24BE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*3) {
+		err = BufferOverwriteError.at("WriteU24BE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU24BE(off, data)
+	return
+}
+
+// WriteU24BENext writes a slice of uint32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU24BENext(data []uint32) (err error) {
+	if err = b.buf.unaligned("WriteU24BENext"); err != nil {
+		return
+	}
+	err = b.WriteU24BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*3, true)
+	}
+	return
+}
+
+// PutU24BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24BE(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU24BE", off, 3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 3) {
+		err = BufferOverwriteError.at("PutU24BE", off, 3, b.buf.cap)
+		return
+	}
+	b.buf.PutU24BE(off, data)
+	return
+}
+
+// PutU24BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24BENext(data uint32) (err error) {
+	if err = b.buf.unaligned("PutU24BENext"); err != nil {
+		return
+	}
+	err = b.PutU24BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// PutU24BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24BEBits(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+24+7)/8) {
+		err = BufferOverwriteError.atBit("PutU24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	b.buf.PutU24BEBits(off, data)
+	return
+}
+
+// PutU24BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU24BEBitsNext(data uint32) (err error) {
+	err = b.PutU24BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// WriteU32LE writes a slice of uint32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU32LE(off int64, data []uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU32LE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*4) {
+		err = BufferOverwriteError.at("WriteU32LE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU32LE(off, data)
+	return
+}
+
+// WriteU32LENext writes a slice of uint32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU32LENext(data []uint32) (err error) {
+	if err = b.buf.unaligned("WriteU32LENext"); err != nil {
+		return
+	}
+	err = b.WriteU32LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*4, true)
+	}
+	return
+}
+
+// PutU32LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32LE(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU32LE", off, 4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 4) {
+		err = BufferOverwriteError.at("PutU32LE", off, 4, b.buf.cap)
+		return
+	}
+	b.buf.PutU32LE(off, data)
+	return
+}
+
+// PutU32LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32LENext(data uint32) (err error) {
+	if err = b.buf.unaligned("PutU32LENext"); err != nil {
+		return
+	}
+	err = b.PutU32LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// PutU32LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32LEBits(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+32+7)/8) {
+		err = BufferOverwriteError.atBit("PutU32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	b.buf.PutU32LEBits(off, data)
+	return
+}
+
+// PutU32LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32LEBitsNext(data uint32) (err error) {
+	err = b.PutU32LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// WriteU32BE writes a slice of uint32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU32BE(off int64, data []uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU32BE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*4) {
+		err = BufferOverwriteError.at("WriteU32BE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU32BE(off, data)
+	return
+}
+
+// WriteU32BENext writes a slice of uint32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU32BENext(data []uint32) (err error) {
+	if err = b.buf.unaligned("WriteU32BENext"); err != nil {
+		return
+	}
+	err = b.WriteU32BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*4, true)
+	}
+	return
+}
+
+// PutU32BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32BE(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU32BE", off, 4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 4) {
+		err = BufferOverwriteError.at("PutU32BE", off, 4, b.buf.cap)
+		return
+	}
+	b.buf.PutU32BE(off, data)
+	return
+}
+
+// PutU32BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32BENext(data uint32) (err error) {
+	if err = b.buf.unaligned("PutU32BENext"); err != nil {
+		return
+	}
+	err = b.PutU32BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// PutU32BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32BEBits(off int64, data uint32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+32+7)/8) {
+		err = BufferOverwriteError.atBit("PutU32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	b.buf.PutU32BEBits(off, data)
+	return
+}
+
+// PutU32BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU32BEBitsNext(data uint32) (err error) {
+	err = b.PutU32BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// WriteU40LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU40LE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU40LE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*5) {
+		err = BufferOverwriteError.at("WriteU40LE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU40LE(off, data)
+	return
+}
+
+// WriteU40LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU40LENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU40LENext"); err != nil {
+		return
+	}
+	err = b.WriteU40LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*5, true)
+	}
+	return
+}
+
+// PutU40LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40LE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU40LE", off, 5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 5) {
+		err = BufferOverwriteError.at("PutU40LE", off, 5, b.buf.cap)
+		return
+	}
+	b.buf.PutU40LE(off, data)
+	return
+}
+
+// PutU40LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40LENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU40LENext"); err != nil {
+		return
+	}
+	err = b.PutU40LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// PutU40LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40LEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+40+7)/8) {
+		err = BufferOverwriteError.atBit("PutU40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	b.buf.PutU40LEBits(off, data)
+	return
+}
+
+// PutU40LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40LEBitsNext(data uint64) (err error) {
+	err = b.PutU40LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// WriteU40BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU40BE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU40BE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*5) {
+		err = BufferOverwriteError.at("WriteU40BE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU40BE(off, data)
+	return
+}
+
+// WriteU40BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU40BENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU40BENext"); err != nil {
+		return
+	}
+	err = b.WriteU40BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*5, true)
+	}
+	return
+}
+
+// PutU40BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40BE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU40BE", off, 5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 5) {
+		err = BufferOverwriteError.at("PutU40BE", off, 5, b.buf.cap)
+		return
+	}
+	b.buf.PutU40BE(off, data)
+	return
+}
+
+// PutU40BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40BENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU40BENext"); err != nil {
+		return
+	}
+	err = b.PutU40BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// PutU40BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40BEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+40+7)/8) {
+		err = BufferOverwriteError.atBit("PutU40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	b.buf.PutU40BEBits(off, data)
+	return
+}
+
+// PutU40BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU40BEBitsNext(data uint64) (err error) {
+	err = b.PutU40BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// WriteU48LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU48LE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU48LE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*6) {
+		err = BufferOverwriteError.at("WriteU48LE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU48LE(off, data)
+	return
+}
+
+// WriteU48LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU48LENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU48LENext"); err != nil {
+		return
+	}
+	err = b.WriteU48LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*6, true)
+	}
+	return
+}
+
+// PutU48LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48LE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU48LE", off, 6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 6) {
+		err = BufferOverwriteError.at("PutU48LE", off, 6, b.buf.cap)
+		return
+	}
+	b.buf.PutU48LE(off, data)
+	return
+}
+
+// PutU48LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48LENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU48LENext"); err != nil {
+		return
+	}
+	err = b.PutU48LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// PutU48LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48LEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+48+7)/8) {
+		err = BufferOverwriteError.atBit("PutU48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	b.buf.PutU48LEBits(off, data)
+	return
+}
+
+// PutU48LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48LEBitsNext(data uint64) (err error) {
+	err = b.PutU48LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// WriteU48BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU48BE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU48BE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*6) {
+		err = BufferOverwriteError.at("WriteU48BE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU48BE(off, data)
+	return
+}
+
+// WriteU48BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU48BENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU48BENext"); err != nil {
+		return
+	}
+	err = b.WriteU48BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*6, true)
+	}
+	return
+}
+
+// PutU48BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48BE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU48BE", off, 6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 6) {
+		err = BufferOverwriteError.at("PutU48BE", off, 6, b.buf.cap)
+		return
+	}
+	b.buf.PutU48BE(off, data)
+	return
+}
+
+// PutU48BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48BENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU48BENext"); err != nil {
+		return
+	}
+	err = b.PutU48BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// PutU48BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48BEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+48+7)/8) {
+		err = BufferOverwriteError.atBit("PutU48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	b.buf.PutU48BEBits(off, data)
+	return
+}
+
+// PutU48BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU48BEBitsNext(data uint64) (err error) {
+	err = b.PutU48BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// WriteU56LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU56LE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU56LE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*7) {
+		err = BufferOverwriteError.at("WriteU56LE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU56LE(off, data)
+	return
+}
+
+// WriteU56LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU56LENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU56LENext"); err != nil {
+		return
+	}
+	err = b.WriteU56LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*7, true)
+	}
+	return
+}
+
+// PutU56LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56LE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU56LE", off, 7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 7) {
+		err = BufferOverwriteError.at("PutU56LE", off, 7, b.buf.cap)
+		return
+	}
+	b.buf.PutU56LE(off, data)
+	return
+}
+
+// PutU56LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56LENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU56LENext"); err != nil {
+		return
+	}
+	err = b.PutU56LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// PutU56LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56LEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+56+7)/8) {
+		err = BufferOverwriteError.atBit("PutU56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	b.buf.PutU56LEBits(off, data)
+	return
+}
+
+// PutU56LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56LEBitsNext(data uint64) (err error) {
+	err = b.PutU56LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// WriteU56BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU56BE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU56BE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*7) {
+		err = BufferOverwriteError.at("WriteU56BE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU56BE(off, data)
+	return
+}
+
+// WriteU56BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU56BENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU56BENext"); err != nil {
+		return
+	}
+	err = b.WriteU56BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*7, true)
+	}
+	return
+}
+
+// PutU56BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56BE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU56BE", off, 7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 7) {
+		err = BufferOverwriteError.at("PutU56BE", off, 7, b.buf.cap)
+		return
+	}
+	b.buf.PutU56BE(off, data)
+	return
+}
+
+// PutU56BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56BENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU56BENext"); err != nil {
+		return
+	}
+	err = b.PutU56BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// PutU56BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56BEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+56+7)/8) {
+		err = BufferOverwriteError.atBit("PutU56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	b.buf.PutU56BEBits(off, data)
+	return
+}
+
+// PutU56BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU56BEBitsNext(data uint64) (err error) {
+	err = b.PutU56BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// WriteU64LE writes a slice of uint64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU64LE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU64LE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*8) {
+		err = BufferOverwriteError.at("WriteU64LE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU64LE(off, data)
+	return
+}
+
+// WriteU64LENext writes a slice of uint64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU64LENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU64LENext"); err != nil {
+		return
+	}
+	err = b.WriteU64LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*8, true)
+	}
+	return
+}
+
+// PutU64LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64LE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU64LE", off, 8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 8) {
+		err = BufferOverwriteError.at("PutU64LE", off, 8, b.buf.cap)
+		return
+	}
+	b.buf.PutU64LE(off, data)
+	return
+}
+
+// PutU64LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64LENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU64LENext"); err != nil {
+		return
+	}
+	err = b.PutU64LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// PutU64LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64LEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+64+7)/8) {
+		err = BufferOverwriteError.atBit("PutU64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	b.buf.PutU64LEBits(off, data)
+	return
+}
+
+// PutU64LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64LEBitsNext(data uint64) (err error) {
+	err = b.PutU64LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// WriteU64BE writes a slice of uint64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteU64BE(off int64, data []uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteU64BE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*8) {
+		err = BufferOverwriteError.at("WriteU64BE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteU64BE(off, data)
+	return
+}
+
+// WriteU64BENext writes a slice of uint64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteU64BENext(data []uint64) (err error) {
+	if err = b.buf.unaligned("WriteU64BENext"); err != nil {
+		return
+	}
+	err = b.WriteU64BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*8, true)
+	}
+	return
+}
+
+// PutU64BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64BE(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutU64BE", off, 8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 8) {
+		err = BufferOverwriteError.at("PutU64BE", off, 8, b.buf.cap)
+		return
+	}
+	b.buf.PutU64BE(off, data)
+	return
+}
+
+// PutU64BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64BENext(data uint64) (err error) {
+	if err = b.buf.unaligned("PutU64BENext"); err != nil {
+		return
+	}
+	err = b.PutU64BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// PutU64BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64BEBits(off int64, data uint64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutU64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+64+7)/8) {
+		err = BufferOverwriteError.atBit("PutU64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	b.buf.PutU64BEBits(off, data)
+	return
+}
+
+// PutU64BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutU64BEBitsNext(data uint64) (err error) {
+	err = b.PutU64BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// WriteI16LE writes a slice of int16s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI16LE(off int64, data []int16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI16LE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*2) {
+		err = BufferOverwriteError.at("WriteI16LE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI16LE(off, data)
+	return
+}
+
+// WriteI16LENext writes a slice of int16s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI16LENext(data []int16) (err error) {
+	if err = b.buf.unaligned("WriteI16LENext"); err != nil {
+		return
+	}
+	err = b.WriteI16LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*2, true)
+	}
+	return
+}
+
+// PutI16LE writes an int16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16LE(off int64, data int16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI16LE", off, 2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 2) {
+		err = BufferOverwriteError.at("PutI16LE", off, 2, b.buf.cap)
+		return
+	}
+	b.buf.PutI16LE(off, data)
+	return
+}
+
+// PutI16LENext writes an int16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16LENext(data int16) (err error) {
+	if err = b.buf.unaligned("PutI16LENext"); err != nil {
+		return
+	}
+	err = b.PutI16LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// PutI16LEBits writes an int16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16LEBits(off int64, data int16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+16+7)/8) {
+		err = BufferOverwriteError.atBit("PutI16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	b.buf.PutI16LEBits(off, data)
+	return
+}
+
+// PutI16LEBitsNext writes an int16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16LEBitsNext(data int16) (err error) {
+	err = b.PutI16LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// WriteI16BE writes a slice of int16s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI16BE(off int64, data []int16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI16BE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*2) {
+		err = BufferOverwriteError.at("WriteI16BE", off, int64(len(data))*2, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI16BE(off, data)
+	return
+}
+
+// WriteI16BENext writes a slice of int16s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI16BENext(data []int16) (err error) {
+	if err = b.buf.unaligned("WriteI16BENext"); err != nil {
+		return
+	}
+	err = b.WriteI16BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*2, true)
+	}
+	return
+}
+
+// PutI16BE writes an int16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16BE(off int64, data int16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI16BE", off, 2, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 2) {
+		err = BufferOverwriteError.at("PutI16BE", off, 2, b.buf.cap)
+		return
+	}
+	b.buf.PutI16BE(off, data)
+	return
+}
+
+// PutI16BENext writes an int16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16BENext(data int16) (err error) {
+	if err = b.buf.unaligned("PutI16BENext"); err != nil {
+		return
+	}
+	err = b.PutI16BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// PutI16BEBits writes an int16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16BEBits(off int64, data int16) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+16+7)/8) {
+		err = BufferOverwriteError.atBit("PutI16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	b.buf.PutI16BEBits(off, data)
+	return
+}
+
+// PutI16BEBitsNext writes an int16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI16BEBitsNext(data int16) (err error) {
+	err = b.PutI16BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// WriteI24LE writes a slice of int32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI24LE(off int64, data []int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI24LE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*3) {
+		err = BufferOverwriteError.at("WriteI24LE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI24LE(off, data)
+	return
+}
+
+// WriteI24LENext writes a slice of int32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI24LENext(data []int32) (err error) {
+	if err = b.buf.unaligned("WriteI24LENext"); err != nil {
+		return
+	}
+	err = b.WriteI24LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*3, true)
+	}
+	return
+}
+
+// PutI24LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24LE(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI24LE", off, 3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 3) {
+		err = BufferOverwriteError.at("PutI24LE", off, 3, b.buf.cap)
+		return
+	}
+	b.buf.PutI24LE(off, data)
+	return
+}
+
+// PutI24LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24LENext(data int32) (err error) {
+	if err = b.buf.unaligned("PutI24LENext"); err != nil {
+		return
+	}
+	err = b.PutI24LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// PutI24LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24LEBits(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+24+7)/8) {
+		err = BufferOverwriteError.atBit("PutI24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	b.buf.PutI24LEBits(off, data)
+	return
+}
+
+// PutI24LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24LEBitsNext(data int32) (err error) {
+	err = b.PutI24LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// WriteI24BE writes a slice of int32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI24BE(off int64, data []int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI24BE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*3) {
+		err = BufferOverwriteError.at("WriteI24BE", off, int64(len(data))*3, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI24BE(off, data)
+	return
+}
+
+// WriteI24BENext writes a slice of int32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI24BENext(data []int32) (err error) {
+	if err = b.buf.unaligned("WriteI24BENext"); err != nil {
+		return
+	}
+	err = b.WriteI24BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*3, true)
+	}
+	return
+}
+
+// PutI24BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24BE(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI24BE", off, 3, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 3) {
+		err = BufferOverwriteError.at("PutI24BE", off, 3, b.buf.cap)
+		return
+	}
+	b.buf.PutI24BE(off, data)
+	return
+}
+
+// PutI24BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24BENext(data int32) (err error) {
+	if err = b.buf.unaligned("PutI24BENext"); err != nil {
+		return
+	}
+	err = b.PutI24BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// PutI24BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24BEBits(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+24+7)/8) {
+		err = BufferOverwriteError.atBit("PutI24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	b.buf.PutI24BEBits(off, data)
+	return
+}
+
+// PutI24BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI24BEBitsNext(data int32) (err error) {
+	err = b.PutI24BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// WriteI32LE writes a slice of int32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI32LE(off int64, data []int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI32LE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*4) {
+		err = BufferOverwriteError.at("WriteI32LE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI32LE(off, data)
+	return
+}
+
+// WriteI32LENext writes a slice of int32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI32LENext(data []int32) (err error) {
+	if err = b.buf.unaligned("WriteI32LENext"); err != nil {
+		return
+	}
+	err = b.WriteI32LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*4, true)
+	}
+	return
+}
+
+// PutI32LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32LE(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI32LE", off, 4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 4) {
+		err = BufferOverwriteError.at("PutI32LE", off, 4, b.buf.cap)
+		return
+	}
+	b.buf.PutI32LE(off, data)
+	return
+}
+
+// PutI32LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32LENext(data int32) (err error) {
+	if err = b.buf.unaligned("PutI32LENext"); err != nil {
+		return
+	}
+	err = b.PutI32LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// PutI32LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32LEBits(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+32+7)/8) {
+		err = BufferOverwriteError.atBit("PutI32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	b.buf.PutI32LEBits(off, data)
+	return
+}
+
+// PutI32LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32LEBitsNext(data int32) (err error) {
+	err = b.PutI32LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// WriteI32BE writes a slice of int32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI32BE(off int64, data []int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI32BE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*4) {
+		err = BufferOverwriteError.at("WriteI32BE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI32BE(off, data)
+	return
+}
+
+// WriteI32BENext writes a slice of int32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI32BENext(data []int32) (err error) {
+	if err = b.buf.unaligned("WriteI32BENext"); err != nil {
+		return
+	}
+	err = b.WriteI32BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*4, true)
+	}
+	return
+}
+
+// PutI32BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32BE(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI32BE", off, 4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 4) {
+		err = BufferOverwriteError.at("PutI32BE", off, 4, b.buf.cap)
+		return
+	}
+	b.buf.PutI32BE(off, data)
+	return
+}
+
+// PutI32BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32BENext(data int32) (err error) {
+	if err = b.buf.unaligned("PutI32BENext"); err != nil {
+		return
+	}
+	err = b.PutI32BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// PutI32BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32BEBits(off int64, data int32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+32+7)/8) {
+		err = BufferOverwriteError.atBit("PutI32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	b.buf.PutI32BEBits(off, data)
+	return
+}
+
+// PutI32BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI32BEBitsNext(data int32) (err error) {
+	err = b.PutI32BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// WriteI40LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI40LE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI40LE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*5) {
+		err = BufferOverwriteError.at("WriteI40LE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI40LE(off, data)
+	return
+}
+
+// WriteI40LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI40LENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI40LENext"); err != nil {
+		return
+	}
+	err = b.WriteI40LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*5, true)
+	}
+	return
+}
+
+// PutI40LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40LE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI40LE", off, 5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 5) {
+		err = BufferOverwriteError.at("PutI40LE", off, 5, b.buf.cap)
+		return
+	}
+	b.buf.PutI40LE(off, data)
+	return
+}
+
+// PutI40LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40LENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI40LENext"); err != nil {
+		return
+	}
+	err = b.PutI40LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// PutI40LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40LEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+40+7)/8) {
+		err = BufferOverwriteError.atBit("PutI40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	b.buf.PutI40LEBits(off, data)
+	return
+}
+
+// PutI40LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40LEBitsNext(data int64) (err error) {
+	err = b.PutI40LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// WriteI40BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI40BE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI40BE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*5) {
+		err = BufferOverwriteError.at("WriteI40BE", off, int64(len(data))*5, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI40BE(off, data)
+	return
+}
+
+// WriteI40BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI40BENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI40BENext"); err != nil {
+		return
+	}
+	err = b.WriteI40BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*5, true)
+	}
+	return
+}
+
+// PutI40BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40BE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI40BE", off, 5, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 5) {
+		err = BufferOverwriteError.at("PutI40BE", off, 5, b.buf.cap)
+		return
+	}
+	b.buf.PutI40BE(off, data)
+	return
+}
+
+// PutI40BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40BENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI40BENext"); err != nil {
+		return
+	}
+	err = b.PutI40BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// PutI40BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40BEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+40+7)/8) {
+		err = BufferOverwriteError.atBit("PutI40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	b.buf.PutI40BEBits(off, data)
+	return
+}
+
+// PutI40BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI40BEBitsNext(data int64) (err error) {
+	err = b.PutI40BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// WriteI48LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI48LE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI48LE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*6) {
+		err = BufferOverwriteError.at("WriteI48LE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI48LE(off, data)
+	return
+}
+
+// WriteI48LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI48LENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI48LENext"); err != nil {
+		return
+	}
+	err = b.WriteI48LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*6, true)
+	}
+	return
+}
+
+// PutI48LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48LE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI48LE", off, 6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 6) {
+		err = BufferOverwriteError.at("PutI48LE", off, 6, b.buf.cap)
+		return
+	}
+	b.buf.PutI48LE(off, data)
+	return
+}
+
+// PutI48LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48LENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI48LENext"); err != nil {
+		return
+	}
+	err = b.PutI48LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// PutI48LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48LEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+48+7)/8) {
+		err = BufferOverwriteError.atBit("PutI48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	b.buf.PutI48LEBits(off, data)
+	return
+}
+
+// PutI48LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48LEBitsNext(data int64) (err error) {
+	err = b.PutI48LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// WriteI48BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI48BE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI48BE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*6) {
+		err = BufferOverwriteError.at("WriteI48BE", off, int64(len(data))*6, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI48BE(off, data)
+	return
+}
+
+// WriteI48BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI48BENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI48BENext"); err != nil {
+		return
+	}
+	err = b.WriteI48BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*6, true)
+	}
+	return
+}
+
+// PutI48BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48BE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI48BE", off, 6, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 6) {
+		err = BufferOverwriteError.at("PutI48BE", off, 6, b.buf.cap)
+		return
+	}
+	b.buf.PutI48BE(off, data)
+	return
+}
+
+// PutI48BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48BENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI48BENext"); err != nil {
+		return
+	}
+	err = b.PutI48BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// PutI48BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48BEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+48+7)/8) {
+		err = BufferOverwriteError.atBit("PutI48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	b.buf.PutI48BEBits(off, data)
+	return
+}
+
+// PutI48BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI48BEBitsNext(data int64) (err error) {
+	err = b.PutI48BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// WriteI56LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI56LE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI56LE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*7) {
+		err = BufferOverwriteError.at("WriteI56LE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI56LE(off, data)
+	return
+}
+
+// WriteI56LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI56LENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI56LENext"); err != nil {
+		return
+	}
+	err = b.WriteI56LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*7, true)
+	}
+	return
+}
+
+// PutI56LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56LE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI56LE", off, 7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 7) {
+		err = BufferOverwriteError.at("PutI56LE", off, 7, b.buf.cap)
+		return
+	}
+	b.buf.PutI56LE(off, data)
+	return
+}
+
+// PutI56LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56LENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI56LENext"); err != nil {
+		return
+	}
+	err = b.PutI56LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// PutI56LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56LEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+56+7)/8) {
+		err = BufferOverwriteError.atBit("PutI56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	b.buf.PutI56LEBits(off, data)
+	return
+}
+
+// PutI56LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56LEBitsNext(data int64) (err error) {
+	err = b.PutI56LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// WriteI56BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI56BE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI56BE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*7) {
+		err = BufferOverwriteError.at("WriteI56BE", off, int64(len(data))*7, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI56BE(off, data)
+	return
+}
+
+// WriteI56BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI56BENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI56BENext"); err != nil {
+		return
+	}
+	err = b.WriteI56BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*7, true)
+	}
+	return
+}
+
+// PutI56BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56BE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI56BE", off, 7, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 7) {
+		err = BufferOverwriteError.at("PutI56BE", off, 7, b.buf.cap)
+		return
+	}
+	b.buf.PutI56BE(off, data)
+	return
+}
+
+// PutI56BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56BENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI56BENext"); err != nil {
+		return
+	}
+	err = b.PutI56BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// PutI56BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56BEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+56+7)/8) {
+		err = BufferOverwriteError.atBit("PutI56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	b.buf.PutI56BEBits(off, data)
+	return
+}
+
+// PutI56BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI56BEBitsNext(data int64) (err error) {
+	err = b.PutI56BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// WriteI64LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI64LE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI64LE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*8) {
+		err = BufferOverwriteError.at("WriteI64LE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI64LE(off, data)
+	return
+}
+
+// WriteI64LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI64LENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI64LENext"); err != nil {
+		return
+	}
+	err = b.WriteI64LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*8, true)
+	}
+	return
+}
+
+// PutI64LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64LE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI64LE", off, 8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 8) {
+		err = BufferOverwriteError.at("PutI64LE", off, 8, b.buf.cap)
+		return
+	}
+	b.buf.PutI64LE(off, data)
+	return
+}
+
+// PutI64LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64LENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI64LENext"); err != nil {
+		return
+	}
+	err = b.PutI64LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// PutI64LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64LEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+64+7)/8) {
+		err = BufferOverwriteError.atBit("PutI64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	b.buf.PutI64LEBits(off, data)
+	return
+}
+
+// PutI64LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64LEBitsNext(data int64) (err error) {
+	err = b.PutI64LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// WriteI64BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteI64BE(off int64, data []int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteI64BE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*8) {
+		err = BufferOverwriteError.at("WriteI64BE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteI64BE(off, data)
+	return
+}
+
+// WriteI64BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteI64BENext(data []int64) (err error) {
+	if err = b.buf.unaligned("WriteI64BENext"); err != nil {
+		return
+	}
+	err = b.WriteI64BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*8, true)
+	}
+	return
+}
+
+// PutI64BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64BE(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutI64BE", off, 8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 8) {
+		err = BufferOverwriteError.at("PutI64BE", off, 8, b.buf.cap)
+		return
+	}
+	b.buf.PutI64BE(off, data)
+	return
+}
+
+// PutI64BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64BENext(data int64) (err error) {
+	if err = b.buf.unaligned("PutI64BENext"); err != nil {
+		return
+	}
+	err = b.PutI64BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// PutI64BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64BEBits(off int64, data int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutI64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+64+7)/8) {
+		err = BufferOverwriteError.atBit("PutI64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	b.buf.PutI64BEBits(off, data)
+	return
+}
+
+// PutI64BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutI64BEBitsNext(data int64) (err error) {
+	err = b.PutI64BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// WriteF32LE writes a slice of float32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteF32LE(off int64, data []float32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteF32LE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*4) {
+		err = BufferOverwriteError.at("WriteF32LE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteF32LE(off, data)
+	return
+}
+
+// WriteF32LENext writes a slice of float32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteF32LENext(data []float32) (err error) {
+	if err = b.buf.unaligned("WriteF32LENext"); err != nil {
+		return
+	}
+	err = b.WriteF32LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*4, true)
+	}
+	return
+}
+
+// PutF32LE writes a float32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32LE(off int64, data float32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutF32LE", off, 4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 4) {
+		err = BufferOverwriteError.at("PutF32LE", off, 4, b.buf.cap)
+		return
+	}
+	b.buf.PutF32LE(off, data)
+	return
+}
+
+// PutF32LENext writes a float32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32LENext(data float32) (err error) {
+	if err = b.buf.unaligned("PutF32LENext"); err != nil {
+		return
+	}
+	err = b.PutF32LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// PutF32LEBits writes a float32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32LEBits(off int64, data float32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutF32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+32+7)/8) {
+		err = BufferOverwriteError.atBit("PutF32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	b.buf.PutF32LEBits(off, data)
+	return
+}
+
+// PutF32LEBitsNext writes a float32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32LEBitsNext(data float32) (err error) {
+	err = b.PutF32LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// WriteF32BE writes a slice of float32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteF32BE(off int64, data []float32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteF32BE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*4) {
+		err = BufferOverwriteError.at("WriteF32BE", off, int64(len(data))*4, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteF32BE(off, data)
+	return
+}
+
+// WriteF32BENext writes a slice of float32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteF32BENext(data []float32) (err error) {
+	if err = b.buf.unaligned("WriteF32BENext"); err != nil {
+		return
+	}
+	err = b.WriteF32BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*4, true)
+	}
+	return
+}
+
+// PutF32BE writes a float32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32BE(off int64, data float32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutF32BE", off, 4, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 4) {
+		err = BufferOverwriteError.at("PutF32BE", off, 4, b.buf.cap)
+		return
+	}
+	b.buf.PutF32BE(off, data)
+	return
+}
+
+// PutF32BENext writes a float32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32BENext(data float32) (err error) {
+	if err = b.buf.unaligned("PutF32BENext"); err != nil {
+		return
+	}
+	err = b.PutF32BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// PutF32BEBits writes a float32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32BEBits(off int64, data float32) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutF32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+32+7)/8) {
+		err = BufferOverwriteError.atBit("PutF32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	b.buf.PutF32BEBits(off, data)
+	return
+}
+
+// PutF32BEBitsNext writes a float32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF32BEBitsNext(data float32) (err error) {
+	err = b.PutF32BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// WriteF64LE writes a slice of float64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteF64LE(off int64, data []float64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteF64LE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*8) {
+		err = BufferOverwriteError.at("WriteF64LE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteF64LE(off, data)
+	return
+}
+
+// WriteF64LENext writes a slice of float64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteF64LENext(data []float64) (err error) {
+	if err = b.buf.unaligned("WriteF64LENext"); err != nil {
+		return
+	}
+	err = b.WriteF64LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*8, true)
+	}
+	return
+}
+
+// PutF64LE writes a float64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64LE(off int64, data float64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutF64LE", off, 8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 8) {
+		err = BufferOverwriteError.at("PutF64LE", off, 8, b.buf.cap)
+		return
+	}
+	b.buf.PutF64LE(off, data)
+	return
+}
+
+// PutF64LENext writes a float64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64LENext(data float64) (err error) {
+	if err = b.buf.unaligned("PutF64LENext"); err != nil {
+		return
+	}
+	err = b.PutF64LE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// PutF64LEBits writes a float64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64LEBits(off int64, data float64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutF64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+64+7)/8) {
+		err = BufferOverwriteError.atBit("PutF64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	b.buf.PutF64LEBits(off, data)
+	return
+}
+
+// PutF64LEBitsNext writes a float64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64LEBitsNext(data float64) (err error) {
+	err = b.PutF64LEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// WriteF64BE writes a slice of float64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) WriteF64BE(off int64, data []float64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("WriteF64BE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, int64(len(data))*8) {
+		err = BufferOverwriteError.at("WriteF64BE", off, int64(len(data))*8, b.buf.cap)
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	b.buf.WriteF64BE(off, data)
+	return
+}
+
+// WriteF64BENext writes a slice of float64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written. the offset is not moved if an error is returned
+func (b *CheckedBuffer) WriteF64BENext(data []float64) (err error) {
+	if err = b.buf.unaligned("WriteF64BENext"); err != nil {
+		return
+	}
+	err = b.WriteF64BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(int64(len(data))*8, true)
+	}
+	return
+}
+
+// PutF64BE writes a float64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64BE(off int64, data float64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.at("PutF64BE", off, 8, b.buf.cap)
+		return
+	}
+	if !b.buf.writable(off, 8) {
+		err = BufferOverwriteError.at("PutF64BE", off, 8, b.buf.cap)
+		return
+	}
+	b.buf.PutF64BE(off, data)
+	return
+}
+
+// PutF64BENext writes a float64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64BENext(data float64) (err error) {
+	if err = b.buf.unaligned("PutF64BENext"); err != nil {
+		return
+	}
+	err = b.PutF64BE(b.buf.off, data)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// PutF64BEBits writes a float64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64BEBits(off int64, data float64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderwriteError.atBit("PutF64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off && !b.buf.reserve(off/8+(off%8+64+7)/8) {
+		err = BufferOverwriteError.atBit("PutF64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	b.buf.PutF64BEBits(off, data)
+	return
+}
+
+// PutF64BEBitsNext writes a float64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) PutF64BEBitsNext(data float64) (err error) {
+	err = b.PutF64BEBits(b.buf.boff, data)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// ReadBytes returns the next n bytes from the specified offset
+// without modifying the internal offset value
+func (b *CheckedBuffer) ReadBytes(off, n int64) (out []byte, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		err = BufferInvalidByteCountError.at("ReadBytes", off, n, b.buf.cap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.at("ReadBytes", off, n, b.buf.cap)
+		return
+
+	}
+
+	if !b.buf.readable(off, n, 1) {
+
+		err = BufferOverreadError.at("ReadBytes", off, n, b.buf.cap)
+		return
+
+	}
+
+	out = b.buf.ReadBytes(off, n)
+	return
+
+}
+
+// ReadBytesNext returns the next n bytes from the current offset
+// and moves the offset forward the amount of bytes read
+func (b *CheckedBuffer) ReadBytesNext(n int64) (out []byte, err error) {
+
+	if err = b.buf.unaligned("ReadBytesNext"); err != nil {
+
+		return
+
+	}
+
+	out, err = b.ReadBytes(b.buf.off, n)
+	if err == nil {
+
+		b.buf.SeekByte(n, true)
+
+	}
+	return
+
+}
+
+// ReadByteAt returns the byte located at the specified offset without
+// modifying the internal offset value. it is not named ReadByte like
+// the Buffer method, as that is reserved for io.ByteReader
+func (b *CheckedBuffer) ReadByteAt(off int64) (out byte, err error) {
+
+	var bytes []byte
+	bytes, err = b.ReadBytes(off, 1)
+	if err == nil {
+
+		out = bytes[0]
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte from the current offset and
+// moves the offset forward a byte
+func (b *CheckedBuffer) ReadByteNext() (out byte, err error) {
+
+	if err = b.buf.unaligned("ReadByteNext"); err != nil {
+
+		return
+
+	}
+
+	out, err = b.ReadByteAt(b.buf.off)
+	if err == nil {
+
+		b.buf.SeekByte(1, true)
+
+	}
+	return
+
+}
+
+// ReadU16LE reads a slice of uint16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LE(off, n int64) (out []uint16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU16LE", off, n*2, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU16LE", off, n*2, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 2) {
+		err = BufferOverreadError.at("ReadU16LE", off, n*2, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint16{}
+		return
+	}
+	out = b.buf.ReadU16LE(off, n)
+	return
+}
+
+// ReadU16LENext reads a slice of uint16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU16LENext(n int64) (out []uint16, err error) {
+	if err = b.buf.unaligned("ReadU16LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU16LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*2, true)
+	}
+	return
+}
+
+// ReadU16LEAt reads a uint16 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LEAt(off int64) (out uint16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU16LEAt", off, 2, b.buf.cap)
+		return
+	}
+	if 2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU16LEAt", off, 2, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU16LEAt(off)
+	return
+}
+
+// ReadU16LEAtNext reads a uint16 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LEAtNext() (out uint16, err error) {
+	if err = b.buf.unaligned("ReadU16LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU16LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// ReadU16LEInto reads len(dst) uint16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LEInto(dst []uint16, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU16LEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU16LEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	b.buf.ReadU16LEInto(dst, off)
+	return
+}
+
+// ReadU16LEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LEIntoNext(dst []uint16) (err error) {
+	if err = b.buf.unaligned("ReadU16LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU16LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*2, true)
+	}
+	return
+}
+
+// ReadU16LEBits reads a uint16 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LEBits(off int64) (out uint16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU16LEBits(off)
+	return
+}
+
+// ReadU16LEBitsNext reads a uint16 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16LEBitsNext() (out uint16, err error) {
+	out, err = b.ReadU16LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// ReadU16BE reads a slice of uint16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BE(off, n int64) (out []uint16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU16BE", off, n*2, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU16BE", off, n*2, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 2) {
+		err = BufferOverreadError.at("ReadU16BE", off, n*2, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint16{}
+		return
+	}
+	out = b.buf.ReadU16BE(off, n)
+	return
+}
+
+// ReadU16BENext reads a slice of uint16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU16BENext(n int64) (out []uint16, err error) {
+	if err = b.buf.unaligned("ReadU16BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU16BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*2, true)
+	}
+	return
+}
+
+// ReadU16BEAt reads a uint16 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BEAt(off int64) (out uint16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU16BEAt", off, 2, b.buf.cap)
+		return
+	}
+	if 2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU16BEAt", off, 2, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU16BEAt(off)
+	return
+}
+
+// ReadU16BEAtNext reads a uint16 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BEAtNext() (out uint16, err error) {
+	if err = b.buf.unaligned("ReadU16BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU16BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// ReadU16BEInto reads len(dst) uint16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BEInto(dst []uint16, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU16BEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU16BEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	b.buf.ReadU16BEInto(dst, off)
+	return
+}
+
+// ReadU16BEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BEIntoNext(dst []uint16) (err error) {
+	if err = b.buf.unaligned("ReadU16BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU16BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*2, true)
+	}
+	return
+}
+
+// ReadU16BEBits reads a uint16 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BEBits(off int64) (out uint16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU16BEBits(off)
+	return
+}
+
+// ReadU16BEBitsNext reads a uint16 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU16BEBitsNext() (out uint16, err error) {
+	out, err = b.ReadU16BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// ReadU24LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LE(off, n int64) (out []uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU24LE", off, n*3, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU24LE", off, n*3, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 3) {
+		err = BufferOverreadError.at("ReadU24LE", off, n*3, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint32{}
+		return
+	}
+	out = b.buf.ReadU24LE(off, n)
+	return
+}
+
+// ReadU24LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU24LENext(n int64) (out []uint32, err error) {
+	if err = b.buf.unaligned("ReadU24LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU24LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*3, true)
+	}
+	return
+}
+
+// ReadU24LEAt reads a uint32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LEAt(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU24LEAt", off, 3, b.buf.cap)
+		return
+	}
+	if 3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU24LEAt", off, 3, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU24LEAt(off)
+	return
+}
+
+// ReadU24LEAtNext reads a uint32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LEAtNext() (out uint32, err error) {
+	if err = b.buf.unaligned("ReadU24LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU24LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// ReadU24LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LEInto(dst []uint32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU24LEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU24LEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	b.buf.ReadU24LEInto(dst, off)
+	return
+}
+
+// ReadU24LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LEIntoNext(dst []uint32) (err error) {
+	if err = b.buf.unaligned("ReadU24LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU24LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*3, true)
+	}
+	return
+}
+
+// ReadU24LEBits reads a uint32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LEBits(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU24LEBits(off)
+	return
+}
+
+// ReadU24LEBitsNext reads a uint32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24LEBitsNext() (out uint32, err error) {
+	out, err = b.ReadU24LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// ReadU24BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BE(off, n int64) (out []uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU24BE", off, n*3, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU24BE", off, n*3, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 3) {
+		err = BufferOverreadError.at("ReadU24BE", off, n*3, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint32{}
+		return
+	}
+	out = b.buf.ReadU24BE(off, n)
+	return
+}
+
+// ReadU24BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU24BENext(n int64) (out []uint32, err error) {
+	if err = b.buf.unaligned("ReadU24BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU24BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*3, true)
+	}
+	return
+}
+
+// ReadU24BEAt reads a uint32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BEAt(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU24BEAt", off, 3, b.buf.cap)
+		return
+	}
+	if 3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU24BEAt", off, 3, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU24BEAt(off)
+	return
+}
+
+// ReadU24BEAtNext reads a uint32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BEAtNext() (out uint32, err error) {
+	if err = b.buf.unaligned("ReadU24BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU24BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// ReadU24BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BEInto(dst []uint32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU24BEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU24BEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	b.buf.ReadU24BEInto(dst, off)
+	return
+}
+
+// ReadU24BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BEIntoNext(dst []uint32) (err error) {
+	if err = b.buf.unaligned("ReadU24BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU24BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*3, true)
+	}
+	return
+}
+
+// ReadU24BEBits reads a uint32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BEBits(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU24BEBits(off)
+	return
+}
+
+// ReadU24BEBitsNext reads a uint32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU24BEBitsNext() (out uint32, err error) {
+	out, err = b.ReadU24BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// ReadU32LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LE(off, n int64) (out []uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 4) {
+		err = BufferOverreadError.at("ReadU32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint32{}
+		return
+	}
+	out = b.buf.ReadU32LE(off, n)
+	return
+}
+
+// ReadU32LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU32LENext(n int64) (out []uint32, err error) {
+	if err = b.buf.unaligned("ReadU32LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU32LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*4, true)
+	}
+	return
+}
+
+// ReadU32LEAt reads a uint32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LEAt(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU32LEAt", off, 4, b.buf.cap)
+		return
+	}
+	if 4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU32LEAt", off, 4, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU32LEAt(off)
+	return
+}
+
+// ReadU32LEAtNext reads a uint32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LEAtNext() (out uint32, err error) {
+	if err = b.buf.unaligned("ReadU32LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU32LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// ReadU32LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LEInto(dst []uint32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU32LEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU32LEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	b.buf.ReadU32LEInto(dst, off)
+	return
+}
+
+// ReadU32LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LEIntoNext(dst []uint32) (err error) {
+	if err = b.buf.unaligned("ReadU32LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU32LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*4, true)
+	}
+	return
+}
+
+// ReadU32LEBits reads a uint32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LEBits(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU32LEBits(off)
+	return
+}
+
+// ReadU32LEBitsNext reads a uint32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32LEBitsNext() (out uint32, err error) {
+	out, err = b.ReadU32LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// ReadU32BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BE(off, n int64) (out []uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 4) {
+		err = BufferOverreadError.at("ReadU32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint32{}
+		return
+	}
+	out = b.buf.ReadU32BE(off, n)
+	return
+}
+
+// ReadU32BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU32BENext(n int64) (out []uint32, err error) {
+	if err = b.buf.unaligned("ReadU32BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU32BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*4, true)
+	}
+	return
+}
+
+// ReadU32BEAt reads a uint32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BEAt(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU32BEAt", off, 4, b.buf.cap)
+		return
+	}
+	if 4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU32BEAt", off, 4, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU32BEAt(off)
+	return
+}
+
+// ReadU32BEAtNext reads a uint32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BEAtNext() (out uint32, err error) {
+	if err = b.buf.unaligned("ReadU32BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU32BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// ReadU32BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BEInto(dst []uint32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU32BEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU32BEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	b.buf.ReadU32BEInto(dst, off)
+	return
+}
+
+// ReadU32BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BEIntoNext(dst []uint32) (err error) {
+	if err = b.buf.unaligned("ReadU32BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU32BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*4, true)
+	}
+	return
+}
+
+// ReadU32BEBits reads a uint32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BEBits(off int64) (out uint32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU32BEBits(off)
+	return
+}
+
+// ReadU32BEBitsNext reads a uint32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU32BEBitsNext() (out uint32, err error) {
+	out, err = b.ReadU32BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// ReadU40LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU40LE", off, n*5, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU40LE", off, n*5, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 5) {
+		err = BufferOverreadError.at("ReadU40LE", off, n*5, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU40LE(off, n)
+	return
+}
+
+// ReadU40LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU40LENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU40LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU40LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*5, true)
+	}
+	return
+}
+
+// ReadU40LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU40LEAt", off, 5, b.buf.cap)
+		return
+	}
+	if 5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU40LEAt", off, 5, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU40LEAt(off)
+	return
+}
+
+// ReadU40LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU40LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU40LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// ReadU40LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU40LEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU40LEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	b.buf.ReadU40LEInto(dst, off)
+	return
+}
+
+// ReadU40LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU40LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU40LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*5, true)
+	}
+	return
+}
+
+// ReadU40LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU40LEBits(off)
+	return
+}
+
+// ReadU40LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40LEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU40LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// ReadU40BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU40BE", off, n*5, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU40BE", off, n*5, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 5) {
+		err = BufferOverreadError.at("ReadU40BE", off, n*5, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU40BE(off, n)
+	return
+}
+
+// ReadU40BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU40BENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU40BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU40BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*5, true)
+	}
+	return
+}
+
+// ReadU40BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU40BEAt", off, 5, b.buf.cap)
+		return
+	}
+	if 5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU40BEAt", off, 5, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU40BEAt(off)
+	return
+}
+
+// ReadU40BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU40BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU40BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// ReadU40BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU40BEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU40BEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	b.buf.ReadU40BEInto(dst, off)
+	return
+}
+
+// ReadU40BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU40BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU40BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*5, true)
+	}
+	return
+}
+
+// ReadU40BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU40BEBits(off)
+	return
+}
+
+// ReadU40BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU40BEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU40BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// ReadU48LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU48LE", off, n*6, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU48LE", off, n*6, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 6) {
+		err = BufferOverreadError.at("ReadU48LE", off, n*6, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU48LE(off, n)
+	return
+}
+
+// ReadU48LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU48LENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU48LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU48LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*6, true)
+	}
+	return
+}
+
+// ReadU48LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU48LEAt", off, 6, b.buf.cap)
+		return
+	}
+	if 6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU48LEAt", off, 6, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU48LEAt(off)
+	return
+}
+
+// ReadU48LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU48LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU48LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// ReadU48LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU48LEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU48LEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	b.buf.ReadU48LEInto(dst, off)
+	return
+}
+
+// ReadU48LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU48LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU48LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*6, true)
+	}
+	return
+}
+
+// ReadU48LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU48LEBits(off)
+	return
+}
+
+// ReadU48LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48LEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU48LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// ReadU48BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU48BE", off, n*6, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU48BE", off, n*6, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 6) {
+		err = BufferOverreadError.at("ReadU48BE", off, n*6, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU48BE(off, n)
+	return
+}
+
+// ReadU48BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU48BENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU48BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU48BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*6, true)
+	}
+	return
+}
+
+// ReadU48BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU48BEAt", off, 6, b.buf.cap)
+		return
+	}
+	if 6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU48BEAt", off, 6, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU48BEAt(off)
+	return
+}
+
+// ReadU48BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU48BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU48BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// ReadU48BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU48BEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU48BEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	b.buf.ReadU48BEInto(dst, off)
+	return
+}
+
+// ReadU48BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU48BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU48BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*6, true)
+	}
+	return
+}
+
+// ReadU48BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU48BEBits(off)
+	return
+}
+
+// ReadU48BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU48BEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU48BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// ReadU56LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU56LE", off, n*7, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU56LE", off, n*7, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 7) {
+		err = BufferOverreadError.at("ReadU56LE", off, n*7, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU56LE(off, n)
+	return
+}
+
+// ReadU56LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU56LENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU56LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU56LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*7, true)
+	}
+	return
+}
+
+// ReadU56LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU56LEAt", off, 7, b.buf.cap)
+		return
+	}
+	if 7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU56LEAt", off, 7, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU56LEAt(off)
+	return
+}
+
+// ReadU56LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU56LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU56LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// ReadU56LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU56LEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU56LEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	b.buf.ReadU56LEInto(dst, off)
+	return
+}
+
+// ReadU56LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU56LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU56LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*7, true)
+	}
+	return
+}
+
+// ReadU56LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU56LEBits(off)
+	return
+}
+
+// ReadU56LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56LEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU56LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// ReadU56BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU56BE", off, n*7, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU56BE", off, n*7, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 7) {
+		err = BufferOverreadError.at("ReadU56BE", off, n*7, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU56BE(off, n)
+	return
+}
+
+// ReadU56BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU56BENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU56BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU56BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*7, true)
+	}
+	return
+}
+
+// ReadU56BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU56BEAt", off, 7, b.buf.cap)
+		return
+	}
+	if 7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU56BEAt", off, 7, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU56BEAt(off)
+	return
+}
+
+// ReadU56BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU56BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU56BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// ReadU56BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU56BEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU56BEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	b.buf.ReadU56BEInto(dst, off)
+	return
+}
+
+// ReadU56BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU56BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU56BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*7, true)
+	}
+	return
+}
+
+// ReadU56BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU56BEBits(off)
+	return
+}
+
+// ReadU56BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU56BEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU56BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// ReadU64LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 8) {
+		err = BufferOverreadError.at("ReadU64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU64LE(off, n)
+	return
+}
+
+// ReadU64LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU64LENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU64LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU64LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*8, true)
+	}
+	return
+}
+
+// ReadU64LEAt reads a uint64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU64LEAt", off, 8, b.buf.cap)
+		return
+	}
+	if 8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU64LEAt", off, 8, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU64LEAt(off)
+	return
+}
+
+// ReadU64LEAtNext reads a uint64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU64LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU64LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// ReadU64LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU64LEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU64LEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	b.buf.ReadU64LEInto(dst, off)
+	return
+}
+
+// ReadU64LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU64LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU64LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*8, true)
+	}
+	return
+}
+
+// ReadU64LEBits reads a uint64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU64LEBits(off)
+	return
+}
+
+// ReadU64LEBitsNext reads a uint64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64LEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU64LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// ReadU64BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BE(off, n int64) (out []uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadU64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 8) {
+		err = BufferOverreadError.at("ReadU64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []uint64{}
+		return
+	}
+	out = b.buf.ReadU64BE(off, n)
+	return
+}
+
+// ReadU64BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadU64BENext(n int64) (out []uint64, err error) {
+	if err = b.buf.unaligned("ReadU64BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadU64BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*8, true)
+	}
+	return
+}
+
+// ReadU64BEAt reads a uint64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BEAt(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU64BEAt", off, 8, b.buf.cap)
+		return
+	}
+	if 8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU64BEAt", off, 8, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadU64BEAt(off)
+	return
+}
+
+// ReadU64BEAtNext reads a uint64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BEAtNext() (out uint64, err error) {
+	if err = b.buf.unaligned("ReadU64BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadU64BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// ReadU64BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BEInto(dst []uint64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadU64BEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadU64BEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	b.buf.ReadU64BEInto(dst, off)
+	return
+}
+
+// ReadU64BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BEIntoNext(dst []uint64) (err error) {
+	if err = b.buf.unaligned("ReadU64BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadU64BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*8, true)
+	}
+	return
+}
+
+// ReadU64BEBits reads a uint64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BEBits(off int64) (out uint64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadU64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadU64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadU64BEBits(off)
+	return
+}
+
+// ReadU64BEBitsNext reads a uint64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadU64BEBitsNext() (out uint64, err error) {
+	out, err = b.ReadU64BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// ReadI16LE reads a slice of int16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LE(off, n int64) (out []int16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI16LE", off, n*2, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI16LE", off, n*2, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 2) {
+		err = BufferOverreadError.at("ReadI16LE", off, n*2, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int16{}
+		return
+	}
+	out = b.buf.ReadI16LE(off, n)
+	return
+}
+
+// ReadI16LENext reads a slice of int16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI16LENext(n int64) (out []int16, err error) {
+	if err = b.buf.unaligned("ReadI16LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI16LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*2, true)
+	}
+	return
+}
+
+// ReadI16LEAt reads an int16 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LEAt(off int64) (out int16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI16LEAt", off, 2, b.buf.cap)
+		return
+	}
+	if 2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI16LEAt", off, 2, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI16LEAt(off)
+	return
+}
+
+// ReadI16LEAtNext reads an int16 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LEAtNext() (out int16, err error) {
+	if err = b.buf.unaligned("ReadI16LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI16LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// ReadI16LEInto reads len(dst) int16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LEInto(dst []int16, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI16LEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI16LEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	b.buf.ReadI16LEInto(dst, off)
+	return
+}
+
+// ReadI16LEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LEIntoNext(dst []int16) (err error) {
+	if err = b.buf.unaligned("ReadI16LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI16LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*2, true)
+	}
+	return
+}
+
+// ReadI16LEBits reads an int16 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LEBits(off int64) (out int16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI16LEBits", off, 16, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI16LEBits(off)
+	return
+}
+
+// ReadI16LEBitsNext reads an int16 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16LEBitsNext() (out int16, err error) {
+	out, err = b.ReadI16LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// ReadI16BE reads a slice of int16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BE(off, n int64) (out []int16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI16BE", off, n*2, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI16BE", off, n*2, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 2) {
+		err = BufferOverreadError.at("ReadI16BE", off, n*2, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int16{}
+		return
+	}
+	out = b.buf.ReadI16BE(off, n)
+	return
+}
+
+// ReadI16BENext reads a slice of int16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI16BENext(n int64) (out []int16, err error) {
+	if err = b.buf.unaligned("ReadI16BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI16BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*2, true)
+	}
+	return
+}
+
+// ReadI16BEAt reads an int16 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BEAt(off int64) (out int16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI16BEAt", off, 2, b.buf.cap)
+		return
+	}
+	if 2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI16BEAt", off, 2, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI16BEAt(off)
+	return
+}
+
+// ReadI16BEAtNext reads an int16 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BEAtNext() (out int16, err error) {
+	if err = b.buf.unaligned("ReadI16BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI16BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(2, true)
+	}
+	return
+}
+
+// ReadI16BEInto reads len(dst) int16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BEInto(dst []int16, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI16BEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*2 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI16BEInto", off, int64(len(dst))*2, b.buf.cap)
+		return
+	}
+	b.buf.ReadI16BEInto(dst, off)
+	return
+}
+
+// ReadI16BEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BEIntoNext(dst []int16) (err error) {
+	if err = b.buf.unaligned("ReadI16BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI16BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*2, true)
+	}
+	return
+}
+
+// ReadI16BEBits reads an int16 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BEBits(off int64) (out int16, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	if 16 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI16BEBits", off, 16, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI16BEBits(off)
+	return
+}
+
+// ReadI16BEBitsNext reads an int16 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI16BEBitsNext() (out int16, err error) {
+	out, err = b.ReadI16BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(16, true)
+	}
+	return
+}
+
+// ReadI24LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LE(off, n int64) (out []int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI24LE", off, n*3, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI24LE", off, n*3, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 3) {
+		err = BufferOverreadError.at("ReadI24LE", off, n*3, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int32{}
+		return
+	}
+	out = b.buf.ReadI24LE(off, n)
+	return
+}
+
+// ReadI24LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI24LENext(n int64) (out []int32, err error) {
+	if err = b.buf.unaligned("ReadI24LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI24LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*3, true)
+	}
+	return
+}
+
+// ReadI24LEAt reads an int32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LEAt(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI24LEAt", off, 3, b.buf.cap)
+		return
+	}
+	if 3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI24LEAt", off, 3, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI24LEAt(off)
+	return
+}
+
+// ReadI24LEAtNext reads an int32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LEAtNext() (out int32, err error) {
+	if err = b.buf.unaligned("ReadI24LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI24LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// ReadI24LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LEInto(dst []int32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI24LEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI24LEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	b.buf.ReadI24LEInto(dst, off)
+	return
+}
+
+// ReadI24LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LEIntoNext(dst []int32) (err error) {
+	if err = b.buf.unaligned("ReadI24LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI24LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*3, true)
+	}
+	return
+}
+
+// ReadI24LEBits reads an int32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LEBits(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI24LEBits", off, 24, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI24LEBits(off)
+	return
+}
+
+// ReadI24LEBitsNext reads an int32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24LEBitsNext() (out int32, err error) {
+	out, err = b.ReadI24LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// ReadI24BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BE(off, n int64) (out []int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI24BE", off, n*3, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI24BE", off, n*3, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 3) {
+		err = BufferOverreadError.at("ReadI24BE", off, n*3, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int32{}
+		return
+	}
+	out = b.buf.ReadI24BE(off, n)
+	return
+}
+
+// ReadI24BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI24BENext(n int64) (out []int32, err error) {
+	if err = b.buf.unaligned("ReadI24BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI24BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*3, true)
+	}
+	return
+}
+
+// ReadI24BEAt reads an int32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BEAt(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI24BEAt", off, 3, b.buf.cap)
+		return
+	}
+	if 3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI24BEAt", off, 3, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI24BEAt(off)
+	return
+}
+
+// ReadI24BEAtNext reads an int32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BEAtNext() (out int32, err error) {
+	if err = b.buf.unaligned("ReadI24BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI24BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(3, true)
+	}
+	return
+}
+
+// ReadI24BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BEInto(dst []int32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI24BEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*3 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI24BEInto", off, int64(len(dst))*3, b.buf.cap)
+		return
+	}
+	b.buf.ReadI24BEInto(dst, off)
+	return
+}
+
+// ReadI24BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BEIntoNext(dst []int32) (err error) {
+	if err = b.buf.unaligned("ReadI24BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI24BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*3, true)
+	}
+	return
+}
+
+// ReadI24BEBits reads an int32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BEBits(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	if 24 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI24BEBits", off, 24, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI24BEBits(off)
+	return
+}
+
+// ReadI24BEBitsNext reads an int32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI24BEBitsNext() (out int32, err error) {
+	out, err = b.ReadI24BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(24, true)
+	}
+	return
+}
+
+// ReadI32LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LE(off, n int64) (out []int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 4) {
+		err = BufferOverreadError.at("ReadI32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int32{}
+		return
+	}
+	out = b.buf.ReadI32LE(off, n)
+	return
+}
+
+// ReadI32LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI32LENext(n int64) (out []int32, err error) {
+	if err = b.buf.unaligned("ReadI32LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI32LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*4, true)
+	}
+	return
+}
+
+// ReadI32LEAt reads an int32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LEAt(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI32LEAt", off, 4, b.buf.cap)
+		return
+	}
+	if 4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI32LEAt", off, 4, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI32LEAt(off)
+	return
+}
+
+// ReadI32LEAtNext reads an int32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LEAtNext() (out int32, err error) {
+	if err = b.buf.unaligned("ReadI32LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI32LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// ReadI32LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LEInto(dst []int32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI32LEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI32LEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	b.buf.ReadI32LEInto(dst, off)
+	return
+}
+
+// ReadI32LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LEIntoNext(dst []int32) (err error) {
+	if err = b.buf.unaligned("ReadI32LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI32LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*4, true)
+	}
+	return
+}
+
+// ReadI32LEBits reads an int32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LEBits(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI32LEBits(off)
+	return
+}
+
+// ReadI32LEBitsNext reads an int32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32LEBitsNext() (out int32, err error) {
+	out, err = b.ReadI32LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// ReadI32BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BE(off, n int64) (out []int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 4) {
+		err = BufferOverreadError.at("ReadI32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int32{}
+		return
+	}
+	out = b.buf.ReadI32BE(off, n)
+	return
+}
+
+// ReadI32BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI32BENext(n int64) (out []int32, err error) {
+	if err = b.buf.unaligned("ReadI32BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI32BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*4, true)
+	}
+	return
+}
+
+// ReadI32BEAt reads an int32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BEAt(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI32BEAt", off, 4, b.buf.cap)
+		return
+	}
+	if 4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI32BEAt", off, 4, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI32BEAt(off)
+	return
+}
+
+// ReadI32BEAtNext reads an int32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BEAtNext() (out int32, err error) {
+	if err = b.buf.unaligned("ReadI32BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI32BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// ReadI32BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BEInto(dst []int32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI32BEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI32BEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	b.buf.ReadI32BEInto(dst, off)
+	return
+}
+
+// ReadI32BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BEIntoNext(dst []int32) (err error) {
+	if err = b.buf.unaligned("ReadI32BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI32BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*4, true)
+	}
+	return
+}
+
+// ReadI32BEBits reads an int32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BEBits(off int64) (out int32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI32BEBits(off)
+	return
+}
+
+// ReadI32BEBitsNext reads an int32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI32BEBitsNext() (out int32, err error) {
+	out, err = b.ReadI32BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// ReadI40LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI40LE", off, n*5, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI40LE", off, n*5, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 5) {
+		err = BufferOverreadError.at("ReadI40LE", off, n*5, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI40LE(off, n)
+	return
+}
+
+// ReadI40LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI40LENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI40LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI40LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*5, true)
+	}
+	return
+}
+
+// ReadI40LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI40LEAt", off, 5, b.buf.cap)
+		return
+	}
+	if 5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI40LEAt", off, 5, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI40LEAt(off)
+	return
+}
+
+// ReadI40LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI40LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI40LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// ReadI40LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI40LEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI40LEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	b.buf.ReadI40LEInto(dst, off)
+	return
+}
+
+// ReadI40LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI40LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI40LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*5, true)
+	}
+	return
+}
+
+// ReadI40LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI40LEBits", off, 40, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI40LEBits(off)
+	return
+}
+
+// ReadI40LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40LEBitsNext() (out int64, err error) {
+	out, err = b.ReadI40LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// ReadI40BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI40BE", off, n*5, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI40BE", off, n*5, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 5) {
+		err = BufferOverreadError.at("ReadI40BE", off, n*5, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI40BE(off, n)
+	return
+}
+
+// ReadI40BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI40BENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI40BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI40BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*5, true)
+	}
+	return
+}
+
+// ReadI40BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI40BEAt", off, 5, b.buf.cap)
+		return
+	}
+	if 5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI40BEAt", off, 5, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI40BEAt(off)
+	return
+}
+
+// ReadI40BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI40BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI40BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(5, true)
+	}
+	return
+}
+
+// ReadI40BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI40BEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*5 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI40BEInto", off, int64(len(dst))*5, b.buf.cap)
+		return
+	}
+	b.buf.ReadI40BEInto(dst, off)
+	return
+}
+
+// ReadI40BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI40BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI40BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*5, true)
+	}
+	return
+}
+
+// ReadI40BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	if 40 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI40BEBits", off, 40, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI40BEBits(off)
+	return
+}
+
+// ReadI40BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI40BEBitsNext() (out int64, err error) {
+	out, err = b.ReadI40BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(40, true)
+	}
+	return
+}
+
+// ReadI48LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI48LE", off, n*6, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI48LE", off, n*6, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 6) {
+		err = BufferOverreadError.at("ReadI48LE", off, n*6, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI48LE(off, n)
+	return
+}
+
+// ReadI48LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI48LENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI48LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI48LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*6, true)
+	}
+	return
+}
+
+// ReadI48LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI48LEAt", off, 6, b.buf.cap)
+		return
+	}
+	if 6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI48LEAt", off, 6, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI48LEAt(off)
+	return
+}
+
+// ReadI48LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI48LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI48LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// ReadI48LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI48LEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI48LEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	b.buf.ReadI48LEInto(dst, off)
+	return
+}
+
+// ReadI48LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI48LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI48LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*6, true)
+	}
+	return
+}
+
+// ReadI48LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI48LEBits", off, 48, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI48LEBits(off)
+	return
+}
+
+// ReadI48LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48LEBitsNext() (out int64, err error) {
+	out, err = b.ReadI48LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// ReadI48BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI48BE", off, n*6, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI48BE", off, n*6, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 6) {
+		err = BufferOverreadError.at("ReadI48BE", off, n*6, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI48BE(off, n)
+	return
+}
+
+// ReadI48BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI48BENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI48BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI48BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*6, true)
+	}
+	return
+}
+
+// ReadI48BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI48BEAt", off, 6, b.buf.cap)
+		return
+	}
+	if 6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI48BEAt", off, 6, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI48BEAt(off)
+	return
+}
+
+// ReadI48BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI48BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI48BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(6, true)
+	}
+	return
+}
+
+// ReadI48BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI48BEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*6 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI48BEInto", off, int64(len(dst))*6, b.buf.cap)
+		return
+	}
+	b.buf.ReadI48BEInto(dst, off)
+	return
+}
+
+// ReadI48BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI48BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI48BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*6, true)
+	}
+	return
+}
+
+// ReadI48BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	if 48 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI48BEBits", off, 48, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI48BEBits(off)
+	return
+}
+
+// ReadI48BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI48BEBitsNext() (out int64, err error) {
+	out, err = b.ReadI48BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(48, true)
+	}
+	return
+}
+
+// ReadI56LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI56LE", off, n*7, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI56LE", off, n*7, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 7) {
+		err = BufferOverreadError.at("ReadI56LE", off, n*7, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI56LE(off, n)
+	return
+}
+
+// ReadI56LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI56LENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI56LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI56LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*7, true)
+	}
+	return
+}
+
+// ReadI56LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI56LEAt", off, 7, b.buf.cap)
+		return
+	}
+	if 7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI56LEAt", off, 7, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI56LEAt(off)
+	return
+}
+
+// ReadI56LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI56LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI56LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// ReadI56LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI56LEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI56LEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	b.buf.ReadI56LEInto(dst, off)
+	return
+}
+
+// ReadI56LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI56LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI56LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*7, true)
+	}
+	return
+}
+
+// ReadI56LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI56LEBits", off, 56, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI56LEBits(off)
+	return
+}
+
+// ReadI56LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56LEBitsNext() (out int64, err error) {
+	out, err = b.ReadI56LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// ReadI56BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI56BE", off, n*7, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI56BE", off, n*7, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 7) {
+		err = BufferOverreadError.at("ReadI56BE", off, n*7, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI56BE(off, n)
+	return
+}
+
+// ReadI56BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI56BENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI56BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI56BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*7, true)
+	}
+	return
+}
+
+// ReadI56BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI56BEAt", off, 7, b.buf.cap)
+		return
+	}
+	if 7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI56BEAt", off, 7, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI56BEAt(off)
+	return
+}
+
+// ReadI56BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI56BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI56BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(7, true)
+	}
+	return
+}
+
+// ReadI56BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI56BEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*7 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI56BEInto", off, int64(len(dst))*7, b.buf.cap)
+		return
+	}
+	b.buf.ReadI56BEInto(dst, off)
+	return
+}
+
+// ReadI56BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI56BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI56BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*7, true)
+	}
+	return
+}
+
+// ReadI56BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	if 56 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI56BEBits", off, 56, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI56BEBits(off)
+	return
+}
+
+// ReadI56BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI56BEBitsNext() (out int64, err error) {
+	out, err = b.ReadI56BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(56, true)
+	}
+	return
+}
+
+// ReadI64LE reads a slice of int64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 8) {
+		err = BufferOverreadError.at("ReadI64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI64LE(off, n)
+	return
+}
+
+// ReadI64LENext reads a slice of int64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI64LENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI64LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI64LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*8, true)
+	}
+	return
+}
+
+// ReadI64LEAt reads an int64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI64LEAt", off, 8, b.buf.cap)
+		return
+	}
+	if 8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI64LEAt", off, 8, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI64LEAt(off)
+	return
+}
+
+// ReadI64LEAtNext reads an int64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI64LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI64LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// ReadI64LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI64LEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI64LEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	b.buf.ReadI64LEInto(dst, off)
+	return
+}
+
+// ReadI64LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI64LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI64LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*8, true)
+	}
+	return
+}
+
+// ReadI64LEBits reads an int64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI64LEBits(off)
+	return
+}
+
+// ReadI64LEBitsNext reads an int64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64LEBitsNext() (out int64, err error) {
+	out, err = b.ReadI64LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// ReadI64BE reads a slice of int64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BE(off, n int64) (out []int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadI64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 8) {
+		err = BufferOverreadError.at("ReadI64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []int64{}
+		return
+	}
+	out = b.buf.ReadI64BE(off, n)
+	return
+}
+
+// ReadI64BENext reads a slice of int64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadI64BENext(n int64) (out []int64, err error) {
+	if err = b.buf.unaligned("ReadI64BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadI64BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*8, true)
+	}
+	return
+}
+
+// ReadI64BEAt reads an int64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BEAt(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI64BEAt", off, 8, b.buf.cap)
+		return
+	}
+	if 8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI64BEAt", off, 8, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadI64BEAt(off)
+	return
+}
+
+// ReadI64BEAtNext reads an int64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BEAtNext() (out int64, err error) {
+	if err = b.buf.unaligned("ReadI64BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadI64BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// ReadI64BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BEInto(dst []int64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadI64BEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadI64BEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	b.buf.ReadI64BEInto(dst, off)
+	return
+}
+
+// ReadI64BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BEIntoNext(dst []int64) (err error) {
+	if err = b.buf.unaligned("ReadI64BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadI64BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*8, true)
+	}
+	return
+}
+
+// ReadI64BEBits reads an int64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BEBits(off int64) (out int64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadI64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadI64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadI64BEBits(off)
+	return
+}
+
+// ReadI64BEBitsNext reads an int64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadI64BEBitsNext() (out int64, err error) {
+	out, err = b.ReadI64BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// ReadF32LE reads a slice of float32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LE(off, n int64) (out []float32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadF32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 4) {
+		err = BufferOverreadError.at("ReadF32LE", off, n*4, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []float32{}
+		return
+	}
+	out = b.buf.ReadF32LE(off, n)
+	return
+}
+
+// ReadF32LENext reads a slice of float32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadF32LENext(n int64) (out []float32, err error) {
+	if err = b.buf.unaligned("ReadF32LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadF32LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*4, true)
+	}
+	return
+}
+
+// ReadF32LEAt reads a float32 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LEAt(off int64) (out float32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF32LEAt", off, 4, b.buf.cap)
+		return
+	}
+	if 4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF32LEAt", off, 4, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadF32LEAt(off)
+	return
+}
+
+// ReadF32LEAtNext reads a float32 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LEAtNext() (out float32, err error) {
+	if err = b.buf.unaligned("ReadF32LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadF32LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// ReadF32LEInto reads len(dst) float32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LEInto(dst []float32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF32LEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF32LEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	b.buf.ReadF32LEInto(dst, off)
+	return
+}
+
+// ReadF32LEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LEIntoNext(dst []float32) (err error) {
+	if err = b.buf.unaligned("ReadF32LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadF32LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*4, true)
+	}
+	return
+}
+
+// ReadF32LEBits reads a float32 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LEBits(off int64) (out float32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadF32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadF32LEBits", off, 32, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadF32LEBits(off)
+	return
+}
+
+// ReadF32LEBitsNext reads a float32 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32LEBitsNext() (out float32, err error) {
+	out, err = b.ReadF32LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// ReadF32BE reads a slice of float32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BE(off, n int64) (out []float32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadF32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 4) {
+		err = BufferOverreadError.at("ReadF32BE", off, n*4, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []float32{}
+		return
+	}
+	out = b.buf.ReadF32BE(off, n)
+	return
+}
+
+// ReadF32BENext reads a slice of float32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadF32BENext(n int64) (out []float32, err error) {
+	if err = b.buf.unaligned("ReadF32BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadF32BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*4, true)
+	}
+	return
+}
+
+// ReadF32BEAt reads a float32 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BEAt(off int64) (out float32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF32BEAt", off, 4, b.buf.cap)
+		return
+	}
+	if 4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF32BEAt", off, 4, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadF32BEAt(off)
+	return
+}
+
+// ReadF32BEAtNext reads a float32 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BEAtNext() (out float32, err error) {
+	if err = b.buf.unaligned("ReadF32BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadF32BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(4, true)
+	}
+	return
+}
+
+// ReadF32BEInto reads len(dst) float32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BEInto(dst []float32, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF32BEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*4 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF32BEInto", off, int64(len(dst))*4, b.buf.cap)
+		return
+	}
+	b.buf.ReadF32BEInto(dst, off)
+	return
+}
+
+// ReadF32BEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BEIntoNext(dst []float32) (err error) {
+	if err = b.buf.unaligned("ReadF32BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadF32BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*4, true)
+	}
+	return
+}
+
+// ReadF32BEBits reads a float32 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BEBits(off int64) (out float32, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadF32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	if 32 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadF32BEBits", off, 32, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadF32BEBits(off)
+	return
+}
+
+// ReadF32BEBitsNext reads a float32 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF32BEBitsNext() (out float32, err error) {
+	out, err = b.ReadF32BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(32, true)
+	}
+	return
+}
+
+// ReadF64LE reads a slice of float64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LE(off, n int64) (out []float64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadF64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 8) {
+		err = BufferOverreadError.at("ReadF64LE", off, n*8, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []float64{}
+		return
+	}
+	out = b.buf.ReadF64LE(off, n)
+	return
+}
+
+// ReadF64LENext reads a slice of float64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadF64LENext(n int64) (out []float64, err error) {
+	if err = b.buf.unaligned("ReadF64LENext"); err != nil {
+		return
+	}
+	out, err = b.ReadF64LE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*8, true)
+	}
+	return
+}
+
+// ReadF64LEAt reads a float64 from the buffer at the specified offset
+// in little-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LEAt(off int64) (out float64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF64LEAt", off, 8, b.buf.cap)
+		return
+	}
+	if 8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF64LEAt", off, 8, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadF64LEAt(off)
+	return
+}
+
+// ReadF64LEAtNext reads a float64 from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LEAtNext() (out float64, err error) {
+	if err = b.buf.unaligned("ReadF64LEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadF64LEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// ReadF64LEInto reads len(dst) float64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LEInto(dst []float64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF64LEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF64LEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	b.buf.ReadF64LEInto(dst, off)
+	return
+}
+
+// ReadF64LEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LEIntoNext(dst []float64) (err error) {
+	if err = b.buf.unaligned("ReadF64LEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadF64LEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*8, true)
+	}
+	return
+}
+
+// ReadF64LEBits reads a float64 from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LEBits(off int64) (out float64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadF64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadF64LEBits", off, 64, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadF64LEBits(off)
+	return
+}
+
+// ReadF64LEBitsNext reads a float64 from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64LEBitsNext() (out float64, err error) {
+	out, err = b.ReadF64LEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// ReadF64BE reads a slice of float64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value. an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BE(off, n int64) (out []float64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if n < 0 {
+		err = BufferInvalidByteCountError.at("ReadF64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if !b.buf.readable(off, n, 8) {
+		err = BufferOverreadError.at("ReadF64BE", off, n*8, b.buf.cap)
+		return
+	}
+	if n == 0 {
+		out = []float64{}
+		return
+	}
+	out = b.buf.ReadF64BE(off, n)
+	return
+}
+
+// ReadF64BENext reads a slice of float64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes read. the offset is not moved if an error is returned
+func (b *CheckedBuffer) ReadF64BENext(n int64) (out []float64, err error) {
+	if err = b.buf.unaligned("ReadF64BENext"); err != nil {
+		return
+	}
+	out, err = b.ReadF64BE(b.buf.off, n)
+	if err == nil {
+		b.buf.SeekByte(n*8, true)
+	}
+	return
+}
+
+// ReadF64BEAt reads a float64 from the buffer at the specified offset
+// in big-endian without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BEAt(off int64) (out float64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF64BEAt", off, 8, b.buf.cap)
+		return
+	}
+	if 8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF64BEAt", off, 8, b.buf.cap)
+		return
+	}
+	out = b.buf.ReadF64BEAt(off)
+	return
+}
+
+// ReadF64BEAtNext reads a float64 from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BEAtNext() (out float64, err error) {
+	if err = b.buf.unaligned("ReadF64BEAtNext"); err != nil {
+		return
+	}
+	out, err = b.ReadF64BEAt(b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(8, true)
+	}
+	return
+}
+
+// ReadF64BEInto reads len(dst) float64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BEInto(dst []float64, off int64) (err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.at("ReadF64BEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	if int64(len(dst))*8 > b.buf.cap-off {
+		err = BufferOverreadError.at("ReadF64BEInto", off, int64(len(dst))*8, b.buf.cap)
+		return
+	}
+	b.buf.ReadF64BEInto(dst, off)
+	return
+}
+
+// ReadF64BEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BEIntoNext(dst []float64) (err error) {
+	if err = b.buf.unaligned("ReadF64BEIntoNext"); err != nil {
+		return
+	}
+	err = b.ReadF64BEInto(dst, b.buf.off)
+	if err == nil {
+		b.buf.SeekByte(int64(len(dst))*8, true)
+	}
+	return
+}
+
+// ReadF64BEBits reads a float64 from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BEBits(off int64) (out float64, err error) {
+	if err = b.buf.err; err != nil {
+		return
+	}
+	if off < 0 {
+		err = BufferUnderreadError.atBit("ReadF64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	if 64 > b.buf.bcap-off {
+		err = BufferOverreadError.atBit("ReadF64BEBits", off, 64, b.buf.bcap)
+		return
+	}
+	out = b.buf.ReadF64BEBits(off)
+	return
+}
+
+// ReadF64BEBitsNext reads a float64 from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read.
+// an error is returned if the operation is out of bounds
+func (b *CheckedBuffer) ReadF64BEBitsNext() (out float64, err error) {
+	out, err = b.ReadF64BEBits(b.buf.boff)
+	if err == nil {
+		b.buf.SeekBit(64, true)
+	}
+	return
+}
+
+// SeekByte seeks to position off of the buffer relative to the
+// current position or exact. the offset is not moved if the new
+// position would be outside of the buffer
+func (b *CheckedBuffer) SeekByte(off int64, relative bool) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if relative {
+
+		off += b.buf.off
+
+	}
+
+	if off > b.buf.cap {
+
+		err = BufferOverseekError.at("SeekByte", off, 0, b.buf.cap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderseekError.at("SeekByte", off, 0, b.buf.cap)
+		return
+
+	}
+
+	b.buf.SeekByte(off, false)
+	return
+
+}
+
+// AfterByte returns the amount of bytes located after the current
+// position or the specified one
+func (b *CheckedBuffer) AfterByte(off ...int64) int64 {
+
+	return b.buf.AfterByte(off...)
+
+}
+
+// AlignByte aligns the byte offset to the bit offset
+func (b *CheckedBuffer) AlignByte() {
+
+	b.buf.AlignByte()
+
+}
+
+/* generic methods */
+
+// TruncateLeft truncates the buffer on the left side
+func (b *CheckedBuffer) TruncateLeft(n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > b.buf.cap {
+
+		err = BufferInvalidByteCountError.count("TruncateLeft", n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.TruncateLeft(n)
+	return
+
+}
+
+// TruncateRight truncates the buffer on the right side
+func (b *CheckedBuffer) TruncateRight(n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 || n > b.buf.cap {
+
+		err = BufferInvalidByteCountError.count("TruncateRight", n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.TruncateRight(n)
+	return
+
+}
+
+// Grow makes the buffer's capacity bigger by n bytes
+func (b *CheckedBuffer) Grow(n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		err = BufferInvalidByteCountError.count("Grow", n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.Grow(n)
+	return
+
+}
+
+// Refresh updates the cached internal statistics of the buffer forcefully
+func (b *CheckedBuffer) Refresh() {
+
+	b.buf.Refresh()
+
+}
+
+// Reset resets the entire buffer
+func (b *CheckedBuffer) Reset() {
+
+	b.buf.Reset()
+
+}
+
+/* internal use methods */
+
+// checkBitWrite returns the error that a write to the bit at the
+// specified offset by op would cause, if any
+func (b *CheckedBuffer) checkBitWrite(op string, off int64) error {
+
+	if b.buf.err != nil {
+
+		return b.buf.err
+
+	}
+
+	if off > (b.buf.bcap-1) && !b.buf.reserve(off/8+1) {
+
+		return BufferOverwriteError.atBit(op, off, 1, b.buf.bcap)
+
+	}
+
+	if off < 0x00 {
+
+		return BufferUnderwriteError.atBit(op, off, 1, b.buf.bcap)
+
+	}
+
+	return nil
+
+}
+
+/* value retrieval */
+
+// Buffer returns the Buffer wrapped by the CheckedBuffer
+func (b *CheckedBuffer) Buffer() *Buffer {
+
+	return b.buf
+
+}
+
+// Bytes returns the internal byte slice of the buffer
+func (b *CheckedBuffer) Bytes() []byte {
+
+	return b.buf.Bytes()
+
+}
+
+// ByteCapacity returns the capacity of the buffer
+func (b *CheckedBuffer) ByteCapacity() int64 {
+
+	return b.buf.ByteCapacity()
+
+}
+
+// BitCapacity returns the bit capacity of the buffer
+func (b *CheckedBuffer) BitCapacity() int64 {
+
+	return b.buf.BitCapacity()
+
+}
+
+// ByteOffset returns the current offset of the buffer
+func (b *CheckedBuffer) ByteOffset() int64 {
+
+	return b.buf.ByteOffset()
+
+}
+
+// BitOffset returns the current bit offset of the buffer
+func (b *CheckedBuffer) BitOffset() int64 {
+
+	return b.buf.BitOffset()
+
+}
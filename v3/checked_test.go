@@ -0,0 +1,417 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestNewCheckedBuffer(t *testing.T) {
+
+	var expected = &Buffer{
+		buf:  []byte{0x00, 0x00, 0x00, 0x00},
+		off:  0x00,
+		cap:  4,
+		boff: 0x00,
+		bcap: 32,
+	}
+
+	out := NewCheckedBuffer([]byte{0x00, 0x00}, []byte{0x00, 0x00})
+	if !cmp.Equal(expected, out.Buffer(), BufferComparer) {
+
+		t.Fatalf("expected buffer does not match the one gotten (got %#v, expected %#v)", out.Buffer(), expected)
+
+	}
+
+}
+
+func TestBufferChecked(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+
+	err := buf.Checked().WriteByteNext(0x01)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if buf.ByteOffset() != 1 || buf.Bytes()[0] != 0x01 {
+
+		t.Fatalf("checked buffer did not operate on the wrapped buffer (offset %d, bytes %#v)", buf.ByteOffset(), buf.Bytes())
+
+	}
+
+}
+
+func TestCheckedBufferReadWriteU32LE(t *testing.T) {
+
+	var expected = []uint32{0x01020304, 0x05060708}
+
+	buf := NewCheckedBuffer(make([]byte, 8))
+
+	err := buf.WriteU32LENext(expected)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if buf.ByteOffset() != 8 {
+
+		t.Fatalf("incorrect offset: %d", buf.ByteOffset())
+
+	}
+
+	out, err := buf.ReadU32LE(0x00, 2)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if !cmp.Equal(expected, out) {
+
+		t.Fatalf("expected uint32 array does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+}
+
+func TestCheckedBufferReadEmpty(t *testing.T) {
+
+	buf := NewCheckedBuffer()
+
+	out, err := buf.ReadF64BE(0x00, 0)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if len(out) != 0 {
+
+		t.Fatalf("expected an empty slice (got %#v)", out)
+
+	}
+
+	err = buf.WriteI16LE(0x00, []int16{})
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+}
+
+func TestCheckedBufferErrors(t *testing.T) {
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+
+	for i, c := range []struct {
+		err      error
+		expected Error
+	}{
+		{func() error { _, err := buf.ReadU64BE(0x00, 1); return err }(), BufferOverreadError},
+		{func() error { _, err := buf.ReadU16LE(-0x01, 1); return err }(), BufferUnderreadError},
+		{func() error { _, err := buf.ReadI32BE(0x00, -1); return err }(), BufferInvalidByteCountError},
+		{buf.WriteU32BE(0x02, []uint32{0x01}), BufferOverwriteError},
+		{buf.WriteF32LE(-0x01, []float32{0x01}), BufferUnderwriteError},
+		{func() error { _, err := buf.ReadBytes(0x03, 2); return err }(), BufferOverreadError},
+		{buf.WriteBytes(-0x01, []byte{0x01}), BufferUnderwriteError},
+		{func() error { _, err := buf.ReadBit(0x20); return err }(), BufferOverreadError},
+		{func() error { _, err := buf.ReadBits(0x00, 65); return err }(), BufferInvalidBitCountError},
+		{buf.SetBit(0x20), BufferOverwriteError},
+		{buf.ClearBit(-0x01), BufferUnderwriteError},
+		{buf.SetBits(0x1e, 0x07, 3), BufferOverwriteError},
+		{buf.SeekByte(0x05, false), BufferOverseekError},
+		{buf.SeekBit(-0x01, true), BufferUnderseekError},
+		{buf.Grow(-1), BufferInvalidByteCountError},
+		{buf.TruncateLeft(5), BufferInvalidByteCountError},
+	} {
+
+		if !errors.Is(c.err, c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, c.err, c.expected)
+
+		}
+
+	}
+
+}
+
+func TestCheckedBufferOverflow(t *testing.T) {
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+
+	// arguments that overflow off + n must not get past the bounds checks
+	for i, c := range []struct {
+		op       func() error
+		expected Error
+	}{
+		{func() error { _, err := buf.ReadBytes(0x01, math.MaxInt64); return err }, BufferOverreadError},
+		{func() error { _, err := buf.ReadBytes(math.MaxInt64, 1); return err }, BufferOverreadError},
+		{func() error { _, err := buf.ReadU32LE(0x00, math.MaxInt64/2); return err }, BufferOverreadError},
+		{func() error { _, err := buf.ReadU64BE(0x01, math.MaxInt64/4); return err }, BufferOverreadError},
+		{func() error { _, err := buf.ReadU16BE(math.MaxInt64, 1); return err }, BufferOverreadError},
+		{func() error { _, err := buf.ReadU16BE(math.MinInt64, math.MaxInt64); return err }, BufferUnderreadError},
+		{func() error { _, err := buf.ReadBits(math.MaxInt64, 8); return err }, BufferOverreadError},
+		{func() error { _, err := buf.ReadBit(math.MaxInt64); return err }, BufferOverreadError},
+		{func() error { return buf.SetBits(math.MaxInt64, 0xff, 8) }, BufferOverwriteError},
+		{func() error { return buf.SetBit(math.MaxInt64) }, BufferOverwriteError},
+		{func() error { return buf.WriteBytes(math.MaxInt64, []byte{0x01}) }, BufferOverwriteError},
+		{func() error { return buf.WriteU32BE(math.MaxInt64-1, []uint32{0x01}) }, BufferOverwriteError},
+		{func() error { return buf.WriteU16LE(math.MinInt64, []uint16{0x01}) }, BufferUnderwriteError},
+	} {
+
+		if err := c.op(); !errors.Is(err, c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, err, c.expected)
+
+		}
+
+	}
+
+	// the same goes for buffers that are allowed to grow
+	buf.Buffer().SetGrowth(true, 0)
+	if err := buf.WriteBytes(math.MaxInt64, []byte{0x01}); !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+	if err := buf.WriteU64LE(math.MaxInt64-4, []uint64{0x01}); !errors.Is(err, BufferOverwriteError) || buf.ByteCapacity() != 4 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+}
+
+func TestCheckedBufferStickyError(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01, 0x02, 0x03, 0x04})
+	buf.SetSticky(true)
+	buf.ReadBytes(0x03, 2)
+
+	// the error recorded by the buffer is returned by every operation
+	cbuf := buf.Checked()
+	for i, err := range []error{
+		func() error { _, err := cbuf.ReadU16BEAt(0x00); return err }(),
+		func() error { _, err := cbuf.ReadU16BE(0x00, 1); return err }(),
+		func() error { _, err := cbuf.ReadBytes(0x00, 1); return err }(),
+		func() error { _, err := cbuf.ReadBits(0x00, 1); return err }(),
+		cbuf.PutU32LE(0x00, 0x01),
+		cbuf.SetBit(0x00),
+		cbuf.SeekByte(0x01, true),
+		cbuf.Insert(0x00, []byte{0x00}),
+	} {
+
+		if !errors.Is(err, BufferOverreadError) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, err, BufferOverreadError)
+
+		}
+
+	}
+
+	if buf.ByteOffset() != 0x00 || buf.Bytes()[0] != 0x01 {
+
+		t.Fatalf("buffer was modified after an error (offset %d, bytes %#v)", buf.ByteOffset(), buf.Bytes())
+
+	}
+
+}
+
+func TestCheckedBufferNextOffsetOnError(t *testing.T) {
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x00, 0x00})
+
+	_, err := buf.ReadU16BENext(1)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	_, err = buf.ReadU16BENext(1)
+	if !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+	if buf.ByteOffset() != 2 {
+
+		t.Fatalf("incorrect offset: %d", buf.ByteOffset())
+
+	}
+
+	_, err = buf.ReadBitsNext(25)
+	if !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+	if buf.BitOffset() != 0 {
+
+		t.Fatalf("incorrect bit offset: %d", buf.BitOffset())
+
+	}
+
+}
+
+func TestCheckedBufferBits(t *testing.T) {
+
+	var expected uint64 = 5
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x00})
+
+	err := buf.SetBitsNext(expected, 3)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	out, err := buf.ReadBits(0x00, 3)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if expected != out {
+
+		t.Fatalf("expected uint64 does not match the one gotten (got %d, expected %d)", out, expected)
+
+	}
+
+	out, err = buf.ReadBits(0x10, 0)
+	if err != nil || out != 0 {
+
+		t.Fatalf("expected an empty read at the end of the buffer (got %d, %v)", out, err)
+
+	}
+
+}
+
+func TestCheckedBufferGrowth(t *testing.T) {
+
+	buf := NewCheckedBuffer()
+	buf.Buffer().SetGrowth(true, 6)
+
+	err := buf.WriteF32BENext([]float32{1.5})
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	err = buf.WriteU32LENext([]uint32{0x01})
+	if !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+	err = buf.SetBit(0x2f)
+	if err != nil || buf.ByteCapacity() != 6 {
+
+		t.Fatalf("expected the buffer to grow to 6 bytes (got %d, %v)", buf.ByteCapacity(), err)
+
+	}
+
+}
+
+func TestCheckedBufferScalar(t *testing.T) {
+
+	var expected uint16 = 0x0102
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x00, 0x00})
+
+	err := buf.PutU16BENext(expected)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	out, err := buf.ReadU16BEAt(0x00)
+	if err != nil || expected != out {
+
+		t.Fatalf("expected uint16 does not match the one gotten (got %#v, %v, expected %#v)", out, err, expected)
+
+	}
+
+	err = buf.PutU16BENext(expected)
+	if !errors.Is(err, BufferOverwriteError) || buf.ByteOffset() != 2 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v at offset %d, expected %v at offset 2)", err, buf.ByteOffset(), BufferOverwriteError)
+
+	}
+
+	_, err = buf.ReadU16LEAtNext()
+	if !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+	err = buf.ReadU16LEInto(make([]uint16, 1), -0x01)
+	if !errors.Is(err, BufferUnderreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferUnderreadError)
+
+	}
+
+}
+
+func TestCheckedBufferU24(t *testing.T) {
+
+	var expected uint32 = 0xabcdef
+
+	buf := NewCheckedBuffer(make([]byte, 4))
+
+	err := buf.PutU24LE(0x01, expected)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	out, err := buf.ReadU24LE(0x01, 1)
+	if err != nil || out[0] != expected {
+
+		t.Fatalf("expected uint24 does not match the one gotten (got %#v, %v, expected %#v)", out, err, expected)
+
+	}
+
+	err = buf.WriteU24BE(0x02, []uint32{expected})
+	if !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+}
@@ -0,0 +1,849 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"hash"
+	"math/bits"
+)
+
+// CRCModel describes a cyclic redundancy check using the usual set of
+// parameters. Poly is written without its highest term, and Width may
+// be anywhere from 8 to 64 bits
+type CRCModel struct {
+	Width  int
+	Poly   uint64
+	Init   uint64
+	RefIn  bool
+	RefOut bool
+	XorOut uint64
+}
+
+var (
+	// CRC8 is CRC-8/SMBUS. its check value is 0xf4
+	CRC8 = CRCModel{Width: 8, Poly: 0x07}
+
+	// CRC16CCITT is CRC-16/IBM-3740, also known as CRC-16/CCITT-FALSE.
+	// its check value is 0x29b1
+	CRC16CCITT = CRCModel{Width: 16, Poly: 0x1021, Init: 0xffff}
+
+	// CRC16ARC is CRC-16/ARC. its check value is 0xbb3d
+	CRC16ARC = CRCModel{Width: 16, Poly: 0x8005, RefIn: true, RefOut: true}
+
+	// CRC32IEEE is CRC-32/ISO-HDLC, the one used by ethernet, zlib and
+	// png. its check value is 0xcbf43926
+	CRC32IEEE = CRCModel{Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff}
+
+	// CRC32C is CRC-32/ISCSI, which uses the polynomial found by
+	// Castagnoli. its check value is 0xe3069283
+	CRC32C = CRCModel{Width: 32, Poly: 0x1edc6f41, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff}
+
+	// CRC64ECMA is CRC-64/ECMA-182. its check value is
+	// 0x6c40df5f0b497347
+	CRC64ECMA = CRCModel{Width: 64, Poly: 0x42f0e1eba9ea3693}
+
+	// CRC64XZ is CRC-64/XZ, the one used by xz. its check value is
+	// 0x995dc9bbdf1939fa
+	CRC64XZ = CRCModel{Width: 64, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff}
+)
+
+/* internal use functions */
+
+// reflectBits reverses the order of the last width bits of v
+func reflectBits(v uint64, width int) uint64 {
+
+	return bits.Reverse64(v) >> uint(64-width)
+
+}
+
+// widthMask returns a mask covering the last width bits of a uint64
+func widthMask(width int) uint64 {
+
+	return ^uint64(0) >> uint(64-width)
+
+}
+
+// appendValue appends the last n bytes of v to b in big-endian, which
+// is how the Sum methods of the checksums output their value
+func appendValue(b []byte, v uint64, n int) []byte {
+
+	for i := n - 1; i >= 0x00; i-- {
+
+		b = append(b, byte(v>>(uint(i)*8)))
+
+	}
+	return b
+
+}
+
+/* CRC */
+
+// CRC implements hash.Hash64 for the cyclic redundancy check described
+// by a CRCModel, processing its input a byte at a time using a table
+type CRC struct {
+	model CRCModel
+	table [256]uint64
+
+	// crc is the register, which is kept reflected if the input is
+	crc uint64
+}
+
+// NewCRC initializes a new CRC computing the checksum described by
+// model. it panics with ChecksumInvalidWidthError if the width of the
+// model is not between 8 and 64 bits
+func NewCRC(model CRCModel) (c *CRC) {
+
+	if model.Width < 8 || model.Width > 64 {
+
+		panic(ChecksumInvalidWidthError.count("NewCRC", int64(model.Width), 64))
+
+	}
+
+	c = &CRC{model: model}
+	mask := widthMask(model.Width)
+	if model.RefIn {
+
+		poly := reflectBits(model.Poly, model.Width)
+		for i := range c.table {
+
+			crc := uint64(i)
+			for j := 0; j < 8; j++ {
+
+				if crc&0x01 != 0x00 {
+
+					crc = crc>>1 ^ poly
+
+				} else {
+
+					crc >>= 1
+
+				}
+
+			}
+			c.table[i] = crc
+
+		}
+
+	} else {
+
+		top := uint64(1) << uint(model.Width-1)
+		for i := range c.table {
+
+			crc := uint64(i) << uint(model.Width-8)
+			for j := 0; j < 8; j++ {
+
+				if crc&top != 0x00 {
+
+					crc = crc<<1 ^ model.Poly
+
+				} else {
+
+					crc <<= 1
+
+				}
+
+			}
+			c.table[i] = crc & mask
+
+		}
+
+	}
+
+	c.Reset()
+	return
+
+}
+
+// Write adds more data to the running checksum. it never returns an
+// error
+func (c *CRC) Write(p []byte) (int, error) {
+
+	crc := c.crc
+	if c.model.RefIn {
+
+		for _, v := range p {
+
+			crc = c.table[byte(crc)^v] ^ crc>>8
+
+		}
+
+	} else {
+
+		shift := uint(c.model.Width - 8)
+		mask := widthMask(c.model.Width)
+		for _, v := range p {
+
+			crc = (c.table[byte(crc>>shift)^v] ^ crc<<8) & mask
+
+		}
+
+	}
+
+	c.crc = crc
+	return len(p), nil
+
+}
+
+// Sum64 returns the current checksum
+func (c *CRC) Sum64() uint64 {
+
+	crc := c.crc
+	if c.model.RefIn != c.model.RefOut {
+
+		crc = reflectBits(crc, c.model.Width)
+
+	}
+	return (crc ^ c.model.XorOut) & widthMask(c.model.Width)
+
+}
+
+// Sum appends the current checksum to b in big-endian
+func (c *CRC) Sum(b []byte) []byte {
+
+	return appendValue(b, c.Sum64(), c.Size())
+
+}
+
+// Reset resets the checksum to its initial state
+func (c *CRC) Reset() {
+
+	c.crc = c.model.Init & widthMask(c.model.Width)
+	if c.model.RefIn {
+
+		c.crc = reflectBits(c.crc, c.model.Width)
+
+	}
+
+}
+
+// Size returns the amount of bytes Sum appends
+func (c *CRC) Size() int {
+
+	return (c.model.Width + 7) / 8
+
+}
+
+// BlockSize returns the block size of the checksum, which is a byte
+func (c *CRC) BlockSize() int {
+
+	return 1
+
+}
+
+/* Adler32 */
+
+// adlerMod is the modulus used by Adler-32 and adlerChunk is the most
+// bytes that can be summed before reducing the sums without overflowing
+const (
+	adlerMod   = 65521
+	adlerChunk = 5552
+)
+
+// Adler32 implements hash.Hash32 for the Adler-32 checksum
+type Adler32 struct {
+	a uint32
+	b uint32
+}
+
+// NewAdler32 initializes a new Adler32
+func NewAdler32() *Adler32 {
+
+	return &Adler32{a: 1}
+
+}
+
+// Write adds more data to the running checksum. it never returns an
+// error
+func (c *Adler32) Write(p []byte) (int, error) {
+
+	n := len(p)
+	for len(p) > 0x00 {
+
+		chunk := p
+		if len(chunk) > adlerChunk {
+
+			chunk = chunk[:adlerChunk]
+
+		}
+		p = p[len(chunk):]
+
+		for _, v := range chunk {
+
+			c.a += uint32(v)
+			c.b += c.a
+
+		}
+		c.a %= adlerMod
+		c.b %= adlerMod
+
+	}
+	return n, nil
+
+}
+
+// Sum32 returns the current checksum
+func (c *Adler32) Sum32() uint32 {
+
+	return c.b<<16 | c.a
+
+}
+
+// Sum appends the current checksum to b in big-endian
+func (c *Adler32) Sum(b []byte) []byte {
+
+	return appendValue(b, uint64(c.Sum32()), 4)
+
+}
+
+// Reset resets the checksum to its initial state
+func (c *Adler32) Reset() {
+
+	c.a = 1
+	c.b = 0x00
+
+}
+
+// Size returns the amount of bytes Sum appends
+func (c *Adler32) Size() int {
+
+	return 4
+
+}
+
+// BlockSize returns the block size of the checksum, which is a byte
+func (c *Adler32) BlockSize() int {
+
+	return 1
+
+}
+
+/* Fletcher16 */
+
+// Fletcher16 implements hash.Hash32 for the Fletcher-16 checksum, which
+// sums the input a byte at a time. the checksum is stored in the last
+// 16 bits of the value returned by Sum32
+type Fletcher16 struct {
+	a uint32
+	b uint32
+}
+
+// NewFletcher16 initializes a new Fletcher16
+func NewFletcher16() *Fletcher16 {
+
+	return &Fletcher16{}
+
+}
+
+// Write adds more data to the running checksum. it never returns an
+// error
+func (c *Fletcher16) Write(p []byte) (int, error) {
+
+	n := len(p)
+	for len(p) > 0x00 {
+
+		// the sums can not overflow within this many bytes
+		chunk := p
+		if len(chunk) > adlerChunk {
+
+			chunk = chunk[:adlerChunk]
+
+		}
+		p = p[len(chunk):]
+
+		for _, v := range chunk {
+
+			c.a += uint32(v)
+			c.b += c.a
+
+		}
+		c.a %= 255
+		c.b %= 255
+
+	}
+	return n, nil
+
+}
+
+// Sum32 returns the current checksum
+func (c *Fletcher16) Sum32() uint32 {
+
+	return c.b<<8 | c.a
+
+}
+
+// Sum appends the current checksum to b in big-endian
+func (c *Fletcher16) Sum(b []byte) []byte {
+
+	return appendValue(b, uint64(c.Sum32()), 2)
+
+}
+
+// Reset resets the checksum to its initial state
+func (c *Fletcher16) Reset() {
+
+	c.a = 0x00
+	c.b = 0x00
+
+}
+
+// Size returns the amount of bytes Sum appends
+func (c *Fletcher16) Size() int {
+
+	return 2
+
+}
+
+// BlockSize returns the block size of the checksum, which is a byte
+func (c *Fletcher16) BlockSize() int {
+
+	return 1
+
+}
+
+/* Fletcher32 */
+
+// Fletcher32 implements hash.Hash32 for the Fletcher-32 checksum, which
+// sums the input as little-endian 16-bit words. an odd byte at the end
+// of the input is padded with a zero byte
+type Fletcher32 struct {
+	a uint32
+	b uint32
+
+	// odd is whether a byte is waiting for the next one to complete its
+	// word, in which case it is stored in pending
+	odd     bool
+	pending byte
+}
+
+// NewFletcher32 initializes a new Fletcher32
+func NewFletcher32() *Fletcher32 {
+
+	return &Fletcher32{}
+
+}
+
+// Write adds more data to the running checksum. it never returns an
+// error
+func (c *Fletcher32) Write(p []byte) (int, error) {
+
+	n := len(p)
+	if c.odd && len(p) > 0x00 {
+
+		c.add(uint32(c.pending) | uint32(p[0])<<8)
+		c.odd = false
+		p = p[1:]
+
+	}
+
+	for len(p) >= 2 {
+
+		// the sums can not overflow within this many words
+		i := 0
+		for ; i+1 < len(p) && i < 2*359; i += 2 {
+
+			c.a += uint32(p[i]) | uint32(p[i+1])<<8
+			c.b += c.a
+
+		}
+		c.a %= 65535
+		c.b %= 65535
+		p = p[i:]
+
+	}
+
+	if len(p) > 0x00 {
+
+		c.odd = true
+		c.pending = p[0]
+
+	}
+	return n, nil
+
+}
+
+// add adds a single word to the sums
+func (c *Fletcher32) add(word uint32) {
+
+	c.a = (c.a + word) % 65535
+	c.b = (c.b + c.a) % 65535
+
+}
+
+// Sum32 returns the current checksum
+func (c *Fletcher32) Sum32() uint32 {
+
+	a, b := c.a, c.b
+	if c.odd {
+
+		a = (a + uint32(c.pending)) % 65535
+		b = (b + a) % 65535
+
+	}
+	return b<<16 | a
+
+}
+
+// Sum appends the current checksum to b in big-endian
+func (c *Fletcher32) Sum(b []byte) []byte {
+
+	return appendValue(b, uint64(c.Sum32()), 4)
+
+}
+
+// Reset resets the checksum to its initial state
+func (c *Fletcher32) Reset() {
+
+	*c = Fletcher32{}
+
+}
+
+// Size returns the amount of bytes Sum appends
+func (c *Fletcher32) Size() int {
+
+	return 4
+
+}
+
+// BlockSize returns the block size of the checksum, which is a word
+func (c *Fletcher32) BlockSize() int {
+
+	return 2
+
+}
+
+/* InternetChecksum */
+
+// InternetChecksum implements hash.Hash32 for the checksum used by IP,
+// TCP and UDP, which is described in RFC 1071. it sums the input as
+// big-endian 16-bit words and stores the checksum in the last 16 bits
+// of the value returned by Sum32. an odd byte at the end of the input is
+// padded with a zero byte. the checksum of data that includes a correct
+// checksum is zero
+type InternetChecksum struct {
+	sum uint64
+
+	// odd is whether a byte is waiting for the next one to complete its
+	// word, in which case it is stored in pending
+	odd     bool
+	pending byte
+}
+
+// NewInternetChecksum initializes a new InternetChecksum
+func NewInternetChecksum() *InternetChecksum {
+
+	return &InternetChecksum{}
+
+}
+
+// Write adds more data to the running checksum. it never returns an
+// error
+func (c *InternetChecksum) Write(p []byte) (int, error) {
+
+	n := len(p)
+	if c.odd && len(p) > 0x00 {
+
+		c.sum += uint64(c.pending)<<8 | uint64(p[0])
+		c.odd = false
+		p = p[1:]
+
+	}
+
+	// the carries are folded back in once the input has been summed,
+	// which is possible as the sum can not overflow 64 bits
+	for len(p) >= 2 {
+
+		c.sum += uint64(p[0])<<8 | uint64(p[1])
+		p = p[2:]
+
+	}
+
+	if len(p) > 0x00 {
+
+		c.odd = true
+		c.pending = p[0]
+
+	}
+	return n, nil
+
+}
+
+// Sum32 returns the current checksum
+func (c *InternetChecksum) Sum32() uint32 {
+
+	sum := c.sum
+	if c.odd {
+
+		sum += uint64(c.pending) << 8
+
+	}
+
+	for sum > 0xffff {
+
+		sum = sum>>16 + sum&0xffff
+
+	}
+	return uint32(^sum & 0xffff)
+
+}
+
+// Sum appends the current checksum to b in big-endian
+func (c *InternetChecksum) Sum(b []byte) []byte {
+
+	return appendValue(b, uint64(c.Sum32()), 2)
+
+}
+
+// Reset resets the checksum to its initial state
+func (c *InternetChecksum) Reset() {
+
+	*c = InternetChecksum{}
+
+}
+
+// Size returns the amount of bytes Sum appends
+func (c *InternetChecksum) Size() int {
+
+	return 2
+
+}
+
+// BlockSize returns the block size of the checksum, which is a word
+func (c *InternetChecksum) BlockSize() int {
+
+	return 2
+
+}
+
+/* internal use methods */
+
+// feed writes the n bytes at off to the hash set with SetHash, ignoring
+// the ones outside of the buffer
+func (b *Buffer) feed(off, n int64) {
+
+	if off < 0x00 {
+
+		n += off
+		off = 0x00
+
+	}
+
+	if off+n > b.cap {
+
+		n = b.cap - off
+
+	}
+
+	if n > 0x00 {
+
+		b.hash.Write(b.buf[off : off+n])
+
+	}
+
+}
+
+// passed returns the offset of the first byte that the position has not
+// moved entirely past, which in unified mode excludes the byte the bit
+// offset is in the middle of
+func (b *Buffer) passed() int64 {
+
+	if b.cmode == SeparateCursors {
+
+		return b.off
+
+	}
+	return b.boff / 8
+
+}
+
+// follow writes the bytes that the position has moved past since the
+// last call to the hash set with SetHash
+func (b *Buffer) follow() {
+
+	if b.hash == nil {
+
+		return
+
+	}
+
+	end := b.passed()
+	if end > b.cap {
+
+		end = b.cap
+
+	}
+
+	if end > b.hend {
+
+		b.feed(b.hend, end-b.hend)
+		b.hend = end
+
+	}
+
+}
+
+// rehash resets the hash set with SetHash and writes the bytes it
+// covers to it again, after they were moved or restored
+func (b *Buffer) rehash() {
+
+	if b.hash == nil {
+
+		return
+
+	}
+
+	if b.hend > b.cap {
+
+		b.hend = b.cap
+
+	}
+
+	if b.hstart > b.hend {
+
+		b.hstart = b.hend
+
+	}
+
+	b.hash.Reset()
+	b.feed(b.hstart, b.hend-b.hstart)
+
+}
+
+/* Buffer */
+
+// HashRange writes the n bytes at the specified offset to h without
+// copying them or modifying the internal offset value
+func (b *Buffer) HashRange(h hash.Hash, off, n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("HashRange", n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("HashRange", off, n, b.cap))
+		return
+
+	}
+
+	if n > b.cap-off {
+
+		b.fail(BufferOverreadError.at("HashRange", off, n, b.cap))
+		return
+
+	}
+
+	h.Write(b.buf[off : off+n])
+
+}
+
+// HashNext writes the next n bytes from the current offset to h and
+// moves the offset forward past them
+func (b *Buffer) HashNext(h hash.Hash, n int64) {
+
+	b.HashRange(h, b.cursor("HashNext"), n)
+	b.SeekByte(n, true)
+
+}
+
+// SetHash makes the buffer write the bytes from the current position
+// on to h as the position moves past them, which keeps a checksum up to
+// date as the ...Next methods write or read data. each byte is written
+// once, when the position first moves entirely past it, whether that is
+// by a relative or an exact seek or, in unified mode, a bit operation.
+//
+// bytes changed afterwards by writing to them at an exact offset are
+// not written again. the ones moved by Insert, Delete and Splice or
+// restored by Rollback are, after resetting h. a nil h stops it
+func (b *Buffer) SetHash(h hash.Hash) {
+
+	b.hash = h
+	b.hstart = b.passed()
+	b.hend = b.hstart
+
+}
+
+/* CheckedBuffer */
+
+// HashRange writes the n bytes at the specified offset to h without
+// copying them or modifying the internal offset value. an error is
+// returned if the range is out of bounds
+func (b *CheckedBuffer) HashRange(h hash.Hash, off, n int64) (err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		err = BufferInvalidByteCountError.count("HashRange", n, b.buf.cap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.at("HashRange", off, n, b.buf.cap)
+		return
+
+	}
+
+	if n > b.buf.cap-off {
+
+		err = BufferOverreadError.at("HashRange", off, n, b.buf.cap)
+		return
+
+	}
+
+	b.buf.HashRange(h, off, n)
+	return
+
+}
+
+// HashNext writes the next n bytes from the current offset to h and
+// moves the offset forward past them. the offset is not moved if an
+// error is returned
+func (b *CheckedBuffer) HashNext(h hash.Hash, n int64) (err error) {
+
+	if err = b.buf.unaligned("HashNext"); err != nil {
+
+		return
+
+	}
+
+	err = b.HashRange(h, b.buf.off, n)
+	if err == nil {
+
+		b.buf.SeekByte(n, true)
+
+	}
+	return
+
+}
+
+// SetHash makes the buffer write the bytes from the current position
+// on to h as the position moves past them. see Buffer.SetHash
+func (b *CheckedBuffer) SetHash(h hash.Hash) {
+
+	b.buf.SetHash(h)
+
+}
@@ -0,0 +1,371 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"hash"
+	"hash/adler32"
+	"hash/crc32"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+// checkInput is the input that the check values of checksums are
+// computed over
+var checkInput = []byte("123456789")
+
+// sum returns the value of a checksum as a uint64
+func sum(h hash.Hash) uint64 {
+
+	switch h := h.(type) {
+
+	case hash.Hash64:
+		return h.Sum64()
+
+	case hash.Hash32:
+		return uint64(h.Sum32())
+
+	}
+	return 0x00
+
+}
+
+/*
+
+tests
+
+*/
+
+func TestCRC(t *testing.T) {
+
+	for i, c := range []struct {
+		model    CRCModel
+		expected uint64
+	}{
+		{CRC8, 0xf4},
+		{CRC16CCITT, 0x29b1},
+		{CRC16ARC, 0xbb3d},
+		{CRC32IEEE, 0xcbf43926},
+		{CRC32C, 0xe3069283},
+		{CRC64ECMA, 0x6c40df5f0b497347},
+		{CRC64XZ, 0x995dc9bbdf1939fa},
+		// CRC-16/GENIBUS, which inverts its output
+		{CRCModel{Width: 16, Poly: 0x1021, Init: 0xffff, XorOut: 0xffff}, 0xd64e},
+		// CRC-24/OPENPGP, which is not a multiple of 16 bits wide
+		{CRCModel{Width: 24, Poly: 0x864cfb, Init: 0xb704ce}, 0x21cf02},
+		// CRC-12/UMTS, which only reflects its output
+		{CRCModel{Width: 12, Poly: 0x80f, RefOut: true}, 0xdaf},
+	} {
+
+		crc := NewCRC(c.model)
+
+		// the input is split to check that the state is kept
+		crc.Write(checkInput[:4])
+		crc.Write(checkInput[4:])
+		if out := crc.Sum64(); out != c.expected {
+
+			t.Fatalf("case %d: expected checksum does not match the one gotten (got %#x, expected %#x)", i, out, c.expected)
+
+		}
+
+		crc.Reset()
+		crc.Write(checkInput)
+		if out := crc.Sum64(); out != c.expected {
+
+			t.Fatalf("case %d: expected checksum does not match the one gotten after a reset (got %#x, expected %#x)", i, out, c.expected)
+
+		}
+
+	}
+
+	if out, expected := NewCRC(CRC32IEEE).Sum(nil), []byte{0x00, 0x00, 0x00, 0x00}; !cmp.Equal(expected, out) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+	data := countingBytes(1000)
+
+	crc := NewCRC(CRC32C)
+	crc.Write(data)
+	if out, expected := crc.Sum64(), crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)); out != uint64(expected) {
+
+		t.Fatalf("expected checksum does not match the one gotten (got %#x, expected %#x)", out, expected)
+
+	}
+
+}
+
+func TestCRCPanic(t *testing.T) {
+
+	defer panicChecker(t, ChecksumInvalidWidthError.count("NewCRC", 4, 64))
+
+	NewCRC(CRCModel{Width: 4, Poly: 0x3})
+
+}
+
+func TestChecksums(t *testing.T) {
+
+	long := countingBytes(20000)
+
+	for i, c := range []struct {
+		h        hash.Hash
+		data     []byte
+		expected uint64
+	}{
+		{NewAdler32(), []byte("Wikipedia"), 0x11e60398},
+		{NewAdler32(), long, uint64(adler32.Checksum(long))},
+		{NewFletcher16(), []byte("abcde"), 0xc8f0},
+		{NewFletcher16(), []byte("abcdef"), 0x2057},
+		{NewFletcher16(), make([]byte, 20000), 0x00},
+		{NewFletcher32(), []byte("abcde"), 0xf04fc729},
+		{NewFletcher32(), []byte("abcdef"), 0x56502d2a},
+		{NewFletcher32(), []byte("abcdefgh"), 0xebe19591},
+		// the example from section 3 of RFC 1071
+		{NewInternetChecksum(), []byte{0x00, 0x01, 0xf2, 0x03, 0xf4, 0xf5, 0xf6, 0xf7}, 0x220d},
+		{NewInternetChecksum(), []byte{0x00, 0x01, 0xf2, 0x03, 0xf4, 0xf5, 0xf6, 0xf7, 0x22, 0x0d}, 0x00},
+		{NewInternetChecksum(), []byte{0x01}, 0xfeff},
+	} {
+
+		// odd splits check that words spanning writes are handled
+		for off := 0; off < len(c.data); off += 3 {
+
+			end := off + 3
+			if end > len(c.data) {
+
+				end = len(c.data)
+
+			}
+			c.h.Write(c.data[off:end])
+
+		}
+
+		if out := sum(c.h); out != c.expected {
+
+			t.Fatalf("case %d: expected checksum does not match the one gotten (got %#x, expected %#x)", i, out, c.expected)
+
+		}
+
+		if len(c.h.Sum(nil)) != c.h.Size() {
+
+			t.Fatalf("case %d: incorrect checksum size (got %d, expected %d)", i, len(c.h.Sum(nil)), c.h.Size())
+
+		}
+
+		c.h.Reset()
+		c.h.Write(c.data)
+		if out := sum(c.h); out != c.expected {
+
+			t.Fatalf("case %d: expected checksum does not match the one gotten after a reset (got %#x, expected %#x)", i, out, c.expected)
+
+		}
+
+	}
+
+}
+
+func TestBufferHash(t *testing.T) {
+
+	buf := NewBuffer(append([]byte{0xff}, checkInput...))
+	crc := NewCRC(CRC32IEEE)
+
+	buf.HashRange(crc, 0x01, 9)
+	if crc.Sum64() != 0xcbf43926 || buf.ByteOffset() != 0x00 {
+
+		t.Fatalf("expected checksum does not match the one gotten (got %#x)", crc.Sum64())
+
+	}
+
+	crc.Reset()
+	buf.ReadByteNext()
+	buf.HashNext(crc, 9)
+	if crc.Sum64() != 0xcbf43926 || buf.ByteOffset() != 10 {
+
+		t.Fatalf("expected checksum does not match the one gotten (got %#x at offset %d)", crc.Sum64(), buf.ByteOffset())
+
+	}
+
+	buf.SetSticky(true)
+	buf.HashRange(crc, 0x05, 6)
+	if !errors.Is(buf.Err(), BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverreadError)
+
+	}
+
+}
+
+func TestBufferSetHash(t *testing.T) {
+
+	buf := NewBuffer([]byte{})
+	buf.SetGrowth(true, 0)
+
+	// a trailer is computed while the packet is written
+	crc := NewCRC(CRC16CCITT)
+	buf.SetHash(crc)
+	buf.WriteBytesNext(checkInput[:3])
+	buf.PutU32BENext(0x34353637)
+	buf.SeekByte(0x00, false)
+	buf.SeekByte(0x07, false)
+	buf.WriteUvarintNext(0x38)
+	buf.WriteByteNext(0x39)
+	buf.SetHash(nil)
+	buf.PutU16BENext(uint16(crc.Sum64()))
+
+	if expected := append(append([]byte{}, checkInput...), 0x29, 0xb1); !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	// and verified while it is read
+	crc.Reset()
+	buf.SeekByte(0x00, false)
+	buf.SetHash(crc)
+	buf.ReadBytesNext(9)
+	buf.SetHash(nil)
+	if out, expected := crc.Sum64(), uint64(buf.ReadU16BEAtNext()); out != expected {
+
+		t.Fatalf("expected checksum does not match the one gotten (got %#x, expected %#x)", out, expected)
+
+	}
+
+	cbuf := buf.Checked()
+	crc.Reset()
+	if err := cbuf.HashRange(crc, 0x00, 9); err != nil || crc.Sum64() != 0x29b1 {
+
+		t.Fatalf("unexpected result (got %#x and %v)", crc.Sum64(), err)
+
+	}
+
+	if err := cbuf.HashNext(crc, 1); !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+}
+
+func TestBufferSetHashFollow(t *testing.T) {
+
+	buf := NewBuffer([]byte{0xff})
+	buf.SetGrowth(true, 0)
+	buf.SetCursorMode(UnifiedCursor)
+	buf.SeekByte(0x01, false)
+
+	crc := NewCRC(CRC32IEEE)
+	buf.SetHash(crc)
+
+	// checks that the checksum covers the bytes from 0x01 up to the
+	// last byte the position moved entirely past
+	check := func(step string, end int64) {
+
+		expected := NewCRC(CRC32IEEE)
+		expected.Write(buf.Bytes()[0x01:end])
+		if crc.Sum64() != expected.Sum64() {
+
+			t.Fatalf("%s: expected checksum does not match the one gotten (got %#x, expected %#x)", step, crc.Sum64(), expected.Sum64())
+
+		}
+
+	}
+
+	buf.SetBitsNext(0x05, 3)
+	check("bits", 0x01)
+	buf.SetBitsNext(0x01, 5)
+	buf.PutU16BENext(0x0203)
+	check("bits and bytes", 0x04)
+
+	buf.Grow(4)
+	buf.SeekByte(0x06, false)
+	check("exact seek", 0x06)
+
+	buf.Insert(0x02, []byte{0xaa, 0xbb})
+	buf.Delete(0x01, 1)
+	check("splice", 0x07)
+
+	m := buf.Mark()
+	buf.SeekByte(0x02, false)
+	buf.WriteByteNext(0xcc)
+	buf.SeekByte(0x07, false)
+	buf.WriteBytesNext([]byte{0xdd, 0xee})
+	buf.Rollback(m)
+	check("rollback", 0x07)
+
+}
+
+func TestBufferHashRangeOverflow(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x01})
+	buf.SetSticky(true)
+
+	buf.HashRange(NewCRC(CRC8), 0x01, math.MaxInt64)
+	if !errors.Is(buf.Err(), BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferOverreadError)
+
+	}
+
+	if err := NewCheckedBuffer([]byte{0x00}).HashRange(NewCRC(CRC8), 0x01, math.MaxInt64); !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkCRC32(b *testing.B) {
+
+	data := make([]byte, 4096)
+	crc := NewCRC(CRC32IEEE)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+
+		crc.Write(data)
+
+	}
+
+}
+
+func BenchmarkInternetChecksum(b *testing.B) {
+
+	data := make([]byte, 4096)
+	sum := NewInternetChecksum()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+
+		sum.Write(data)
+
+	}
+
+}
@@ -0,0 +1,113 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+// CursorMode specifies how the byte and bit offsets of a Buffer relate
+// to each other
+type CursorMode byte
+
+const (
+	// SeparateCursors keeps the byte and bit offsets independent of
+	// each other, only synchronizing them on AlignByte and AlignBit.
+	// this is the default
+	SeparateCursors CursorMode = iota
+
+	// UnifiedCursor makes byte and bit operations share a single
+	// position. byte operations at a position that is not on a byte
+	// boundary start at the next one
+	UnifiedCursor
+
+	// UnifiedCursorStrict makes byte and bit operations share a single
+	// position like UnifiedCursor, but byte operations at a position
+	// that is not on a byte boundary fail with BufferUnalignedError
+	UnifiedCursorStrict
+)
+
+/* internal use methods */
+
+// unaligned returns an error if the buffer is in UnifiedCursorStrict
+// mode and the current position is not on a byte boundary
+func (b *Buffer) unaligned(op string) error {
+
+	if b.cmode == UnifiedCursorStrict && b.boff%8 != 0x00 {
+
+		return BufferUnalignedError.atBit(op, b.boff, 0, b.bcap)
+
+	}
+	return nil
+
+}
+
+// cursor returns the byte offset that an operation at the current
+// position should use, failing if the position is unaligned and the
+// buffer requires it not to be
+func (b *Buffer) cursor(op string) int64 {
+
+	if b.err == nil && b.cmode == UnifiedCursorStrict && b.boff%8 != 0x00 {
+
+		b.fail(BufferUnalignedError.atBit(op, b.boff, 0, b.bcap))
+
+	}
+	return b.off
+
+}
+
+// syncByte moves the byte offset to the first byte boundary at or
+// after the bit offset if the cursors are unified
+func (b *Buffer) syncByte() {
+
+	if b.cmode == SeparateCursors {
+
+		return
+
+	}
+
+	b.off = b.boff / 8
+	if b.boff%8 > 0x00 {
+
+		b.off++
+
+	}
+
+}
+
+// syncBit moves the bit offset to the byte offset if the cursors are
+// unified
+func (b *Buffer) syncBit() {
+
+	if b.cmode != SeparateCursors {
+
+		b.boff = b.off * 8
+
+	}
+
+}
+
+/* Buffer */
+
+// SetCursorMode sets how the byte and bit offsets of the buffer relate
+// to each other. when the cursors become unified, the bit offset is
+// moved to the byte offset
+func (b *Buffer) SetCursorMode(mode CursorMode) {
+
+	b.cmode = mode
+	b.syncBit()
+
+}
+
+// CursorMode returns how the byte and bit offsets of the buffer relate
+// to each other
+func (b *Buffer) CursorMode() CursorMode {
+
+	return b.cmode
+
+}
@@ -0,0 +1,158 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferSetCursorMode(t *testing.T) {
+
+	buf := NewBuffer(make([]byte, 4))
+
+	buf.SeekByte(0x02, false)
+	buf.SeekBit(0x03, false)
+	buf.SetCursorMode(UnifiedCursor)
+	if buf.CursorMode() != UnifiedCursor {
+
+		t.Fatalf("expected cursor mode does not match the one gotten (got %d, expected %d)", buf.CursorMode(), UnifiedCursor)
+
+	}
+
+	if buf.ByteOffset() != 2 || buf.BitOffset() != 16 {
+
+		t.Fatalf("expected the bit offset to move to the byte offset (got byte offset %d, bit offset %d)", buf.ByteOffset(), buf.BitOffset())
+
+	}
+
+	buf.SeekBit(0x09, false)
+	if buf.ByteOffset() != 2 {
+
+		t.Fatalf("expected the byte offset to move to the next byte boundary (got %d)", buf.ByteOffset())
+
+	}
+
+	buf.SeekByte(-0x01, true)
+	if buf.BitOffset() != 8 {
+
+		t.Fatalf("expected the bit offset to follow the byte offset (got %d)", buf.BitOffset())
+
+	}
+
+}
+
+func TestBufferUnifiedCursor(t *testing.T) {
+
+	var expected = []byte{0xab, 0xc0, 0x12, 0x34, 0xa0}
+
+	buf := NewBuffer(make([]byte, 5))
+	buf.SetCursorMode(UnifiedCursor)
+
+	buf.SetBitsNext(0xabc, 12)
+	buf.PutU16BENext(0x1234)
+	buf.SetBitsNext(0x05, 3)
+	if !cmp.Equal(expected, buf.Bytes()) || buf.BitOffset() != 35 || buf.ByteOffset() != 5 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at byte offset %d and bit offset %d, expected %#v)", buf.Bytes(), buf.ByteOffset(), buf.BitOffset(), expected)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	if out := buf.ReadBitsNext(4); out != 0x0a {
+
+		t.Fatalf("expected bits do not match the ones gotten (got %#x, expected 0xa)", out)
+
+	}
+
+	buf.AlignByte()
+	if out := buf.ReadByteNext(); out != 0xc0 || buf.BitOffset() != 16 {
+
+		t.Fatalf("expected byte does not match the one gotten (got %#x at bit offset %d, expected 0xc0)", out, buf.BitOffset())
+
+	}
+
+}
+
+func TestBufferUnifiedCursorStrict(t *testing.T) {
+
+	buf := NewBuffer(make([]byte, 4))
+	buf.SetCursorMode(UnifiedCursorStrict)
+	buf.SetSticky(true)
+
+	buf.ReadBitNext()
+	buf.ReadU16LEAtNext()
+	if !errors.Is(buf.Err(), BufferUnalignedError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferUnalignedError)
+
+	}
+
+	if buf.ByteOffset() != 1 || buf.BitOffset() != 1 {
+
+		t.Fatalf("expected the offsets to stay in place (got byte offset %d, bit offset %d)", buf.ByteOffset(), buf.BitOffset())
+
+	}
+
+	buf.ClearErr()
+	buf.SeekBit(0x07, true)
+	buf.WriteBytesNext([]byte{0x01})
+	if buf.Err() != nil || buf.BitOffset() != 16 {
+
+		t.Fatalf("unexpected error at bit offset %d: %v", buf.BitOffset(), buf.Err())
+
+	}
+
+	buf.SeekBit(0x01, true)
+	if _, err := buf.Read(make([]byte, 1)); !errors.Is(err, BufferUnalignedError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferUnalignedError)
+
+	}
+
+}
+
+func TestCheckedBufferUnifiedCursorStrict(t *testing.T) {
+
+	buf := NewCheckedBuffer(make([]byte, 4))
+	buf.Buffer().SetCursorMode(UnifiedCursorStrict)
+
+	err := buf.SetBitsNext(0x01, 2)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	_, err = buf.ReadU16BENext(1)
+	if !errors.Is(err, BufferUnalignedError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferUnalignedError)
+
+	}
+
+	_, err = buf.ReadByteNext()
+	if !errors.Is(err, BufferUnalignedError) || buf.ByteOffset() != 1 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v at offset %d, expected %v)", err, buf.ByteOffset(), BufferUnalignedError)
+
+	}
+
+}
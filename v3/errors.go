@@ -13,16 +13,135 @@ package v3
 
 import "fmt"
 
-// Error implements a custom error type used in crunch
+// Error implements a custom error type used in crunch. errors returned
+// or panicked with by an operation carry the name of the operation,
+// the offset and size it requested and the capacity of the buffer at
+// the time. they still match the error values below with errors.Is
 type Error struct {
 	scope string
 	error string
+
+	op   string
+	unit string
+	off  int64
+	size int64
+	cap  int64
 }
 
 // Error formats the error held in a Error as a string
 func (e Error) Error() string {
 
-	return fmt.Sprintf("crunch: %s: %s", e.scope, e.error)
+	switch {
+
+	case e.op == "":
+		return fmt.Sprintf("crunch: %s: %s", e.scope, e.error)
+
+	case e.unit == "field":
+		return fmt.Sprintf("crunch: %s: field %s: %s", e.scope, e.op, e.error)
+
+	case e.unit == "":
+		return fmt.Sprintf("crunch: %s: %s: %s (count %d, capacity %d)", e.scope, e.op, e.error, e.size, e.cap)
+
+	default:
+		return fmt.Sprintf("crunch: %s: %s: %s (%s offset %d, size %d, capacity %d)", e.scope, e.op, e.error, e.unit, e.off, e.size, e.cap)
+
+	}
+
+}
+
+// Is reports whether the error is an instance of target, ignoring the
+// details of the operation that caused it
+func (e Error) Is(target error) bool {
+
+	t, ok := target.(Error)
+	return ok &&
+		e.scope == t.scope &&
+		e.error == t.error
+
+}
+
+// Op returns the name of the operation that caused the error or, for
+// the errors of Marshal and Unmarshal, the path of the field that did
+func (e Error) Op() string {
+
+	return e.op
+
+}
+
+// Offset returns the byte or bit offset requested by the operation
+// that caused the error
+func (e Error) Offset() int64 {
+
+	return e.off
+
+}
+
+// Size returns the amount of bytes or bits requested by the operation
+// that caused the error
+func (e Error) Size() int64 {
+
+	return e.size
+
+}
+
+// Capacity returns the byte or bit capacity of the buffer at the time
+// of the error
+func (e Error) Capacity() int64 {
+
+	return e.cap
+
+}
+
+// Bits reports whether the offset, size and capacity of the error are
+// measured in bits instead of bytes
+func (e Error) Bits() bool {
+
+	return e.unit == "bit"
+
+}
+
+// at returns a copy of the error describing a byte operation
+func (e Error) at(op string, off, size, cap int64) Error {
+
+	e.op = op
+	e.unit = "byte"
+	e.off = off
+	e.size = size
+	e.cap = cap
+	return e
+
+}
+
+// atBit returns a copy of the error describing a bit operation
+func (e Error) atBit(op string, off, size, cap int64) Error {
+
+	e.op = op
+	e.unit = "bit"
+	e.off = off
+	e.size = size
+	e.cap = cap
+	return e
+
+}
+
+// count returns a copy of the error describing an operation that was
+// given an invalid count
+func (e Error) count(op string, size, cap int64) Error {
+
+	e.op = op
+	e.size = size
+	e.cap = cap
+	return e
+
+}
+
+// field returns a copy of the error describing the struct field at the
+// path name
+func (e Error) field(name string) Error {
+
+	e.op = name
+	e.unit = "field"
+	return e
 
 }
 
@@ -55,6 +174,20 @@ var (
 		error: "write offset is less than zero",
 	}
 
+	// BufferOverseekError represents an instance in which a seek
+	// attempted to move the offset past the buffer itself
+	BufferOverseekError = Error{
+		scope: "buffer",
+		error: "seek offset exceeds buffer capacity",
+	}
+
+	// BufferUnderseekError represents an instance in which a seek
+	// attempted to move the offset before the buffer itself
+	BufferUnderseekError = Error{
+		scope: "buffer",
+		error: "seek offset is less than zero",
+	}
+
 	// BufferInvalidByteCountError represents an instance in which an
 	// invalid byte count was passed to one of the buffer's methods
 	BufferInvalidByteCountError = Error{
@@ -62,10 +195,136 @@ var (
 		error: "invalid byte count requested",
 	}
 
+	// BufferInvalidBitCountError represents an instance in which an
+	// invalid bit count was passed to one of the buffer's methods
+	BufferInvalidBitCountError = Error{
+		scope: "buffer",
+		error: "invalid bit count requested",
+	}
+
+	// BufferInvalidWhenceError represents an instance in which an
+	// invalid whence was passed to one of the buffer's Seek methods
+	BufferInvalidWhenceError = Error{
+		scope: "buffer",
+		error: "invalid whence",
+	}
+
+	// BufferUnalignedError represents an instance in which a byte
+	// operation was attempted at a position that is not on a byte
+	// boundary while the cursors of the buffer are unified strictly
+	BufferUnalignedError = Error{
+		scope: "buffer",
+		error: "byte operation at an unaligned bit offset",
+	}
+
+	// BufferVarintOverflowError represents an instance in which a
+	// variable-length integer was too long to fit in 64 bits
+	BufferVarintOverflowError = Error{
+		scope: "buffer",
+		error: "varint overflows a 64-bit integer",
+	}
+
+	// BufferVarintTruncatedError represents an instance in which a
+	// variable-length integer continued past the end of the buffer
+	BufferVarintTruncatedError = Error{
+		scope: "buffer",
+		error: "varint is truncated",
+	}
+
+	// BufferStringUnterminatedError represents an instance in which a
+	// NUL-terminated string had no terminator within its maximum length
+	BufferStringUnterminatedError = Error{
+		scope: "buffer",
+		error: "string is not terminated",
+	}
+
+	// BufferStringTooLongError represents an instance in which a
+	// string was too long to be described by its length prefix or to
+	// fit in its fixed-width field
+	BufferStringTooLongError = Error{
+		scope: "buffer",
+		error: "string is too long",
+	}
+
+	// BufferInvalidMarkError represents an instance in which a mark
+	// that was already released, or that belongs to another buffer, was
+	// rolled back or committed
+	BufferInvalidMarkError = Error{
+		scope: "buffer",
+		error: "mark is not active",
+	}
+
+	// BufferPlaceholderOverflowError represents an instance in which
+	// the value of a placeholder did not fit in its slot
+	BufferPlaceholderOverflowError = Error{
+		scope: "buffer",
+		error: "value does not fit in placeholder",
+	}
+
+	// BufferPlaceholderOrderError represents an instance in which a
+	// placeholder was closed while a varint placeholder inside of the
+	// section it describes was still open
+	BufferPlaceholderOrderError = Error{
+		scope: "buffer",
+		error: "placeholder closed before the varint placeholders inside of it",
+	}
+
+	// BufferPlaceholderSpliceError represents an instance in which an
+	// insertion, deletion or splice would have cut across the slot of an
+	// open placeholder
+	BufferPlaceholderSpliceError = Error{
+		scope: "buffer",
+		error: "splice cuts across a placeholder",
+	}
+
+	// ChecksumInvalidWidthError represents an instance in which a
+	// CRCModel with a width outside of the supported range was used
+	ChecksumInvalidWidthError = Error{
+		scope: "checksum",
+		error: "invalid crc width",
+	}
+
+	// MarshalUnsupportedTypeError represents an instance in which a
+	// value of a type that can not be marshaled was encountered
+	MarshalUnsupportedTypeError = Error{
+		scope: "marshal",
+		error: "unsupported type",
+	}
+
+	// MarshalInvalidTagError represents an instance in which a struct
+	// tag could not be parsed or does not apply to its field
+	MarshalInvalidTagError = Error{
+		scope: "marshal",
+		error: "invalid struct tag",
+	}
+
+	// MarshalInvalidLengthError represents an instance in which the
+	// length of a field was negative, was longer than the data left to
+	// read, was shorter than the value it describes or referred to a
+	// field that is not an integer
+	MarshalInvalidLengthError = Error{
+		scope: "marshal",
+		error: "invalid length",
+	}
+
+	// MarshalLengthMismatchError represents an instance in which the
+	// length of a field did not match the value it is stored in
+	MarshalLengthMismatchError = Error{
+		scope: "marshal",
+		error: "length does not match the field it refers to",
+	}
+
 	// BytesBufNegativeReadError represents an instance in which a
 	// reader returned a negative count from its Read method
 	BytesBufNegativeReadError = Error{
 		scope: "bytesbuf",
 		error: "reader returned negative count from Read",
 	}
+
+	// WriterClosedError represents an instance in which a Writer was
+	// written to after being closed
+	WriterClosedError = Error{
+		scope: "writer",
+		error: "write to closed writer",
+	}
 )
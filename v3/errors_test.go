@@ -11,7 +11,10 @@ file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
 package v3
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 /*
 
@@ -31,3 +34,81 @@ func TestErrorError(t *testing.T) {
 	}
 
 }
+
+func TestErrorDetails(t *testing.T) {
+
+	var (
+		expected1 = "crunch: buffer: ReadU64LE: read exceeds buffer capacity (byte offset 4, size 16, capacity 12)"
+		expected2 = "crunch: buffer: SetBits: write offset exceeds buffer capacity (bit offset 30, size 3, capacity 32)"
+		expected3 = "crunch: buffer: Grow: invalid byte count requested (count -1, capacity 0)"
+	)
+
+	buf := NewBuffer(make([]byte, 12))
+	buf.SetSticky(true)
+
+	_ = buf.ReadU64LE(0x04, 2)
+
+	err, ok := buf.Err().(Error)
+	if !ok {
+
+		t.Fatalf("expected an Error (got %#v)", buf.Err())
+
+	}
+
+	if err.Error() != expected1 {
+
+		t.Fatalf("expected string does not match the one gotten (got \"%s\", expected \"%s\")", err.Error(), expected1)
+
+	}
+
+	if err.Op() != "ReadU64LE" || err.Offset() != 4 || err.Size() != 16 || err.Capacity() != 12 || err.Bits() {
+
+		t.Fatalf("unexpected error details (got %q, %d, %d, %d, %t)", err.Op(), err.Offset(), err.Size(), err.Capacity(), err.Bits())
+
+	}
+
+	out := NewCheckedBuffer(make([]byte, 4)).SetBits(0x1e, 0x07, 3)
+	if out.Error() != expected2 || !out.(Error).Bits() {
+
+		t.Fatalf("expected string does not match the one gotten (got \"%s\", expected \"%s\")", out.Error(), expected2)
+
+	}
+
+	out = NewCheckedBuffer().Grow(-1)
+	if out.Error() != expected3 {
+
+		t.Fatalf("expected string does not match the one gotten (got \"%s\", expected \"%s\")", out.Error(), expected3)
+
+	}
+
+}
+
+func TestErrorIs(t *testing.T) {
+
+	var out error
+
+	func() {
+
+		defer func() {
+
+			out, _ = recover().(error)
+
+		}()
+
+		NewBuffer([]byte{0x00}).WriteU32BE(0x00, []uint32{0x01})
+
+	}()
+
+	if !errors.Is(out, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", out, BufferOverwriteError)
+
+	}
+
+	if errors.Is(out, BufferOverreadError) {
+
+		t.Fatalf("error unexpectedly matched %v", BufferOverreadError)
+
+	}
+
+}
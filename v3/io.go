@@ -0,0 +1,711 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import "io"
+
+// minRead is the minimum amount of bytes a buffer grows by when
+// ReadFrom runs out of space
+const minRead = 512
+
+// readFromGrowth returns the amount of bytes that ReadFrom grows a
+// buffer whose offset has reached its capacity by, limited by the
+// maximum size of the buffer if it has one. 0 is returned once the
+// maximum is reached
+func readFromGrowth(off, length, limit int64) int64 {
+
+	size := off + minRead
+	if limit > 0x00 && size > limit {
+
+		size = limit
+
+	}
+
+	if size <= off {
+
+		return 0x00
+
+	}
+	return size - length
+
+}
+
+/* Buffer */
+
+// BufferIO implements the io interfaces whose methods clash with the
+// ones Buffer already has (io.ByteReader, io.ByteWriter and
+// io.ByteScanner) on top of a Buffer. all of the other io interfaces
+// are implemented by Buffer itself
+type BufferIO struct {
+	*Buffer
+}
+
+// IO returns a BufferIO that operates on the same data and offsets as
+// the buffer
+func (b *Buffer) IO() *BufferIO {
+
+	return &BufferIO{
+		Buffer: b,
+	}
+
+}
+
+// Read reads up to len(p) bytes from the buffer at the current offset
+// into p and moves the offset forward the amount of bytes read. it
+// returns io.EOF once the offset reaches the end of the buffer
+func (b *Buffer) Read(p []byte) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if err = b.unaligned("Read"); err != nil {
+
+		return
+
+	}
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderreadError.at("Read", b.off, int64(len(p)), b.cap)
+
+	}
+
+	if b.off >= b.cap {
+
+		if len(p) == 0 {
+
+			return 0, nil
+
+		}
+		return 0, io.EOF
+
+	}
+
+	n = copy(p, b.buf[b.off:])
+	b.SeekByte(int64(n), true)
+	return
+
+}
+
+// Write writes p to the buffer at the current offset and moves the
+// offset forward the amount of bytes written. if p does not fit and
+// the buffer cannot grow, as much of it as possible is written and an
+// error is returned
+func (b *Buffer) Write(p []byte) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if err = b.unaligned("Write"); err != nil {
+
+		return
+
+	}
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderwriteError.at("Write", b.off, int64(len(p)), b.cap)
+
+	}
+
+	if b.off+int64(len(p)) > b.cap {
+
+		b.reserve(b.off + int64(len(p)))
+
+	}
+
+	if b.off < b.cap {
+
+		b.save(b.off, int64(len(p)))
+		n = copy(b.buf[b.off:], p)
+
+	}
+
+	if n < len(p) {
+
+		err = BufferOverwriteError.at("Write", b.off, int64(len(p)), b.cap)
+
+	}
+	b.SeekByte(int64(n), true)
+	return
+
+}
+
+// Seek moves the offset of the buffer to offset, interpreted
+// according to whence, and returns the new offset. seeking past the
+// end of the buffer is allowed, but seeking before it is not
+func (b *Buffer) Seek(offset int64, whence int) (int64, error) {
+
+	if b.err != nil {
+
+		return b.off, b.err
+
+	}
+
+	switch whence {
+
+	case io.SeekStart:
+		break
+
+	case io.SeekCurrent:
+		offset += b.off
+
+	case io.SeekEnd:
+		offset += b.cap
+
+	default:
+		return b.off, BufferInvalidWhenceError
+
+	}
+
+	if offset < 0x00 {
+
+		return b.off, BufferUnderseekError.at("Seek", offset, 0, b.cap)
+
+	}
+
+	b.SeekByte(offset, false)
+	return offset, nil
+
+}
+
+// ReadAt reads len(p) bytes from the buffer at the specified offset
+// into p without modifying the internal offset value. if fewer bytes
+// are available, io.EOF is returned alongside them
+func (b *Buffer) ReadAt(p []byte, off int64) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if off < 0x00 {
+
+		return 0, BufferUnderreadError.at("ReadAt", off, int64(len(p)), b.cap)
+
+	}
+
+	if off >= b.cap {
+
+		return 0, io.EOF
+
+	}
+
+	n = copy(p, b.buf[off:])
+	if n < len(p) {
+
+		err = io.EOF
+
+	}
+	return
+
+}
+
+// WriteAt writes p to the buffer at the specified offset without
+// modifying the internal offset value. if p does not fit and the
+// buffer cannot grow, as much of it as possible is written and an
+// error is returned
+func (b *Buffer) WriteAt(p []byte, off int64) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if off < 0x00 {
+
+		return 0, BufferUnderwriteError.at("WriteAt", off, int64(len(p)), b.cap)
+
+	}
+
+	if off+int64(len(p)) > b.cap {
+
+		b.reserve(off + int64(len(p)))
+
+	}
+
+	if off < b.cap {
+
+		b.save(off, int64(len(p)))
+		n = copy(b.buf[off:], p)
+
+	}
+
+	if n < len(p) {
+
+		err = BufferOverwriteError.at("WriteAt", off, int64(len(p)), b.cap)
+
+	}
+	return
+
+}
+
+// WriteTo writes the bytes from the current offset to the end of the
+// buffer to w and moves the offset forward the amount of bytes written
+func (b *Buffer) WriteTo(w io.Writer) (n int64, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if err = b.unaligned("WriteTo"); err != nil {
+
+		return
+
+	}
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderreadError.at("WriteTo", b.off, 0, b.cap)
+
+	}
+
+	if b.off >= b.cap {
+
+		return
+
+	}
+
+	m, err := w.Write(b.buf[b.off:])
+	if m < len(b.buf[b.off:]) && err == nil {
+
+		err = io.ErrShortWrite
+
+	}
+
+	n = int64(m)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ReadFrom reads from r until io.EOF and writes the data to the buffer
+// at the current offset, moving the offset forward the amount of bytes
+// read. unlike the other write methods, it grows the buffer as needed,
+// but never past the maximum size set with SetGrowth
+func (b *Buffer) ReadFrom(r io.Reader) (n int64, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	if err = b.unaligned("ReadFrom"); err != nil {
+
+		return
+
+	}
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderwriteError.at("ReadFrom", b.off, 0, b.cap)
+
+	}
+
+	length := b.cap
+	for {
+
+		if b.off >= b.cap {
+
+			size := readFromGrowth(b.off, b.cap, b.gmax)
+			if size == 0x00 {
+
+				err = BufferOverwriteError.at("ReadFrom", b.off, minRead, b.cap)
+				break
+
+			}
+			b.Grow(size)
+
+		}
+
+		b.save(b.off, b.cap-b.off)
+		m, e := r.Read(b.buf[b.off:b.cap])
+		if m < 0 {
+
+			err = BytesBufNegativeReadError.count("ReadFrom", int64(m), b.cap)
+			break
+
+		}
+
+		n += int64(m)
+		b.SeekByte(int64(m), true)
+
+		if e == io.EOF {
+
+			break
+
+		}
+
+		if e != nil {
+
+			err = e
+			break
+
+		}
+
+	}
+
+	// the bytes that were grown but not read into are removed again
+	if length < b.off {
+
+		length = b.off
+
+	}
+
+	if b.cap > length {
+
+		b.TruncateRight(b.cap - length)
+
+	}
+	return
+
+}
+
+// ReadByte returns the byte at the current offset and moves the offset
+// forward a byte. it returns io.EOF at the end of the buffer
+func (b *BufferIO) ReadByte() (out byte, err error) {
+
+	var p [1]byte
+	_, err = b.Read(p[:])
+	out = p[0]
+	return
+
+}
+
+// UnreadByte moves the offset back a byte
+func (b *BufferIO) UnreadByte() error {
+
+	if b.err != nil {
+
+		return b.err
+
+	}
+
+	if b.off <= 0x00 {
+
+		return BufferUnderseekError.at("UnreadByte", b.off-1, 0, b.cap)
+
+	}
+
+	b.SeekByte(-1, true)
+	return nil
+
+}
+
+// WriteByte writes a byte to the buffer at the current offset and
+// moves the offset forward a byte
+func (b *BufferIO) WriteByte(c byte) (err error) {
+
+	_, err = b.Write([]byte{c})
+	return
+
+}
+
+/* MiniBuffer */
+
+// Read reads up to len(p) bytes from the buffer at the current offset
+// into p and moves the offset forward the amount of bytes read. it
+// returns io.EOF once the offset reaches the end of the buffer
+func (b *MiniBuffer) Read(p []byte) (n int, err error) {
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderreadError.at("Read", b.off, int64(len(p)), b.cap)
+
+	}
+
+	if b.off >= b.cap {
+
+		if len(p) == 0 {
+
+			return 0, nil
+
+		}
+		return 0, io.EOF
+
+	}
+
+	n = copy(p, b.buf[b.off:])
+	b.SeekByte(int64(n), true)
+	return
+
+}
+
+// Write writes p to the buffer at the current offset and moves the
+// offset forward the amount of bytes written. if p does not fit and
+// the buffer cannot grow, as much of it as possible is written and an
+// error is returned
+func (b *MiniBuffer) Write(p []byte) (n int, err error) {
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderwriteError.at("Write", b.off, int64(len(p)), b.cap)
+
+	}
+
+	if b.off+int64(len(p)) > b.cap {
+
+		b.reserve(b.off + int64(len(p)))
+
+	}
+
+	if b.off < b.cap {
+
+		n = copy(b.buf[b.off:], p)
+
+	}
+
+	if n < len(p) {
+
+		err = BufferOverwriteError.at("Write", b.off, int64(len(p)), b.cap)
+
+	}
+	b.SeekByte(int64(n), true)
+	return
+
+}
+
+// Seek moves the offset of the buffer to offset, interpreted
+// according to whence, and returns the new offset. seeking past the
+// end of the buffer is allowed, but seeking before it is not
+func (b *MiniBuffer) Seek(offset int64, whence int) (int64, error) {
+
+	switch whence {
+
+	case io.SeekStart:
+		break
+
+	case io.SeekCurrent:
+		offset += b.off
+
+	case io.SeekEnd:
+		offset += b.cap
+
+	default:
+		return b.off, BufferInvalidWhenceError
+
+	}
+
+	if offset < 0x00 {
+
+		return b.off, BufferUnderseekError.at("Seek", offset, 0, b.cap)
+
+	}
+
+	b.SeekByte(offset, false)
+	return offset, nil
+
+}
+
+// ReadAt reads len(p) bytes from the buffer at the specified offset
+// into p without modifying the internal offset value. if fewer bytes
+// are available, io.EOF is returned alongside them
+func (b *MiniBuffer) ReadAt(p []byte, off int64) (n int, err error) {
+
+	if off < 0x00 {
+
+		return 0, BufferUnderreadError.at("ReadAt", off, int64(len(p)), b.cap)
+
+	}
+
+	if off >= b.cap {
+
+		return 0, io.EOF
+
+	}
+
+	n = copy(p, b.buf[off:])
+	if n < len(p) {
+
+		err = io.EOF
+
+	}
+	return
+
+}
+
+// WriteAt writes p to the buffer at the specified offset without
+// modifying the internal offset value. if p does not fit and the
+// buffer cannot grow, as much of it as possible is written and an
+// error is returned
+func (b *MiniBuffer) WriteAt(p []byte, off int64) (n int, err error) {
+
+	if off < 0x00 {
+
+		return 0, BufferUnderwriteError.at("WriteAt", off, int64(len(p)), b.cap)
+
+	}
+
+	if off+int64(len(p)) > b.cap {
+
+		b.reserve(off + int64(len(p)))
+
+	}
+
+	if off < b.cap {
+
+		n = copy(b.buf[off:], p)
+
+	}
+
+	if n < len(p) {
+
+		err = BufferOverwriteError.at("WriteAt", off, int64(len(p)), b.cap)
+
+	}
+	return
+
+}
+
+// WriteTo writes the bytes from the current offset to the end of the
+// buffer to w and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) WriteTo(w io.Writer) (n int64, err error) {
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderreadError.at("WriteTo", b.off, 0, b.cap)
+
+	}
+
+	if b.off >= b.cap {
+
+		return
+
+	}
+
+	m, err := w.Write(b.buf[b.off:])
+	if m < len(b.buf[b.off:]) && err == nil {
+
+		err = io.ErrShortWrite
+
+	}
+
+	n = int64(m)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// ReadFrom reads from r until io.EOF and writes the data to the buffer
+// at the current offset, moving the offset forward the amount of bytes
+// read. unlike the other write methods, it grows the buffer as needed,
+// but never past the maximum size set with SetGrowth
+func (b *MiniBuffer) ReadFrom(r io.Reader) (n int64, err error) {
+
+	if b.off < 0x00 {
+
+		return 0, BufferUnderwriteError.at("ReadFrom", b.off, 0, b.cap)
+
+	}
+
+	length := b.cap
+	for {
+
+		if b.off >= b.cap {
+
+			size := readFromGrowth(b.off, b.cap, b.gmax)
+			if size == 0x00 {
+
+				err = BufferOverwriteError.at("ReadFrom", b.off, minRead, b.cap)
+				break
+
+			}
+			b.Grow(size)
+
+		}
+
+		m, e := r.Read(b.buf[b.off:b.cap])
+		if m < 0 {
+
+			err = BytesBufNegativeReadError.count("ReadFrom", int64(m), b.cap)
+			break
+
+		}
+
+		n += int64(m)
+		b.SeekByte(int64(m), true)
+
+		if e == io.EOF {
+
+			break
+
+		}
+
+		if e != nil {
+
+			err = e
+			break
+
+		}
+
+	}
+
+	// the bytes that were grown but not read into are removed again
+	if length < b.off {
+
+		length = b.off
+
+	}
+
+	if b.cap > length {
+
+		b.TruncateRight(b.cap - length)
+
+	}
+	return
+
+}
+
+// ReadByte returns the byte at the current offset and moves the offset
+// forward a byte. it returns io.EOF at the end of the buffer
+func (b *MiniBuffer) ReadByte() (out byte, err error) {
+
+	var p [1]byte
+	_, err = b.Read(p[:])
+	out = p[0]
+	return
+
+}
+
+// UnreadByte moves the offset back a byte
+func (b *MiniBuffer) UnreadByte() error {
+
+	if b.off <= 0x00 {
+
+		return BufferUnderseekError.at("UnreadByte", b.off-1, 0, b.cap)
+
+	}
+
+	b.SeekByte(-1, true)
+	return nil
+
+}
+
+// WriteByte writes a byte to the buffer at the current offset and
+// moves the offset forward a byte
+func (b *MiniBuffer) WriteByte(c byte) (err error) {
+
+	_, err = b.Write([]byte{c})
+	return
+
+}
@@ -0,0 +1,361 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+// these ensure that both buffers implement the io interfaces
+var (
+	_ interface {
+		io.ReadWriteSeeker
+		io.ReaderAt
+		io.WriterAt
+		io.WriterTo
+		io.ReaderFrom
+	} = &Buffer{}
+
+	_ interface {
+		io.ReadWriteSeeker
+		io.ReaderAt
+		io.WriterAt
+		io.WriterTo
+		io.ReaderFrom
+		io.ByteScanner
+		io.ByteWriter
+	} = &BufferIO{}
+
+	_ interface {
+		io.ReadWriteSeeker
+		io.ReaderAt
+		io.WriterAt
+		io.WriterTo
+		io.ReaderFrom
+		io.ByteScanner
+		io.ByteWriter
+	} = &MiniBuffer{}
+)
+
+// endlessReader is an io.Reader that never runs out of zeroes
+type endlessReader struct{}
+
+func (endlessReader) Read(p []byte) (int, error) {
+
+	for i := range p {
+
+		p[i] = 0x00
+
+	}
+	return len(p), nil
+
+}
+
+/*
+
+tests
+
+*/
+
+func TestBufferIOReader(t *testing.T) {
+
+	var expected = []byte("crunch - utilities for taking bytes out of things")
+
+	err := iotest.TestReader(NewBuffer(expected).IO(), expected)
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+}
+
+func TestMiniBufferIOReader(t *testing.T) {
+
+	var (
+		expected = []byte("crunch - utilities for taking bytes out of things")
+
+		buf *MiniBuffer
+	)
+
+	NewMiniBuffer(&buf, expected)
+
+	err := iotest.TestReader(buf, expected)
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+}
+
+func TestBufferCopy(t *testing.T) {
+
+	var expected = []byte{0x01, 0x02, 0x03, 0x04}
+
+	buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03, 0x04})
+	buf.SeekByte(0x01, false)
+
+	out := &bytes.Buffer{}
+	n, err := io.Copy(out, buf)
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+	if n != 4 || !cmp.Equal(expected, out.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out.Bytes(), expected)
+
+	}
+
+	if buf.ByteOffset() != 5 {
+
+		t.Fatalf("incorrect offset: %d", buf.ByteOffset())
+
+	}
+
+}
+
+func TestBufferBinaryRead(t *testing.T) {
+
+	var (
+		expected1 uint16 = 0x0102
+		expected2 uint32 = 0x04030201
+
+		out struct {
+			A uint16
+			B uint32
+		}
+	)
+
+	buf := NewBuffer([]byte{0x01, 0x02, 0x01, 0x02, 0x03, 0x04})
+
+	err := binary.Read(bufio.NewReader(buf), binary.BigEndian, &out.A)
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+	if out.A != expected1 {
+
+		t.Fatalf("expected uint16 does not match the one gotten (got %d, expected %d)", out.A, expected1)
+
+	}
+
+	buf.SeekByte(0x02, false)
+
+	err = binary.Read(buf, binary.LittleEndian, &out.B)
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+	if out.B != expected2 {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %d, expected %d)", out.B, expected2)
+
+	}
+
+	err = binary.Read(buf, binary.LittleEndian, &out.B)
+	if err != io.EOF {
+
+		t.Fatalf("expected io.EOF at the end of the buffer (got %v)", err)
+
+	}
+
+}
+
+func TestBufferWrite(t *testing.T) {
+
+	var expected = []byte{0x00, 0x01, 0x02, 0x03}
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+	buf.SeekByte(0x01, false)
+
+	n, err := buf.Write([]byte{0x01, 0x02, 0x03, 0x04})
+	if n != 3 || !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected a short write (got %d, %v)", n, err)
+
+	}
+
+	if !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	err = buf.IO().WriteByte(0x05)
+	if !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+	n, err = buf.WriteAt([]byte{0x05, 0x06}, 0x00)
+	if n != 2 || err != nil || buf.Bytes()[1] != 0x06 {
+
+		t.Fatalf("unexpected result from WriteAt (got %d, %v, %#v)", n, err, buf.Bytes())
+
+	}
+
+}
+
+func TestBufferSeek(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x00, 0x00, 0x00})
+
+	for i, c := range []struct {
+		offset   int64
+		whence   int
+		expected int64
+	}{
+		{0x02, io.SeekStart, 0x02},
+		{0x01, io.SeekCurrent, 0x03},
+		{-0x04, io.SeekEnd, 0x00},
+		{0x02, io.SeekEnd, 0x06},
+	} {
+
+		out, err := buf.Seek(c.offset, c.whence)
+		if err != nil || out != c.expected || buf.ByteOffset() != c.expected {
+
+			t.Fatalf("case %d: expected offset does not match the one gotten (got %d, %v, expected %d)", i, out, err, c.expected)
+
+		}
+
+	}
+
+	_, err := buf.Seek(-0x07, io.SeekCurrent)
+	if !errors.Is(err, BufferUnderseekError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferUnderseekError)
+
+	}
+
+	_, err = buf.Seek(0x00, 3)
+	if !errors.Is(err, BufferInvalidWhenceError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferInvalidWhenceError)
+
+	}
+
+}
+
+func TestBufferReadFrom(t *testing.T) {
+
+	var expected = append([]byte{0x01, 0x02}, bytes.Repeat([]byte("crunch"), 200)...)
+
+	buf := NewBuffer([]byte{0x01, 0x02, 0x03})
+	buf.SeekByte(0x02, false)
+
+	n, err := buf.ReadFrom(iotest.OneByteReader(strings.NewReader(strings.Repeat("crunch", 200))))
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+	if n != 1200 || buf.ByteOffset() != 1202 {
+
+		t.Fatalf("incorrect count or offset: %d, %d", n, buf.ByteOffset())
+
+	}
+
+	if !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+}
+
+func TestBufferReadFromLimit(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01, 0x02})
+	buf.SetGrowth(true, 1000)
+
+	// the reader never runs out, so only the maximum size stops it
+	n, err := buf.ReadFrom(endlessReader{})
+	if !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+	if n != 1000 || buf.ByteCapacity() != 1000 || buf.ByteOffset() != 1000 {
+
+		t.Fatalf("incorrect count, capacity or offset: %d, %d, %d", n, buf.ByteCapacity(), buf.ByteOffset())
+
+	}
+
+}
+
+func TestMiniBufferReadFrom(t *testing.T) {
+
+	var (
+		expected = []byte{0x01, 0x02, 0x03}
+
+		buf *MiniBuffer
+	)
+
+	NewMiniBuffer(&buf, []byte{0x00, 0x00, 0x00, 0x00})
+
+	n, err := buf.ReadFrom(bytes.NewReader(expected))
+	if err != nil || n != 3 {
+
+		t.Fatalf("unexpected result from ReadFrom (got %d, %v)", n, err)
+
+	}
+
+	var out []byte
+	buf.Bytes(&out)
+	if !cmp.Equal(append(expected, 0x00), out) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out, append(expected, 0x00))
+
+	}
+
+}
+
+func TestMiniBufferReadFromLimit(t *testing.T) {
+
+	var buf *MiniBuffer
+	NewMiniBuffer(&buf, []byte{})
+	buf.SetGrowth(true, 600)
+
+	n, err := buf.ReadFrom(endlessReader{})
+	if !errors.Is(err, BufferOverwriteError) || n != 600 || buf.cap != 600 {
+
+		t.Fatalf("unexpected result from ReadFrom (got %d, %v)", n, err)
+
+	}
+
+}
@@ -0,0 +1,279 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+// Mark is a checkpoint of the state of a Buffer, taken by Mark and
+// released by either Rollback or Commit
+type Mark struct {
+	off  int64
+	boff int64
+	cap  int64
+	err  error
+
+	// entries is the length of the journal when the mark was taken and
+	// depth is the amount of marks that were active afterwards
+	entries int
+	depth   int
+
+	// slots holds the placeholders that were open when the mark was
+	// taken, along with their state at the time
+	slots []slotState
+}
+
+// slotState is the state of an open placeholder when a mark was taken
+type slotState struct {
+	p     *Placeholder
+	state Placeholder
+}
+
+// journal records the bytes overwritten in a Buffer while a mark is
+// active, so that they can be restored by Rollback
+type journal struct {
+	// entries describe the bytes in saved, which are stored in the order
+	// that they were overwritten in
+	entries []journalEntry
+	saved   []byte
+
+	marks int
+}
+
+// journalEntry describes n bytes that were overwritten at off
+type journalEntry struct {
+	off int64
+	n   int64
+}
+
+/* internal use methods */
+
+// save records the n bytes located at off in the journal before they
+// are overwritten, if there is an active mark
+func (b *Buffer) save(off, n int64) {
+
+	if b.journal.marks > 0x00 {
+
+		b.record(off, n)
+
+	}
+
+}
+
+// saveBits is the bit-level variant of save. it records the bytes
+// containing the n bits located at the bit offset off
+func (b *Buffer) saveBits(off, n int64) {
+
+	if b.journal.marks > 0x00 {
+
+		b.record(off/8, (off+n+7)/8-off/8)
+
+	}
+
+}
+
+// record appends the n bytes located at off to the journal, ignoring
+// the ones past the end of the buffer
+func (b *Buffer) record(off, n int64) {
+
+	if off+n > b.cap {
+
+		n = b.cap - off
+
+	}
+
+	if n <= 0x00 {
+
+		return
+
+	}
+
+	b.journal.entries = append(b.journal.entries, journalEntry{off, n})
+	b.journal.saved = append(b.journal.saved, b.buf[off:off+n]...)
+
+}
+
+// active reports whether m is still active on the buffer
+func (b *Buffer) active(m Mark) bool {
+
+	return m.depth > 0x00 &&
+		m.depth <= b.journal.marks &&
+		m.entries <= len(b.journal.entries)
+
+}
+
+// release releases m along with every mark taken after it, discarding
+// the journal once no marks are left
+func (b *Buffer) release(m Mark) {
+
+	b.journal.marks = m.depth - 1
+	if b.journal.marks == 0x00 {
+
+		b.journal.entries = b.journal.entries[:0]
+		b.journal.saved = b.journal.saved[:0]
+
+	}
+
+}
+
+/* Buffer */
+
+// Mark takes a checkpoint of the offsets, capacity, error, open
+// placeholders and contents of the buffer. until it is released by Rollback or Commit, every write
+// made through the buffer records the bytes it overwrites, so that
+// Rollback can restore them. marks can be nested, and releasing one also
+// releases every mark taken after it.
+//
+// writes made to the slice returned by Bytes, to a Slice view or through
+// any other buffer sharing the same memory are not recorded, and neither
+// are TruncateLeft and TruncateRight
+func (b *Buffer) Mark() (m Mark) {
+
+	b.journal.marks++
+	m = Mark{
+		off:     b.off,
+		boff:    b.boff,
+		cap:     b.cap,
+		err:     b.err,
+		entries: len(b.journal.entries),
+		depth:   b.journal.marks,
+	}
+
+	for _, p := range b.slots {
+
+		m.slots = append(m.slots, slotState{p, *p})
+
+	}
+	return
+
+}
+
+// Rollback restores the state of the buffer to the one it was in when
+// m was taken and releases it. this includes any error recorded in
+// sticky mode since then and the placeholders that were open, while the
+// ones reserved since then are abandoned
+func (b *Buffer) Rollback(m Mark) {
+
+	if !b.active(m) {
+
+		b.fail(BufferInvalidMarkError.at("Rollback", m.off, 0x00, b.cap))
+		return
+
+	}
+
+	// bytes past the end of the buffer may have been written to before
+	// it shrunk, so the whole backing array is considered
+	full := b.buf[:cap(b.buf)]
+	for i := len(b.journal.entries) - 1; i >= m.entries; i-- {
+
+		e := b.journal.entries[i]
+		start := int64(len(b.journal.saved)) - e.n
+		if e.off < int64(len(full)) {
+
+			copy(full[e.off:], b.journal.saved[start:])
+
+		}
+		b.journal.saved = b.journal.saved[:start]
+
+	}
+	b.journal.entries = b.journal.entries[:m.entries]
+
+	if m.cap <= int64(cap(b.buf)) {
+
+		b.buf = b.buf[:m.cap]
+		b.Refresh()
+
+	}
+
+	// placeholders reserved since the mark was taken are abandoned, and
+	// the ones that were open then are reopened as they were
+	for _, p := range b.slots {
+
+		p.done = true
+
+	}
+
+	b.slots = b.slots[:0]
+	for _, saved := range m.slots {
+
+		*saved.p = saved.state
+		b.slots = append(b.slots, saved.p)
+
+	}
+
+	b.off = m.off
+	b.boff = m.boff
+	b.err = m.err
+	b.release(m)
+
+	// the bytes past the restored position are written to the hash again
+	// once it moves past them
+	if end := b.passed(); end < b.hend {
+
+		b.hend = end
+
+	}
+	b.rehash()
+
+}
+
+// Commit releases m, keeping the changes made since it was taken. they
+// can still be undone by rolling back a mark taken before m
+func (b *Buffer) Commit(m Mark) {
+
+	if !b.active(m) {
+
+		b.fail(BufferInvalidMarkError.at("Commit", m.off, 0x00, b.cap))
+		return
+
+	}
+	b.release(m)
+
+}
+
+/* CheckedBuffer */
+
+// Mark takes a checkpoint of the state of the buffer. see Buffer.Mark
+func (b *CheckedBuffer) Mark() Mark {
+
+	return b.buf.Mark()
+
+}
+
+// Rollback restores the state of the buffer to the one it was in when
+// m was taken and releases it. an error is returned if m is not active
+func (b *CheckedBuffer) Rollback(m Mark) (err error) {
+
+	if !b.buf.active(m) {
+
+		err = BufferInvalidMarkError.at("Rollback", m.off, 0x00, b.buf.cap)
+		return
+
+	}
+
+	b.buf.Rollback(m)
+	return
+
+}
+
+// Commit releases m, keeping the changes made since it was taken. an
+// error is returned if m is not active
+func (b *CheckedBuffer) Commit(m Mark) (err error) {
+
+	if !b.buf.active(m) {
+
+		err = BufferInvalidMarkError.at("Commit", m.off, 0x00, b.buf.cap)
+		return
+
+	}
+
+	b.buf.Commit(m)
+	return
+
+}
@@ -0,0 +1,222 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferRollback(t *testing.T) {
+
+	data := countingBytes(16)
+
+	buf := NewBuffer(append([]byte{}, data...))
+	buf.SeekByte(0x02, false)
+	buf.SeekBit(0x05, false)
+
+	m := buf.Mark()
+	buf.PutU32LENext(0xdeadbeef)
+	buf.WriteI16BE(0x08, []int16{-1, -2})
+	buf.PutU24BEBits(0x03, 0xabcdef)
+	buf.SetBitsNext(0x1ff, 9)
+	buf.WriteBytes(0x0e, []byte{0xaa, 0xbb})
+	buf.WriteCString(0x0a, "hi")
+	buf.WriteUvarint(0x06, 0xffff)
+	buf.SetBit(0x7f)
+	buf.ClearBit(0x00)
+	buf.FlipBit(0x40)
+	buf.FlipAllBits()
+	buf.SetAllBits()
+	buf.ClearAllBits()
+	_, _ = buf.Write([]byte{0x01, 0x02})
+	buf.Rollback(m)
+
+	if !cmp.Equal(data, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), data)
+
+	}
+
+	if buf.ByteOffset() != 0x02 || buf.BitOffset() != 0x05 {
+
+		t.Fatalf("incorrect offsets (got %d and %d, expected 2 and 5)", buf.ByteOffset(), buf.BitOffset())
+
+	}
+
+	// the journal is only kept while a mark is active
+	buf.PutU16LE(0x00, 0xffff)
+	if len(buf.journal.entries) != 0x00 || buf.ReadU16LEAt(0x00) != 0xffff {
+
+		t.Fatalf("unexpected journal entries: %#v", buf.journal.entries)
+
+	}
+
+}
+
+func TestBufferRollbackGrowth(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01, 0x02})
+	buf.SetGrowth(true, 0)
+	buf.SetSticky(true)
+
+	m := buf.Mark()
+	buf.SeekByte(0x01, false)
+	buf.PutU64BENext(0x00)
+	buf.ReadBytes(0x10, 1)
+	if buf.ByteCapacity() != 9 || !errors.Is(buf.Err(), BufferOverreadError) {
+
+		t.Fatalf("unexpected state (got capacity %d and %v)", buf.ByteCapacity(), buf.Err())
+
+	}
+
+	// the capacity and the error recorded in sticky mode are restored
+	buf.Rollback(m)
+	if buf.Err() != nil || !cmp.Equal([]byte{0x01, 0x02}, buf.Bytes()) {
+
+		t.Fatalf("unexpected state (got %#v and %v)", buf.Bytes(), buf.Err())
+
+	}
+
+}
+
+func TestBufferNestedMarks(t *testing.T) {
+
+	buf := NewBuffer(make([]byte, 4))
+
+	outer := buf.Mark()
+	buf.WriteByteNext(0x01)
+
+	inner := buf.Mark()
+	buf.WriteByteNext(0x02)
+	buf.Commit(inner)
+
+	m := buf.Mark()
+	buf.WriteByteNext(0x03)
+	buf.Rollback(m)
+
+	if expected := []byte{0x01, 0x02, 0x00, 0x00}; !cmp.Equal(expected, buf.Bytes()) || buf.ByteOffset() != 0x02 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d, expected %#v)", buf.Bytes(), buf.ByteOffset(), expected)
+
+	}
+
+	// committed changes are still undone by rolling back an outer mark,
+	// which releases the marks taken after it
+	inner = buf.Mark()
+	buf.WriteByteNext(0x04)
+	buf.Rollback(outer)
+	if !cmp.Equal(make([]byte, 4), buf.Bytes()) || buf.ByteOffset() != 0x00 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d)", buf.Bytes(), buf.ByteOffset())
+
+	}
+
+	buf.SetSticky(true)
+	buf.Commit(inner)
+	if !errors.Is(buf.Err(), BufferInvalidMarkError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferInvalidMarkError)
+
+	}
+
+}
+
+func TestBufferMarkReadFrom(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01, 0x02, 0x03})
+	buf.SeekByte(0x01, false)
+
+	m := buf.Mark()
+	if _, err := buf.ReadFrom(bytes.NewReader(countingBytes(600))); err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+	buf.Rollback(m)
+
+	if !cmp.Equal([]byte{0x01, 0x02, 0x03}, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v)", buf.Bytes())
+
+	}
+
+}
+
+func TestBufferRollbackPanic(t *testing.T) {
+
+	defer panicChecker(t, BufferInvalidMarkError.at("Rollback", 0x00, 0x00, 0x02))
+
+	buf := NewBuffer(make([]byte, 2))
+
+	m := buf.Mark()
+	buf.Commit(m)
+	buf.Rollback(m)
+
+}
+
+func TestCheckedBufferRollback(t *testing.T) {
+
+	buf := NewCheckedBuffer(make([]byte, 2))
+
+	m := buf.Mark()
+	if err := buf.PutU16BENext(0xffff); err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if err := buf.Rollback(m); err != nil || !cmp.Equal(make([]byte, 2), buf.Buffer().Bytes()) {
+
+		t.Fatalf("unexpected result (got %#v and %v)", buf.Buffer().Bytes(), err)
+
+	}
+
+	if err := buf.Commit(m); !errors.Is(err, BufferInvalidMarkError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferInvalidMarkError)
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkBufferMarkRollback(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 64))
+
+	for n := 0; n < b.N; n++ {
+
+		m := buf.Mark()
+		buf.PutU32LENext(0xdeadbeef)
+		buf.PutU64BEBitsNext(0x00)
+		buf.Rollback(m)
+
+	}
+
+}
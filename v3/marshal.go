@@ -0,0 +1,1052 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// fieldTag holds the options of a crunch struct tag
+type fieldTag struct {
+	// encoding is one of u, i, f, uvarint, varint, uleb128, sleb128 or
+	// cstring, or empty if it is inferred from the type of the field
+	encoding string
+
+	// size is the amount of bytes taken up by an integer or a float
+	size int64
+
+	// little is set if multi-byte values are stored in little-endian
+	little bool
+
+	// bits is the amount of bits taken up by a bit field
+	bits int64
+
+	// length is the integer literal or the name of the field holding
+	// the length of a slice or a string
+	length string
+
+	// prefix is the size of the length prefix of a string, which is -1
+	// for a varint prefix
+	prefix int64
+
+	// width is the size of a fixed-width string
+	width int64
+}
+
+/* internal use functions */
+
+// parseTag parses the contents of a crunch struct tag. skip is set if
+// the field is to be left alone
+func parseTag(tag string) (t fieldTag, skip bool, ok bool) {
+
+	if tag == "-" {
+
+		return t, true, true
+
+	}
+
+	if tag == "" {
+
+		return t, false, true
+
+	}
+
+	for _, option := range strings.Split(tag, ",") {
+
+		key, value := option, ""
+		if i := strings.IndexByte(option, '='); i >= 0x00 {
+
+			key, value = option[:i], option[i+1:]
+
+		}
+
+		switch key {
+
+		case "be", "le":
+			t.little = key == "le"
+
+		case "uvarint", "varint", "uleb128", "sleb128", "cstring":
+			t.encoding = key
+
+		case "bits":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 1 || n > 64 {
+
+				return t, false, false
+
+			}
+			t.bits = n
+
+		case "len":
+			if value == "" {
+
+				return t, false, false
+
+			}
+			t.length = value
+
+		case "prefix":
+			switch value {
+
+			case "u8":
+				t.prefix = 1
+
+			case "u16":
+				t.prefix = 2
+
+			case "u32":
+				t.prefix = 4
+
+			case "uvarint":
+				t.prefix = -1
+
+			default:
+				return t, false, false
+
+			}
+
+		case "size":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 0x00 {
+
+				return t, false, false
+
+			}
+			t.width = n
+
+		default:
+			// the remaining options are integer and float types such
+			// as u24 or f32
+			if len(key) < 2 || (key[0] != 'u' && key[0] != 'i' && key[0] != 'f') {
+
+				return t, false, false
+
+			}
+
+			n, err := strconv.ParseInt(key[1:], 10, 64)
+			if err != nil || n%8 != 0x00 || n < 8 || n > 64 || (key[0] == 'f' && n != 32 && n != 64) {
+
+				return t, false, false
+
+			}
+			t.encoding, t.size = key[:1], n/8
+
+		}
+
+	}
+	return t, false, true
+
+}
+
+// stringPrefix returns the StringPrefix described by the tag
+func (t fieldTag) stringPrefix() StringPrefix {
+
+	switch {
+
+	case t.prefix == 1:
+		return PrefixU8
+
+	case t.prefix == 2 && t.little:
+		return PrefixU16LE
+
+	case t.prefix == 2:
+		return PrefixU16BE
+
+	case t.prefix == 4 && t.little:
+		return PrefixU32LE
+
+	case t.prefix == 4:
+		return PrefixU32BE
+
+	}
+	return PrefixUvarint
+
+}
+
+// elem returns the tag used by the elements of a slice or an array
+func (t fieldTag) elem() fieldTag {
+
+	t.length = ""
+	return t
+
+}
+
+// lengthOf resolves the length option of a tag, looking up fields in
+// the struct parent
+func lengthOf(parent reflect.Value, t fieldTag, path string) (n int64, err error) {
+
+	if n, err := strconv.ParseInt(t.length, 10, 64); err == nil && n >= 0x00 {
+
+		return n, nil
+
+	}
+
+	v := parent.FieldByName(t.length)
+	switch v.Kind() {
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = v.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n = int64(v.Uint())
+
+	default:
+		return 0, MarshalInvalidLengthError.field(path)
+
+	}
+
+	if n < 0x00 {
+
+		return 0, MarshalInvalidLengthError.field(path)
+
+	}
+	return
+
+}
+
+// integerSize returns the amount of bytes an integer or a float of the
+// type of v takes up by default
+func integerSize(v reflect.Value) int64 {
+
+	switch v.Kind() {
+
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return 1
+
+	case reflect.Int16, reflect.Uint16:
+		return 2
+
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 4
+
+	}
+	return 8
+
+}
+
+// minBits returns the least amount of bits that a value of the type
+// typ takes up in the encoding described by t. slices and strings whose
+// length is stored elsewhere may be empty, so they count as nothing
+func minBits(typ reflect.Type, t fieldTag) int64 {
+
+	if t.bits != 0x00 {
+
+		return t.bits
+
+	}
+
+	switch typ.Kind() {
+
+	case reflect.String:
+		switch {
+
+		case t.encoding == "cstring", t.prefix < 0x00:
+			return 8
+
+		case t.prefix != 0x00:
+			return t.prefix * 8
+
+		}
+		return t.width * 8
+
+	case reflect.Slice:
+		return 0x00
+
+	case reflect.Array:
+		return int64(typ.Len()) * minBits(typ.Elem(), t.elem())
+
+	case reflect.Struct:
+		var n int64
+		for i := 0; i < typ.NumField(); i++ {
+
+			f := typ.Field(i)
+			if f.PkgPath != "" {
+
+				continue
+
+			}
+
+			if tag, skip, ok := parseTag(f.Tag.Get("crunch")); ok && !skip {
+
+				n += minBits(f.Type, tag)
+
+			}
+
+		}
+		return n
+
+	}
+
+	switch {
+
+	case t.encoding == "uvarint", t.encoding == "varint", t.encoding == "uleb128", t.encoding == "sleb128":
+		return 8
+
+	case t.size != 0x00:
+		return t.size * 8
+
+	}
+	return integerSize(reflect.Zero(typ)) * 8
+
+}
+
+/* internal use methods */
+
+// holds reports whether enough data is left in the buffer for n values
+// that take up at least size bits each. values that take up no space
+// are counted as a bit so that a hostile length cannot make a decoder
+// loop or allocate without bound
+func (b *Buffer) holds(n, size int64) bool {
+
+	if size < 1 {
+
+		size = 1
+
+	}
+
+	left := (b.cap - b.off) * 8
+	if bits := b.bcap - b.boff; bits > left {
+
+		left = bits
+
+	}
+	return n <= left/size
+
+}
+
+// readUintNext reads an unsigned integer that is size bytes long from
+// the buffer at the current offset
+func (b *Buffer) readUintNext(size int64, little bool) uint64 {
+
+	switch {
+
+	case size == 1:
+		return uint64(b.ReadByteNext())
+
+	case size == 2 && little:
+		return uint64(b.ReadU16LEAtNext())
+
+	case size == 2:
+		return uint64(b.ReadU16BEAtNext())
+
+	case size == 3 && little:
+		return uint64(b.ReadU24LEAtNext())
+
+	case size == 3:
+		return uint64(b.ReadU24BEAtNext())
+
+	case size == 4 && little:
+		return uint64(b.ReadU32LEAtNext())
+
+	case size == 4:
+		return uint64(b.ReadU32BEAtNext())
+
+	case size == 5 && little:
+		return b.ReadU40LEAtNext()
+
+	case size == 5:
+		return b.ReadU40BEAtNext()
+
+	case size == 6 && little:
+		return b.ReadU48LEAtNext()
+
+	case size == 6:
+		return b.ReadU48BEAtNext()
+
+	case size == 7 && little:
+		return b.ReadU56LEAtNext()
+
+	case size == 7:
+		return b.ReadU56BEAtNext()
+
+	case little:
+		return b.ReadU64LEAtNext()
+
+	}
+	return b.ReadU64BEAtNext()
+
+}
+
+// putUintNext writes the last size bytes of data to the buffer at the
+// current offset
+func (b *Buffer) putUintNext(size int64, little bool, data uint64) {
+
+	switch {
+
+	case size == 1:
+		b.WriteByteNext(byte(data))
+
+	case size == 2 && little:
+		b.PutU16LENext(uint16(data))
+
+	case size == 2:
+		b.PutU16BENext(uint16(data))
+
+	case size == 3 && little:
+		b.PutU24LENext(uint32(data))
+
+	case size == 3:
+		b.PutU24BENext(uint32(data))
+
+	case size == 4 && little:
+		b.PutU32LENext(uint32(data))
+
+	case size == 4:
+		b.PutU32BENext(uint32(data))
+
+	case size == 5 && little:
+		b.PutU40LENext(data)
+
+	case size == 5:
+		b.PutU40BENext(data)
+
+	case size == 6 && little:
+		b.PutU48LENext(data)
+
+	case size == 6:
+		b.PutU48BENext(data)
+
+	case size == 7 && little:
+		b.PutU56LENext(data)
+
+	case size == 7:
+		b.PutU56BENext(data)
+
+	case little:
+		b.PutU64LENext(data)
+
+	default:
+		b.PutU64BENext(data)
+
+	}
+
+}
+
+// marshalStruct writes the exported fields of the struct v to the
+// buffer
+func (b *Buffer) marshalStruct(v reflect.Value, path string) error {
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+
+		f := t.Field(i)
+		if f.PkgPath != "" {
+
+			continue
+
+		}
+
+		name := f.Name
+		if path != "" {
+
+			name = strings.Join([]string{path, f.Name}, ".")
+
+		}
+
+		tag, skip, ok := parseTag(f.Tag.Get("crunch"))
+		if !ok {
+
+			return MarshalInvalidTagError.field(name)
+
+		}
+
+		if skip {
+
+			continue
+
+		}
+
+		if err := b.marshalValue(v, v.Field(i), tag, name); err != nil {
+
+			return err
+
+		}
+
+		if b.err != nil {
+
+			return b.err
+
+		}
+
+	}
+	return nil
+
+}
+
+// marshalValue writes the value v, which is located in the struct
+// parent, to the buffer in the encoding described by t
+func (b *Buffer) marshalValue(parent, v reflect.Value, t fieldTag, path string) error {
+
+	var data uint64
+	switch v.Kind() {
+
+	case reflect.Bool:
+		if v.Bool() {
+
+			data = 0x01
+
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		data = uint64(v.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		data = v.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		if t.bits != 0x00 || (t.encoding != "" && t.encoding != "f") {
+
+			return MarshalInvalidTagError.field(path)
+
+		}
+
+		size := t.size
+		if size == 0x00 {
+
+			size = integerSize(v)
+
+		}
+
+		switch {
+
+		case size == 4 && t.little:
+			b.PutF32LENext(float32(v.Float()))
+
+		case size == 4:
+			b.PutF32BENext(float32(v.Float()))
+
+		case t.little:
+			b.PutF64LENext(v.Float())
+
+		default:
+			b.PutF64BENext(v.Float())
+
+		}
+		return nil
+
+	case reflect.String:
+		return b.marshalString(parent, v.String(), t, path)
+
+	case reflect.Slice, reflect.Array:
+		n := int64(v.Len())
+		if v.Kind() == reflect.Slice {
+
+			if t.length == "" {
+
+				return MarshalInvalidTagError.field(path)
+
+			}
+
+			m, err := lengthOf(parent, t, path)
+			if err != nil {
+
+				return err
+
+			}
+
+			if m != n {
+
+				return MarshalLengthMismatchError.field(path)
+
+			}
+
+			if v.Type().Elem().Kind() == reflect.Uint8 && t.encoding == "" && t.bits == 0x00 {
+
+				b.WriteBytesNext(v.Bytes())
+				return nil
+
+			}
+
+		}
+
+		for i := int64(0); i < n; i++ {
+
+			err := b.marshalValue(parent, v.Index(int(i)), t.elem(), strings.Join([]string{path, "[", strconv.FormatInt(i, 10), "]"}, ""))
+			if err != nil {
+
+				return err
+
+			}
+
+		}
+		return nil
+
+	case reflect.Struct:
+		return b.marshalStruct(v, path)
+
+	default:
+		return MarshalUnsupportedTypeError.field(path)
+
+	}
+
+	if t.bits != 0x00 {
+
+		b.SetBitsNext(data, t.bits)
+		return nil
+
+	}
+
+	switch t.encoding {
+
+	case "uvarint":
+		b.WriteUvarintNext(data)
+
+	case "varint":
+		b.WriteVarintNext(int64(data))
+
+	case "uleb128":
+		b.WriteULEB128Next(data)
+
+	case "sleb128":
+		b.WriteSLEB128Next(int64(data))
+
+	case "", "u", "i":
+		size := t.size
+		if size == 0x00 {
+
+			size = integerSize(v)
+
+		}
+		b.putUintNext(size, t.little, data)
+
+	default:
+		return MarshalInvalidTagError.field(path)
+
+	}
+	return nil
+
+}
+
+// marshalString writes the string data to the buffer in the encoding
+// described by t
+func (b *Buffer) marshalString(parent reflect.Value, data string, t fieldTag, path string) error {
+
+	switch {
+
+	case t.encoding == "cstring":
+		b.WriteCStringNext(data)
+
+	case t.prefix != 0x00:
+		b.WritePStringNext(t.stringPrefix(), data)
+
+	case t.width != 0x00:
+		b.WriteFixedStringNext(t.width, data, 0x00)
+
+	case t.length != "":
+		n, err := lengthOf(parent, t, path)
+		if err != nil {
+
+			return err
+
+		}
+
+		if n != int64(len(data)) {
+
+			return MarshalLengthMismatchError.field(path)
+
+		}
+		b.WriteBytesNext([]byte(data))
+
+	default:
+		return MarshalInvalidTagError.field(path)
+
+	}
+	return nil
+
+}
+
+// unmarshalStruct reads the exported fields of the struct v from the
+// buffer
+func (b *Buffer) unmarshalStruct(v reflect.Value, path string) error {
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+
+		f := t.Field(i)
+		if f.PkgPath != "" {
+
+			continue
+
+		}
+
+		name := f.Name
+		if path != "" {
+
+			name = strings.Join([]string{path, f.Name}, ".")
+
+		}
+
+		tag, skip, ok := parseTag(f.Tag.Get("crunch"))
+		if !ok {
+
+			return MarshalInvalidTagError.field(name)
+
+		}
+
+		if skip {
+
+			continue
+
+		}
+
+		if err := b.unmarshalValue(v, v.Field(i), tag, name); err != nil {
+
+			return err
+
+		}
+
+		if b.err != nil {
+
+			return b.err
+
+		}
+
+	}
+	return nil
+
+}
+
+// unmarshalValue reads the value v, which is located in the struct
+// parent, from the buffer in the encoding described by t
+func (b *Buffer) unmarshalValue(parent, v reflect.Value, t fieldTag, path string) error {
+
+	var signed bool
+	switch v.Kind() {
+
+	case reflect.Bool, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		signed = true
+
+	case reflect.Float32, reflect.Float64:
+		if t.bits != 0x00 || (t.encoding != "" && t.encoding != "f") {
+
+			return MarshalInvalidTagError.field(path)
+
+		}
+
+		size := t.size
+		if size == 0x00 {
+
+			size = integerSize(v)
+
+		}
+
+		switch {
+
+		case size == 4 && t.little:
+			v.SetFloat(float64(b.ReadF32LEAtNext()))
+
+		case size == 4:
+			v.SetFloat(float64(b.ReadF32BEAtNext()))
+
+		case t.little:
+			v.SetFloat(b.ReadF64LEAtNext())
+
+		default:
+			v.SetFloat(b.ReadF64BEAtNext())
+
+		}
+		return nil
+
+	case reflect.String:
+		return b.unmarshalString(parent, v, t, path)
+
+	case reflect.Slice, reflect.Array:
+		n := int64(v.Len())
+		if v.Kind() == reflect.Slice {
+
+			if t.length == "" {
+
+				return MarshalInvalidTagError.field(path)
+
+			}
+
+			var err error
+			n, err = lengthOf(parent, t, path)
+			if err != nil {
+
+				return err
+
+			}
+
+			if !b.holds(n, minBits(v.Type().Elem(), t.elem())) {
+
+				return MarshalInvalidLengthError.field(path)
+
+			}
+
+			if v.Type().Elem().Kind() == reflect.Uint8 && t.encoding == "" && t.bits == 0x00 {
+
+				// the bytes are copied so that the value does not
+				// change along with the buffer
+				data := b.ReadBytesNext(n)
+				v.SetBytes(append(make([]byte, 0, len(data)), data...))
+				return nil
+
+			}
+			v.Set(reflect.MakeSlice(v.Type(), int(n), int(n)))
+
+		}
+
+		for i := int64(0); i < n; i++ {
+
+			err := b.unmarshalValue(parent, v.Index(int(i)), t.elem(), strings.Join([]string{path, "[", strconv.FormatInt(i, 10), "]"}, ""))
+			if err != nil {
+
+				return err
+
+			}
+
+			if b.err != nil {
+
+				return b.err
+
+			}
+
+		}
+		return nil
+
+	case reflect.Struct:
+		return b.unmarshalStruct(v, path)
+
+	default:
+		return MarshalUnsupportedTypeError.field(path)
+
+	}
+
+	var data uint64
+	if t.bits != 0x00 {
+
+		data = b.ReadBitsNext(t.bits)
+		if signed && t.bits < 64 {
+
+			data = uint64(int64(data<<(64-t.bits)) >> (64 - t.bits))
+
+		}
+
+	} else {
+
+		switch t.encoding {
+
+		case "uvarint":
+			data = b.ReadUvarintNext()
+
+		case "varint":
+			data = uint64(b.ReadVarintNext())
+
+		case "uleb128":
+			data = b.ReadULEB128Next()
+
+		case "sleb128":
+			data = uint64(b.ReadSLEB128Next())
+
+		case "", "u", "i":
+			size := t.size
+			if size == 0x00 {
+
+				size = integerSize(v)
+
+			}
+
+			data = b.readUintNext(size, t.little)
+			if (t.encoding == "i" || (t.encoding == "" && signed)) && size < 8 {
+
+				data = uint64(int64(data<<(64-size*8)) >> (64 - size*8))
+
+			}
+
+		default:
+			return MarshalInvalidTagError.field(path)
+
+		}
+
+	}
+
+	// a value that does not fit in the field would be truncated by
+	// SetInt or SetUint, which happens if the tag is wider than it
+	switch {
+
+	case v.Kind() == reflect.Bool:
+		v.SetBool(data != 0x00)
+
+	case signed:
+		if v.OverflowInt(int64(data)) {
+
+			return MarshalInvalidTagError.field(path)
+
+		}
+		v.SetInt(int64(data))
+
+	default:
+		if v.OverflowUint(data) {
+
+			return MarshalInvalidTagError.field(path)
+
+		}
+		v.SetUint(data)
+
+	}
+	return nil
+
+}
+
+// unmarshalString reads the string v from the buffer in the encoding
+// described by t
+func (b *Buffer) unmarshalString(parent, v reflect.Value, t fieldTag, path string) error {
+
+	switch {
+
+	case t.encoding == "cstring":
+		v.SetString(b.ReadCStringNext(-1))
+
+	case t.prefix != 0x00:
+		v.SetString(b.ReadPStringNext(t.stringPrefix()))
+
+	case t.width != 0x00:
+		v.SetString(b.ReadFixedStringNext(t.width, 0x00))
+
+	case t.length != "":
+		n, err := lengthOf(parent, t, path)
+		if err != nil {
+
+			return err
+
+		}
+
+		if !b.holds(n, 8) {
+
+			return MarshalInvalidLengthError.field(path)
+
+		}
+		v.SetString(string(b.ReadBytesNext(n)))
+
+	default:
+		return MarshalInvalidTagError.field(path)
+
+	}
+	return nil
+
+}
+
+// capture runs f with the buffer in sticky mode and returns the first
+// error encountered by either of them. the buffer keeps the error
+// afterwards only if it was in sticky mode beforehand. its mode is
+// restored even if f panics
+func (b *Buffer) capture(f func() error) (err error) {
+
+	if b.err != nil {
+
+		return b.err
+
+	}
+
+	sticky := b.sticky
+	b.sticky = true
+	defer func() {
+
+		b.sticky = sticky
+		if !sticky {
+
+			b.err = nil
+
+		}
+
+	}()
+
+	err = f()
+	if err == nil && b.err != nil {
+
+		err = b.err
+
+	}
+	return
+
+}
+
+/* marshaling functions */
+
+// Marshal writes the exported fields of the struct v, which may also
+// be a pointer to one, to the buffer at its current offset in the order
+// they are declared in. the encoding of each field is inferred from its
+// type and can be changed with a crunch struct tag holding a
+// comma-separated list of the following options:
+//
+//	u8 ... u64, i8 ... i64  store an integer in that many bits
+//	f32, f64                store a float in that many bits
+//	be, le                  use big-endian (the default) or little-endian
+//	uvarint, varint         store an integer as a protobuf-style varint
+//	uleb128, sleb128        store an integer as LEB128
+//	bits=N                  store an integer or a bool in N bits at the
+//	                        current bit offset
+//	len=N, len=Field        use N or the value of the earlier field Field
+//	                        as the length of a slice or a string
+//	cstring                 store a string with a NUL terminator
+//	prefix=u8|u16|u32|uvarint
+//	                        store a string after its length
+//	size=N                  store a string in a NUL-padded N-byte field
+//	-                       skip the field
+//
+// bit fields are written at the bit offset of the buffer while every
+// other field is written at its byte offset, so structs that mix them
+// should be used with a buffer in UnifiedCursor mode. the buffer is
+// switched to sticky mode for the duration of the call and the first
+// error encountered is returned
+func Marshal(b *Buffer, v interface{}) error {
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+
+		rv = rv.Elem()
+
+	}
+
+	if rv.Kind() != reflect.Struct {
+
+		return MarshalUnsupportedTypeError.field(fmt.Sprintf("%T", v))
+
+	}
+
+	return b.capture(func() error {
+
+		return b.marshalStruct(rv, "")
+
+	})
+
+}
+
+// Unmarshal reads the exported fields of the struct pointed to by v
+// from the buffer at its current offset. it is the counterpart of
+// Marshal and accepts the same struct tags. slices and strings with a
+// len option are allocated with the length read into the field it
+// refers to, which has to be declared before them. an integer that was
+// stored with a tag wider than its field and does not fit in it is
+// rejected with MarshalInvalidTagError instead of being truncated
+func Unmarshal(b *Buffer, v interface{}) error {
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+
+		return MarshalUnsupportedTypeError.field(fmt.Sprintf("%T", v))
+
+	}
+
+	return b.capture(func() error {
+
+		return b.unmarshalStruct(rv.Elem(), "")
+
+	})
+
+}
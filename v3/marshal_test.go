@@ -0,0 +1,331 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+type marshalPoint struct {
+	X int16 `crunch:"le"`
+	Y int16 `crunch:"le"`
+}
+
+type marshalMessage struct {
+	Magic   uint32
+	Version uint8  `crunch:"bits=3"`
+	Flag    bool   `crunch:"bits=1"`
+	Delta   int8   `crunch:"bits=4"`
+	Size    uint32 `crunch:"u24,le"`
+	Count   uint16
+	Points  []marshalPoint `crunch:"len=Count"`
+	Raw     []byte         `crunch:"len=2"`
+	Name    string         `crunch:"prefix=u8"`
+	Tag     string         `crunch:"cstring"`
+	Code    string         `crunch:"size=4"`
+	Offset  int64          `crunch:"varint"`
+	Ratio   float32        `crunch:"le"`
+	Pair    [2]uint8
+	Ignored int `crunch:"-"`
+	hidden  int
+}
+
+var marshalBytes = []byte{
+	0xca, 0xfe, 0xba, 0xbe,
+	0b101_1_1110,
+	0x03, 0x02, 0x01,
+	0x00, 0x02,
+	0xff, 0xff, 0x01, 0x00,
+	0x02, 0x00, 0xfe, 0xff,
+	0xaa, 0xbb,
+	0x02, 'h', 'i',
+	'a', 'b', 0x00,
+	'x', 'y', 0x00, 0x00,
+	0x03,
+	0x00, 0x00, 0xc0, 0x3f,
+	0x07, 0x08,
+}
+
+/*
+
+tests
+
+*/
+
+func TestMarshal(t *testing.T) {
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 0)
+	buf.SetCursorMode(UnifiedCursor)
+
+	err := Marshal(buf, &marshalMessage{
+		Magic:   0xcafebabe,
+		Version: 5,
+		Flag:    true,
+		Delta:   -2,
+		Size:    0x010203,
+		Count:   2,
+		Points:  []marshalPoint{{-1, 1}, {2, -2}},
+		Raw:     []byte{0xaa, 0xbb},
+		Name:    "hi",
+		Tag:     "ab",
+		Code:    "xy",
+		Offset:  -2,
+		Ratio:   1.5,
+		Pair:    [2]uint8{0x07, 0x08},
+		Ignored: 1,
+	})
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if !cmp.Equal(marshalBytes, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), marshalBytes)
+
+	}
+
+}
+
+func TestUnmarshal(t *testing.T) {
+
+	var expected = marshalMessage{
+		Magic:   0xcafebabe,
+		Version: 5,
+		Flag:    true,
+		Delta:   -2,
+		Size:    0x010203,
+		Count:   2,
+		Points:  []marshalPoint{{-1, 1}, {2, -2}},
+		Raw:     []byte{0xaa, 0xbb},
+		Name:    "hi",
+		Tag:     "ab",
+		Code:    "xy",
+		Offset:  -2,
+		Ratio:   1.5,
+		Pair:    [2]uint8{0x07, 0x08},
+	}
+
+	buf := NewBuffer(marshalBytes)
+	buf.SetCursorMode(UnifiedCursor)
+
+	var out marshalMessage
+	if err := Unmarshal(buf, &out); err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if !cmp.Equal(expected, out, cmp.AllowUnexported(marshalMessage{})) {
+
+		t.Fatalf("expected struct does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+	if buf.ByteOffset() != buf.ByteCapacity() {
+
+		t.Fatalf("incorrect offset: %d", buf.ByteOffset())
+
+	}
+
+}
+
+func TestMarshalErrors(t *testing.T) {
+
+	type badTag struct {
+		A uint8 `crunch:"u12"`
+	}
+	type mismatch struct {
+		N uint8
+		S []uint16 `crunch:"len=N"`
+	}
+	type badLength struct {
+		S string `crunch:"len=Missing"`
+	}
+	type unsupported struct {
+		M map[string]int
+	}
+
+	buf := NewBuffer(make([]byte, 4))
+
+	for i, c := range []struct {
+		err      error
+		expected Error
+	}{
+		{Marshal(buf, &badTag{}), MarshalInvalidTagError},
+		{Marshal(buf, &mismatch{N: 2}), MarshalLengthMismatchError},
+		{Marshal(buf, &badLength{}), MarshalInvalidLengthError},
+		{Marshal(buf, &unsupported{}), MarshalUnsupportedTypeError},
+		{Marshal(buf, 5), MarshalUnsupportedTypeError},
+		{Unmarshal(buf, badTag{}), MarshalUnsupportedTypeError},
+		{Marshal(buf, &marshalMessage{}), BufferOverwriteError},
+		{Unmarshal(buf, &marshalMessage{}), BufferOverreadError},
+	} {
+
+		if !errors.Is(c.err, c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, c.err, c.expected)
+
+		}
+
+	}
+
+	// the buffer does not keep the error unless it is sticky
+	if buf.Err() != nil {
+
+		t.Fatalf("unexpected error left in the buffer: %v", buf.Err())
+
+	}
+
+	var e Error
+	if err := Marshal(buf, &mismatch{N: 2}); !errors.As(err, &e) || e.Op() != "S" {
+
+		t.Fatalf("expected the error to name the field (got %v)", err)
+
+	}
+
+}
+
+func TestUnmarshalOverflow(t *testing.T) {
+
+	type narrowInt struct {
+		A int8 `crunch:"u64"`
+	}
+	type narrowUint struct {
+		A uint8 `crunch:"uvarint"`
+	}
+	type signedUint struct {
+		A uint16 `crunch:"i16"`
+	}
+
+	for i, c := range []struct {
+		bytes []byte
+		v     interface{}
+	}{
+		{[]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80}, &narrowInt{}},
+		{[]byte{0x80, 0x02}, &narrowUint{}},
+		{[]byte{0xff, 0xff}, &signedUint{}},
+	} {
+
+		if err := Unmarshal(NewBuffer(c.bytes), c.v); !errors.Is(err, MarshalInvalidTagError) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, err, MarshalInvalidTagError)
+
+		}
+
+	}
+
+	// values that fit in the field are still accepted
+	var v narrowInt
+	if err := Unmarshal(NewBuffer([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x80}), &v); err != nil || v.A != -128 {
+
+		t.Fatalf("expected value does not match the one gotten (got %d and %v, expected %d)", v.A, err, -128)
+
+	}
+
+}
+
+func TestMarshalCapturePanic(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00})
+
+	// the buffer leaves sticky mode even if the walk panics
+	func() {
+
+		defer func() { _ = recover() }()
+		_ = buf.capture(func() error {
+
+			buf.ReadBytes(0x01, 1)
+			panic("failure")
+
+		})
+
+	}()
+
+	if buf.sticky || buf.Err() != nil {
+
+		t.Fatalf("the buffer was left in sticky mode (sticky %v, error %v)", buf.sticky, buf.Err())
+
+	}
+
+}
+
+func TestUnmarshalHostileLengths(t *testing.T) {
+
+	type bytesField struct {
+		Count int64
+		Data  []byte `crunch:"len=Count"`
+	}
+	type wideField struct {
+		Count uint32
+		Data  []uint64 `crunch:"len=Count"`
+	}
+	type stringField struct {
+		Count uint64
+		Data  string `crunch:"len=Count"`
+	}
+	type emptyField struct {
+		Count uint32
+		Data  []struct{} `crunch:"len=Count"`
+	}
+	type bitField struct {
+		Count uint8
+		Data  []bool `crunch:"len=Count,bits=1"`
+	}
+
+	for i, c := range []struct {
+		bytes []byte
+		v     interface{}
+	}{
+		{[]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, &bytesField{}},
+		{[]byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, &bytesField{}},
+		{[]byte{0x10, 0x00, 0x00, 0x00, 0x00, 0x00}, &wideField{}},
+		{[]byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, &wideField{}},
+		{[]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xf0, 'a'}, &stringField{}},
+		{[]byte{0xff, 0xff, 0xff, 0xff}, &emptyField{}},
+		{[]byte{0x11, 0xff, 0xff}, &bitField{}},
+	} {
+
+		buf := NewBuffer(c.bytes)
+		buf.SetCursorMode(UnifiedCursor)
+
+		if err := Unmarshal(buf, c.v); !errors.Is(err, MarshalInvalidLengthError) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, err, MarshalInvalidLengthError)
+
+		}
+
+	}
+
+	// lengths that fit are still accepted
+	buf := NewBuffer([]byte{0x10, 0xff, 0xff})
+	buf.SetCursorMode(UnifiedCursor)
+
+	var v bitField
+	if err := Unmarshal(buf, &v); err != nil || len(v.Data) != 16 {
+
+		t.Fatalf("unexpected result (got %d values and %v)", len(v.Data), err)
+
+	}
+
+}
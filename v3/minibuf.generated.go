@@ -22,6 +22,11 @@ type MiniBuffer struct {
 	cap  int64
 	boff int64
 	bcap int64
+
+	grow bool
+	gmax int64
+
+	order BitOrder
 }
 
 // NewMiniBuffer initilaizes a new MiniBuffer with the provided byte
@@ -64,13 +69,33 @@ func NewMiniBuffer(out **MiniBuffer, slices ...[]byte) {
 
 }
 
+/* internal use methods */
+
+// reserve grows the buffer so that it is at least n bytes long if the
+// buffer is allowed to grow automatically
+func (b *MiniBuffer) reserve(n int64) {
+
+	if !b.grow || (b.gmax > 0x00 && n > b.gmax) {
+
+		return
+
+	}
+
+	if n > b.cap {
+
+		b.Grow(n - b.cap)
+
+	}
+
+}
+
 /* bitfield methods */
 
 // ReadBit stores the bit located at the specified offset without
 // modifying the internal offset value in out
 func (b *MiniBuffer) ReadBit(out *byte, off int64) {
 
-	*out = (b.buf[off/8] >> (7 - uint64(off%8))) & 1
+	*out = (b.buf[off/8] >> b.order.shift(off)) & 1
 
 }
 
@@ -87,22 +112,7 @@ func (b *MiniBuffer) ReadBitNext(out *byte) {
 // modifying the internal offset value in out
 func (b *MiniBuffer) ReadBits(out *uint64, off, n int64) {
 
-	var (
-		bout byte
-
-		i = int64(0)
-	)
-	{
-	read_loop:
-		b.ReadBit(&bout, off+i)
-		*out = (*out << uint64(1)) | uint64(bout)
-		i++
-		if i < n {
-
-			goto read_loop
-
-		}
-	}
+	*out = readBits(b.buf, off, n, b.order)
 
 }
 
@@ -119,7 +129,13 @@ func (b *MiniBuffer) ReadBitsNext(out *uint64, n int64) {
 // modifying the internal offset value
 func (b *MiniBuffer) SetBit(off int64) {
 
-	b.buf[off/8] |= (1 << uint(7-(off%8)))
+	if b.grow && off > (b.bcap-1) {
+
+		b.reserve(off/8 + 1)
+
+	}
+
+	b.buf[off/8] |= (1 << b.order.shift(off))
 
 }
 
@@ -136,7 +152,13 @@ func (b *MiniBuffer) SetBitNext() {
 // modifying the internal offset value
 func (b *MiniBuffer) ClearBit(off int64) {
 
-	b.buf[off/8] &= ^(1 << uint(7-(off%8)))
+	if b.grow && off > (b.bcap-1) {
+
+		b.reserve(off/8 + 1)
+
+	}
+
+	b.buf[off/8] &= ^(1 << b.order.shift(off))
 
 }
 
@@ -153,27 +175,14 @@ func (b *MiniBuffer) ClearBitNext() {
 // modifying the internal offset value
 func (b *MiniBuffer) SetBits(off int64, data uint64, n int64) {
 
-	i := int64(0)
-
-	{
-	write_loop:
-		if byte((data>>uint64(n-i-1))&1) == 0 {
-
-			b.ClearBit(off + i)
-
-		} else {
-
-			b.SetBit(off + i)
-
-		}
-		i++
-		if i < n {
+	if b.grow && (off+n) > b.bcap {
 
-			goto write_loop
+		b.reserve((off + n + 7) / 8)
 
-		}
 	}
 
+	writeBits(b.buf, off, data, n, b.order)
+
 }
 
 // SetBitsNext sets the next n bits from the current offset and moves
@@ -189,7 +198,13 @@ func (b *MiniBuffer) SetBitsNext(data uint64, n int64) {
 // modifying the internal offset value
 func (b *MiniBuffer) FlipBit(off int64) {
 
-	b.buf[off/8] ^= (1 << uint(7-(off%8)))
+	if b.grow && off > (b.bcap-1) {
+
+		b.reserve(off/8 + 1)
+
+	}
+
+	b.buf[off/8] ^= (1 << b.order.shift(off))
 
 }
 
@@ -306,6 +321,12 @@ func (b *MiniBuffer) AlignBit() {
 // without modifying the internal offset value
 func (b *MiniBuffer) WriteBytes(off int64, data []byte) {
 
+	if b.grow && (off+int64(len(data))) > b.cap {
+
+		b.reserve(off + int64(len(data)))
+
+	}
+
 	copy(b.buf[off:], data)
 
 }
@@ -323,6 +344,9 @@ func (b *MiniBuffer) WriteBytesNext(data []byte) {
 // specified offset in little-endian without modifying the internal
 // offset value
 func (b *MiniBuffer) WriteU16LE(off int64, data []uint16) {
+	if b.grow && (off+int64(len(data))*2) > b.cap {
+		b.reserve(off + int64(len(data))*2)
+	}
 	i := 0
 	n := len(data)
 	{
@@ -344,10 +368,46 @@ func (b *MiniBuffer) WriteU16LENext(data []uint16) {
 	b.SeekByte(int64(len(data))*2, true)
 }
 
+// PutU16LE writes a uint16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU16LE(off int64, data uint16) {
+	if b.grow && (off+2) > b.cap {
+		b.reserve(off + 2)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+}
+
+// PutU16LENext writes a uint16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU16LENext(data uint16) {
+	b.PutU16LE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutU16LEBits writes a uint16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU16LEBits(off int64, data uint16) {
+	if b.grow && (off+16) > b.bcap {
+		b.reserve((off + 16 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, true)
+}
+
+// PutU16LEBitsNext writes a uint16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU16LEBitsNext(data uint16) {
+	b.PutU16LEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
 // WriteU16BE writes a slice of uint16s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
 func (b *MiniBuffer) WriteU16BE(off int64, data []uint16) {
+	if b.grow && (off+int64(len(data))*2) > b.cap {
+		b.reserve(off + int64(len(data))*2)
+	}
 	i := 0
 	n := len(data)
 	{
@@ -369,10 +429,172 @@ func (b *MiniBuffer) WriteU16BENext(data []uint16) {
 	b.SeekByte(int64(len(data))*2, true)
 }
 
+// PutU16BE writes a uint16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU16BE(off int64, data uint16) {
+	if b.grow && (off+2) > b.cap {
+		b.reserve(off + 2)
+	}
+	b.buf[off] = byte(data >> 8)
+	b.buf[off+1] = byte(data)
+}
+
+// PutU16BENext writes a uint16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU16BENext(data uint16) {
+	b.PutU16BE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutU16BEBits writes a uint16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU16BEBits(off int64, data uint16) {
+	if b.grow && (off+16) > b.bcap {
+		b.reserve((off + 16 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, false)
+}
+
+// PutU16BEBitsNext writes a uint16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU16BEBitsNext(data uint16) {
+	b.PutU16BEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteU24LE writes a slice of uint32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteU24LE(off int64, data []uint32) {
+	if b.grow && (off+int64(len(data))*3) > b.cap {
+		b.reserve(off + int64(len(data))*3)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*3)] = byte(data[i])
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i] >> 16)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU24LENext writes a slice of uint32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteU24LENext(data []uint32) {
+	b.WriteU24LE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutU24LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU24LE(off int64, data uint32) {
+	if b.grow && (off+3) > b.cap {
+		b.reserve(off + 3)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+}
+
+// PutU24LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU24LENext(data uint32) {
+	b.PutU24LE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutU24LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU24LEBits(off int64, data uint32) {
+	if b.grow && (off+24) > b.bcap {
+		b.reserve((off + 24 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, true)
+}
+
+// PutU24LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU24LEBitsNext(data uint32) {
+	b.PutU24LEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteU24BE writes a slice of uint32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteU24BE(off int64, data []uint32) {
+	if b.grow && (off+int64(len(data))*3) > b.cap {
+		b.reserve(off + int64(len(data))*3)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*3)] = byte(data[i] >> 16)
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteU24BENext writes a slice of uint32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteU24BENext(data []uint32) {
+	b.WriteU24BE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
+}
+
+// PutU24BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU24BE(off int64, data uint32) {
+	if b.grow && (off+3) > b.cap {
+		b.reserve(off + 3)
+	}
+	b.buf[off] = byte(data >> 16)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data)
+}
+
+// PutU24BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU24BENext(data uint32) {
+	b.PutU24BE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutU24BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU24BEBits(off int64, data uint32) {
+	if b.grow && (off+24) > b.bcap {
+		b.reserve((off + 24 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, false)
+}
+
+// PutU24BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU24BEBitsNext(data uint32) {
+	b.PutU24BEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
 // WriteU32LE writes a slice of uint32s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
 func (b *MiniBuffer) WriteU32LE(off int64, data []uint32) {
+	if b.grow && (off+int64(len(data))*4) > b.cap {
+		b.reserve(off + int64(len(data))*4)
+	}
 	i := 0
 	n := len(data)
 	{
@@ -396,10 +618,48 @@ func (b *MiniBuffer) WriteU32LENext(data []uint32) {
 	b.SeekByte(int64(len(data))*4, true)
 }
 
+// PutU32LE writes a uint32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU32LE(off int64, data uint32) {
+	if b.grow && (off+4) > b.cap {
+		b.reserve(off + 4)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+}
+
+// PutU32LENext writes a uint32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU32LENext(data uint32) {
+	b.PutU32LE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutU32LEBits writes a uint32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU32LEBits(off int64, data uint32) {
+	if b.grow && (off+32) > b.bcap {
+		b.reserve((off + 32 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, true)
+}
+
+// PutU32LEBitsNext writes a uint32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU32LEBitsNext(data uint32) {
+	b.PutU32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
 // WriteU32BE writes a slice of uint32s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
 func (b *MiniBuffer) WriteU32BE(off int64, data []uint32) {
+	if b.grow && (off+int64(len(data))*4) > b.cap {
+		b.reserve(off + int64(len(data))*4)
+	}
 	i := 0
 	n := len(data)
 	{
@@ -423,22 +683,57 @@ func (b *MiniBuffer) WriteU32BENext(data []uint32) {
 	b.SeekByte(int64(len(data))*4, true)
 }
 
-// WriteU64LE writes a slice of uint64s to the buffer at the
+// PutU32BE writes a uint32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU32BE(off int64, data uint32) {
+	if b.grow && (off+4) > b.cap {
+		b.reserve(off + 4)
+	}
+	b.buf[off] = byte(data >> 24)
+	b.buf[off+1] = byte(data >> 16)
+	b.buf[off+2] = byte(data >> 8)
+	b.buf[off+3] = byte(data)
+}
+
+// PutU32BENext writes a uint32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU32BENext(data uint32) {
+	b.PutU32BE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutU32BEBits writes a uint32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU32BEBits(off int64, data uint32) {
+	if b.grow && (off+32) > b.bcap {
+		b.reserve((off + 32 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, false)
+}
+
+// PutU32BEBitsNext writes a uint32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU32BEBitsNext(data uint32) {
+	b.PutU32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteU40LE writes a slice of uint64s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteU64LE(off int64, data []uint64) {
+func (b *MiniBuffer) WriteU40LE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*5) > b.cap {
+		b.reserve(off + int64(len(data))*5)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*8)] = byte(data[i])
-		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 8)
-		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 16)
-		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 24)
-		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 32)
-		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 40)
-		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 48)
-		b.buf[off+int64(7+(i*8))] = byte(data[i] >> 56)
+		b.buf[off+int64(i*5)] = byte(data[i])
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*5))] = byte(data[i] >> 32)
 		i++
 		if i < n {
 			goto write_loop
@@ -446,30 +741,66 @@ func (b *MiniBuffer) WriteU64LE(off int64, data []uint64) {
 	}
 }
 
-// WriteU64LENext writes a slice of uint64s to the buffer at the
+// WriteU40LENext writes a slice of uint64s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteU64LENext(data []uint64) {
-	b.WriteU64LE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+func (b *MiniBuffer) WriteU40LENext(data []uint64) {
+	b.WriteU40LE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
 }
 
-// WriteU64BE writes a slice of uint64s to the buffer at the
+// PutU40LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU40LE(off int64, data uint64) {
+	if b.grow && (off+5) > b.cap {
+		b.reserve(off + 5)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+}
+
+// PutU40LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU40LENext(data uint64) {
+	b.PutU40LE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutU40LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU40LEBits(off int64, data uint64) {
+	if b.grow && (off+40) > b.bcap {
+		b.reserve((off + 40 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, true)
+}
+
+// PutU40LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU40LEBitsNext(data uint64) {
+	b.PutU40LEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteU40BE writes a slice of uint64s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteU64BE(off int64, data []uint64) {
+func (b *MiniBuffer) WriteU40BE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*5) > b.cap {
+		b.reserve(off + int64(len(data))*5)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*8)] = byte(data[i] >> 56)
-		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 48)
-		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 40)
-		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 32)
-		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 24)
-		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 16)
-		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 8)
-		b.buf[off+int64(7+(i*8))] = byte(data[i])
+		b.buf[off+int64(i*5)] = byte(data[i] >> 32)
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(4+(i*5))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -477,24 +808,67 @@ func (b *MiniBuffer) WriteU64BE(off int64, data []uint64) {
 	}
 }
 
-// WriteU64BENext writes a slice of uint64s to the buffer at the
+// WriteU40BENext writes a slice of uint64s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteU64BENext(data []uint64) {
-	b.WriteU64BE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+func (b *MiniBuffer) WriteU40BENext(data []uint64) {
+	b.WriteU40BE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
 }
 
-// WriteI16LE writes a slice of int16s to the buffer at the
+// PutU40BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU40BE(off int64, data uint64) {
+	if b.grow && (off+5) > b.cap {
+		b.reserve(off + 5)
+	}
+	b.buf[off] = byte(data >> 32)
+	b.buf[off+1] = byte(data >> 24)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 8)
+	b.buf[off+4] = byte(data)
+}
+
+// PutU40BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU40BENext(data uint64) {
+	b.PutU40BE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutU40BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU40BEBits(off int64, data uint64) {
+	if b.grow && (off+40) > b.bcap {
+		b.reserve((off + 40 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, false)
+}
+
+// PutU40BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU40BEBitsNext(data uint64) {
+	b.PutU40BEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteU48LE writes a slice of uint64s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteI16LE(off int64, data []int16) {
+func (b *MiniBuffer) WriteU48LE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*6) > b.cap {
+		b.reserve(off + int64(len(data))*6)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*2)] = byte(data[i])
-		b.buf[off+int64(1+(i*2))] = byte(data[i] >> 8)
+		b.buf[off+int64(i*6)] = byte(data[i])
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*6))] = byte(data[i] >> 40)
 		i++
 		if i < n {
 			goto write_loop
@@ -502,24 +876,68 @@ func (b *MiniBuffer) WriteI16LE(off int64, data []int16) {
 	}
 }
 
-// WriteI16LENext writes a slice of int16s to the buffer at the
+// WriteU48LENext writes a slice of uint64s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteI16LENext(data []int16) {
-	b.WriteI16LE(b.off, data)
-	b.SeekByte(int64(len(data))*2, true)
+func (b *MiniBuffer) WriteU48LENext(data []uint64) {
+	b.WriteU48LE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
 }
 
-// WriteI16BE writes a slice of int16s to the buffer at the
+// PutU48LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU48LE(off int64, data uint64) {
+	if b.grow && (off+6) > b.cap {
+		b.reserve(off + 6)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+}
+
+// PutU48LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU48LENext(data uint64) {
+	b.PutU48LE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutU48LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU48LEBits(off int64, data uint64) {
+	if b.grow && (off+48) > b.bcap {
+		b.reserve((off + 48 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, true)
+}
+
+// PutU48LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU48LEBitsNext(data uint64) {
+	b.PutU48LEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteU48BE writes a slice of uint64s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteI16BE(off int64, data []int16) {
+func (b *MiniBuffer) WriteU48BE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*6) > b.cap {
+		b.reserve(off + int64(len(data))*6)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*2)] = byte(data[i] >> 8)
-		b.buf[off+int64(1+(i*2))] = byte(data[i])
+		b.buf[off+int64(i*6)] = byte(data[i] >> 40)
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(5+(i*6))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -527,26 +945,69 @@ func (b *MiniBuffer) WriteI16BE(off int64, data []int16) {
 	}
 }
 
-// WriteI16BENext writes a slice of int16s to the buffer at the
+// WriteU48BENext writes a slice of uint64s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteI16BENext(data []int16) {
-	b.WriteI16BE(b.off, data)
-	b.SeekByte(int64(len(data))*2, true)
+func (b *MiniBuffer) WriteU48BENext(data []uint64) {
+	b.WriteU48BE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
 }
 
-// WriteI32LE writes a slice of int32s to the buffer at the
+// PutU48BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU48BE(off int64, data uint64) {
+	if b.grow && (off+6) > b.cap {
+		b.reserve(off + 6)
+	}
+	b.buf[off] = byte(data >> 40)
+	b.buf[off+1] = byte(data >> 32)
+	b.buf[off+2] = byte(data >> 24)
+	b.buf[off+3] = byte(data >> 16)
+	b.buf[off+4] = byte(data >> 8)
+	b.buf[off+5] = byte(data)
+}
+
+// PutU48BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU48BENext(data uint64) {
+	b.PutU48BE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutU48BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU48BEBits(off int64, data uint64) {
+	if b.grow && (off+48) > b.bcap {
+		b.reserve((off + 48 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, false)
+}
+
+// PutU48BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU48BEBitsNext(data uint64) {
+	b.PutU48BEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteU56LE writes a slice of uint64s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteI32LE(off int64, data []int32) {
+func (b *MiniBuffer) WriteU56LE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*7) > b.cap {
+		b.reserve(off + int64(len(data))*7)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*4)] = byte(data[i])
-		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 8)
-		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 16)
-		b.buf[off+int64(3+(i*4))] = byte(data[i] >> 24)
+		b.buf[off+int64(i*7)] = byte(data[i])
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*7))] = byte(data[i] >> 48)
 		i++
 		if i < n {
 			goto write_loop
@@ -554,26 +1015,70 @@ func (b *MiniBuffer) WriteI32LE(off int64, data []int32) {
 	}
 }
 
-// WriteI32LENext writes a slice of int32s to the buffer at the
+// WriteU56LENext writes a slice of uint64s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteI32LENext(data []int32) {
-	b.WriteI32LE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+func (b *MiniBuffer) WriteU56LENext(data []uint64) {
+	b.WriteU56LE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
 }
 
-// WriteI32BE writes a slice of int32s to the buffer at the
+// PutU56LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU56LE(off int64, data uint64) {
+	if b.grow && (off+7) > b.cap {
+		b.reserve(off + 7)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+}
+
+// PutU56LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU56LENext(data uint64) {
+	b.PutU56LE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutU56LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU56LEBits(off int64, data uint64) {
+	if b.grow && (off+56) > b.bcap {
+		b.reserve((off + 56 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, true)
+}
+
+// PutU56LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU56LEBitsNext(data uint64) {
+	b.PutU56LEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteU56BE writes a slice of uint64s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteI32BE(off int64, data []int32) {
+func (b *MiniBuffer) WriteU56BE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*7) > b.cap {
+		b.reserve(off + int64(len(data))*7)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*4)] = byte(data[i] >> 24)
-		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 16)
-		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 8)
-		b.buf[off+int64(3+(i*4))] = byte(data[i])
+		b.buf[off+int64(i*7)] = byte(data[i] >> 48)
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(6+(i*7))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -581,18 +1086,59 @@ func (b *MiniBuffer) WriteI32BE(off int64, data []int32) {
 	}
 }
 
-// WriteI32BENext writes a slice of int32s to the buffer at the
+// WriteU56BENext writes a slice of uint64s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteI32BENext(data []int32) {
-	b.WriteI32BE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+func (b *MiniBuffer) WriteU56BENext(data []uint64) {
+	b.WriteU56BE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
 }
 
-// WriteI64LE writes a slice of int64s to the buffer at the
+// PutU56BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU56BE(off int64, data uint64) {
+	if b.grow && (off+7) > b.cap {
+		b.reserve(off + 7)
+	}
+	b.buf[off] = byte(data >> 48)
+	b.buf[off+1] = byte(data >> 40)
+	b.buf[off+2] = byte(data >> 32)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 16)
+	b.buf[off+5] = byte(data >> 8)
+	b.buf[off+6] = byte(data)
+}
+
+// PutU56BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU56BENext(data uint64) {
+	b.PutU56BE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutU56BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU56BEBits(off int64, data uint64) {
+	if b.grow && (off+56) > b.bcap {
+		b.reserve((off + 56 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, false)
+}
+
+// PutU56BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU56BEBitsNext(data uint64) {
+	b.PutU56BEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteU64LE writes a slice of uint64s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteI64LE(off int64, data []int64) {
+func (b *MiniBuffer) WriteU64LE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*8) > b.cap {
+		b.reserve(off + int64(len(data))*8)
+	}
 	i := 0
 	n := len(data)
 	{
@@ -612,18 +1158,60 @@ func (b *MiniBuffer) WriteI64LE(off int64, data []int64) {
 	}
 }
 
-// WriteI64LENext writes a slice of int64s to the buffer at the
+// WriteU64LENext writes a slice of uint64s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteI64LENext(data []int64) {
-	b.WriteI64LE(b.off, data)
+func (b *MiniBuffer) WriteU64LENext(data []uint64) {
+	b.WriteU64LE(b.off, data)
 	b.SeekByte(int64(len(data))*8, true)
 }
 
-// WriteI64BE writes a slice of int64s to the buffer at the
+// PutU64LE writes a uint64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU64LE(off int64, data uint64) {
+	if b.grow && (off+8) > b.cap {
+		b.reserve(off + 8)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+	b.buf[off+7] = byte(data >> 56)
+}
+
+// PutU64LENext writes a uint64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU64LENext(data uint64) {
+	b.PutU64LE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutU64LEBits writes a uint64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU64LEBits(off int64, data uint64) {
+	if b.grow && (off+64) > b.bcap {
+		b.reserve((off + 64 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, true)
+}
+
+// PutU64LEBitsNext writes a uint64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU64LEBitsNext(data uint64) {
+	b.PutU64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteU64BE writes a slice of uint64s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteI64BE(off int64, data []int64) {
+func (b *MiniBuffer) WriteU64BE(off int64, data []uint64) {
+	if b.grow && (off+int64(len(data))*8) > b.cap {
+		b.reserve(off + int64(len(data))*8)
+	}
 	i := 0
 	n := len(data)
 	{
@@ -643,26 +1231,66 @@ func (b *MiniBuffer) WriteI64BE(off int64, data []int64) {
 	}
 }
 
-// WriteI64BENext writes a slice of int64s to the buffer at the
+// WriteU64BENext writes a slice of uint64s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteI64BENext(data []int64) {
-	b.WriteI64BE(b.off, data)
+func (b *MiniBuffer) WriteU64BENext(data []uint64) {
+	b.WriteU64BE(b.off, data)
 	b.SeekByte(int64(len(data))*8, true)
 }
 
-// WriteF32LE writes a slice of float32s to the buffer at the
+// PutU64BE writes a uint64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutU64BE(off int64, data uint64) {
+	if b.grow && (off+8) > b.cap {
+		b.reserve(off + 8)
+	}
+	b.buf[off] = byte(data >> 56)
+	b.buf[off+1] = byte(data >> 48)
+	b.buf[off+2] = byte(data >> 40)
+	b.buf[off+3] = byte(data >> 32)
+	b.buf[off+4] = byte(data >> 24)
+	b.buf[off+5] = byte(data >> 16)
+	b.buf[off+6] = byte(data >> 8)
+	b.buf[off+7] = byte(data)
+}
+
+// PutU64BENext writes a uint64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutU64BENext(data uint64) {
+	b.PutU64BE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutU64BEBits writes a uint64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutU64BEBits(off int64, data uint64) {
+	if b.grow && (off+64) > b.bcap {
+		b.reserve((off + 64 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, false)
+}
+
+// PutU64BEBitsNext writes a uint64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutU64BEBitsNext(data uint64) {
+	b.PutU64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteI16LE writes a slice of int16s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteF32LE(off int64, data []float32) {
+func (b *MiniBuffer) WriteI16LE(off int64, data []int16) {
+	if b.grow && (off+int64(len(data))*2) > b.cap {
+		b.reserve(off + int64(len(data))*2)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
-		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(i*2)] = byte(data[i])
+		b.buf[off+int64(1+(i*2))] = byte(data[i] >> 8)
 		i++
 		if i < n {
 			goto write_loop
@@ -670,26 +1298,60 @@ func (b *MiniBuffer) WriteF32LE(off int64, data []float32) {
 	}
 }
 
-// WriteF32LENext writes a slice of float32s to the buffer at the
+// WriteI16LENext writes a slice of int16s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteF32LENext(data []float32) {
-	b.WriteF32LE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+func (b *MiniBuffer) WriteI16LENext(data []int16) {
+	b.WriteI16LE(b.off, data)
+	b.SeekByte(int64(len(data))*2, true)
 }
 
-// WriteF32BE writes a slice of float32s to the buffer at the
+// PutI16LE writes an int16 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI16LE(off int64, data int16) {
+	if b.grow && (off+2) > b.cap {
+		b.reserve(off + 2)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+}
+
+// PutI16LENext writes an int16 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI16LENext(data int16) {
+	b.PutI16LE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutI16LEBits writes an int16 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI16LEBits(off int64, data int16) {
+	if b.grow && (off+16) > b.bcap {
+		b.reserve((off + 16 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, true)
+}
+
+// PutI16LEBitsNext writes an int16 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI16LEBitsNext(data int16) {
+	b.PutI16LEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteI16BE writes a slice of int16s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteF32BE(off int64, data []float32) {
+func (b *MiniBuffer) WriteI16BE(off int64, data []int16) {
+	if b.grow && (off+int64(len(data))*2) > b.cap {
+		b.reserve(off + int64(len(data))*2)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
-		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(i*2)] = byte(data[i] >> 8)
+		b.buf[off+int64(1+(i*2))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -697,30 +1359,61 @@ func (b *MiniBuffer) WriteF32BE(off int64, data []float32) {
 	}
 }
 
-// WriteF32BENext writes a slice of float32s to the buffer at the
+// WriteI16BENext writes a slice of int16s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteF32BENext(data []float32) {
-	b.WriteF32BE(b.off, data)
-	b.SeekByte(int64(len(data))*4, true)
+func (b *MiniBuffer) WriteI16BENext(data []int16) {
+	b.WriteI16BE(b.off, data)
+	b.SeekByte(int64(len(data))*2, true)
 }
 
-// WriteF64LE writes a slice of float64s to the buffer at the
+// PutI16BE writes an int16 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI16BE(off int64, data int16) {
+	if b.grow && (off+2) > b.cap {
+		b.reserve(off + 2)
+	}
+	b.buf[off] = byte(data >> 8)
+	b.buf[off+1] = byte(data)
+}
+
+// PutI16BENext writes an int16 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI16BENext(data int16) {
+	b.PutI16BE(b.off, data)
+	b.SeekByte(2, true)
+}
+
+// PutI16BEBits writes an int16 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI16BEBits(off int64, data int16) {
+	if b.grow && (off+16) > b.bcap {
+		b.reserve((off + 16 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 2, b.order, false)
+}
+
+// PutI16BEBitsNext writes an int16 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI16BEBitsNext(data int16) {
+	b.PutI16BEBits(b.boff, data)
+	b.SeekBit(16, true)
+}
+
+// WriteI24LE writes a slice of int32s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteF64LE(off int64, data []float64) {
+func (b *MiniBuffer) WriteI24LE(off int64, data []int32) {
+	if b.grow && (off+int64(len(data))*3) > b.cap {
+		b.reserve(off + int64(len(data))*3)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
-		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
-		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
-		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
-		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
-		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
+		b.buf[off+int64(i*3)] = byte(data[i])
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i] >> 16)
 		i++
 		if i < n {
 			goto write_loop
@@ -728,30 +1421,62 @@ func (b *MiniBuffer) WriteF64LE(off int64, data []float64) {
 	}
 }
 
-// WriteF64LENext writes a slice of float64s to the buffer at the
+// WriteI24LENext writes a slice of int32s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteF64LENext(data []float64) {
-	b.WriteF64LE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+func (b *MiniBuffer) WriteI24LENext(data []int32) {
+	b.WriteI24LE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
 }
 
-// WriteF64BE writes a slice of float64s to the buffer at the
+// PutI24LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI24LE(off int64, data int32) {
+	if b.grow && (off+3) > b.cap {
+		b.reserve(off + 3)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+}
+
+// PutI24LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI24LENext(data int32) {
+	b.PutI24LE(b.off, data)
+	b.SeekByte(3, true)
+}
+
+// PutI24LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI24LEBits(off int64, data int32) {
+	if b.grow && (off+24) > b.bcap {
+		b.reserve((off + 24 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, true)
+}
+
+// PutI24LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI24LEBitsNext(data int32) {
+	b.PutI24LEBits(b.boff, data)
+	b.SeekBit(24, true)
+}
+
+// WriteI24BE writes a slice of int32s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) WriteF64BE(off int64, data []float64) {
+func (b *MiniBuffer) WriteI24BE(off int64, data []int32) {
+	if b.grow && (off+int64(len(data))*3) > b.cap {
+		b.reserve(off + int64(len(data))*3)
+	}
 	i := 0
 	n := len(data)
 	{
 	write_loop:
-		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
-		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
-		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
-		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
-		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
-		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
-		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
-		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(i*3)] = byte(data[i] >> 16)
+		b.buf[off+int64(1+(i*3))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*3))] = byte(data[i])
 		i++
 		if i < n {
 			goto write_loop
@@ -759,85 +1484,2221 @@ func (b *MiniBuffer) WriteF64BE(off int64, data []float64) {
 	}
 }
 
-// WriteF64BENext writes a slice of float64s to the buffer at the
+// WriteI24BENext writes a slice of int32s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) WriteF64BENext(data []float64) {
-	b.WriteF64BE(b.off, data)
-	b.SeekByte(int64(len(data))*8, true)
+func (b *MiniBuffer) WriteI24BENext(data []int32) {
+	b.WriteI24BE(b.off, data)
+	b.SeekByte(int64(len(data))*3, true)
 }
 
-// ReadBytes stores the next n bytes from the specified offset
-// without modifying the internal offset value in out
-func (b *MiniBuffer) ReadBytes(out *[]byte, off, n int64) {
-
-	*out = b.buf[off : off+n]
-
+// PutI24BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI24BE(off int64, data int32) {
+	if b.grow && (off+3) > b.cap {
+		b.reserve(off + 3)
+	}
+	b.buf[off] = byte(data >> 16)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data)
 }
 
-// ReadBytesNext stores the next n bytes from the current offset and
-// moves the offset forward the amount of bytes read in out
-func (b *MiniBuffer) ReadBytesNext(out *[]byte, n int64) {
+// PutI24BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI24BENext(data int32) {
+	b.PutI24BE(b.off, data)
+	b.SeekByte(3, true)
+}
 
-	b.ReadBytes(out, b.off, n)
-	b.SeekByte(n, true)
+// PutI24BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI24BEBits(off int64, data int32) {
+	if b.grow && (off+24) > b.bcap {
+		b.reserve((off + 24 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 3, b.order, false)
+}
 
+// PutI24BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI24BEBitsNext(data int32) {
+	b.PutI24BEBits(b.boff, data)
+	b.SeekBit(24, true)
 }
 
-// ReadU16LE reads a slice of uint16s from the buffer at the
+// WriteI32LE writes a slice of int32s to the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadU16LE(out *[]uint16, off, n int64) {
-	i := int64(0)
+func (b *MiniBuffer) WriteI32LE(off int64, data []int32) {
+	if b.grow && (off+int64(len(data))*4) > b.cap {
+		b.reserve(off + int64(len(data))*4)
+	}
+	i := 0
+	n := len(data)
 	{
-	read_loop:
-		(*out)[i] = uint16(b.buf[off+(i*2)]) | uint16(b.buf[off+(1+(i*2))])<<8
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(data[i])
+		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*4))] = byte(data[i] >> 24)
 		i++
 		if i < n {
-			goto read_loop
+			goto write_loop
 		}
 	}
 }
 
-// ReadU16LENext reads a slice of uint16s from the buffer at the
+// WriteI32LENext writes a slice of int32s to the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadU16LENext(out *[]uint16, n int64) {
-	b.ReadU16LE(out, b.off, n)
-	b.SeekByte(n*2, true)
+func (b *MiniBuffer) WriteI32LENext(data []int32) {
+	b.WriteI32LE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
 }
 
-// ReadU16BE reads a slice of uint16s from the buffer at the
+// PutI32LE writes an int32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI32LE(off int64, data int32) {
+	if b.grow && (off+4) > b.cap {
+		b.reserve(off + 4)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+}
+
+// PutI32LENext writes an int32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI32LENext(data int32) {
+	b.PutI32LE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutI32LEBits writes an int32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI32LEBits(off int64, data int32) {
+	if b.grow && (off+32) > b.bcap {
+		b.reserve((off + 32 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, true)
+}
+
+// PutI32LEBitsNext writes an int32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI32LEBitsNext(data int32) {
+	b.PutI32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteI32BE writes a slice of int32s to the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadU16BE(out *[]uint16, off, n int64) {
-	i := int64(0)
+func (b *MiniBuffer) WriteI32BE(off int64, data []int32) {
+	if b.grow && (off+int64(len(data))*4) > b.cap {
+		b.reserve(off + int64(len(data))*4)
+	}
+	i := 0
+	n := len(data)
 	{
-	read_loop:
-		(*out)[i] = uint16(b.buf[off+(1+(i*2))]) | uint16(b.buf[off+(i*2)])<<8
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(data[i] >> 24)
+		b.buf[off+int64(1+(i*4))] = byte(data[i] >> 16)
+		b.buf[off+int64(2+(i*4))] = byte(data[i] >> 8)
+		b.buf[off+int64(3+(i*4))] = byte(data[i])
 		i++
 		if i < n {
-			goto read_loop
+			goto write_loop
 		}
 	}
 }
 
-// ReadU16BENext reads a slice of uint16s from the buffer at the
+// WriteI32BENext writes a slice of int32s to the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadU16BENext(out *[]uint16, n int64) {
-	b.ReadU16BE(out, b.off, n)
-	b.SeekByte(n*2, true)
+func (b *MiniBuffer) WriteI32BENext(data []int32) {
+	b.WriteI32BE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutI32BE writes an int32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI32BE(off int64, data int32) {
+	if b.grow && (off+4) > b.cap {
+		b.reserve(off + 4)
+	}
+	b.buf[off] = byte(data >> 24)
+	b.buf[off+1] = byte(data >> 16)
+	b.buf[off+2] = byte(data >> 8)
+	b.buf[off+3] = byte(data)
+}
+
+// PutI32BENext writes an int32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI32BENext(data int32) {
+	b.PutI32BE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutI32BEBits writes an int32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI32BEBits(off int64, data int32) {
+	if b.grow && (off+32) > b.bcap {
+		b.reserve((off + 32 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 4, b.order, false)
+}
+
+// PutI32BEBitsNext writes an int32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI32BEBitsNext(data int32) {
+	b.PutI32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteI40LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI40LE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*5) > b.cap {
+		b.reserve(off + int64(len(data))*5)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*5)] = byte(data[i])
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*5))] = byte(data[i] >> 32)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI40LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI40LENext(data []int64) {
+	b.WriteI40LE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutI40LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI40LE(off int64, data int64) {
+	if b.grow && (off+5) > b.cap {
+		b.reserve(off + 5)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+}
+
+// PutI40LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI40LENext(data int64) {
+	b.PutI40LE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutI40LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI40LEBits(off int64, data int64) {
+	if b.grow && (off+40) > b.bcap {
+		b.reserve((off + 40 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, true)
+}
+
+// PutI40LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI40LEBitsNext(data int64) {
+	b.PutI40LEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteI40BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI40BE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*5) > b.cap {
+		b.reserve(off + int64(len(data))*5)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*5)] = byte(data[i] >> 32)
+		b.buf[off+int64(1+(i*5))] = byte(data[i] >> 24)
+		b.buf[off+int64(2+(i*5))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*5))] = byte(data[i] >> 8)
+		b.buf[off+int64(4+(i*5))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI40BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI40BENext(data []int64) {
+	b.WriteI40BE(b.off, data)
+	b.SeekByte(int64(len(data))*5, true)
+}
+
+// PutI40BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI40BE(off int64, data int64) {
+	if b.grow && (off+5) > b.cap {
+		b.reserve(off + 5)
+	}
+	b.buf[off] = byte(data >> 32)
+	b.buf[off+1] = byte(data >> 24)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 8)
+	b.buf[off+4] = byte(data)
+}
+
+// PutI40BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI40BENext(data int64) {
+	b.PutI40BE(b.off, data)
+	b.SeekByte(5, true)
+}
+
+// PutI40BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI40BEBits(off int64, data int64) {
+	if b.grow && (off+40) > b.bcap {
+		b.reserve((off + 40 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 5, b.order, false)
+}
+
+// PutI40BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI40BEBitsNext(data int64) {
+	b.PutI40BEBits(b.boff, data)
+	b.SeekBit(40, true)
+}
+
+// WriteI48LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI48LE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*6) > b.cap {
+		b.reserve(off + int64(len(data))*6)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*6)] = byte(data[i])
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*6))] = byte(data[i] >> 40)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI48LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI48LENext(data []int64) {
+	b.WriteI48LE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutI48LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI48LE(off int64, data int64) {
+	if b.grow && (off+6) > b.cap {
+		b.reserve(off + 6)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+}
+
+// PutI48LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI48LENext(data int64) {
+	b.PutI48LE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutI48LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI48LEBits(off int64, data int64) {
+	if b.grow && (off+48) > b.bcap {
+		b.reserve((off + 48 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, true)
+}
+
+// PutI48LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI48LEBitsNext(data int64) {
+	b.PutI48LEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteI48BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI48BE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*6) > b.cap {
+		b.reserve(off + int64(len(data))*6)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*6)] = byte(data[i] >> 40)
+		b.buf[off+int64(1+(i*6))] = byte(data[i] >> 32)
+		b.buf[off+int64(2+(i*6))] = byte(data[i] >> 24)
+		b.buf[off+int64(3+(i*6))] = byte(data[i] >> 16)
+		b.buf[off+int64(4+(i*6))] = byte(data[i] >> 8)
+		b.buf[off+int64(5+(i*6))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI48BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI48BENext(data []int64) {
+	b.WriteI48BE(b.off, data)
+	b.SeekByte(int64(len(data))*6, true)
+}
+
+// PutI48BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI48BE(off int64, data int64) {
+	if b.grow && (off+6) > b.cap {
+		b.reserve(off + 6)
+	}
+	b.buf[off] = byte(data >> 40)
+	b.buf[off+1] = byte(data >> 32)
+	b.buf[off+2] = byte(data >> 24)
+	b.buf[off+3] = byte(data >> 16)
+	b.buf[off+4] = byte(data >> 8)
+	b.buf[off+5] = byte(data)
+}
+
+// PutI48BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI48BENext(data int64) {
+	b.PutI48BE(b.off, data)
+	b.SeekByte(6, true)
+}
+
+// PutI48BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI48BEBits(off int64, data int64) {
+	if b.grow && (off+48) > b.bcap {
+		b.reserve((off + 48 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 6, b.order, false)
+}
+
+// PutI48BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI48BEBitsNext(data int64) {
+	b.PutI48BEBits(b.boff, data)
+	b.SeekBit(48, true)
+}
+
+// WriteI56LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI56LE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*7) > b.cap {
+		b.reserve(off + int64(len(data))*7)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*7)] = byte(data[i])
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*7))] = byte(data[i] >> 48)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI56LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI56LENext(data []int64) {
+	b.WriteI56LE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutI56LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI56LE(off int64, data int64) {
+	if b.grow && (off+7) > b.cap {
+		b.reserve(off + 7)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+}
+
+// PutI56LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI56LENext(data int64) {
+	b.PutI56LE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutI56LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI56LEBits(off int64, data int64) {
+	if b.grow && (off+56) > b.bcap {
+		b.reserve((off + 56 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, true)
+}
+
+// PutI56LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI56LEBitsNext(data int64) {
+	b.PutI56LEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteI56BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI56BE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*7) > b.cap {
+		b.reserve(off + int64(len(data))*7)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*7)] = byte(data[i] >> 48)
+		b.buf[off+int64(1+(i*7))] = byte(data[i] >> 40)
+		b.buf[off+int64(2+(i*7))] = byte(data[i] >> 32)
+		b.buf[off+int64(3+(i*7))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*7))] = byte(data[i] >> 16)
+		b.buf[off+int64(5+(i*7))] = byte(data[i] >> 8)
+		b.buf[off+int64(6+(i*7))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI56BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI56BENext(data []int64) {
+	b.WriteI56BE(b.off, data)
+	b.SeekByte(int64(len(data))*7, true)
+}
+
+// PutI56BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI56BE(off int64, data int64) {
+	if b.grow && (off+7) > b.cap {
+		b.reserve(off + 7)
+	}
+	b.buf[off] = byte(data >> 48)
+	b.buf[off+1] = byte(data >> 40)
+	b.buf[off+2] = byte(data >> 32)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 16)
+	b.buf[off+5] = byte(data >> 8)
+	b.buf[off+6] = byte(data)
+}
+
+// PutI56BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI56BENext(data int64) {
+	b.PutI56BE(b.off, data)
+	b.SeekByte(7, true)
+}
+
+// PutI56BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI56BEBits(off int64, data int64) {
+	if b.grow && (off+56) > b.bcap {
+		b.reserve((off + 56 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 7, b.order, false)
+}
+
+// PutI56BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI56BEBitsNext(data int64) {
+	b.PutI56BEBits(b.boff, data)
+	b.SeekBit(56, true)
+}
+
+// WriteI64LE writes a slice of int64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI64LE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*8) > b.cap {
+		b.reserve(off + int64(len(data))*8)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(data[i])
+		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 8)
+		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 16)
+		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 24)
+		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 32)
+		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 40)
+		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 48)
+		b.buf[off+int64(7+(i*8))] = byte(data[i] >> 56)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI64LENext writes a slice of int64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI64LENext(data []int64) {
+	b.WriteI64LE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutI64LE writes an int64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI64LE(off int64, data int64) {
+	if b.grow && (off+8) > b.cap {
+		b.reserve(off + 8)
+	}
+	b.buf[off] = byte(data)
+	b.buf[off+1] = byte(data >> 8)
+	b.buf[off+2] = byte(data >> 16)
+	b.buf[off+3] = byte(data >> 24)
+	b.buf[off+4] = byte(data >> 32)
+	b.buf[off+5] = byte(data >> 40)
+	b.buf[off+6] = byte(data >> 48)
+	b.buf[off+7] = byte(data >> 56)
+}
+
+// PutI64LENext writes an int64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI64LENext(data int64) {
+	b.PutI64LE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutI64LEBits writes an int64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI64LEBits(off int64, data int64) {
+	if b.grow && (off+64) > b.bcap {
+		b.reserve((off + 64 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, true)
+}
+
+// PutI64LEBitsNext writes an int64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI64LEBitsNext(data int64) {
+	b.PutI64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteI64BE writes a slice of int64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteI64BE(off int64, data []int64) {
+	if b.grow && (off+int64(len(data))*8) > b.cap {
+		b.reserve(off + int64(len(data))*8)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(data[i] >> 56)
+		b.buf[off+int64(1+(i*8))] = byte(data[i] >> 48)
+		b.buf[off+int64(2+(i*8))] = byte(data[i] >> 40)
+		b.buf[off+int64(3+(i*8))] = byte(data[i] >> 32)
+		b.buf[off+int64(4+(i*8))] = byte(data[i] >> 24)
+		b.buf[off+int64(5+(i*8))] = byte(data[i] >> 16)
+		b.buf[off+int64(6+(i*8))] = byte(data[i] >> 8)
+		b.buf[off+int64(7+(i*8))] = byte(data[i])
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteI64BENext writes a slice of int64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteI64BENext(data []int64) {
+	b.WriteI64BE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutI64BE writes an int64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutI64BE(off int64, data int64) {
+	if b.grow && (off+8) > b.cap {
+		b.reserve(off + 8)
+	}
+	b.buf[off] = byte(data >> 56)
+	b.buf[off+1] = byte(data >> 48)
+	b.buf[off+2] = byte(data >> 40)
+	b.buf[off+3] = byte(data >> 32)
+	b.buf[off+4] = byte(data >> 24)
+	b.buf[off+5] = byte(data >> 16)
+	b.buf[off+6] = byte(data >> 8)
+	b.buf[off+7] = byte(data)
+}
+
+// PutI64BENext writes an int64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutI64BENext(data int64) {
+	b.PutI64BE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutI64BEBits writes an int64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutI64BEBits(off int64, data int64) {
+	if b.grow && (off+64) > b.bcap {
+		b.reserve((off + 64 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(data), 8, b.order, false)
+}
+
+// PutI64BEBitsNext writes an int64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutI64BEBitsNext(data int64) {
+	b.PutI64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteF32LE writes a slice of float32s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteF32LE(off int64, data []float32) {
+	if b.grow && (off+int64(len(data))*4) > b.cap {
+		b.reserve(off + int64(len(data))*4)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF32LENext writes a slice of float32s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteF32LENext(data []float32) {
+	b.WriteF32LE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutF32LE writes a float32 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutF32LE(off int64, data float32) {
+	if b.grow && (off+4) > b.cap {
+		b.reserve(off + 4)
+	}
+	u := *(*uint32)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u)
+	b.buf[off+1] = byte(u >> 8)
+	b.buf[off+2] = byte(u >> 16)
+	b.buf[off+3] = byte(u >> 24)
+}
+
+// PutF32LENext writes a float32 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutF32LENext(data float32) {
+	b.PutF32LE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutF32LEBits writes a float32 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutF32LEBits(off int64, data float32) {
+	if b.grow && (off+32) > b.bcap {
+		b.reserve((off + 32 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(*(*uint32)(unsafe.Pointer(&data))), 4, b.order, true)
+}
+
+// PutF32LEBitsNext writes a float32 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutF32LEBitsNext(data float32) {
+	b.PutF32LEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteF32BE writes a slice of float32s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteF32BE(off int64, data []float32) {
+	if b.grow && (off+int64(len(data))*4) > b.cap {
+		b.reserve(off + int64(len(data))*4)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*4)] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(1+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(2+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(3+(i*4))] = byte(*(*uint32)(unsafe.Pointer(&data[i])))
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF32BENext writes a slice of float32s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteF32BENext(data []float32) {
+	b.WriteF32BE(b.off, data)
+	b.SeekByte(int64(len(data))*4, true)
+}
+
+// PutF32BE writes a float32 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutF32BE(off int64, data float32) {
+	if b.grow && (off+4) > b.cap {
+		b.reserve(off + 4)
+	}
+	u := *(*uint32)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u >> 24)
+	b.buf[off+1] = byte(u >> 16)
+	b.buf[off+2] = byte(u >> 8)
+	b.buf[off+3] = byte(u)
+}
+
+// PutF32BENext writes a float32 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutF32BENext(data float32) {
+	b.PutF32BE(b.off, data)
+	b.SeekByte(4, true)
+}
+
+// PutF32BEBits writes a float32 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutF32BEBits(off int64, data float32) {
+	if b.grow && (off+32) > b.bcap {
+		b.reserve((off + 32 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(*(*uint32)(unsafe.Pointer(&data))), 4, b.order, false)
+}
+
+// PutF32BEBitsNext writes a float32 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutF32BEBitsNext(data float32) {
+	b.PutF32BEBits(b.boff, data)
+	b.SeekBit(32, true)
+}
+
+// WriteF64LE writes a slice of float64s to the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteF64LE(off int64, data []float64) {
+	if b.grow && (off+int64(len(data))*8) > b.cap {
+		b.reserve(off + int64(len(data))*8)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
+		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
+		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
+		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
+		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF64LENext writes a slice of float64s to the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteF64LENext(data []float64) {
+	b.WriteF64LE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutF64LE writes a float64 to the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) PutF64LE(off int64, data float64) {
+	if b.grow && (off+8) > b.cap {
+		b.reserve(off + 8)
+	}
+	u := *(*uint64)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u)
+	b.buf[off+1] = byte(u >> 8)
+	b.buf[off+2] = byte(u >> 16)
+	b.buf[off+3] = byte(u >> 24)
+	b.buf[off+4] = byte(u >> 32)
+	b.buf[off+5] = byte(u >> 40)
+	b.buf[off+6] = byte(u >> 48)
+	b.buf[off+7] = byte(u >> 56)
+}
+
+// PutF64LENext writes a float64 to the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutF64LENext(data float64) {
+	b.PutF64LE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutF64LEBits writes a float64 to the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutF64LEBits(off int64, data float64) {
+	if b.grow && (off+64) > b.bcap {
+		b.reserve((off + 64 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(*(*uint64)(unsafe.Pointer(&data))), 8, b.order, true)
+}
+
+// PutF64LEBitsNext writes a float64 to the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutF64LEBitsNext(data float64) {
+	b.PutF64LEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// WriteF64BE writes a slice of float64s to the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteF64BE(off int64, data []float64) {
+	if b.grow && (off+int64(len(data))*8) > b.cap {
+		b.reserve(off + int64(len(data))*8)
+	}
+	i := 0
+	n := len(data)
+	{
+	write_loop:
+		b.buf[off+int64(i*8)] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 56)
+		b.buf[off+int64(1+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 48)
+		b.buf[off+int64(2+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 40)
+		b.buf[off+int64(3+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 32)
+		b.buf[off+int64(4+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 24)
+		b.buf[off+int64(5+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 16)
+		b.buf[off+int64(6+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])) >> 8)
+		b.buf[off+int64(7+(i*8))] = byte(*(*uint64)(unsafe.Pointer(&data[i])))
+		i++
+		if i < n {
+			goto write_loop
+		}
+	}
+}
+
+// WriteF64BENext writes a slice of float64s to the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteF64BENext(data []float64) {
+	b.WriteF64BE(b.off, data)
+	b.SeekByte(int64(len(data))*8, true)
+}
+
+// PutF64BE writes a float64 to the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) PutF64BE(off int64, data float64) {
+	if b.grow && (off+8) > b.cap {
+		b.reserve(off + 8)
+	}
+	u := *(*uint64)(unsafe.Pointer(&data))
+	b.buf[off] = byte(u >> 56)
+	b.buf[off+1] = byte(u >> 48)
+	b.buf[off+2] = byte(u >> 40)
+	b.buf[off+3] = byte(u >> 32)
+	b.buf[off+4] = byte(u >> 24)
+	b.buf[off+5] = byte(u >> 16)
+	b.buf[off+6] = byte(u >> 8)
+	b.buf[off+7] = byte(u)
+}
+
+// PutF64BENext writes a float64 to the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes written
+func (b *MiniBuffer) PutF64BENext(data float64) {
+	b.PutF64BE(b.off, data)
+	b.SeekByte(8, true)
+}
+
+// PutF64BEBits writes a float64 to the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) PutF64BEBits(off int64, data float64) {
+	if b.grow && (off+64) > b.bcap {
+		b.reserve((off + 64 + 7) / 8)
+	}
+	writeBitsBytes(b.buf, off, uint64(*(*uint64)(unsafe.Pointer(&data))), 8, b.order, false)
+}
+
+// PutF64BEBitsNext writes a float64 to the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits written
+func (b *MiniBuffer) PutF64BEBitsNext(data float64) {
+	b.PutF64BEBits(b.boff, data)
+	b.SeekBit(64, true)
+}
+
+// ReadBytes stores the next n bytes from the specified offset
+// without modifying the internal offset value in out
+func (b *MiniBuffer) ReadBytes(out *[]byte, off, n int64) {
+
+	*out = b.buf[off : off+n]
+
+}
+
+// ReadBytesNext stores the next n bytes from the current offset and
+// moves the offset forward the amount of bytes read in out
+func (b *MiniBuffer) ReadBytesNext(out *[]byte, n int64) {
+
+	b.ReadBytes(out, b.off, n)
+	b.SeekByte(n, true)
+
+}
+
+// ReadU16LE reads a slice of uint16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU16LE(out *[]uint16, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint16(b.buf[off+(i*2)]) | uint16(b.buf[off+(1+(i*2))])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU16LENext reads a slice of uint16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU16LENext(out *[]uint16, n int64) {
+	b.ReadU16LE(out, b.off, n)
+	b.SeekByte(n*2, true)
+}
+
+// ReadU16LEAt reads a uint16 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU16LEAt(out *uint16, off int64) {
+	*out = uint16(b.buf[off]) | uint16(b.buf[off+1])<<8
+}
+
+// ReadU16LEAtNext reads a uint16 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU16LEAtNext(out *uint16) {
+	b.ReadU16LEAt(out, b.off)
+	b.SeekByte(2, true)
+}
+
+// ReadU16LEInto reads len(dst) uint16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU16LEInto(dst []uint16, off int64) {
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = uint16(b.buf[o]) | uint16(b.buf[o+1])<<8
+	}
+}
+
+// ReadU16LEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU16LEIntoNext(dst []uint16) {
+	b.ReadU16LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadU16LEBits reads a uint16 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU16LEBits(out *uint16, off int64) {
+	*out = uint16(readBitsBytes(b.buf, off, 2, b.order, true))
+}
+
+// ReadU16LEBitsNext reads a uint16 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU16LEBitsNext(out *uint16) {
+	b.ReadU16LEBits(out, b.boff)
+	b.SeekBit(16, true)
+}
+
+// ReadU16BE reads a slice of uint16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU16BE(out *[]uint16, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint16(b.buf[off+(1+(i*2))]) | uint16(b.buf[off+(i*2)])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU16BENext reads a slice of uint16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU16BENext(out *[]uint16, n int64) {
+	b.ReadU16BE(out, b.off, n)
+	b.SeekByte(n*2, true)
+}
+
+// ReadU16BEAt reads a uint16 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU16BEAt(out *uint16, off int64) {
+	*out = uint16(b.buf[off])<<8 | uint16(b.buf[off+1])
+}
+
+// ReadU16BEAtNext reads a uint16 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU16BEAtNext(out *uint16) {
+	b.ReadU16BEAt(out, b.off)
+	b.SeekByte(2, true)
+}
+
+// ReadU16BEInto reads len(dst) uint16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU16BEInto(dst []uint16, off int64) {
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = uint16(b.buf[o])<<8 | uint16(b.buf[o+1])
+	}
+}
+
+// ReadU16BEIntoNext reads len(dst) uint16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU16BEIntoNext(dst []uint16) {
+	b.ReadU16BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadU16BEBits reads a uint16 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU16BEBits(out *uint16, off int64) {
+	*out = uint16(readBitsBytes(b.buf, off, 2, b.order, false))
+}
+
+// ReadU16BEBitsNext reads a uint16 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU16BEBitsNext(out *uint16) {
+	b.ReadU16BEBits(out, b.boff)
+	b.SeekBit(16, true)
+}
+
+// ReadU24LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU24LE(out *[]uint32, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint32(b.buf[off+(i*3)]) | uint32(b.buf[off+(1+(i*3))])<<8 | uint32(b.buf[off+(2+(i*3))])<<16
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU24LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU24LENext(out *[]uint32, n int64) {
+	b.ReadU24LE(out, b.off, n)
+	b.SeekByte(n*3, true)
+}
+
+// ReadU24LEAt reads a uint32 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU24LEAt(out *uint32, off int64) {
+	*out = uint32(b.buf[off]) | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])<<16
+}
+
+// ReadU24LEAtNext reads a uint32 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU24LEAtNext(out *uint32) {
+	b.ReadU24LEAt(out, b.off)
+	b.SeekByte(3, true)
+}
+
+// ReadU24LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU24LEInto(dst []uint32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = uint32(b.buf[o]) | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])<<16
+	}
+}
+
+// ReadU24LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU24LEIntoNext(dst []uint32) {
+	b.ReadU24LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadU24LEBits reads a uint32 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU24LEBits(out *uint32, off int64) {
+	*out = uint32(readBitsBytes(b.buf, off, 3, b.order, true))
+}
+
+// ReadU24LEBitsNext reads a uint32 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU24LEBitsNext(out *uint32) {
+	b.ReadU24LEBits(out, b.boff)
+	b.SeekBit(24, true)
+}
+
+// ReadU24BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU24BE(out *[]uint32, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint32(b.buf[off+(2+(i*3))]) | uint32(b.buf[off+(1+(i*3))])<<8 | uint32(b.buf[off+(i*3)])<<16
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU24BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU24BENext(out *[]uint32, n int64) {
+	b.ReadU24BE(out, b.off, n)
+	b.SeekByte(n*3, true)
+}
+
+// ReadU24BEAt reads a uint32 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU24BEAt(out *uint32, off int64) {
+	*out = uint32(b.buf[off])<<16 | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])
+}
+
+// ReadU24BEAtNext reads a uint32 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU24BEAtNext(out *uint32) {
+	b.ReadU24BEAt(out, b.off)
+	b.SeekByte(3, true)
+}
+
+// ReadU24BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU24BEInto(dst []uint32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = uint32(b.buf[o])<<16 | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])
+	}
+}
+
+// ReadU24BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU24BEIntoNext(dst []uint32) {
+	b.ReadU24BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadU24BEBits reads a uint32 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU24BEBits(out *uint32, off int64) {
+	*out = uint32(readBitsBytes(b.buf, off, 3, b.order, false))
+}
+
+// ReadU24BEBitsNext reads a uint32 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU24BEBitsNext(out *uint32) {
+	b.ReadU24BEBits(out, b.boff)
+	b.SeekBit(24, true)
+}
+
+// ReadU32LE reads a slice of uint32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU32LE(out *[]uint32, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint32(b.buf[off+(i*4)]) | uint32(b.buf[off+(1+(i*4))])<<8 | uint32(b.buf[off+(2+(i*4))])<<16 | uint32(b.buf[off+(3+(i*4))])<<24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU32LENext reads a slice of uint32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU32LENext(out *[]uint32, n int64) {
+	b.ReadU32LE(out, b.off, n)
+	b.SeekByte(n*4, true)
+}
+
+// ReadU32LEAt reads a uint32 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU32LEAt(out *uint32, off int64) {
+	*out = uint32(b.buf[off]) | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])<<16 | uint32(b.buf[off+3])<<24
+}
+
+// ReadU32LEAtNext reads a uint32 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU32LEAtNext(out *uint32) {
+	b.ReadU32LEAt(out, b.off)
+	b.SeekByte(4, true)
+}
+
+// ReadU32LEInto reads len(dst) uint32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU32LEInto(dst []uint32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = uint32(b.buf[o]) | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])<<16 | uint32(b.buf[o+3])<<24
+	}
+}
+
+// ReadU32LEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU32LEIntoNext(dst []uint32) {
+	b.ReadU32LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadU32LEBits reads a uint32 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU32LEBits(out *uint32, off int64) {
+	*out = uint32(readBitsBytes(b.buf, off, 4, b.order, true))
+}
+
+// ReadU32LEBitsNext reads a uint32 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU32LEBitsNext(out *uint32) {
+	b.ReadU32LEBits(out, b.boff)
+	b.SeekBit(32, true)
+}
+
+// ReadU32BE reads a slice of uint32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU32BE(out *[]uint32, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint32(b.buf[off+(3+(i*4))]) | uint32(b.buf[off+(2+(i*4))])<<8 | uint32(b.buf[off+(1+(i*4))])<<16 | uint32(b.buf[off+(i*4)])<<24
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU32BENext reads a slice of uint32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU32BENext(out *[]uint32, n int64) {
+	b.ReadU32BE(out, b.off, n)
+	b.SeekByte(n*4, true)
+}
+
+// ReadU32BEAt reads a uint32 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU32BEAt(out *uint32, off int64) {
+	*out = uint32(b.buf[off])<<24 | uint32(b.buf[off+1])<<16 | uint32(b.buf[off+2])<<8 | uint32(b.buf[off+3])
+}
+
+// ReadU32BEAtNext reads a uint32 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU32BEAtNext(out *uint32) {
+	b.ReadU32BEAt(out, b.off)
+	b.SeekByte(4, true)
+}
+
+// ReadU32BEInto reads len(dst) uint32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU32BEInto(dst []uint32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = uint32(b.buf[o])<<24 | uint32(b.buf[o+1])<<16 | uint32(b.buf[o+2])<<8 | uint32(b.buf[o+3])
+	}
+}
+
+// ReadU32BEIntoNext reads len(dst) uint32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU32BEIntoNext(dst []uint32) {
+	b.ReadU32BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadU32BEBits reads a uint32 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU32BEBits(out *uint32, off int64) {
+	*out = uint32(readBitsBytes(b.buf, off, 4, b.order, false))
+}
+
+// ReadU32BEBitsNext reads a uint32 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU32BEBitsNext(out *uint32) {
+	b.ReadU32BEBits(out, b.boff)
+	b.SeekBit(32, true)
+}
+
+// ReadU40LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU40LE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(i*5)]) | uint64(b.buf[off+(1+(i*5))])<<8 | uint64(b.buf[off+(2+(i*5))])<<16 | uint64(b.buf[off+(3+(i*5))])<<24 | uint64(b.buf[off+(4+(i*5))])<<32
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU40LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU40LENext(out *[]uint64, n int64) {
+	b.ReadU40LE(out, b.off, n)
+	b.SeekByte(n*5, true)
+}
+
+// ReadU40LEAt reads a uint64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU40LEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32
+}
+
+// ReadU40LEAtNext reads a uint64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU40LEAtNext(out *uint64) {
+	b.ReadU40LEAt(out, b.off)
+	b.SeekByte(5, true)
+}
+
+// ReadU40LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU40LEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32
+	}
+}
+
+// ReadU40LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU40LEIntoNext(dst []uint64) {
+	b.ReadU40LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadU40LEBits reads a uint64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU40LEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 5, b.order, true))
+}
+
+// ReadU40LEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU40LEBitsNext(out *uint64) {
+	b.ReadU40LEBits(out, b.boff)
+	b.SeekBit(40, true)
+}
+
+// ReadU40BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU40BE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(4+(i*5))]) | uint64(b.buf[off+(3+(i*5))])<<8 | uint64(b.buf[off+(2+(i*5))])<<16 | uint64(b.buf[off+(1+(i*5))])<<24 | uint64(b.buf[off+(i*5)])<<32
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU40BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU40BENext(out *[]uint64, n int64) {
+	b.ReadU40BE(out, b.off, n)
+	b.SeekByte(n*5, true)
+}
+
+// ReadU40BEAt reads a uint64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU40BEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off])<<32 | uint64(b.buf[off+1])<<24 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<8 | uint64(b.buf[off+4])
+}
+
+// ReadU40BEAtNext reads a uint64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU40BEAtNext(out *uint64) {
+	b.ReadU40BEAt(out, b.off)
+	b.SeekByte(5, true)
+}
+
+// ReadU40BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU40BEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = uint64(b.buf[o])<<32 | uint64(b.buf[o+1])<<24 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<8 | uint64(b.buf[o+4])
+	}
+}
+
+// ReadU40BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU40BEIntoNext(dst []uint64) {
+	b.ReadU40BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadU40BEBits reads a uint64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU40BEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 5, b.order, false))
+}
+
+// ReadU40BEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU40BEBitsNext(out *uint64) {
+	b.ReadU40BEBits(out, b.boff)
+	b.SeekBit(40, true)
+}
+
+// ReadU48LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU48LE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(i*6)]) | uint64(b.buf[off+(1+(i*6))])<<8 | uint64(b.buf[off+(2+(i*6))])<<16 | uint64(b.buf[off+(3+(i*6))])<<24 | uint64(b.buf[off+(4+(i*6))])<<32 | uint64(b.buf[off+(5+(i*6))])<<40
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU48LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU48LENext(out *[]uint64, n int64) {
+	b.ReadU48LE(out, b.off, n)
+	b.SeekByte(n*6, true)
+}
+
+// ReadU48LEAt reads a uint64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU48LEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40
+}
+
+// ReadU48LEAtNext reads a uint64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU48LEAtNext(out *uint64) {
+	b.ReadU48LEAt(out, b.off)
+	b.SeekByte(6, true)
+}
+
+// ReadU48LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU48LEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40
+	}
+}
+
+// ReadU48LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU48LEIntoNext(dst []uint64) {
+	b.ReadU48LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadU48LEBits reads a uint64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU48LEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 6, b.order, true))
+}
+
+// ReadU48LEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU48LEBitsNext(out *uint64) {
+	b.ReadU48LEBits(out, b.boff)
+	b.SeekBit(48, true)
+}
+
+// ReadU48BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU48BE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(5+(i*6))]) | uint64(b.buf[off+(4+(i*6))])<<8 | uint64(b.buf[off+(3+(i*6))])<<16 | uint64(b.buf[off+(2+(i*6))])<<24 | uint64(b.buf[off+(1+(i*6))])<<32 | uint64(b.buf[off+(i*6)])<<40
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU48BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU48BENext(out *[]uint64, n int64) {
+	b.ReadU48BE(out, b.off, n)
+	b.SeekByte(n*6, true)
+}
+
+// ReadU48BEAt reads a uint64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU48BEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off])<<40 | uint64(b.buf[off+1])<<32 | uint64(b.buf[off+2])<<24 | uint64(b.buf[off+3])<<16 | uint64(b.buf[off+4])<<8 | uint64(b.buf[off+5])
+}
+
+// ReadU48BEAtNext reads a uint64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU48BEAtNext(out *uint64) {
+	b.ReadU48BEAt(out, b.off)
+	b.SeekByte(6, true)
+}
+
+// ReadU48BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU48BEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = uint64(b.buf[o])<<40 | uint64(b.buf[o+1])<<32 | uint64(b.buf[o+2])<<24 | uint64(b.buf[o+3])<<16 | uint64(b.buf[o+4])<<8 | uint64(b.buf[o+5])
+	}
+}
+
+// ReadU48BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU48BEIntoNext(dst []uint64) {
+	b.ReadU48BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadU48BEBits reads a uint64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU48BEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 6, b.order, false))
+}
+
+// ReadU48BEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU48BEBitsNext(out *uint64) {
+	b.ReadU48BEBits(out, b.boff)
+	b.SeekBit(48, true)
+}
+
+// ReadU56LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU56LE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(i*7)]) | uint64(b.buf[off+(1+(i*7))])<<8 | uint64(b.buf[off+(2+(i*7))])<<16 | uint64(b.buf[off+(3+(i*7))])<<24 | uint64(b.buf[off+(4+(i*7))])<<32 | uint64(b.buf[off+(5+(i*7))])<<40 | uint64(b.buf[off+(6+(i*7))])<<48
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU56LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU56LENext(out *[]uint64, n int64) {
+	b.ReadU56LE(out, b.off, n)
+	b.SeekByte(n*7, true)
+}
+
+// ReadU56LEAt reads a uint64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU56LEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40 | uint64(b.buf[off+6])<<48
+}
+
+// ReadU56LEAtNext reads a uint64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU56LEAtNext(out *uint64) {
+	b.ReadU56LEAt(out, b.off)
+	b.SeekByte(7, true)
+}
+
+// ReadU56LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU56LEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40 | uint64(b.buf[o+6])<<48
+	}
+}
+
+// ReadU56LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU56LEIntoNext(dst []uint64) {
+	b.ReadU56LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadU56LEBits reads a uint64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU56LEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 7, b.order, true))
+}
+
+// ReadU56LEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU56LEBitsNext(out *uint64) {
+	b.ReadU56LEBits(out, b.boff)
+	b.SeekBit(56, true)
+}
+
+// ReadU56BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU56BE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(6+(i*7))]) | uint64(b.buf[off+(5+(i*7))])<<8 | uint64(b.buf[off+(4+(i*7))])<<16 | uint64(b.buf[off+(3+(i*7))])<<24 | uint64(b.buf[off+(2+(i*7))])<<32 | uint64(b.buf[off+(1+(i*7))])<<40 | uint64(b.buf[off+(i*7)])<<48
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU56BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU56BENext(out *[]uint64, n int64) {
+	b.ReadU56BE(out, b.off, n)
+	b.SeekByte(n*7, true)
+}
+
+// ReadU56BEAt reads a uint64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU56BEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off])<<48 | uint64(b.buf[off+1])<<40 | uint64(b.buf[off+2])<<32 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<16 | uint64(b.buf[off+5])<<8 | uint64(b.buf[off+6])
+}
+
+// ReadU56BEAtNext reads a uint64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU56BEAtNext(out *uint64) {
+	b.ReadU56BEAt(out, b.off)
+	b.SeekByte(7, true)
+}
+
+// ReadU56BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU56BEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = uint64(b.buf[o])<<48 | uint64(b.buf[o+1])<<40 | uint64(b.buf[o+2])<<32 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<16 | uint64(b.buf[o+5])<<8 | uint64(b.buf[o+6])
+	}
+}
+
+// ReadU56BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU56BEIntoNext(dst []uint64) {
+	b.ReadU56BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadU56BEBits reads a uint64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU56BEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 7, b.order, false))
+}
+
+// ReadU56BEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU56BEBitsNext(out *uint64) {
+	b.ReadU56BEBits(out, b.boff)
+	b.SeekBit(56, true)
+}
+
+// ReadU64LE reads a slice of uint64s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU64LE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(i*8)]) | uint64(b.buf[off+(1+(i*8))])<<8 | uint64(b.buf[off+(2+(i*8))])<<16 | uint64(b.buf[off+(3+(i*8))])<<24 | uint64(b.buf[off+(4+(i*8))])<<32 | uint64(b.buf[off+(5+(i*8))])<<40 | uint64(b.buf[off+(6+(i*8))])<<48 | uint64(b.buf[off+(7+(i*8))])<<56
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU64LENext reads a slice of uint64s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU64LENext(out *[]uint64, n int64) {
+	b.ReadU64LE(out, b.off, n)
+	b.SeekByte(n*8, true)
+}
+
+// ReadU64LEAt reads a uint64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU64LEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40 | uint64(b.buf[off+6])<<48 | uint64(b.buf[off+7])<<56
+}
+
+// ReadU64LEAtNext reads a uint64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU64LEAtNext(out *uint64) {
+	b.ReadU64LEAt(out, b.off)
+	b.SeekByte(8, true)
+}
+
+// ReadU64LEInto reads len(dst) uint64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU64LEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40 | uint64(b.buf[o+6])<<48 | uint64(b.buf[o+7])<<56
+	}
+}
+
+// ReadU64LEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU64LEIntoNext(dst []uint64) {
+	b.ReadU64LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadU64LEBits reads a uint64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU64LEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 8, b.order, true))
+}
+
+// ReadU64LEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU64LEBitsNext(out *uint64) {
+	b.ReadU64LEBits(out, b.boff)
+	b.SeekBit(64, true)
+}
+
+// ReadU64BE reads a slice of uint64s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadU64BE(out *[]uint64, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = uint64(b.buf[off+(7+(i*8))]) | uint64(b.buf[off+(6+(i*8))])<<8 | uint64(b.buf[off+(5+(i*8))])<<16 | uint64(b.buf[off+(4+(i*8))])<<24 | uint64(b.buf[off+(3+(i*8))])<<32 | uint64(b.buf[off+(2+(i*8))])<<40 | uint64(b.buf[off+(1+(i*8))])<<48 | uint64(b.buf[off+(i*8)])<<56
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadU64BENext reads a slice of uint64s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadU64BENext(out *[]uint64, n int64) {
+	b.ReadU64BE(out, b.off, n)
+	b.SeekByte(n*8, true)
+}
+
+// ReadU64BEAt reads a uint64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadU64BEAt(out *uint64, off int64) {
+	*out = uint64(b.buf[off])<<56 | uint64(b.buf[off+1])<<48 | uint64(b.buf[off+2])<<40 | uint64(b.buf[off+3])<<32 | uint64(b.buf[off+4])<<24 | uint64(b.buf[off+5])<<16 | uint64(b.buf[off+6])<<8 | uint64(b.buf[off+7])
+}
+
+// ReadU64BEAtNext reads a uint64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU64BEAtNext(out *uint64) {
+	b.ReadU64BEAt(out, b.off)
+	b.SeekByte(8, true)
+}
+
+// ReadU64BEInto reads len(dst) uint64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadU64BEInto(dst []uint64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = uint64(b.buf[o])<<56 | uint64(b.buf[o+1])<<48 | uint64(b.buf[o+2])<<40 | uint64(b.buf[o+3])<<32 | uint64(b.buf[o+4])<<24 | uint64(b.buf[o+5])<<16 | uint64(b.buf[o+6])<<8 | uint64(b.buf[o+7])
+	}
+}
+
+// ReadU64BEIntoNext reads len(dst) uint64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadU64BEIntoNext(dst []uint64) {
+	b.ReadU64BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadU64BEBits reads a uint64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadU64BEBits(out *uint64, off int64) {
+	*out = uint64(readBitsBytes(b.buf, off, 8, b.order, false))
+}
+
+// ReadU64BEBitsNext reads a uint64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadU64BEBitsNext(out *uint64) {
+	b.ReadU64BEBits(out, b.boff)
+	b.SeekBit(64, true)
+}
+
+// ReadI16LE reads a slice of int16s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadI16LE(out *[]int16, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = int16(b.buf[off+(i*2)]) | int16(b.buf[off+(1+(i*2))])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadI16LENext reads a slice of int16s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadI16LENext(out *[]int16, n int64) {
+	b.ReadI16LE(out, b.off, n)
+	b.SeekByte(n*2, true)
+}
+
+// ReadI16LEAt reads an int16 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI16LEAt(out *int16, off int64) {
+	*out = int16(b.buf[off]) | int16(b.buf[off+1])<<8
+}
+
+// ReadI16LEAtNext reads an int16 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI16LEAtNext(out *int16) {
+	b.ReadI16LEAt(out, b.off)
+	b.SeekByte(2, true)
+}
+
+// ReadI16LEInto reads len(dst) int16s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI16LEInto(dst []int16, off int64) {
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = int16(b.buf[o]) | int16(b.buf[o+1])<<8
+	}
+}
+
+// ReadI16LEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI16LEIntoNext(dst []int16) {
+	b.ReadI16LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadI16LEBits reads an int16 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI16LEBits(out *int16, off int64) {
+	*out = int16(readBitsBytes(b.buf, off, 2, b.order, true))
+}
+
+// ReadI16LEBitsNext reads an int16 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI16LEBitsNext(out *int16) {
+	b.ReadI16LEBits(out, b.boff)
+	b.SeekBit(16, true)
+}
+
+// ReadI16BE reads a slice of int16s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadI16BE(out *[]int16, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = int16(b.buf[off+(1+(i*2))]) | int16(b.buf[off+(i*2)])<<8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadI16BENext reads a slice of int16s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadI16BENext(out *[]int16, n int64) {
+	b.ReadI16BE(out, b.off, n)
+	b.SeekByte(n*2, true)
+}
+
+// ReadI16BEAt reads an int16 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI16BEAt(out *int16, off int64) {
+	*out = int16(b.buf[off])<<8 | int16(b.buf[off+1])
+}
+
+// ReadI16BEAtNext reads an int16 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI16BEAtNext(out *int16) {
+	b.ReadI16BEAt(out, b.off)
+	b.SeekByte(2, true)
+}
+
+// ReadI16BEInto reads len(dst) int16s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI16BEInto(dst []int16, off int64) {
+	for i := range dst {
+		o := off + int64(i)*2
+		dst[i] = int16(b.buf[o])<<8 | int16(b.buf[o+1])
+	}
+}
+
+// ReadI16BEIntoNext reads len(dst) int16s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI16BEIntoNext(dst []int16) {
+	b.ReadI16BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*2, true)
+}
+
+// ReadI16BEBits reads an int16 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI16BEBits(out *int16, off int64) {
+	*out = int16(readBitsBytes(b.buf, off, 2, b.order, false))
+}
+
+// ReadI16BEBitsNext reads an int16 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI16BEBitsNext(out *int16) {
+	b.ReadI16BEBits(out, b.boff)
+	b.SeekBit(16, true)
+}
+
+// ReadI24LE reads a slice of int32s from the buffer at the
+// specified offset in little-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadI24LE(out *[]int32, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = int32(b.buf[off+(i*3)]) | int32(b.buf[off+(1+(i*3))])<<8 | int32(b.buf[off+(2+(i*3))])<<16
+		(*out)[i] = (*out)[i] << 8 >> 8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadI24LENext reads a slice of int32s from the buffer at the
+// current offset in little-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadI24LENext(out *[]int32, n int64) {
+	b.ReadI24LE(out, b.off, n)
+	b.SeekByte(n*3, true)
+}
+
+// ReadI24LEAt reads an int32 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI24LEAt(out *int32, off int64) {
+	*out = int32(b.buf[off]) | int32(b.buf[off+1])<<8 | int32(b.buf[off+2])<<16
+	*out = *out << 8 >> 8
+}
+
+// ReadI24LEAtNext reads an int32 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI24LEAtNext(out *int32) {
+	b.ReadI24LEAt(out, b.off)
+	b.SeekByte(3, true)
+}
+
+// ReadI24LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI24LEInto(dst []int32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = int32(b.buf[o]) | int32(b.buf[o+1])<<8 | int32(b.buf[o+2])<<16
+		dst[i] = dst[i] << 8 >> 8
+	}
+}
+
+// ReadI24LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI24LEIntoNext(dst []int32) {
+	b.ReadI24LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadI24LEBits reads an int32 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI24LEBits(out *int32, off int64) {
+	*out = int32(readBitsBytes(b.buf, off, 3, b.order, true))
+	*out = *out << 8 >> 8
+}
+
+// ReadI24LEBitsNext reads an int32 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI24LEBitsNext(out *int32) {
+	b.ReadI24LEBits(out, b.boff)
+	b.SeekBit(24, true)
+}
+
+// ReadI24BE reads a slice of int32s from the buffer at the
+// specified offset in big-endian without modifying the internal
+// offset value
+func (b *MiniBuffer) ReadI24BE(out *[]int32, off, n int64) {
+	i := int64(0)
+	{
+	read_loop:
+		(*out)[i] = int32(b.buf[off+(2+(i*3))]) | int32(b.buf[off+(1+(i*3))])<<8 | int32(b.buf[off+(i*3)])<<16
+		(*out)[i] = (*out)[i] << 8 >> 8
+		i++
+		if i < n {
+			goto read_loop
+		}
+	}
+}
+
+// ReadI24BENext reads a slice of int32s from the buffer at the
+// current offset in big-endian and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) ReadI24BENext(out *[]int32, n int64) {
+	b.ReadI24BE(out, b.off, n)
+	b.SeekByte(n*3, true)
+}
+
+// ReadI24BEAt reads an int32 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI24BEAt(out *int32, off int64) {
+	*out = int32(b.buf[off])<<16 | int32(b.buf[off+1])<<8 | int32(b.buf[off+2])
+	*out = *out << 8 >> 8
+}
+
+// ReadI24BEAtNext reads an int32 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI24BEAtNext(out *int32) {
+	b.ReadI24BEAt(out, b.off)
+	b.SeekByte(3, true)
+}
+
+// ReadI24BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI24BEInto(dst []int32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*3
+		dst[i] = int32(b.buf[o])<<16 | int32(b.buf[o+1])<<8 | int32(b.buf[o+2])
+		dst[i] = dst[i] << 8 >> 8
+	}
+}
+
+// ReadI24BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI24BEIntoNext(dst []int32) {
+	b.ReadI24BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*3, true)
+}
+
+// ReadI24BEBits reads an int32 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI24BEBits(out *int32, off int64) {
+	*out = int32(readBitsBytes(b.buf, off, 3, b.order, false))
+	*out = *out << 8 >> 8
+}
+
+// ReadI24BEBitsNext reads an int32 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI24BEBitsNext(out *int32) {
+	b.ReadI24BEBits(out, b.boff)
+	b.SeekBit(24, true)
 }
 
-// ReadU32LE reads a slice of uint32s from the buffer at the
+// ReadI32LE reads a slice of int32s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadU32LE(out *[]uint32, off, n int64) {
+func (b *MiniBuffer) ReadI32LE(out *[]int32, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = uint32(b.buf[off+(i*4)]) | uint32(b.buf[off+(1+(i*4))])<<8 | uint32(b.buf[off+(2+(i*4))])<<16 | uint32(b.buf[off+(3+(i*4))])<<24
+		(*out)[i] = int32(b.buf[off+(i*4)]) | int32(b.buf[off+(1+(i*4))])<<8 | int32(b.buf[off+(2+(i*4))])<<16 | int32(b.buf[off+(3+(i*4))])<<24
 		i++
 		if i < n {
 			goto read_loop
@@ -845,22 +3706,64 @@ func (b *MiniBuffer) ReadU32LE(out *[]uint32, off, n int64) {
 	}
 }
 
-// ReadU32LENext reads a slice of uint32s from the buffer at the
+// ReadI32LENext reads a slice of int32s from the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadU32LENext(out *[]uint32, n int64) {
-	b.ReadU32LE(out, b.off, n)
+func (b *MiniBuffer) ReadI32LENext(out *[]int32, n int64) {
+	b.ReadI32LE(out, b.off, n)
 	b.SeekByte(n*4, true)
 }
 
-// ReadU32BE reads a slice of uint32s from the buffer at the
+// ReadI32LEAt reads an int32 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI32LEAt(out *int32, off int64) {
+	*out = int32(b.buf[off]) | int32(b.buf[off+1])<<8 | int32(b.buf[off+2])<<16 | int32(b.buf[off+3])<<24
+}
+
+// ReadI32LEAtNext reads an int32 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI32LEAtNext(out *int32) {
+	b.ReadI32LEAt(out, b.off)
+	b.SeekByte(4, true)
+}
+
+// ReadI32LEInto reads len(dst) int32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI32LEInto(dst []int32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = int32(b.buf[o]) | int32(b.buf[o+1])<<8 | int32(b.buf[o+2])<<16 | int32(b.buf[o+3])<<24
+	}
+}
+
+// ReadI32LEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI32LEIntoNext(dst []int32) {
+	b.ReadI32LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadI32LEBits reads an int32 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI32LEBits(out *int32, off int64) {
+	*out = int32(readBitsBytes(b.buf, off, 4, b.order, true))
+}
+
+// ReadI32LEBitsNext reads an int32 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI32LEBitsNext(out *int32) {
+	b.ReadI32LEBits(out, b.boff)
+	b.SeekBit(32, true)
+}
+
+// ReadI32BE reads a slice of int32s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadU32BE(out *[]uint32, off, n int64) {
+func (b *MiniBuffer) ReadI32BE(out *[]int32, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = uint32(b.buf[off+(3+(i*4))]) | uint32(b.buf[off+(2+(i*4))])<<8 | uint32(b.buf[off+(1+(i*4))])<<16 | uint32(b.buf[off+(i*4)])<<24
+		(*out)[i] = int32(b.buf[off+(3+(i*4))]) | int32(b.buf[off+(2+(i*4))])<<8 | int32(b.buf[off+(1+(i*4))])<<16 | int32(b.buf[off+(i*4)])<<24
 		i++
 		if i < n {
 			goto read_loop
@@ -868,22 +3771,65 @@ func (b *MiniBuffer) ReadU32BE(out *[]uint32, off, n int64) {
 	}
 }
 
-// ReadU32BENext reads a slice of uint32s from the buffer at the
+// ReadI32BENext reads a slice of int32s from the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadU32BENext(out *[]uint32, n int64) {
-	b.ReadU32BE(out, b.off, n)
+func (b *MiniBuffer) ReadI32BENext(out *[]int32, n int64) {
+	b.ReadI32BE(out, b.off, n)
 	b.SeekByte(n*4, true)
 }
 
-// ReadU64LE reads a slice of uint64s from the buffer at the
+// ReadI32BEAt reads an int32 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI32BEAt(out *int32, off int64) {
+	*out = int32(b.buf[off])<<24 | int32(b.buf[off+1])<<16 | int32(b.buf[off+2])<<8 | int32(b.buf[off+3])
+}
+
+// ReadI32BEAtNext reads an int32 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI32BEAtNext(out *int32) {
+	b.ReadI32BEAt(out, b.off)
+	b.SeekByte(4, true)
+}
+
+// ReadI32BEInto reads len(dst) int32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI32BEInto(dst []int32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*4
+		dst[i] = int32(b.buf[o])<<24 | int32(b.buf[o+1])<<16 | int32(b.buf[o+2])<<8 | int32(b.buf[o+3])
+	}
+}
+
+// ReadI32BEIntoNext reads len(dst) int32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI32BEIntoNext(dst []int32) {
+	b.ReadI32BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadI32BEBits reads an int32 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI32BEBits(out *int32, off int64) {
+	*out = int32(readBitsBytes(b.buf, off, 4, b.order, false))
+}
+
+// ReadI32BEBitsNext reads an int32 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI32BEBitsNext(out *int32) {
+	b.ReadI32BEBits(out, b.boff)
+	b.SeekBit(32, true)
+}
+
+// ReadI40LE reads a slice of int64s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadU64LE(out *[]uint64, off, n int64) {
+func (b *MiniBuffer) ReadI40LE(out *[]int64, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = uint64(b.buf[off+(i*8)]) | uint64(b.buf[off+(1+(i*8))])<<8 | uint64(b.buf[off+(2+(i*8))])<<16 | uint64(b.buf[off+(3+(i*8))])<<24 | uint64(b.buf[off+(4+(i*8))])<<32 | uint64(b.buf[off+(5+(i*8))])<<40 | uint64(b.buf[off+(6+(i*8))])<<48 | uint64(b.buf[off+(7+(i*8))])<<56
+		(*out)[i] = int64(b.buf[off+(i*5)]) | int64(b.buf[off+(1+(i*5))])<<8 | int64(b.buf[off+(2+(i*5))])<<16 | int64(b.buf[off+(3+(i*5))])<<24 | int64(b.buf[off+(4+(i*5))])<<32
+		(*out)[i] = (*out)[i] << 24 >> 24
 		i++
 		if i < n {
 			goto read_loop
@@ -891,22 +3837,68 @@ func (b *MiniBuffer) ReadU64LE(out *[]uint64, off, n int64) {
 	}
 }
 
-// ReadU64LENext reads a slice of uint64s from the buffer at the
+// ReadI40LENext reads a slice of int64s from the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadU64LENext(out *[]uint64, n int64) {
-	b.ReadU64LE(out, b.off, n)
-	b.SeekByte(n*8, true)
+func (b *MiniBuffer) ReadI40LENext(out *[]int64, n int64) {
+	b.ReadI40LE(out, b.off, n)
+	b.SeekByte(n*5, true)
 }
 
-// ReadU64BE reads a slice of uint64s from the buffer at the
+// ReadI40LEAt reads an int64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI40LEAt(out *int64, off int64) {
+	*out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32
+	*out = *out << 24 >> 24
+}
+
+// ReadI40LEAtNext reads an int64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI40LEAtNext(out *int64) {
+	b.ReadI40LEAt(out, b.off)
+	b.SeekByte(5, true)
+}
+
+// ReadI40LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI40LEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32
+		dst[i] = dst[i] << 24 >> 24
+	}
+}
+
+// ReadI40LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI40LEIntoNext(dst []int64) {
+	b.ReadI40LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadI40LEBits reads an int64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI40LEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 5, b.order, true))
+	*out = *out << 24 >> 24
+}
+
+// ReadI40LEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI40LEBitsNext(out *int64) {
+	b.ReadI40LEBits(out, b.boff)
+	b.SeekBit(40, true)
+}
+
+// ReadI40BE reads a slice of int64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadU64BE(out *[]uint64, off, n int64) {
+func (b *MiniBuffer) ReadI40BE(out *[]int64, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = uint64(b.buf[off+(7+(i*8))]) | uint64(b.buf[off+(6+(i*8))])<<8 | uint64(b.buf[off+(5+(i*8))])<<16 | uint64(b.buf[off+(4+(i*8))])<<24 | uint64(b.buf[off+(3+(i*8))])<<32 | uint64(b.buf[off+(2+(i*8))])<<40 | uint64(b.buf[off+(1+(i*8))])<<48 | uint64(b.buf[off+(i*8)])<<56
+		(*out)[i] = int64(b.buf[off+(4+(i*5))]) | int64(b.buf[off+(3+(i*5))])<<8 | int64(b.buf[off+(2+(i*5))])<<16 | int64(b.buf[off+(1+(i*5))])<<24 | int64(b.buf[off+(i*5)])<<32
+		(*out)[i] = (*out)[i] << 24 >> 24
 		i++
 		if i < n {
 			goto read_loop
@@ -914,22 +3906,68 @@ func (b *MiniBuffer) ReadU64BE(out *[]uint64, off, n int64) {
 	}
 }
 
-// ReadU64BENext reads a slice of uint64s from the buffer at the
+// ReadI40BENext reads a slice of int64s from the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadU64BENext(out *[]uint64, n int64) {
-	b.ReadU64BE(out, b.off, n)
-	b.SeekByte(n*8, true)
+func (b *MiniBuffer) ReadI40BENext(out *[]int64, n int64) {
+	b.ReadI40BE(out, b.off, n)
+	b.SeekByte(n*5, true)
 }
 
-// ReadI16LE reads a slice of int16s from the buffer at the
+// ReadI40BEAt reads an int64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI40BEAt(out *int64, off int64) {
+	*out = int64(b.buf[off])<<32 | int64(b.buf[off+1])<<24 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<8 | int64(b.buf[off+4])
+	*out = *out << 24 >> 24
+}
+
+// ReadI40BEAtNext reads an int64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI40BEAtNext(out *int64) {
+	b.ReadI40BEAt(out, b.off)
+	b.SeekByte(5, true)
+}
+
+// ReadI40BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI40BEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*5
+		dst[i] = int64(b.buf[o])<<32 | int64(b.buf[o+1])<<24 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<8 | int64(b.buf[o+4])
+		dst[i] = dst[i] << 24 >> 24
+	}
+}
+
+// ReadI40BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI40BEIntoNext(dst []int64) {
+	b.ReadI40BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*5, true)
+}
+
+// ReadI40BEBits reads an int64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI40BEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 5, b.order, false))
+	*out = *out << 24 >> 24
+}
+
+// ReadI40BEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI40BEBitsNext(out *int64) {
+	b.ReadI40BEBits(out, b.boff)
+	b.SeekBit(40, true)
+}
+
+// ReadI48LE reads a slice of int64s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadI16LE(out *[]int16, off, n int64) {
+func (b *MiniBuffer) ReadI48LE(out *[]int64, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = int16(b.buf[off+(i*2)]) | int16(b.buf[off+(1+(i*2))])<<8
+		(*out)[i] = int64(b.buf[off+(i*6)]) | int64(b.buf[off+(1+(i*6))])<<8 | int64(b.buf[off+(2+(i*6))])<<16 | int64(b.buf[off+(3+(i*6))])<<24 | int64(b.buf[off+(4+(i*6))])<<32 | int64(b.buf[off+(5+(i*6))])<<40
+		(*out)[i] = (*out)[i] << 16 >> 16
 		i++
 		if i < n {
 			goto read_loop
@@ -937,22 +3975,68 @@ func (b *MiniBuffer) ReadI16LE(out *[]int16, off, n int64) {
 	}
 }
 
-// ReadI16LENext reads a slice of int16s from the buffer at the
+// ReadI48LENext reads a slice of int64s from the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadI16LENext(out *[]int16, n int64) {
-	b.ReadI16LE(out, b.off, n)
-	b.SeekByte(n*2, true)
+func (b *MiniBuffer) ReadI48LENext(out *[]int64, n int64) {
+	b.ReadI48LE(out, b.off, n)
+	b.SeekByte(n*6, true)
 }
 
-// ReadI16BE reads a slice of int16s from the buffer at the
+// ReadI48LEAt reads an int64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI48LEAt(out *int64, off int64) {
+	*out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32 | int64(b.buf[off+5])<<40
+	*out = *out << 16 >> 16
+}
+
+// ReadI48LEAtNext reads an int64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI48LEAtNext(out *int64) {
+	b.ReadI48LEAt(out, b.off)
+	b.SeekByte(6, true)
+}
+
+// ReadI48LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI48LEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32 | int64(b.buf[o+5])<<40
+		dst[i] = dst[i] << 16 >> 16
+	}
+}
+
+// ReadI48LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI48LEIntoNext(dst []int64) {
+	b.ReadI48LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadI48LEBits reads an int64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI48LEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 6, b.order, true))
+	*out = *out << 16 >> 16
+}
+
+// ReadI48LEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI48LEBitsNext(out *int64) {
+	b.ReadI48LEBits(out, b.boff)
+	b.SeekBit(48, true)
+}
+
+// ReadI48BE reads a slice of int64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadI16BE(out *[]int16, off, n int64) {
+func (b *MiniBuffer) ReadI48BE(out *[]int64, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = int16(b.buf[off+(1+(i*2))]) | int16(b.buf[off+(i*2)])<<8
+		(*out)[i] = int64(b.buf[off+(5+(i*6))]) | int64(b.buf[off+(4+(i*6))])<<8 | int64(b.buf[off+(3+(i*6))])<<16 | int64(b.buf[off+(2+(i*6))])<<24 | int64(b.buf[off+(1+(i*6))])<<32 | int64(b.buf[off+(i*6)])<<40
+		(*out)[i] = (*out)[i] << 16 >> 16
 		i++
 		if i < n {
 			goto read_loop
@@ -960,22 +4044,68 @@ func (b *MiniBuffer) ReadI16BE(out *[]int16, off, n int64) {
 	}
 }
 
-// ReadI16BENext reads a slice of int16s from the buffer at the
+// ReadI48BENext reads a slice of int64s from the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadI16BENext(out *[]int16, n int64) {
-	b.ReadI16BE(out, b.off, n)
-	b.SeekByte(n*2, true)
+func (b *MiniBuffer) ReadI48BENext(out *[]int64, n int64) {
+	b.ReadI48BE(out, b.off, n)
+	b.SeekByte(n*6, true)
 }
 
-// ReadI32LE reads a slice of int32s from the buffer at the
+// ReadI48BEAt reads an int64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI48BEAt(out *int64, off int64) {
+	*out = int64(b.buf[off])<<40 | int64(b.buf[off+1])<<32 | int64(b.buf[off+2])<<24 | int64(b.buf[off+3])<<16 | int64(b.buf[off+4])<<8 | int64(b.buf[off+5])
+	*out = *out << 16 >> 16
+}
+
+// ReadI48BEAtNext reads an int64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI48BEAtNext(out *int64) {
+	b.ReadI48BEAt(out, b.off)
+	b.SeekByte(6, true)
+}
+
+// ReadI48BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI48BEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*6
+		dst[i] = int64(b.buf[o])<<40 | int64(b.buf[o+1])<<32 | int64(b.buf[o+2])<<24 | int64(b.buf[o+3])<<16 | int64(b.buf[o+4])<<8 | int64(b.buf[o+5])
+		dst[i] = dst[i] << 16 >> 16
+	}
+}
+
+// ReadI48BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI48BEIntoNext(dst []int64) {
+	b.ReadI48BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*6, true)
+}
+
+// ReadI48BEBits reads an int64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI48BEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 6, b.order, false))
+	*out = *out << 16 >> 16
+}
+
+// ReadI48BEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI48BEBitsNext(out *int64) {
+	b.ReadI48BEBits(out, b.boff)
+	b.SeekBit(48, true)
+}
+
+// ReadI56LE reads a slice of int64s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadI32LE(out *[]int32, off, n int64) {
+func (b *MiniBuffer) ReadI56LE(out *[]int64, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = int32(b.buf[off+(i*4)]) | int32(b.buf[off+(1+(i*4))])<<8 | int32(b.buf[off+(2+(i*4))])<<16 | int32(b.buf[off+(3+(i*4))])<<24
+		(*out)[i] = int64(b.buf[off+(i*7)]) | int64(b.buf[off+(1+(i*7))])<<8 | int64(b.buf[off+(2+(i*7))])<<16 | int64(b.buf[off+(3+(i*7))])<<24 | int64(b.buf[off+(4+(i*7))])<<32 | int64(b.buf[off+(5+(i*7))])<<40 | int64(b.buf[off+(6+(i*7))])<<48
+		(*out)[i] = (*out)[i] << 8 >> 8
 		i++
 		if i < n {
 			goto read_loop
@@ -983,22 +4113,68 @@ func (b *MiniBuffer) ReadI32LE(out *[]int32, off, n int64) {
 	}
 }
 
-// ReadI32LENext reads a slice of int32s from the buffer at the
+// ReadI56LENext reads a slice of int64s from the buffer at the
 // current offset in little-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadI32LENext(out *[]int32, n int64) {
-	b.ReadI32LE(out, b.off, n)
-	b.SeekByte(n*4, true)
+func (b *MiniBuffer) ReadI56LENext(out *[]int64, n int64) {
+	b.ReadI56LE(out, b.off, n)
+	b.SeekByte(n*7, true)
 }
 
-// ReadI32BE reads a slice of int32s from the buffer at the
+// ReadI56LEAt reads an int64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI56LEAt(out *int64, off int64) {
+	*out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32 | int64(b.buf[off+5])<<40 | int64(b.buf[off+6])<<48
+	*out = *out << 8 >> 8
+}
+
+// ReadI56LEAtNext reads an int64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI56LEAtNext(out *int64) {
+	b.ReadI56LEAt(out, b.off)
+	b.SeekByte(7, true)
+}
+
+// ReadI56LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI56LEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32 | int64(b.buf[o+5])<<40 | int64(b.buf[o+6])<<48
+		dst[i] = dst[i] << 8 >> 8
+	}
+}
+
+// ReadI56LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI56LEIntoNext(dst []int64) {
+	b.ReadI56LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadI56LEBits reads an int64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI56LEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 7, b.order, true))
+	*out = *out << 8 >> 8
+}
+
+// ReadI56LEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI56LEBitsNext(out *int64) {
+	b.ReadI56LEBits(out, b.boff)
+	b.SeekBit(56, true)
+}
+
+// ReadI56BE reads a slice of int64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
-func (b *MiniBuffer) ReadI32BE(out *[]int32, off, n int64) {
+func (b *MiniBuffer) ReadI56BE(out *[]int64, off, n int64) {
 	i := int64(0)
 	{
 	read_loop:
-		(*out)[i] = int32(b.buf[off+(3+(i*4))]) | int32(b.buf[off+(2+(i*4))])<<8 | int32(b.buf[off+(1+(i*4))])<<16 | int32(b.buf[off+(i*4)])<<24
+		(*out)[i] = int64(b.buf[off+(6+(i*7))]) | int64(b.buf[off+(5+(i*7))])<<8 | int64(b.buf[off+(4+(i*7))])<<16 | int64(b.buf[off+(3+(i*7))])<<24 | int64(b.buf[off+(2+(i*7))])<<32 | int64(b.buf[off+(1+(i*7))])<<40 | int64(b.buf[off+(i*7)])<<48
+		(*out)[i] = (*out)[i] << 8 >> 8
 		i++
 		if i < n {
 			goto read_loop
@@ -1006,12 +4182,57 @@ func (b *MiniBuffer) ReadI32BE(out *[]int32, off, n int64) {
 	}
 }
 
-// ReadI32BENext reads a slice of int32s from the buffer at the
+// ReadI56BENext reads a slice of int64s from the buffer at the
 // current offset in big-endian and moves the offset forward the
 // amount of bytes written
-func (b *MiniBuffer) ReadI32BENext(out *[]int32, n int64) {
-	b.ReadI32BE(out, b.off, n)
-	b.SeekByte(n*4, true)
+func (b *MiniBuffer) ReadI56BENext(out *[]int64, n int64) {
+	b.ReadI56BE(out, b.off, n)
+	b.SeekByte(n*7, true)
+}
+
+// ReadI56BEAt reads an int64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI56BEAt(out *int64, off int64) {
+	*out = int64(b.buf[off])<<48 | int64(b.buf[off+1])<<40 | int64(b.buf[off+2])<<32 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<16 | int64(b.buf[off+5])<<8 | int64(b.buf[off+6])
+	*out = *out << 8 >> 8
+}
+
+// ReadI56BEAtNext reads an int64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI56BEAtNext(out *int64) {
+	b.ReadI56BEAt(out, b.off)
+	b.SeekByte(7, true)
+}
+
+// ReadI56BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI56BEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*7
+		dst[i] = int64(b.buf[o])<<48 | int64(b.buf[o+1])<<40 | int64(b.buf[o+2])<<32 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<16 | int64(b.buf[o+5])<<8 | int64(b.buf[o+6])
+		dst[i] = dst[i] << 8 >> 8
+	}
+}
+
+// ReadI56BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI56BEIntoNext(dst []int64) {
+	b.ReadI56BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*7, true)
+}
+
+// ReadI56BEBits reads an int64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI56BEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 7, b.order, false))
+	*out = *out << 8 >> 8
+}
+
+// ReadI56BEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI56BEBitsNext(out *int64) {
+	b.ReadI56BEBits(out, b.boff)
+	b.SeekBit(56, true)
 }
 
 // ReadI64LE reads a slice of int64s from the buffer at the
@@ -1037,6 +4258,48 @@ func (b *MiniBuffer) ReadI64LENext(out *[]int64, n int64) {
 	b.SeekByte(n*8, true)
 }
 
+// ReadI64LEAt reads an int64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI64LEAt(out *int64, off int64) {
+	*out = int64(b.buf[off]) | int64(b.buf[off+1])<<8 | int64(b.buf[off+2])<<16 | int64(b.buf[off+3])<<24 | int64(b.buf[off+4])<<32 | int64(b.buf[off+5])<<40 | int64(b.buf[off+6])<<48 | int64(b.buf[off+7])<<56
+}
+
+// ReadI64LEAtNext reads an int64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI64LEAtNext(out *int64) {
+	b.ReadI64LEAt(out, b.off)
+	b.SeekByte(8, true)
+}
+
+// ReadI64LEInto reads len(dst) int64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI64LEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = int64(b.buf[o]) | int64(b.buf[o+1])<<8 | int64(b.buf[o+2])<<16 | int64(b.buf[o+3])<<24 | int64(b.buf[o+4])<<32 | int64(b.buf[o+5])<<40 | int64(b.buf[o+6])<<48 | int64(b.buf[o+7])<<56
+	}
+}
+
+// ReadI64LEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI64LEIntoNext(dst []int64) {
+	b.ReadI64LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadI64LEBits reads an int64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI64LEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 8, b.order, true))
+}
+
+// ReadI64LEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI64LEBitsNext(out *int64) {
+	b.ReadI64LEBits(out, b.boff)
+	b.SeekBit(64, true)
+}
+
 // ReadI64BE reads a slice of int64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
@@ -1060,6 +4323,48 @@ func (b *MiniBuffer) ReadI64BENext(out *[]int64, n int64) {
 	b.SeekByte(n*8, true)
 }
 
+// ReadI64BEAt reads an int64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadI64BEAt(out *int64, off int64) {
+	*out = int64(b.buf[off])<<56 | int64(b.buf[off+1])<<48 | int64(b.buf[off+2])<<40 | int64(b.buf[off+3])<<32 | int64(b.buf[off+4])<<24 | int64(b.buf[off+5])<<16 | int64(b.buf[off+6])<<8 | int64(b.buf[off+7])
+}
+
+// ReadI64BEAtNext reads an int64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI64BEAtNext(out *int64) {
+	b.ReadI64BEAt(out, b.off)
+	b.SeekByte(8, true)
+}
+
+// ReadI64BEInto reads len(dst) int64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadI64BEInto(dst []int64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*8
+		dst[i] = int64(b.buf[o])<<56 | int64(b.buf[o+1])<<48 | int64(b.buf[o+2])<<40 | int64(b.buf[o+3])<<32 | int64(b.buf[o+4])<<24 | int64(b.buf[o+5])<<16 | int64(b.buf[o+6])<<8 | int64(b.buf[o+7])
+	}
+}
+
+// ReadI64BEIntoNext reads len(dst) int64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadI64BEIntoNext(dst []int64) {
+	b.ReadI64BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadI64BEBits reads an int64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadI64BEBits(out *int64, off int64) {
+	*out = int64(readBitsBytes(b.buf, off, 8, b.order, false))
+}
+
+// ReadI64BEBitsNext reads an int64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadI64BEBitsNext(out *int64) {
+	b.ReadI64BEBits(out, b.boff)
+	b.SeekBit(64, true)
+}
+
 // ReadF32LE reads a slice of float32s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
@@ -1085,6 +4390,51 @@ func (b *MiniBuffer) ReadF32LENext(out *[]float32, n int64) {
 	b.SeekByte(n*4, true)
 }
 
+// ReadF32LEAt reads a float32 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadF32LEAt(out *float32, off int64) {
+	u := uint32(b.buf[off]) | uint32(b.buf[off+1])<<8 | uint32(b.buf[off+2])<<16 | uint32(b.buf[off+3])<<24
+	*out = *(*float32)(unsafe.Pointer(&u))
+}
+
+// ReadF32LEAtNext reads a float32 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF32LEAtNext(out *float32) {
+	b.ReadF32LEAt(out, b.off)
+	b.SeekByte(4, true)
+}
+
+// ReadF32LEInto reads len(dst) float32s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadF32LEInto(dst []float32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*4
+		u := uint32(b.buf[o]) | uint32(b.buf[o+1])<<8 | uint32(b.buf[o+2])<<16 | uint32(b.buf[o+3])<<24
+		dst[i] = *(*float32)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF32LEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF32LEIntoNext(dst []float32) {
+	b.ReadF32LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadF32LEBits reads a float32 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadF32LEBits(out *float32, off int64) {
+	u := uint32(readBitsBytes(b.buf, off, 4, b.order, true))
+	*out = *(*float32)(unsafe.Pointer(&u))
+}
+
+// ReadF32LEBitsNext reads a float32 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadF32LEBitsNext(out *float32) {
+	b.ReadF32LEBits(out, b.boff)
+	b.SeekBit(32, true)
+}
+
 // ReadF32BE reads a slice of float32s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
@@ -1110,6 +4460,51 @@ func (b *MiniBuffer) ReadF32BENext(out *[]float32, n int64) {
 	b.SeekByte(n*4, true)
 }
 
+// ReadF32BEAt reads a float32 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadF32BEAt(out *float32, off int64) {
+	u := uint32(b.buf[off])<<24 | uint32(b.buf[off+1])<<16 | uint32(b.buf[off+2])<<8 | uint32(b.buf[off+3])
+	*out = *(*float32)(unsafe.Pointer(&u))
+}
+
+// ReadF32BEAtNext reads a float32 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF32BEAtNext(out *float32) {
+	b.ReadF32BEAt(out, b.off)
+	b.SeekByte(4, true)
+}
+
+// ReadF32BEInto reads len(dst) float32s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadF32BEInto(dst []float32, off int64) {
+	for i := range dst {
+		o := off + int64(i)*4
+		u := uint32(b.buf[o])<<24 | uint32(b.buf[o+1])<<16 | uint32(b.buf[o+2])<<8 | uint32(b.buf[o+3])
+		dst[i] = *(*float32)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF32BEIntoNext reads len(dst) float32s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF32BEIntoNext(dst []float32) {
+	b.ReadF32BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*4, true)
+}
+
+// ReadF32BEBits reads a float32 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadF32BEBits(out *float32, off int64) {
+	u := uint32(readBitsBytes(b.buf, off, 4, b.order, false))
+	*out = *(*float32)(unsafe.Pointer(&u))
+}
+
+// ReadF32BEBitsNext reads a float32 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadF32BEBitsNext(out *float32) {
+	b.ReadF32BEBits(out, b.boff)
+	b.SeekBit(32, true)
+}
+
 // ReadF64LE reads a slice of float64s from the buffer at the
 // specified offset in little-endian without modifying the internal
 // offset value
@@ -1135,6 +4530,51 @@ func (b *MiniBuffer) ReadF64LENext(out *[]float64, n int64) {
 	b.SeekByte(n*8, true)
 }
 
+// ReadF64LEAt reads a float64 into out from the buffer at the specified offset
+// in little-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadF64LEAt(out *float64, off int64) {
+	u := uint64(b.buf[off]) | uint64(b.buf[off+1])<<8 | uint64(b.buf[off+2])<<16 | uint64(b.buf[off+3])<<24 | uint64(b.buf[off+4])<<32 | uint64(b.buf[off+5])<<40 | uint64(b.buf[off+6])<<48 | uint64(b.buf[off+7])<<56
+	*out = *(*float64)(unsafe.Pointer(&u))
+}
+
+// ReadF64LEAtNext reads a float64 into out from the buffer at the current offset
+// in little-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF64LEAtNext(out *float64) {
+	b.ReadF64LEAt(out, b.off)
+	b.SeekByte(8, true)
+}
+
+// ReadF64LEInto reads len(dst) float64s from the buffer at the specified
+// offset in little-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadF64LEInto(dst []float64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*8
+		u := uint64(b.buf[o]) | uint64(b.buf[o+1])<<8 | uint64(b.buf[o+2])<<16 | uint64(b.buf[o+3])<<24 | uint64(b.buf[o+4])<<32 | uint64(b.buf[o+5])<<40 | uint64(b.buf[o+6])<<48 | uint64(b.buf[o+7])<<56
+		dst[i] = *(*float64)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF64LEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in little-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF64LEIntoNext(dst []float64) {
+	b.ReadF64LEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadF64LEBits reads a float64 into out from the buffer at the specified bit
+// offset in little-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadF64LEBits(out *float64, off int64) {
+	u := uint64(readBitsBytes(b.buf, off, 8, b.order, true))
+	*out = *(*float64)(unsafe.Pointer(&u))
+}
+
+// ReadF64LEBitsNext reads a float64 into out from the buffer at the current bit
+// offset in little-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadF64LEBitsNext(out *float64) {
+	b.ReadF64LEBits(out, b.boff)
+	b.SeekBit(64, true)
+}
+
 // ReadF64BE reads a slice of float64s from the buffer at the
 // specified offset in big-endian without modifying the internal
 // offset value
@@ -1160,6 +4600,51 @@ func (b *MiniBuffer) ReadF64BENext(out *[]float64, n int64) {
 	b.SeekByte(n*8, true)
 }
 
+// ReadF64BEAt reads a float64 into out from the buffer at the specified offset
+// in big-endian without modifying the internal offset value
+func (b *MiniBuffer) ReadF64BEAt(out *float64, off int64) {
+	u := uint64(b.buf[off])<<56 | uint64(b.buf[off+1])<<48 | uint64(b.buf[off+2])<<40 | uint64(b.buf[off+3])<<32 | uint64(b.buf[off+4])<<24 | uint64(b.buf[off+5])<<16 | uint64(b.buf[off+6])<<8 | uint64(b.buf[off+7])
+	*out = *(*float64)(unsafe.Pointer(&u))
+}
+
+// ReadF64BEAtNext reads a float64 into out from the buffer at the current offset
+// in big-endian and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF64BEAtNext(out *float64) {
+	b.ReadF64BEAt(out, b.off)
+	b.SeekByte(8, true)
+}
+
+// ReadF64BEInto reads len(dst) float64s from the buffer at the specified
+// offset in big-endian into dst without modifying the internal offset value
+func (b *MiniBuffer) ReadF64BEInto(dst []float64, off int64) {
+	for i := range dst {
+		o := off + int64(i)*8
+		u := uint64(b.buf[o])<<56 | uint64(b.buf[o+1])<<48 | uint64(b.buf[o+2])<<40 | uint64(b.buf[o+3])<<32 | uint64(b.buf[o+4])<<24 | uint64(b.buf[o+5])<<16 | uint64(b.buf[o+6])<<8 | uint64(b.buf[o+7])
+		dst[i] = *(*float64)(unsafe.Pointer(&u))
+	}
+}
+
+// ReadF64BEIntoNext reads len(dst) float64s from the buffer at the current
+// offset in big-endian into dst and moves the offset forward the amount of bytes read
+func (b *MiniBuffer) ReadF64BEIntoNext(dst []float64) {
+	b.ReadF64BEInto(dst, b.off)
+	b.SeekByte(int64(len(dst))*8, true)
+}
+
+// ReadF64BEBits reads a float64 into out from the buffer at the specified bit
+// offset in big-endian without modifying the internal bit offset value
+func (b *MiniBuffer) ReadF64BEBits(out *float64, off int64) {
+	u := uint64(readBitsBytes(b.buf, off, 8, b.order, false))
+	*out = *(*float64)(unsafe.Pointer(&u))
+}
+
+// ReadF64BEBitsNext reads a float64 into out from the buffer at the current bit
+// offset in big-endian and moves the bit offset forward the amount of bits read
+func (b *MiniBuffer) ReadF64BEBitsNext(out *float64) {
+	b.ReadF64BEBits(out, b.boff)
+	b.SeekBit(64, true)
+}
+
 // SeekByte seeks to position off of the buffer relative to the
 // current position or exact
 func (b *MiniBuffer) SeekByte(off int64, relative bool) {
@@ -1233,6 +4718,25 @@ func (b *MiniBuffer) Grow(n int64) {
 
 }
 
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the buffer. it defaults to MSBFirst
+func (b *MiniBuffer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// SetGrowth enables or disables automatic growth. while it is
+// enabled, writes past the end of the buffer grow it with Grow, as
+// long as it would not become longer than max bytes. a max of zero or
+// less means that the buffer may grow without limit
+func (b *MiniBuffer) SetGrowth(enabled bool, max int64) {
+
+	b.grow = enabled
+	b.gmax = max
+
+}
+
 // Refresh updates the cached internal statistics of the buffer forcefully
 func (b *MiniBuffer) Refresh() {
 
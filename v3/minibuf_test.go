@@ -1584,6 +1584,109 @@ func TestMiniBufferFlipAllBits(t *testing.T) {
 
 }
 
+func TestMiniBufferGrowth(t *testing.T) {
+
+	var (
+		expected = []byte{0x00, 0x01, 0x00, 0x02, 0x03, 0xe0}
+
+		buf *MiniBuffer
+		out []byte
+	)
+
+	NewMiniBuffer(&buf)
+	buf.SetGrowth(true, 0)
+
+	buf.WriteU16BENext([]uint16{0x01, 0x02})
+	buf.WriteBytesNext([]byte{0x03})
+	buf.SetBits(0x28, 0x07, 3)
+
+	buf.Bytes(&out)
+	if !cmp.Equal(expected, out) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+}
+
+func TestMiniBufferReadU32BEAt(t *testing.T) {
+
+	var expected uint32 = 0x01020304
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0x01, 0x02, 0x03, 0x04})
+
+	var out uint32
+	buf.ReadU32BEAt(&out, 0x01)
+	if expected != out {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %#v, expected %#v)", out, expected)
+
+	}
+
+	buf.SeekByte(0x01, false)
+	buf.ReadU32BEAtNext(&out)
+	if expected != out || buf.off != 5 {
+
+		t.Fatalf("expected uint32 does not match the one gotten (got %#v at offset %d, expected %#v at offset 5)", out, buf.off, expected)
+
+	}
+
+}
+
+func TestMiniBufferReadF32LEInto(t *testing.T) {
+
+	var expected = []float32{1.5, -2.5}
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0x00, 0xc0, 0x3f, 0x00, 0x00, 0x20, 0xc0})
+
+	out := make([]float32, 2)
+	buf.ReadF32LEIntoNext(out)
+	if !cmp.Equal(expected, out) || buf.off != 8 {
+
+		t.Fatalf("expected float32 array does not match the one gotten (got %#v at offset %d, expected %#v at offset 8)", out, buf.off, expected)
+
+	}
+
+}
+
+func TestMiniBufferPutI64LE(t *testing.T) {
+
+	var expected = []byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, make([]byte, 4))
+	buf.SetGrowth(true, 0)
+
+	buf.PutI64LENext(-2)
+	if !cmp.Equal(expected, buf.buf) || buf.off != 8 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d, expected %#v at offset 8)", buf.buf, buf.off, expected)
+
+	}
+
+}
+
+func TestMiniBufferReadI40BE(t *testing.T) {
+
+	var expected = []int64{-0x0100000000, 0x0102030405}
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, make([]byte, 10))
+
+	buf.WriteI40BE(0x00, expected)
+
+	out := make([]int64, 2)
+	buf.ReadI40BENext(&out, 2)
+	if !cmp.Equal(expected, out) || buf.off != 10 {
+
+		t.Fatalf("expected int64 array does not match the one gotten (got %#v at offset %d, expected %#v at offset 10)", out, buf.off, expected)
+
+	}
+
+}
+
 /*
 
 benchmarks
@@ -1769,3 +1872,38 @@ func BenchmarkMiniBufferGrow(b *testing.B) {
 	}
 
 }
+
+func BenchmarkMiniBufferPutU32LE(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+
+	for n := 0; n < b.N; n++ {
+
+		buf.PutU32LE(0x00, 0x01)
+		buf.PutU32LE(0x04, 0x02)
+
+	}
+
+}
+
+func BenchmarkMiniBufferReadU32LEAt(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+
+	var out uint32
+	for n := 0; n < b.N; n++ {
+
+		buf.ReadU32LEAt(&out, 0x00)
+		buf.ReadU32LEAt(&out, 0x04)
+
+	}
+
+	_ = out
+
+}
@@ -0,0 +1,205 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+// blankSlot holds the bytes a newly reserved slot is filled with
+var blankSlot [8]byte
+
+// Placeholder is a slot reserved in a Buffer by Reserve or ReserveNext
+// for a value that is only known later, usually the length of the
+// section that follows the slot. it is stored using one of the integer
+// types of StringPrefix and is written once Close or Fill is called.
+//
+// placeholders can be nested and closed in any order, except that one
+// can not be closed while a PrefixUvarint placeholder inside of its
+// section is still open. a PrefixUvarint slot starts out a single byte
+// long and is widened with Splice if the value needs more, which moves
+// everything after it, including the cursors and the slots of the
+// placeholders that are still open, and so changes the length of the
+// sections around it
+type Placeholder struct {
+	buf    *Buffer
+	prefix StringPrefix
+
+	// off is the offset of the slot, width is the amount of bytes it
+	// takes up and start is the offset of the section after it
+	off   int64
+	width int64
+	start int64
+
+	done bool
+}
+
+/* internal use methods */
+
+// fill writes value to the slot of the placeholder, widening it if it
+// is a varint that needs more bytes, and closes it
+func (p *Placeholder) fill(op string, value uint64) {
+
+	b := p.buf
+	if p.done || b.err != nil {
+
+		return
+
+	}
+
+	if value > p.prefix.limit() {
+
+		b.fail(BufferPlaceholderOverflowError.at(op, p.off, p.width, b.cap))
+		return
+
+	}
+
+	if width := p.prefix.width(value); width != p.width {
+
+		// the slots after this one move along with their sections
+		b.splice(op, p.off, p.width, make([]byte, width), p)
+		if b.err != nil {
+
+			return
+
+		}
+
+	}
+
+	if p.off+p.width > b.cap {
+
+		b.fail(BufferOverwriteError.at(op, p.off, p.width, b.cap))
+		return
+
+	}
+
+	b.save(p.off, p.width)
+	p.prefix.put(b.buf[p.off:], value)
+	p.done = true
+
+	for i, q := range b.slots {
+
+		if q == p {
+
+			b.slots = append(b.slots[:i], b.slots[i+1:]...)
+			break
+
+		}
+
+	}
+
+}
+
+/* Buffer */
+
+// Reserve reserves a slot for a value stored as prefix at the specified
+// offset without modifying the internal offset value. the slot is
+// zeroed until the returned placeholder is closed. the section that it
+// describes starts right after it
+func (b *Buffer) Reserve(off int64, prefix StringPrefix) (p *Placeholder) {
+
+	p = &Placeholder{
+		buf:    b,
+		prefix: prefix,
+		off:    off,
+		width:  prefix.width(0x00),
+		done:   true,
+	}
+	p.start = off + p.width
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	b.WriteBytes(off, blankSlot[:p.width])
+	if b.err != nil {
+
+		return
+
+	}
+
+	p.done = false
+	b.slots = append(b.slots, p)
+	return
+
+}
+
+// ReserveNext reserves a slot for a value stored as prefix at the
+// current offset and moves the offset forward past it, to the start of
+// the section that it describes
+func (b *Buffer) ReserveNext(prefix StringPrefix) (p *Placeholder) {
+
+	p = b.Reserve(b.cursor("ReserveNext"), prefix)
+	b.SeekByte(p.width, true)
+	return
+
+}
+
+/* Placeholder */
+
+// Close writes the amount of bytes between the end of the slot and the
+// current offset of the buffer to the slot, which is the length of the
+// section written after it. it fails if a PrefixUvarint placeholder in
+// the section is still open, as widening its slot would leave the length
+// out of date. closing a placeholder more than once does nothing
+func (p *Placeholder) Close() {
+
+	if p.done || p.buf.err != nil {
+
+		return
+
+	}
+
+	for _, q := range p.buf.slots {
+
+		if q.prefix == PrefixUvarint && q.off >= p.start && q.off < p.buf.off {
+
+			p.buf.fail(BufferPlaceholderOrderError.at("Close", p.off, p.width, p.buf.cap))
+			return
+
+		}
+
+	}
+
+	n := p.buf.off - p.start
+	if n < 0x00 {
+
+		p.buf.fail(BufferInvalidByteCountError.count("Close", n, p.buf.cap))
+		return
+
+	}
+	p.fill("Close", uint64(n))
+
+}
+
+// Fill writes value to the slot instead of the length of the section,
+// such as the offset of something written after the placeholder was
+// reserved, and closes it. as widening a varint slot moves everything
+// after it, offsets should be stored in fixed-width slots
+func (p *Placeholder) Fill(value uint64) {
+
+	p.fill("Fill", value)
+
+}
+
+// Offset returns the offset of the slot of the placeholder
+func (p *Placeholder) Offset() int64 {
+
+	return p.off
+
+}
+
+// Len returns the amount of bytes between the end of the slot and the
+// current offset of the buffer
+func (p *Placeholder) Len() int64 {
+
+	return p.buf.off - p.start
+
+}
@@ -0,0 +1,282 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestPlaceholder(t *testing.T) {
+
+	for _, c := range []struct {
+		prefix StringPrefix
+		bytes  []byte
+	}{
+		{PrefixU8, []byte{0x02, 0xaa, 0xbb}},
+		{PrefixU16LE, []byte{0x02, 0x00, 0xaa, 0xbb}},
+		{PrefixU16BE, []byte{0x00, 0x02, 0xaa, 0xbb}},
+		{PrefixU32LE, []byte{0x02, 0x00, 0x00, 0x00, 0xaa, 0xbb}},
+		{PrefixU32BE, []byte{0x00, 0x00, 0x00, 0x02, 0xaa, 0xbb}},
+		{PrefixU64LE, []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xaa, 0xbb}},
+		{PrefixU64BE, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xaa, 0xbb}},
+		{PrefixUvarint, []byte{0x02, 0xaa, 0xbb}},
+	} {
+
+		buf := NewBuffer([]byte{})
+		buf.SetGrowth(true, 0)
+
+		p := buf.ReserveNext(c.prefix)
+		buf.WriteBytesNext([]byte{0xaa, 0xbb})
+		if p.Len() != 2 {
+
+			t.Fatalf("expected length does not match the one gotten (got %d, expected 2)", p.Len())
+
+		}
+
+		p.Close()
+		if !cmp.Equal(c.bytes, buf.Bytes()) {
+
+			t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), c.bytes)
+
+		}
+
+	}
+
+}
+
+func TestPlaceholderNested(t *testing.T) {
+
+	buf := NewBuffer([]byte{})
+	buf.SetGrowth(true, 0)
+
+	outer := buf.ReserveNext(PrefixU16BE)
+	inner := buf.ReserveNext(PrefixUvarint)
+	buf.WriteBytesNext(make([]byte, 200))
+	sibling := buf.ReserveNext(PrefixU8)
+
+	// widening the varint slot moves the cursor and the slots after it
+	inner.Fill(200)
+	if buf.ByteOffset() != 205 || sibling.Offset() != 204 {
+
+		t.Fatalf("unexpected state (got offset %d and slot at %d)", buf.ByteOffset(), sibling.Offset())
+
+	}
+
+	sibling.Fill(0x7f)
+	outer.Close()
+
+	expected := append(append([]byte{0x00, 0xcb, 0xc8, 0x01}, make([]byte, 200)...), 0x7f)
+	if !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	// closing twice does nothing
+	buf.WriteByteNext(0xff)
+	outer.Close()
+	if buf.ReadU16BEAt(0x00) != 0xcb || len(buf.slots) != 0x00 {
+
+		t.Fatalf("placeholder was closed twice (got %#v)", buf.Bytes()[:2])
+
+	}
+
+}
+
+func TestPlaceholderOrder(t *testing.T) {
+
+	buf := NewBuffer([]byte{})
+	buf.SetGrowth(true, 0)
+	buf.SetSticky(true)
+
+	outer := buf.ReserveNext(PrefixUvarint)
+	inner := buf.ReserveNext(PrefixUvarint)
+	buf.WriteBytesNext(make([]byte, 200))
+
+	// the inner slot is widened once it is closed, so the outer one can
+	// not be closed before it
+	outer.Close()
+	if !errors.Is(buf.Err(), BufferPlaceholderOrderError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", buf.Err(), BufferPlaceholderOrderError)
+
+	}
+
+	buf.ClearErr()
+	inner.Close()
+	outer.Close()
+
+	expected := append([]byte{0xca, 0x01, 0xc8, 0x01}, make([]byte, 200)...)
+	if buf.Err() != nil || !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, %v, expected %#v)", buf.Bytes()[:4], buf.Err(), expected[:4])
+
+	}
+
+	// varint slots outside of the section do not matter
+	buf.Reset()
+	buf.SetGrowth(true, 0)
+	before := buf.ReserveNext(PrefixUvarint)
+	sized := buf.ReserveNext(PrefixU8)
+	buf.WriteBytesNext(make([]byte, 200))
+	sized.Close()
+	buf.WriteBytesNext(make([]byte, 200))
+	before.Close()
+	if buf.Err() != nil || buf.Bytes()[2] != 200 {
+
+		t.Fatalf("unexpected state (got %v and %#v)", buf.Err(), buf.Bytes()[:3])
+
+	}
+
+}
+
+func TestPlaceholderRollback(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01, 0x02})
+	buf.SetGrowth(true, 0)
+	buf.SeekByte(0x02, false)
+
+	m := buf.Mark()
+	p := buf.ReserveNext(PrefixUvarint)
+	buf.WriteBytesNext(make([]byte, 300))
+	p.Close()
+	buf.Rollback(m)
+
+	if !cmp.Equal([]byte{0x01, 0x02}, buf.Bytes()) || buf.ByteOffset() != 0x02 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d)", buf.Bytes(), buf.ByteOffset())
+
+	}
+
+}
+
+func TestPlaceholderInsert(t *testing.T) {
+
+	buf := NewBuffer([]byte{})
+	buf.SetGrowth(true, 0)
+
+	p := buf.ReserveNext(PrefixU8)
+	buf.WriteBytesNext([]byte{0x01, 0x02, 0x03})
+
+	// the slot moves forward along with everything after it
+	buf.Insert(0x00, []byte{0xaa})
+	p.Close()
+	if expected := []byte{0xaa, 0x03, 0x01, 0x02, 0x03}; !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+}
+
+func TestPlaceholderRollbackSlots(t *testing.T) {
+
+	buf := NewBuffer([]byte{})
+	buf.SetGrowth(true, 0)
+	buf.SetSticky(true)
+
+	outer := buf.ReserveNext(PrefixU16BE)
+	sibling := buf.ReserveNext(PrefixU8)
+
+	// the varint placeholder reserved after the mark is abandoned, so it
+	// does not keep the outer one from being closed, while the one that
+	// was closed after it is reopened
+	m := buf.Mark()
+	inner := buf.ReserveNext(PrefixUvarint)
+	buf.WriteBytesNext(make([]byte, 200))
+	sibling.Close()
+	buf.Rollback(m)
+
+	buf.WriteBytesNext([]byte{0x01, 0x02})
+	sibling.Close()
+	outer.Close()
+	inner.Close()
+
+	if expected := []byte{0x00, 0x03, 0x02, 0x01, 0x02}; buf.Err() != nil || !cmp.Equal(expected, buf.Bytes()) || len(buf.slots) != 0x00 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, %v, expected %#v)", buf.Bytes(), buf.Err(), expected)
+
+	}
+
+}
+
+func TestPlaceholderErrors(t *testing.T) {
+
+	for i, c := range []struct {
+		op       func(*Buffer)
+		expected Error
+	}{
+		{func(b *Buffer) { p := b.ReserveNext(PrefixU8); b.WriteBytesNext(make([]byte, 256)); p.Close() }, BufferPlaceholderOverflowError},
+		{func(b *Buffer) { b.ReserveNext(PrefixU16LE).Fill(0x10000) }, BufferPlaceholderOverflowError},
+		{func(b *Buffer) { p := b.ReserveNext(PrefixU8); b.SeekByte(0x00, false); p.Close() }, BufferInvalidByteCountError},
+		{func(b *Buffer) { b.Reserve(-0x01, PrefixU32BE) }, BufferUnderwriteError},
+	} {
+
+		buf := NewBuffer([]byte{})
+		buf.SetGrowth(true, 0)
+		buf.SetSticky(true)
+
+		c.op(buf)
+		if !errors.Is(buf.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, buf.Err(), c.expected)
+
+		}
+
+	}
+
+	// a placeholder that could not be reserved does nothing
+	buf := NewBuffer([]byte{})
+	buf.SetSticky(true)
+
+	p := buf.ReserveNext(PrefixU32LE)
+	buf.ClearErr()
+	p.Close()
+	if buf.Err() != nil || buf.ByteCapacity() != 0x00 {
+
+		t.Fatalf("unexpected state (got %#v and %v)", buf.Bytes(), buf.Err())
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkPlaceholder(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 64))
+
+	for n := 0; n < b.N; n++ {
+
+		buf.SeekByte(0x00, false)
+		p := buf.ReserveNext(PrefixU32BE)
+		buf.PutU64LENext(0x00)
+		p.Close()
+
+	}
+
+}
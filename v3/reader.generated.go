@@ -0,0 +1,1600 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"io"
+	"math"
+)
+
+// defaultReaderSize is the size of the window of a Reader created with
+// NewReader
+const defaultReaderSize = 4096
+
+// Reader implements a streaming decoder in go that reads data from an
+// io.Reader through a window that is refilled on demand, so that input
+// of any length can be decoded using a bounded amount of memory. it
+// only moves forward, and its byte and bit operations share a single
+// position as they do in a Buffer using UnifiedCursor.
+//
+// instead of panicking, a Reader records the first error it runs into
+// and every following call becomes a no-op that returns zero values,
+// like a Buffer in sticky mode. running out of input before a value
+// starts records io.EOF, while running out partway through one records
+// io.ErrUnexpectedEOF. the window only grows as input arrives, so a
+// hostile length fails with an error once the input ends instead of
+// being allocated up front
+type Reader struct {
+	r io.Reader
+
+	// buf holds the window. the unread part of it is buf[head:tail]
+	buf  []byte
+	head int
+	tail int
+
+	// off is the amount of bytes consumed before the window and bit is
+	// the bit offset within the byte at head
+	off int64
+	bit int64
+
+	err error
+
+	order BitOrder
+
+	// scratch is the buffer the typed methods operate on
+	scratch Buffer
+}
+
+// NewReader initializes a new Reader that reads from r with a window of
+// the default size
+func NewReader(r io.Reader) *Reader {
+
+	return NewReaderSize(r, defaultReaderSize)
+
+}
+
+// NewReaderSize initializes a new Reader that reads from r with a
+// window of at least size bytes. the window only grows past size if a
+// single read needs more than that
+func NewReaderSize(r io.Reader, size int) *Reader {
+
+	if size < 16 {
+
+		size = 16
+
+	}
+
+	return &Reader{
+		r:   r,
+		buf: make([]byte, size),
+	}
+
+}
+
+/* internal use methods */
+
+// fill makes sure that at least n unread bytes are in the window,
+// recording an error if the input ends before it is possible
+func (b *Reader) fill(n int) bool {
+
+	if b.tail-b.head >= n {
+
+		return true
+
+	}
+
+	// move the unread bytes to the start of the window
+	b.tail = copy(b.buf, b.buf[b.head:b.tail])
+	b.off += int64(b.head)
+	b.head = 0x00
+
+	for b.tail < n {
+
+		// the window is doubled each time it fills up rather than grown
+		// to n at once, so that it never gets much larger than the input
+		if b.tail == len(b.buf) {
+
+			size := n
+			if len(b.buf) < n/2 {
+
+				size = len(b.buf) * 2
+
+			}
+
+			buf := make([]byte, size)
+			copy(buf, b.buf[:b.tail])
+			b.buf = buf
+
+		}
+
+		want := n
+		if want > len(b.buf) {
+
+			want = len(b.buf)
+
+		}
+
+		m, err := io.ReadAtLeast(b.r, b.buf[b.tail:], want-b.tail)
+		b.tail += m
+		if err != nil {
+
+			// the input ending within a value is always unexpected
+			if err == io.EOF && b.tail > 0x00 {
+
+				err = io.ErrUnexpectedEOF
+
+			}
+			b.err = err
+			return false
+
+		}
+
+	}
+	return true
+
+}
+
+// skip consumes the next n bytes of the input a window at a time,
+// returning whether or not they could be read
+func (b *Reader) skip(n int64) bool {
+
+	for n > 0x00 {
+
+		chunk := n
+		if chunk > int64(len(b.buf)) {
+
+			chunk = int64(len(b.buf))
+
+		}
+
+		if !b.fill(int(chunk)) {
+
+			return false
+
+		}
+		b.head += int(chunk)
+		n -= chunk
+
+	}
+	return true
+
+}
+
+// align moves the position to the next byte boundary if it is not on
+// one already
+func (b *Reader) align() {
+
+	if b.bit > 0x00 {
+
+		b.head++
+		b.bit = 0x00
+
+	}
+
+}
+
+// window consumes the next n values of size bytes each and returns the
+// scratch buffer pointed at them, or nil if they could not be read
+func (b *Reader) window(op string, n, size int64) *Buffer {
+
+	if b.err != nil {
+
+		return nil
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count(op, n, b.ByteOffset())
+		return nil
+
+	}
+
+	// a length that does not fit in an int could never be read anyway
+	if n > math.MaxInt/size {
+
+		b.err = BufferOverreadError.at(op, b.ByteOffset(), n, b.ByteOffset())
+		return nil
+
+	}
+	n *= size
+
+	b.align()
+	if !b.fill(int(n)) {
+
+		return nil
+
+	}
+
+	b.scratch.buf = b.buf[b.head : b.head+int(n) : b.head+int(n)]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+	b.head += int(n)
+	return &b.scratch
+
+}
+
+// bitWindow is the bit-level variant of window. it consumes the next n
+// bits of the input and returns the scratch buffer pointed at the
+// bytes containing them, along with the offset of the first of them
+// within it
+func (b *Reader) bitWindow(op string, n int64) (*Buffer, int64) {
+
+	if b.err != nil {
+
+		return nil, 0x00
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count(op, n, b.ByteOffset())
+		return nil, 0x00
+
+	}
+
+	if n/8 > math.MaxInt-1 {
+
+		b.err = BufferOverreadError.atBit(op, b.BitOffset(), n, b.BitOffset())
+		return nil, 0x00
+
+	}
+
+	// n is split up so that adding the bit offset to it cannot overflow
+	length := int(n/8 + (b.bit+n%8+7)/8)
+	if !b.fill(length) {
+
+		return nil, 0x00
+
+	}
+
+	b.scratch.buf = b.buf[b.head : b.head+length : b.head+length]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+
+	bit := b.bit
+	b.head += int(n/8 + (b.bit+n%8)/8)
+	b.bit = (b.bit + n%8) % 8
+	return &b.scratch, bit
+
+}
+
+/* bitfield methods */
+
+// ReadBitNext returns the next bit and moves the position forward a bit
+func (b *Reader) ReadBitNext() (out byte) {
+
+	if w, bit := b.bitWindow("ReadBitNext", 1); w != nil {
+
+		out = w.ReadBit(bit)
+
+	}
+	return
+
+}
+
+// ReadBitsNext returns the next n bits and moves the position forward
+// the amount of bits read
+func (b *Reader) ReadBitsNext(n int64) (out uint64) {
+
+	if w, bit := b.bitWindow("ReadBitsNext", n); w != nil {
+
+		out = w.ReadBits(bit, n)
+
+	}
+	return
+
+}
+
+// SkipBits moves the position forward n bits without reading them
+func (b *Reader) SkipBits(n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count("SkipBits", n, b.ByteOffset())
+		return
+
+	}
+
+	// n is split up so that adding the bit offset to it cannot overflow
+	bit := b.bit + n%8
+	b.bit = 0x00
+	if b.skip(n/8+bit/8) && bit%8 > 0x00 && b.fill(1) {
+
+		b.bit = bit % 8
+
+	}
+
+}
+
+// AlignByte moves the position forward to the next byte boundary if it
+// is not on one already
+func (b *Reader) AlignByte() {
+
+	if b.err != nil {
+
+		return
+
+	}
+	b.align()
+
+}
+
+/* byte buffer methods */
+
+// ReadBytesNext returns the next n bytes and moves the position forward
+// the amount of bytes read. the returned slice points into the window
+// of the reader, so it is only valid until the next call
+func (b *Reader) ReadBytesNext(n int64) (out []byte) {
+
+	if w := b.window("ReadBytesNext", n, 1); w != nil {
+
+		out = w.buf
+
+	}
+	return
+
+}
+
+// ReadByteNext returns the next byte and moves the position forward a
+// byte
+func (b *Reader) ReadByteNext() (out byte) {
+
+	if w := b.window("ReadByteNext", 1, 1); w != nil {
+
+		out = w.buf[0]
+
+	}
+	return
+
+}
+
+// ReadU16LENext reads a slice of uint16s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU16LENext(n int64) (out []uint16) {
+	if w := b.window("ReadU16LENext", n, 2); w != nil && n > 0 {
+		out = w.ReadU16LE(0, n)
+	}
+	return
+}
+
+// ReadU16LEAtNext reads a uint16 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU16LEAtNext() (out uint16) {
+	if w := b.window("ReadU16LEAtNext", 1, 2); w != nil {
+		out = w.ReadU16LEAt(0)
+	}
+	return
+}
+
+// ReadU16LEIntoNext reads len(dst) uint16s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU16LEIntoNext(dst []uint16) {
+	if w := b.window("ReadU16LEIntoNext", int64(len(dst)), 2); w != nil {
+		w.ReadU16LEInto(dst, 0)
+	}
+}
+
+// ReadU16LEBitsNext reads a uint16 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU16LEBitsNext() (out uint16) {
+	if w, bit := b.bitWindow("ReadU16LEBitsNext", 16); w != nil {
+		out = w.ReadU16LEBits(bit)
+	}
+	return
+}
+
+// ReadU16BENext reads a slice of uint16s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU16BENext(n int64) (out []uint16) {
+	if w := b.window("ReadU16BENext", n, 2); w != nil && n > 0 {
+		out = w.ReadU16BE(0, n)
+	}
+	return
+}
+
+// ReadU16BEAtNext reads a uint16 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU16BEAtNext() (out uint16) {
+	if w := b.window("ReadU16BEAtNext", 1, 2); w != nil {
+		out = w.ReadU16BEAt(0)
+	}
+	return
+}
+
+// ReadU16BEIntoNext reads len(dst) uint16s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU16BEIntoNext(dst []uint16) {
+	if w := b.window("ReadU16BEIntoNext", int64(len(dst)), 2); w != nil {
+		w.ReadU16BEInto(dst, 0)
+	}
+}
+
+// ReadU16BEBitsNext reads a uint16 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU16BEBitsNext() (out uint16) {
+	if w, bit := b.bitWindow("ReadU16BEBitsNext", 16); w != nil {
+		out = w.ReadU16BEBits(bit)
+	}
+	return
+}
+
+// ReadU24LENext reads a slice of uint32s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU24LENext(n int64) (out []uint32) {
+	if w := b.window("ReadU24LENext", n, 3); w != nil && n > 0 {
+		out = w.ReadU24LE(0, n)
+	}
+	return
+}
+
+// ReadU24LEAtNext reads a uint32 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU24LEAtNext() (out uint32) {
+	if w := b.window("ReadU24LEAtNext", 1, 3); w != nil {
+		out = w.ReadU24LEAt(0)
+	}
+	return
+}
+
+// ReadU24LEIntoNext reads len(dst) uint32s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU24LEIntoNext(dst []uint32) {
+	if w := b.window("ReadU24LEIntoNext", int64(len(dst)), 3); w != nil {
+		w.ReadU24LEInto(dst, 0)
+	}
+}
+
+// ReadU24LEBitsNext reads a uint32 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU24LEBitsNext() (out uint32) {
+	if w, bit := b.bitWindow("ReadU24LEBitsNext", 24); w != nil {
+		out = w.ReadU24LEBits(bit)
+	}
+	return
+}
+
+// ReadU24BENext reads a slice of uint32s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU24BENext(n int64) (out []uint32) {
+	if w := b.window("ReadU24BENext", n, 3); w != nil && n > 0 {
+		out = w.ReadU24BE(0, n)
+	}
+	return
+}
+
+// ReadU24BEAtNext reads a uint32 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU24BEAtNext() (out uint32) {
+	if w := b.window("ReadU24BEAtNext", 1, 3); w != nil {
+		out = w.ReadU24BEAt(0)
+	}
+	return
+}
+
+// ReadU24BEIntoNext reads len(dst) uint32s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU24BEIntoNext(dst []uint32) {
+	if w := b.window("ReadU24BEIntoNext", int64(len(dst)), 3); w != nil {
+		w.ReadU24BEInto(dst, 0)
+	}
+}
+
+// ReadU24BEBitsNext reads a uint32 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU24BEBitsNext() (out uint32) {
+	if w, bit := b.bitWindow("ReadU24BEBitsNext", 24); w != nil {
+		out = w.ReadU24BEBits(bit)
+	}
+	return
+}
+
+// ReadU32LENext reads a slice of uint32s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU32LENext(n int64) (out []uint32) {
+	if w := b.window("ReadU32LENext", n, 4); w != nil && n > 0 {
+		out = w.ReadU32LE(0, n)
+	}
+	return
+}
+
+// ReadU32LEAtNext reads a uint32 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU32LEAtNext() (out uint32) {
+	if w := b.window("ReadU32LEAtNext", 1, 4); w != nil {
+		out = w.ReadU32LEAt(0)
+	}
+	return
+}
+
+// ReadU32LEIntoNext reads len(dst) uint32s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU32LEIntoNext(dst []uint32) {
+	if w := b.window("ReadU32LEIntoNext", int64(len(dst)), 4); w != nil {
+		w.ReadU32LEInto(dst, 0)
+	}
+}
+
+// ReadU32LEBitsNext reads a uint32 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU32LEBitsNext() (out uint32) {
+	if w, bit := b.bitWindow("ReadU32LEBitsNext", 32); w != nil {
+		out = w.ReadU32LEBits(bit)
+	}
+	return
+}
+
+// ReadU32BENext reads a slice of uint32s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU32BENext(n int64) (out []uint32) {
+	if w := b.window("ReadU32BENext", n, 4); w != nil && n > 0 {
+		out = w.ReadU32BE(0, n)
+	}
+	return
+}
+
+// ReadU32BEAtNext reads a uint32 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU32BEAtNext() (out uint32) {
+	if w := b.window("ReadU32BEAtNext", 1, 4); w != nil {
+		out = w.ReadU32BEAt(0)
+	}
+	return
+}
+
+// ReadU32BEIntoNext reads len(dst) uint32s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU32BEIntoNext(dst []uint32) {
+	if w := b.window("ReadU32BEIntoNext", int64(len(dst)), 4); w != nil {
+		w.ReadU32BEInto(dst, 0)
+	}
+}
+
+// ReadU32BEBitsNext reads a uint32 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU32BEBitsNext() (out uint32) {
+	if w, bit := b.bitWindow("ReadU32BEBitsNext", 32); w != nil {
+		out = w.ReadU32BEBits(bit)
+	}
+	return
+}
+
+// ReadU40LENext reads a slice of uint64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU40LENext(n int64) (out []uint64) {
+	if w := b.window("ReadU40LENext", n, 5); w != nil && n > 0 {
+		out = w.ReadU40LE(0, n)
+	}
+	return
+}
+
+// ReadU40LEAtNext reads a uint64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU40LEAtNext() (out uint64) {
+	if w := b.window("ReadU40LEAtNext", 1, 5); w != nil {
+		out = w.ReadU40LEAt(0)
+	}
+	return
+}
+
+// ReadU40LEIntoNext reads len(dst) uint64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU40LEIntoNext(dst []uint64) {
+	if w := b.window("ReadU40LEIntoNext", int64(len(dst)), 5); w != nil {
+		w.ReadU40LEInto(dst, 0)
+	}
+}
+
+// ReadU40LEBitsNext reads a uint64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU40LEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU40LEBitsNext", 40); w != nil {
+		out = w.ReadU40LEBits(bit)
+	}
+	return
+}
+
+// ReadU40BENext reads a slice of uint64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU40BENext(n int64) (out []uint64) {
+	if w := b.window("ReadU40BENext", n, 5); w != nil && n > 0 {
+		out = w.ReadU40BE(0, n)
+	}
+	return
+}
+
+// ReadU40BEAtNext reads a uint64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU40BEAtNext() (out uint64) {
+	if w := b.window("ReadU40BEAtNext", 1, 5); w != nil {
+		out = w.ReadU40BEAt(0)
+	}
+	return
+}
+
+// ReadU40BEIntoNext reads len(dst) uint64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU40BEIntoNext(dst []uint64) {
+	if w := b.window("ReadU40BEIntoNext", int64(len(dst)), 5); w != nil {
+		w.ReadU40BEInto(dst, 0)
+	}
+}
+
+// ReadU40BEBitsNext reads a uint64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU40BEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU40BEBitsNext", 40); w != nil {
+		out = w.ReadU40BEBits(bit)
+	}
+	return
+}
+
+// ReadU48LENext reads a slice of uint64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU48LENext(n int64) (out []uint64) {
+	if w := b.window("ReadU48LENext", n, 6); w != nil && n > 0 {
+		out = w.ReadU48LE(0, n)
+	}
+	return
+}
+
+// ReadU48LEAtNext reads a uint64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU48LEAtNext() (out uint64) {
+	if w := b.window("ReadU48LEAtNext", 1, 6); w != nil {
+		out = w.ReadU48LEAt(0)
+	}
+	return
+}
+
+// ReadU48LEIntoNext reads len(dst) uint64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU48LEIntoNext(dst []uint64) {
+	if w := b.window("ReadU48LEIntoNext", int64(len(dst)), 6); w != nil {
+		w.ReadU48LEInto(dst, 0)
+	}
+}
+
+// ReadU48LEBitsNext reads a uint64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU48LEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU48LEBitsNext", 48); w != nil {
+		out = w.ReadU48LEBits(bit)
+	}
+	return
+}
+
+// ReadU48BENext reads a slice of uint64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU48BENext(n int64) (out []uint64) {
+	if w := b.window("ReadU48BENext", n, 6); w != nil && n > 0 {
+		out = w.ReadU48BE(0, n)
+	}
+	return
+}
+
+// ReadU48BEAtNext reads a uint64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU48BEAtNext() (out uint64) {
+	if w := b.window("ReadU48BEAtNext", 1, 6); w != nil {
+		out = w.ReadU48BEAt(0)
+	}
+	return
+}
+
+// ReadU48BEIntoNext reads len(dst) uint64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU48BEIntoNext(dst []uint64) {
+	if w := b.window("ReadU48BEIntoNext", int64(len(dst)), 6); w != nil {
+		w.ReadU48BEInto(dst, 0)
+	}
+}
+
+// ReadU48BEBitsNext reads a uint64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU48BEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU48BEBitsNext", 48); w != nil {
+		out = w.ReadU48BEBits(bit)
+	}
+	return
+}
+
+// ReadU56LENext reads a slice of uint64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU56LENext(n int64) (out []uint64) {
+	if w := b.window("ReadU56LENext", n, 7); w != nil && n > 0 {
+		out = w.ReadU56LE(0, n)
+	}
+	return
+}
+
+// ReadU56LEAtNext reads a uint64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU56LEAtNext() (out uint64) {
+	if w := b.window("ReadU56LEAtNext", 1, 7); w != nil {
+		out = w.ReadU56LEAt(0)
+	}
+	return
+}
+
+// ReadU56LEIntoNext reads len(dst) uint64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU56LEIntoNext(dst []uint64) {
+	if w := b.window("ReadU56LEIntoNext", int64(len(dst)), 7); w != nil {
+		w.ReadU56LEInto(dst, 0)
+	}
+}
+
+// ReadU56LEBitsNext reads a uint64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU56LEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU56LEBitsNext", 56); w != nil {
+		out = w.ReadU56LEBits(bit)
+	}
+	return
+}
+
+// ReadU56BENext reads a slice of uint64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU56BENext(n int64) (out []uint64) {
+	if w := b.window("ReadU56BENext", n, 7); w != nil && n > 0 {
+		out = w.ReadU56BE(0, n)
+	}
+	return
+}
+
+// ReadU56BEAtNext reads a uint64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU56BEAtNext() (out uint64) {
+	if w := b.window("ReadU56BEAtNext", 1, 7); w != nil {
+		out = w.ReadU56BEAt(0)
+	}
+	return
+}
+
+// ReadU56BEIntoNext reads len(dst) uint64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU56BEIntoNext(dst []uint64) {
+	if w := b.window("ReadU56BEIntoNext", int64(len(dst)), 7); w != nil {
+		w.ReadU56BEInto(dst, 0)
+	}
+}
+
+// ReadU56BEBitsNext reads a uint64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU56BEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU56BEBitsNext", 56); w != nil {
+		out = w.ReadU56BEBits(bit)
+	}
+	return
+}
+
+// ReadU64LENext reads a slice of uint64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU64LENext(n int64) (out []uint64) {
+	if w := b.window("ReadU64LENext", n, 8); w != nil && n > 0 {
+		out = w.ReadU64LE(0, n)
+	}
+	return
+}
+
+// ReadU64LEAtNext reads a uint64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU64LEAtNext() (out uint64) {
+	if w := b.window("ReadU64LEAtNext", 1, 8); w != nil {
+		out = w.ReadU64LEAt(0)
+	}
+	return
+}
+
+// ReadU64LEIntoNext reads len(dst) uint64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU64LEIntoNext(dst []uint64) {
+	if w := b.window("ReadU64LEIntoNext", int64(len(dst)), 8); w != nil {
+		w.ReadU64LEInto(dst, 0)
+	}
+}
+
+// ReadU64LEBitsNext reads a uint64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU64LEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU64LEBitsNext", 64); w != nil {
+		out = w.ReadU64LEBits(bit)
+	}
+	return
+}
+
+// ReadU64BENext reads a slice of uint64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU64BENext(n int64) (out []uint64) {
+	if w := b.window("ReadU64BENext", n, 8); w != nil && n > 0 {
+		out = w.ReadU64BE(0, n)
+	}
+	return
+}
+
+// ReadU64BEAtNext reads a uint64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadU64BEAtNext() (out uint64) {
+	if w := b.window("ReadU64BEAtNext", 1, 8); w != nil {
+		out = w.ReadU64BEAt(0)
+	}
+	return
+}
+
+// ReadU64BEIntoNext reads len(dst) uint64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadU64BEIntoNext(dst []uint64) {
+	if w := b.window("ReadU64BEIntoNext", int64(len(dst)), 8); w != nil {
+		w.ReadU64BEInto(dst, 0)
+	}
+}
+
+// ReadU64BEBitsNext reads a uint64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadU64BEBitsNext() (out uint64) {
+	if w, bit := b.bitWindow("ReadU64BEBitsNext", 64); w != nil {
+		out = w.ReadU64BEBits(bit)
+	}
+	return
+}
+
+// ReadI16LENext reads a slice of int16s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI16LENext(n int64) (out []int16) {
+	if w := b.window("ReadI16LENext", n, 2); w != nil && n > 0 {
+		out = w.ReadI16LE(0, n)
+	}
+	return
+}
+
+// ReadI16LEAtNext reads an int16 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI16LEAtNext() (out int16) {
+	if w := b.window("ReadI16LEAtNext", 1, 2); w != nil {
+		out = w.ReadI16LEAt(0)
+	}
+	return
+}
+
+// ReadI16LEIntoNext reads len(dst) int16s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI16LEIntoNext(dst []int16) {
+	if w := b.window("ReadI16LEIntoNext", int64(len(dst)), 2); w != nil {
+		w.ReadI16LEInto(dst, 0)
+	}
+}
+
+// ReadI16LEBitsNext reads an int16 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI16LEBitsNext() (out int16) {
+	if w, bit := b.bitWindow("ReadI16LEBitsNext", 16); w != nil {
+		out = w.ReadI16LEBits(bit)
+	}
+	return
+}
+
+// ReadI16BENext reads a slice of int16s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI16BENext(n int64) (out []int16) {
+	if w := b.window("ReadI16BENext", n, 2); w != nil && n > 0 {
+		out = w.ReadI16BE(0, n)
+	}
+	return
+}
+
+// ReadI16BEAtNext reads an int16 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI16BEAtNext() (out int16) {
+	if w := b.window("ReadI16BEAtNext", 1, 2); w != nil {
+		out = w.ReadI16BEAt(0)
+	}
+	return
+}
+
+// ReadI16BEIntoNext reads len(dst) int16s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI16BEIntoNext(dst []int16) {
+	if w := b.window("ReadI16BEIntoNext", int64(len(dst)), 2); w != nil {
+		w.ReadI16BEInto(dst, 0)
+	}
+}
+
+// ReadI16BEBitsNext reads an int16 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI16BEBitsNext() (out int16) {
+	if w, bit := b.bitWindow("ReadI16BEBitsNext", 16); w != nil {
+		out = w.ReadI16BEBits(bit)
+	}
+	return
+}
+
+// ReadI24LENext reads a slice of int32s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI24LENext(n int64) (out []int32) {
+	if w := b.window("ReadI24LENext", n, 3); w != nil && n > 0 {
+		out = w.ReadI24LE(0, n)
+	}
+	return
+}
+
+// ReadI24LEAtNext reads an int32 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI24LEAtNext() (out int32) {
+	if w := b.window("ReadI24LEAtNext", 1, 3); w != nil {
+		out = w.ReadI24LEAt(0)
+	}
+	return
+}
+
+// ReadI24LEIntoNext reads len(dst) int32s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI24LEIntoNext(dst []int32) {
+	if w := b.window("ReadI24LEIntoNext", int64(len(dst)), 3); w != nil {
+		w.ReadI24LEInto(dst, 0)
+	}
+}
+
+// ReadI24LEBitsNext reads an int32 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI24LEBitsNext() (out int32) {
+	if w, bit := b.bitWindow("ReadI24LEBitsNext", 24); w != nil {
+		out = w.ReadI24LEBits(bit)
+	}
+	return
+}
+
+// ReadI24BENext reads a slice of int32s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI24BENext(n int64) (out []int32) {
+	if w := b.window("ReadI24BENext", n, 3); w != nil && n > 0 {
+		out = w.ReadI24BE(0, n)
+	}
+	return
+}
+
+// ReadI24BEAtNext reads an int32 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI24BEAtNext() (out int32) {
+	if w := b.window("ReadI24BEAtNext", 1, 3); w != nil {
+		out = w.ReadI24BEAt(0)
+	}
+	return
+}
+
+// ReadI24BEIntoNext reads len(dst) int32s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI24BEIntoNext(dst []int32) {
+	if w := b.window("ReadI24BEIntoNext", int64(len(dst)), 3); w != nil {
+		w.ReadI24BEInto(dst, 0)
+	}
+}
+
+// ReadI24BEBitsNext reads an int32 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI24BEBitsNext() (out int32) {
+	if w, bit := b.bitWindow("ReadI24BEBitsNext", 24); w != nil {
+		out = w.ReadI24BEBits(bit)
+	}
+	return
+}
+
+// ReadI32LENext reads a slice of int32s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI32LENext(n int64) (out []int32) {
+	if w := b.window("ReadI32LENext", n, 4); w != nil && n > 0 {
+		out = w.ReadI32LE(0, n)
+	}
+	return
+}
+
+// ReadI32LEAtNext reads an int32 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI32LEAtNext() (out int32) {
+	if w := b.window("ReadI32LEAtNext", 1, 4); w != nil {
+		out = w.ReadI32LEAt(0)
+	}
+	return
+}
+
+// ReadI32LEIntoNext reads len(dst) int32s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI32LEIntoNext(dst []int32) {
+	if w := b.window("ReadI32LEIntoNext", int64(len(dst)), 4); w != nil {
+		w.ReadI32LEInto(dst, 0)
+	}
+}
+
+// ReadI32LEBitsNext reads an int32 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI32LEBitsNext() (out int32) {
+	if w, bit := b.bitWindow("ReadI32LEBitsNext", 32); w != nil {
+		out = w.ReadI32LEBits(bit)
+	}
+	return
+}
+
+// ReadI32BENext reads a slice of int32s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI32BENext(n int64) (out []int32) {
+	if w := b.window("ReadI32BENext", n, 4); w != nil && n > 0 {
+		out = w.ReadI32BE(0, n)
+	}
+	return
+}
+
+// ReadI32BEAtNext reads an int32 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI32BEAtNext() (out int32) {
+	if w := b.window("ReadI32BEAtNext", 1, 4); w != nil {
+		out = w.ReadI32BEAt(0)
+	}
+	return
+}
+
+// ReadI32BEIntoNext reads len(dst) int32s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI32BEIntoNext(dst []int32) {
+	if w := b.window("ReadI32BEIntoNext", int64(len(dst)), 4); w != nil {
+		w.ReadI32BEInto(dst, 0)
+	}
+}
+
+// ReadI32BEBitsNext reads an int32 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI32BEBitsNext() (out int32) {
+	if w, bit := b.bitWindow("ReadI32BEBitsNext", 32); w != nil {
+		out = w.ReadI32BEBits(bit)
+	}
+	return
+}
+
+// ReadI40LENext reads a slice of int64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI40LENext(n int64) (out []int64) {
+	if w := b.window("ReadI40LENext", n, 5); w != nil && n > 0 {
+		out = w.ReadI40LE(0, n)
+	}
+	return
+}
+
+// ReadI40LEAtNext reads an int64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI40LEAtNext() (out int64) {
+	if w := b.window("ReadI40LEAtNext", 1, 5); w != nil {
+		out = w.ReadI40LEAt(0)
+	}
+	return
+}
+
+// ReadI40LEIntoNext reads len(dst) int64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI40LEIntoNext(dst []int64) {
+	if w := b.window("ReadI40LEIntoNext", int64(len(dst)), 5); w != nil {
+		w.ReadI40LEInto(dst, 0)
+	}
+}
+
+// ReadI40LEBitsNext reads an int64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI40LEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI40LEBitsNext", 40); w != nil {
+		out = w.ReadI40LEBits(bit)
+	}
+	return
+}
+
+// ReadI40BENext reads a slice of int64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI40BENext(n int64) (out []int64) {
+	if w := b.window("ReadI40BENext", n, 5); w != nil && n > 0 {
+		out = w.ReadI40BE(0, n)
+	}
+	return
+}
+
+// ReadI40BEAtNext reads an int64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI40BEAtNext() (out int64) {
+	if w := b.window("ReadI40BEAtNext", 1, 5); w != nil {
+		out = w.ReadI40BEAt(0)
+	}
+	return
+}
+
+// ReadI40BEIntoNext reads len(dst) int64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI40BEIntoNext(dst []int64) {
+	if w := b.window("ReadI40BEIntoNext", int64(len(dst)), 5); w != nil {
+		w.ReadI40BEInto(dst, 0)
+	}
+}
+
+// ReadI40BEBitsNext reads an int64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI40BEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI40BEBitsNext", 40); w != nil {
+		out = w.ReadI40BEBits(bit)
+	}
+	return
+}
+
+// ReadI48LENext reads a slice of int64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI48LENext(n int64) (out []int64) {
+	if w := b.window("ReadI48LENext", n, 6); w != nil && n > 0 {
+		out = w.ReadI48LE(0, n)
+	}
+	return
+}
+
+// ReadI48LEAtNext reads an int64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI48LEAtNext() (out int64) {
+	if w := b.window("ReadI48LEAtNext", 1, 6); w != nil {
+		out = w.ReadI48LEAt(0)
+	}
+	return
+}
+
+// ReadI48LEIntoNext reads len(dst) int64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI48LEIntoNext(dst []int64) {
+	if w := b.window("ReadI48LEIntoNext", int64(len(dst)), 6); w != nil {
+		w.ReadI48LEInto(dst, 0)
+	}
+}
+
+// ReadI48LEBitsNext reads an int64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI48LEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI48LEBitsNext", 48); w != nil {
+		out = w.ReadI48LEBits(bit)
+	}
+	return
+}
+
+// ReadI48BENext reads a slice of int64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI48BENext(n int64) (out []int64) {
+	if w := b.window("ReadI48BENext", n, 6); w != nil && n > 0 {
+		out = w.ReadI48BE(0, n)
+	}
+	return
+}
+
+// ReadI48BEAtNext reads an int64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI48BEAtNext() (out int64) {
+	if w := b.window("ReadI48BEAtNext", 1, 6); w != nil {
+		out = w.ReadI48BEAt(0)
+	}
+	return
+}
+
+// ReadI48BEIntoNext reads len(dst) int64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI48BEIntoNext(dst []int64) {
+	if w := b.window("ReadI48BEIntoNext", int64(len(dst)), 6); w != nil {
+		w.ReadI48BEInto(dst, 0)
+	}
+}
+
+// ReadI48BEBitsNext reads an int64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI48BEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI48BEBitsNext", 48); w != nil {
+		out = w.ReadI48BEBits(bit)
+	}
+	return
+}
+
+// ReadI56LENext reads a slice of int64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI56LENext(n int64) (out []int64) {
+	if w := b.window("ReadI56LENext", n, 7); w != nil && n > 0 {
+		out = w.ReadI56LE(0, n)
+	}
+	return
+}
+
+// ReadI56LEAtNext reads an int64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI56LEAtNext() (out int64) {
+	if w := b.window("ReadI56LEAtNext", 1, 7); w != nil {
+		out = w.ReadI56LEAt(0)
+	}
+	return
+}
+
+// ReadI56LEIntoNext reads len(dst) int64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI56LEIntoNext(dst []int64) {
+	if w := b.window("ReadI56LEIntoNext", int64(len(dst)), 7); w != nil {
+		w.ReadI56LEInto(dst, 0)
+	}
+}
+
+// ReadI56LEBitsNext reads an int64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI56LEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI56LEBitsNext", 56); w != nil {
+		out = w.ReadI56LEBits(bit)
+	}
+	return
+}
+
+// ReadI56BENext reads a slice of int64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI56BENext(n int64) (out []int64) {
+	if w := b.window("ReadI56BENext", n, 7); w != nil && n > 0 {
+		out = w.ReadI56BE(0, n)
+	}
+	return
+}
+
+// ReadI56BEAtNext reads an int64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI56BEAtNext() (out int64) {
+	if w := b.window("ReadI56BEAtNext", 1, 7); w != nil {
+		out = w.ReadI56BEAt(0)
+	}
+	return
+}
+
+// ReadI56BEIntoNext reads len(dst) int64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI56BEIntoNext(dst []int64) {
+	if w := b.window("ReadI56BEIntoNext", int64(len(dst)), 7); w != nil {
+		w.ReadI56BEInto(dst, 0)
+	}
+}
+
+// ReadI56BEBitsNext reads an int64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI56BEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI56BEBitsNext", 56); w != nil {
+		out = w.ReadI56BEBits(bit)
+	}
+	return
+}
+
+// ReadI64LENext reads a slice of int64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI64LENext(n int64) (out []int64) {
+	if w := b.window("ReadI64LENext", n, 8); w != nil && n > 0 {
+		out = w.ReadI64LE(0, n)
+	}
+	return
+}
+
+// ReadI64LEAtNext reads an int64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI64LEAtNext() (out int64) {
+	if w := b.window("ReadI64LEAtNext", 1, 8); w != nil {
+		out = w.ReadI64LEAt(0)
+	}
+	return
+}
+
+// ReadI64LEIntoNext reads len(dst) int64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI64LEIntoNext(dst []int64) {
+	if w := b.window("ReadI64LEIntoNext", int64(len(dst)), 8); w != nil {
+		w.ReadI64LEInto(dst, 0)
+	}
+}
+
+// ReadI64LEBitsNext reads an int64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI64LEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI64LEBitsNext", 64); w != nil {
+		out = w.ReadI64LEBits(bit)
+	}
+	return
+}
+
+// ReadI64BENext reads a slice of int64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI64BENext(n int64) (out []int64) {
+	if w := b.window("ReadI64BENext", n, 8); w != nil && n > 0 {
+		out = w.ReadI64BE(0, n)
+	}
+	return
+}
+
+// ReadI64BEAtNext reads an int64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadI64BEAtNext() (out int64) {
+	if w := b.window("ReadI64BEAtNext", 1, 8); w != nil {
+		out = w.ReadI64BEAt(0)
+	}
+	return
+}
+
+// ReadI64BEIntoNext reads len(dst) int64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadI64BEIntoNext(dst []int64) {
+	if w := b.window("ReadI64BEIntoNext", int64(len(dst)), 8); w != nil {
+		w.ReadI64BEInto(dst, 0)
+	}
+}
+
+// ReadI64BEBitsNext reads an int64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadI64BEBitsNext() (out int64) {
+	if w, bit := b.bitWindow("ReadI64BEBitsNext", 64); w != nil {
+		out = w.ReadI64BEBits(bit)
+	}
+	return
+}
+
+// ReadF32LENext reads a slice of float32s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF32LENext(n int64) (out []float32) {
+	if w := b.window("ReadF32LENext", n, 4); w != nil && n > 0 {
+		out = w.ReadF32LE(0, n)
+	}
+	return
+}
+
+// ReadF32LEAtNext reads a float32 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF32LEAtNext() (out float32) {
+	if w := b.window("ReadF32LEAtNext", 1, 4); w != nil {
+		out = w.ReadF32LEAt(0)
+	}
+	return
+}
+
+// ReadF32LEIntoNext reads len(dst) float32s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadF32LEIntoNext(dst []float32) {
+	if w := b.window("ReadF32LEIntoNext", int64(len(dst)), 4); w != nil {
+		w.ReadF32LEInto(dst, 0)
+	}
+}
+
+// ReadF32LEBitsNext reads a float32 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadF32LEBitsNext() (out float32) {
+	if w, bit := b.bitWindow("ReadF32LEBitsNext", 32); w != nil {
+		out = w.ReadF32LEBits(bit)
+	}
+	return
+}
+
+// ReadF32BENext reads a slice of float32s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF32BENext(n int64) (out []float32) {
+	if w := b.window("ReadF32BENext", n, 4); w != nil && n > 0 {
+		out = w.ReadF32BE(0, n)
+	}
+	return
+}
+
+// ReadF32BEAtNext reads a float32 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF32BEAtNext() (out float32) {
+	if w := b.window("ReadF32BEAtNext", 1, 4); w != nil {
+		out = w.ReadF32BEAt(0)
+	}
+	return
+}
+
+// ReadF32BEIntoNext reads len(dst) float32s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadF32BEIntoNext(dst []float32) {
+	if w := b.window("ReadF32BEIntoNext", int64(len(dst)), 4); w != nil {
+		w.ReadF32BEInto(dst, 0)
+	}
+}
+
+// ReadF32BEBitsNext reads a float32 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadF32BEBitsNext() (out float32) {
+	if w, bit := b.bitWindow("ReadF32BEBitsNext", 32); w != nil {
+		out = w.ReadF32BEBits(bit)
+	}
+	return
+}
+
+// ReadF64LENext reads a slice of float64s from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF64LENext(n int64) (out []float64) {
+	if w := b.window("ReadF64LENext", n, 8); w != nil && n > 0 {
+		out = w.ReadF64LE(0, n)
+	}
+	return
+}
+
+// ReadF64LEAtNext reads a float64 from the input in
+// little-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF64LEAtNext() (out float64) {
+	if w := b.window("ReadF64LEAtNext", 1, 8); w != nil {
+		out = w.ReadF64LEAt(0)
+	}
+	return
+}
+
+// ReadF64LEIntoNext reads len(dst) float64s from the input in
+// little-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadF64LEIntoNext(dst []float64) {
+	if w := b.window("ReadF64LEIntoNext", int64(len(dst)), 8); w != nil {
+		w.ReadF64LEInto(dst, 0)
+	}
+}
+
+// ReadF64LEBitsNext reads a float64 from the input in
+// little-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadF64LEBitsNext() (out float64) {
+	if w, bit := b.bitWindow("ReadF64LEBitsNext", 64); w != nil {
+		out = w.ReadF64LEBits(bit)
+	}
+	return
+}
+
+// ReadF64BENext reads a slice of float64s from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF64BENext(n int64) (out []float64) {
+	if w := b.window("ReadF64BENext", n, 8); w != nil && n > 0 {
+		out = w.ReadF64BE(0, n)
+	}
+	return
+}
+
+// ReadF64BEAtNext reads a float64 from the input in
+// big-endian and moves the position forward the amount of bytes read
+func (b *Reader) ReadF64BEAtNext() (out float64) {
+	if w := b.window("ReadF64BEAtNext", 1, 8); w != nil {
+		out = w.ReadF64BEAt(0)
+	}
+	return
+}
+
+// ReadF64BEIntoNext reads len(dst) float64s from the input in
+// big-endian into dst and moves the position forward the amount of bytes read
+func (b *Reader) ReadF64BEIntoNext(dst []float64) {
+	if w := b.window("ReadF64BEIntoNext", int64(len(dst)), 8); w != nil {
+		w.ReadF64BEInto(dst, 0)
+	}
+}
+
+// ReadF64BEBitsNext reads a float64 from the input in
+// big-endian at the current bit position and moves it forward the amount of bits read
+func (b *Reader) ReadF64BEBitsNext() (out float64) {
+	if w, bit := b.bitWindow("ReadF64BEBitsNext", 64); w != nil {
+		out = w.ReadF64BEBits(bit)
+	}
+	return
+}
+
+// SkipBytes moves the position forward n bytes, starting at the next
+// byte boundary, without reading them. the skipped bytes are discarded
+// from the window as they are read, so skipping does not grow it
+func (b *Reader) SkipBytes(n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count("SkipBytes", n, b.ByteOffset())
+		return
+
+	}
+
+	b.align()
+	b.skip(n)
+
+}
+
+// Read implements io.Reader. it reads up to len(p) bytes, starting at
+// the next byte boundary, into p and moves the position forward the
+// amount of bytes read
+func (b *Reader) Read(p []byte) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	b.align()
+	if b.head == b.tail {
+
+		// large reads skip the window entirely
+		if len(p) >= len(b.buf) {
+
+			n, err = b.r.Read(p)
+			b.off += int64(n)
+			return
+
+		}
+
+		if !b.fill(1) {
+
+			return 0, b.err
+
+		}
+
+	}
+
+	n = copy(p, b.buf[b.head:b.tail])
+	b.head += n
+	return
+
+}
+
+// Reset discards the state of the reader and makes it read from r
+func (b *Reader) Reset(r io.Reader) {
+
+	b.r = r
+	b.head = 0x00
+	b.tail = 0x00
+	b.off = 0x00
+	b.bit = 0x00
+	b.err = nil
+
+}
+
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the reader. it defaults to MSBFirst
+func (b *Reader) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// Buffered returns the amount of bytes that have been read from the
+// underlying io.Reader but not consumed yet
+func (b *Reader) Buffered() int {
+
+	return b.tail - b.head
+
+}
+
+// ByteOffset returns the amount of bytes consumed so far, counting a
+// partially consumed byte
+func (b *Reader) ByteOffset() int64 {
+
+	if b.bit > 0x00 {
+
+		return b.off + int64(b.head) + 1
+
+	}
+	return b.off + int64(b.head)
+
+}
+
+// BitOffset returns the amount of bits consumed so far
+func (b *Reader) BitOffset() int64 {
+
+	return (b.off+int64(b.head))*8 + b.bit
+
+}
+
+// Err returns the error recorded by the reader, if any
+func (b *Reader) Err() error {
+
+	return b.err
+
+}
@@ -0,0 +1,256 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+// countingBytes returns n bytes counting up from zero
+func countingBytes(n int) (out []byte) {
+
+	out = make([]byte, n)
+	for i := range out {
+
+		out[i] = byte(i)
+
+	}
+	return
+
+}
+
+/*
+
+tests
+
+*/
+
+func TestReader(t *testing.T) {
+
+	data := countingBytes(256)
+
+	// the smallest window is used so that most reads refill it
+	r := NewReaderSize(iotest.HalfReader(bytes.NewReader(data)), 0)
+	ref := NewBuffer(data)
+	ref.SetCursorMode(UnifiedCursor)
+
+	for i := 0; i < 8; i++ {
+
+		if out, expected := r.ReadU32LEAtNext(), ref.ReadU32LEAtNext(); out != expected {
+
+			t.Fatalf("iteration %d: expected value does not match the one gotten (got %#v, expected %#v)", i, out, expected)
+
+		}
+
+		if out, expected := r.ReadI24BENext(3), ref.ReadI24BENext(3); !cmp.Equal(expected, out) {
+
+			t.Fatalf("iteration %d: expected values do not match the ones gotten (got %#v, expected %#v)", i, out, expected)
+
+		}
+
+		if out, expected := r.ReadBitsNext(5), ref.ReadBitsNext(5); out != expected {
+
+			t.Fatalf("iteration %d: expected bits do not match the ones gotten (got %#v, expected %#v)", i, out, expected)
+
+		}
+
+		if out, expected := r.ReadU16BEBitsNext(), ref.ReadU16BEBitsNext(); out != expected {
+
+			t.Fatalf("iteration %d: expected value does not match the one gotten (got %#v, expected %#v)", i, out, expected)
+
+		}
+
+		// byte reads start at the next byte boundary
+		if out, expected := r.ReadBytesNext(2), ref.ReadBytesNext(2); !cmp.Equal(expected, out) {
+
+			t.Fatalf("iteration %d: expected byte array does not match the one gotten (got %#v, expected %#v)", i, out, expected)
+
+		}
+
+		if r.ByteOffset() != ref.ByteOffset() || r.BitOffset() != ref.BitOffset() {
+
+			t.Fatalf("iteration %d: incorrect offsets (got %d and %d, expected %d and %d)", i, r.ByteOffset(), r.BitOffset(), ref.ByteOffset(), ref.BitOffset())
+
+		}
+
+	}
+
+	if r.Err() != nil {
+
+		t.Fatalf("unexpected error: %v", r.Err())
+
+	}
+
+}
+
+func TestReaderLargeRead(t *testing.T) {
+
+	data := countingBytes(100)
+
+	r := NewReaderSize(bytes.NewReader(data), 16)
+	r.ReadByteNext()
+
+	// reads larger than the window grow it
+	if out := r.ReadBytesNext(64); !cmp.Equal(data[1:65], out) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out, data[1:65])
+
+	}
+
+	// skips do not
+	r.SkipBytes(30)
+	if out := r.ReadF32LENext(1); r.Err() != nil || len(out) != 1 || r.ByteOffset() != 99 {
+
+		t.Fatalf("unexpected result (got %#v and %v at offset %d)", out, r.Err(), r.ByteOffset())
+
+	}
+
+}
+
+func TestReaderSkipBits(t *testing.T) {
+
+	r := NewReader(bytes.NewReader([]byte{0x00, 0x00, 0x0f, 0xff}))
+
+	r.SkipBits(3)
+	r.SkipBits(17)
+	if out := r.ReadBitsNext(4); out != 0x0f || r.BitOffset() != 24 {
+
+		t.Fatalf("expected bits do not match the ones gotten (got %#v at bit offset %d, expected %#v)", out, r.BitOffset(), 0x0f)
+
+	}
+
+}
+
+func TestReaderEOF(t *testing.T) {
+
+	for i, c := range []struct {
+		bytes    []byte
+		op       func(*Reader)
+		expected error
+	}{
+		{[]byte{}, func(r *Reader) { r.ReadU32BEAtNext() }, io.EOF},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadU32BEAtNext() }, io.ErrUnexpectedEOF},
+		{[]byte{0x00}, func(r *Reader) { r.ReadBitsNext(8); r.ReadBitNext() }, io.EOF},
+		{[]byte{0x00}, func(r *Reader) { r.ReadBitsNext(4); r.ReadBitsNext(8) }, io.ErrUnexpectedEOF},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadU16LEIntoNext(make([]uint16, 2)) }, io.ErrUnexpectedEOF},
+		{make([]byte, 40), func(r *Reader) { r.SkipBytes(41) }, io.ErrUnexpectedEOF},
+		{[]byte{0x00}, func(r *Reader) { r.ReadBytesNext(-1) }, BufferInvalidByteCountError},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadBytesNext(math.MaxInt64) }, io.ErrUnexpectedEOF},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadU32LENext(math.MaxInt64 / 2) }, BufferOverreadError},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadU64BENext(1 << 61) }, BufferOverreadError},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadBitNext(); r.ReadBitsNext(math.MaxInt64) }, io.ErrUnexpectedEOF},
+		{[]byte{0x00, 0x00}, func(r *Reader) { r.ReadBitsNext(3); r.SkipBits(math.MaxInt64 - 2) }, io.ErrUnexpectedEOF},
+	} {
+
+		r := NewReaderSize(bytes.NewReader(c.bytes), 16)
+
+		c.op(r)
+		if !errors.Is(r.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, r.Err(), c.expected)
+
+		}
+
+	}
+
+}
+
+func TestReaderErrors(t *testing.T) {
+
+	failure := errors.New("failure")
+
+	r := NewReader(iotest.TimeoutReader(bytes.NewReader(make([]byte, 8))))
+	r.ReadBytesNext(8)
+	r.ReadByteNext()
+	if !errors.Is(r.Err(), iotest.ErrTimeout) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", r.Err(), iotest.ErrTimeout)
+
+	}
+
+	// every following call is a no-op
+	r.Reset(iotest.ErrReader(failure))
+	r.ReadByteNext()
+	if out := r.ReadU16BEAtNext(); out != 0x00 || !errors.Is(r.Err(), failure) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", r.Err(), failure)
+
+	}
+
+}
+
+func TestReaderIO(t *testing.T) {
+
+	data := countingBytes(5000)
+
+	err := iotest.TestReader(NewReaderSize(bytes.NewReader(data), 64), data)
+	if err != nil {
+
+		t.Fatal(err)
+
+	}
+
+	// reads start at the next byte boundary
+	r := NewReader(bytes.NewReader(data))
+	r.ReadBitNext()
+
+	out := make([]byte, 2)
+	if n, err := r.Read(out); n != 2 || err != nil || !cmp.Equal(data[1:3], out) {
+
+		t.Fatalf("unexpected result (got %#v with %d bytes and %v)", out, n, err)
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkReaderReadU32LEAtNext(b *testing.B) {
+
+	b.ReportAllocs()
+
+	data := make([]byte, 4096)
+	source := bytes.NewReader(data)
+	r := NewReader(source)
+
+	var out uint32
+	for n := 0; n < b.N; n++ {
+
+		if r.Buffered() == 0x00 {
+
+			source.Reset(data)
+
+		}
+		out = r.ReadU32LEAtNext()
+
+	}
+
+	_ = out
+
+}
@@ -0,0 +1,165 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+/* Buffer */
+
+// Slice returns a buffer that views the n bytes of the buffer at the
+// specified offset without copying them or modifying the internal
+// offset value. the view has its own offsets, which start at the
+// beginning of the window, and every check it makes is confined to
+// the window. writes to the view are visible in the buffer and the
+// other way around. the view inherits the bit order, cursor mode and
+// sticky mode of the buffer, but does not grow unless SetGrowth is
+// called on it, in which case growing it copies the window
+func (b *Buffer) Slice(off, n int64) (out *Buffer) {
+
+	out = &Buffer{
+		buf:    []byte{},
+		sticky: b.sticky,
+		order:  b.order,
+		cmode:  b.cmode,
+	}
+
+	if b.err != nil {
+
+		out.err = b.err
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("Slice", n, b.cap))
+		out.err = b.err
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("Slice", off, n, b.cap))
+		out.err = b.err
+		return
+
+	}
+
+	if n > b.cap-off {
+
+		b.fail(BufferOverreadError.at("Slice", off, n, b.cap))
+		out.err = b.err
+		return
+
+	}
+
+	// the capacity of the window is limited so that growing the view
+	// by hand reallocates it instead of overwriting the bytes after it
+	out.buf = b.buf[off : off+n : off+n]
+	out.Refresh()
+	return
+
+}
+
+// SliceNext returns a view of the next n bytes of the buffer from the
+// current offset and moves the offset forward past them
+func (b *Buffer) SliceNext(n int64) (out *Buffer) {
+
+	out = b.Slice(b.cursor("SliceNext"), n)
+	b.SeekByte(n, true)
+	return
+
+}
+
+/* CheckedBuffer */
+
+// Slice returns a buffer that views the n bytes of the buffer at the
+// specified offset without copying them or modifying the internal
+// offset value
+func (b *CheckedBuffer) Slice(off, n int64) (out *CheckedBuffer, err error) {
+
+	if err = b.buf.err; err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		err = BufferInvalidByteCountError.count("Slice", n, b.buf.cap)
+		return
+
+	}
+
+	if off < 0x00 {
+
+		err = BufferUnderreadError.at("Slice", off, n, b.buf.cap)
+		return
+
+	}
+
+	if n > b.buf.cap-off {
+
+		err = BufferOverreadError.at("Slice", off, n, b.buf.cap)
+		return
+
+	}
+
+	out = b.buf.Slice(off, n).Checked()
+	return
+
+}
+
+// SliceNext returns a view of the next n bytes of the buffer from the
+// current offset and moves the offset forward past them
+func (b *CheckedBuffer) SliceNext(n int64) (out *CheckedBuffer, err error) {
+
+	if err = b.buf.unaligned("SliceNext"); err != nil {
+
+		return
+
+	}
+
+	out, err = b.Slice(b.buf.off, n)
+	if err == nil {
+
+		b.buf.SeekByte(n, true)
+
+	}
+	return
+
+}
+
+/* MiniBuffer */
+
+// Slice stores a buffer that views the n bytes of the buffer at the
+// specified offset without copying them or modifying the internal
+// offset value in out. the view has its own offsets, which start at
+// the beginning of the window, and inherits the bit order of the
+// buffer, but does not grow unless SetGrowth is called on it
+func (b *MiniBuffer) Slice(out **MiniBuffer, off, n int64) {
+
+	*out = &MiniBuffer{
+		buf:   b.buf[off : off+n : off+n],
+		order: b.order,
+	}
+	(*out).Refresh()
+
+}
+
+// SliceNext stores a view of the next n bytes of the buffer from the
+// current offset in out and moves the offset forward past them
+func (b *MiniBuffer) SliceNext(out **MiniBuffer, n int64) {
+
+	b.Slice(out, b.off, n)
+	b.SeekByte(n, true)
+
+}
@@ -0,0 +1,236 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferSlice(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05})
+	buf.SeekByte(0x01, false)
+
+	view := buf.Slice(0x02, 3)
+	if !cmp.Equal([]byte{0x02, 0x03, 0x04}, view.Bytes()) || view.ByteCapacity() != 3 || view.BitCapacity() != 24 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", view.Bytes(), []byte{0x02, 0x03, 0x04})
+
+	}
+
+	if buf.ByteOffset() != 0x01 || view.ByteOffset() != 0x00 {
+
+		t.Fatalf("offsets were not independent (buffer at %d, view at %d)", buf.ByteOffset(), view.ByteOffset())
+
+	}
+
+	// the view shares its bytes with the buffer
+	view.WriteByteNext(0xff)
+	buf.WriteByte(0x03, 0xee)
+	if buf.ReadByte(0x02) != 0xff || view.ReadByteNext() != 0xee {
+
+		t.Fatalf("view does not share its bytes with the buffer (got %#v and %#v)", buf.Bytes(), view.Bytes())
+
+	}
+
+	// a view of a view is relative to the window it is made from
+	if out := view.Slice(0x01, 2).ReadByte(0x01); out != 0x04 {
+
+		t.Fatalf("expected byte does not match the one gotten (got %#v, expected %#v)", out, 0x04)
+
+	}
+
+}
+
+func TestBufferSliceNext(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x02, 0xaa, 0xbb, 0x01, 0xcc})
+
+	var chunks [][]byte
+	for buf.ByteOffset() < buf.ByteCapacity() {
+
+		chunk := buf.SliceNext(int64(buf.ReadByteNext()))
+		chunks = append(chunks, chunk.ReadBytesNext(chunk.ByteCapacity()))
+		if chunk.ByteOffset() != chunk.ByteCapacity() {
+
+			t.Fatalf("chunk offset was not moved (got %d)", chunk.ByteOffset())
+
+		}
+
+	}
+
+	if expected := [][]byte{{0xaa, 0xbb}, {0xcc}}; !cmp.Equal(expected, chunks) {
+
+		t.Fatalf("expected chunks do not match the ones gotten (got %#v, expected %#v)", chunks, expected)
+
+	}
+
+}
+
+func TestBufferSliceBounds(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03})
+
+	// reads and writes past the end of the window fail even though the
+	// buffer is larger
+	view := buf.Slice(0x01, 2)
+	view.SetSticky(true)
+	view.ReadBytes(0x01, 2)
+	if !errors.Is(view.Err(), BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", view.Err(), BufferOverreadError)
+
+	}
+
+	view = buf.Slice(0x01, 2)
+	view.SetSticky(true)
+	view.WriteBytes(0x01, []byte{0xff, 0xff})
+	if !errors.Is(view.Err(), BufferOverwriteError) || !cmp.Equal([]byte{0x00, 0x01, 0x02, 0x03}, buf.Bytes()) {
+
+		t.Fatalf("write past the end of the view succeeded (got %v, %#v)", view.Err(), buf.Bytes())
+
+	}
+
+	// growing the view copies it instead of overwriting the bytes after it
+	view = buf.Slice(0x01, 2)
+	view.SetGrowth(true, 0)
+	view.WriteBytes(0x01, []byte{0xff, 0xff})
+	if !cmp.Equal([]byte{0x01, 0xff, 0xff}, view.Bytes()) || !cmp.Equal([]byte{0x00, 0x01, 0x02, 0x03}, buf.Bytes()) {
+
+		t.Fatalf("growing the view overwrote the buffer (got %#v and %#v)", view.Bytes(), buf.Bytes())
+
+	}
+
+	for i, c := range []struct {
+		off      int64
+		n        int64
+		expected Error
+	}{
+		{0x03, 2, BufferOverreadError},
+		{-0x01, 2, BufferUnderreadError},
+		{0x00, -1, BufferInvalidByteCountError},
+		{0x01, math.MaxInt64, BufferOverreadError},
+	} {
+
+		buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03})
+		buf.SetSticky(true)
+
+		view := buf.Slice(c.off, c.n)
+		if !errors.Is(buf.Err(), c.expected) || !errors.Is(view.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v and %v, expected %v)", i, buf.Err(), view.Err(), c.expected)
+
+		}
+
+	}
+
+}
+
+func TestBufferSlicePanic(t *testing.T) {
+
+	defer panicChecker(t, BufferOverreadError.at("Slice", 0x02, 3, 4))
+
+	buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03})
+	buf.Slice(0x02, 3)
+
+}
+
+func TestCheckedBufferSlice(t *testing.T) {
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x01, 0x02, 0x03})
+
+	view, err := buf.SliceNext(2)
+	if err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if out, err := view.ReadBytes(0x01, 2); !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v with %#v, expected %v)", err, out, BufferOverreadError)
+
+	}
+
+	if _, err := buf.SliceNext(3); !errors.Is(err, BufferOverreadError) || buf.buf.ByteOffset() != 2 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v at offset %d, expected %v)", err, buf.buf.ByteOffset(), BufferOverreadError)
+
+	}
+
+	if _, err := buf.Slice(0x01, math.MaxInt64); !errors.Is(err, BufferOverreadError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverreadError)
+
+	}
+
+}
+
+func TestMiniBufferSlice(t *testing.T) {
+
+	var (
+		view *MiniBuffer
+		out  []byte
+	)
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x00, 0x01, 0x02, 0x03})
+
+	buf.SeekByte(0x01, false)
+	buf.SliceNext(&view, 2)
+	view.ReadBytesNext(&out, 1)
+	if !cmp.Equal([]byte{0x01}, out) || buf.off != 3 || view.off != 1 || view.cap != 2 {
+
+		t.Fatalf("unexpected view state (read %#v, buffer at %d, view at %d with capacity %d)", out, buf.off, view.off, view.cap)
+
+	}
+
+	// growing the view does not overwrite the bytes after it
+	view.SetGrowth(true, 0)
+	view.WriteBytes(0x02, []byte{0xff})
+	if !cmp.Equal([]byte{0x00, 0x01, 0x02, 0x03}, buf.buf) {
+
+		t.Fatalf("view overwrote the bytes after it: %#v", buf.buf)
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkBufferSlice(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 64))
+
+	for n := 0; n < b.N; n++ {
+
+		buf.Slice(0x10, 0x20)
+
+	}
+
+}
@@ -0,0 +1,283 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+/* internal use methods */
+
+// splice replaces the n bytes at off with data, moving the bytes after
+// them, both cursors and the slots of the open placeholders accordingly.
+// slot is the placeholder whose slot is being replaced to widen it, if
+// any, as every other slot must be left whole
+func (b *Buffer) splice(op string, off, n int64, data []byte, slot *Placeholder) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if n < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count(op, n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at(op, off, n, b.cap))
+		return
+
+	}
+
+	if n > b.cap-off {
+
+		b.fail(BufferOverwriteError.at(op, off, n, b.cap))
+		return
+
+	}
+
+	if q := b.crossed(off, n); q != nil && q != slot {
+
+		b.fail(BufferPlaceholderSpliceError.at(op, off, n, b.cap))
+		return
+
+	}
+
+	// everything after off moves, so all of it is journaled
+	b.save(off, b.cap-off)
+
+	k := int64(len(data))
+	end := b.cap
+	if k > n {
+
+		b.Grow(k - n)
+
+	}
+
+	copy(b.buf[off+k:], b.buf[off+n:end])
+	copy(b.buf[off:], data)
+	if k < n {
+
+		b.buf = b.buf[:end-n+k]
+		b.Refresh()
+
+	}
+
+	// the bytes written to the hash move like the cursors, and are
+	// written again if any of them were replaced
+	if b.hash != nil {
+
+		changed := off < b.hend
+		b.hstart = spliced(b.hstart, off, n, k)
+		b.hend = spliced(b.hend, off, n, k)
+		if changed {
+
+			b.rehash()
+
+		}
+
+	}
+
+	// cursors before off are left alone, the ones within the replaced
+	// bytes move to off and the ones after them keep pointing at the
+	// same bytes
+	b.off = spliced(b.off, off, n, k)
+
+	switch {
+
+	case b.boff/8 >= off+n:
+		b.boff += (k - n) * 8
+
+	case b.boff/8 >= off:
+		b.boff = off * 8
+
+	}
+	b.syncByte()
+
+	for _, q := range b.slots {
+
+		switch {
+
+		case q == slot:
+			q.width = k
+			q.start = off + k
+
+		case q.off >= off+n:
+			q.off += k - n
+			q.start += k - n
+
+		}
+
+	}
+
+}
+
+// spliced returns where the offset pos ends up after replacing the n
+// bytes at off with k others
+func spliced(pos, off, n, k int64) int64 {
+
+	switch {
+
+	case pos >= off+n:
+		return pos + k - n
+
+	case pos >= off:
+		return off
+
+	}
+	return pos
+
+}
+
+// crossed returns an open placeholder whose slot would be cut across by
+// replacing the n bytes at off, or nil if there is none. slots that
+// start at or after the end of the replaced bytes are moved instead
+func (b *Buffer) crossed(off, n int64) *Placeholder {
+
+	for _, q := range b.slots {
+
+		if q.off < off+n && off < q.off+q.width && (n > 0x00 || q.off < off) {
+
+			return q
+
+		}
+
+	}
+	return nil
+
+}
+
+/* Buffer */
+
+// Insert inserts data into the buffer at the specified offset, moving
+// the bytes from there on forward and growing the buffer with Grow. an
+// offset equal to the capacity of the buffer appends data to it.
+//
+// cursors and the slots of open placeholders at or after off are moved
+// forward the length of data, so that they keep pointing at the same
+// bytes. inserting into the middle of a slot fails. data must not
+// overlap the contents of the buffer
+func (b *Buffer) Insert(off int64, data []byte) {
+
+	b.splice("Insert", off, 0x00, data, nil)
+
+}
+
+// Delete removes the n bytes at the specified offset from the buffer,
+// moving the bytes after them back and shrinking it.
+//
+// cursors within the removed bytes move to off, while the ones after
+// them are moved back n bytes so that they keep pointing at the same
+// bytes, along with the slots of open placeholders. removing any part of
+// a slot fails
+func (b *Buffer) Delete(off, n int64) {
+
+	b.splice("Delete", off, n, nil, nil)
+
+}
+
+// Splice replaces the n bytes at the specified offset with data,
+// moving the bytes after them and growing or shrinking the buffer as
+// needed. it follows the same rules as Insert and Delete: cursors
+// within the replaced bytes move to off, while the ones after them keep
+// pointing at the same bytes. data must not overlap the contents of the
+// buffer
+func (b *Buffer) Splice(off, n int64, data []byte) {
+
+	b.splice("Splice", off, n, data, nil)
+
+}
+
+/* CheckedBuffer */
+
+// spliceError returns the error that replacing the n bytes at off by
+// op would cause, if any
+func (b *CheckedBuffer) spliceError(op string, off, n int64) error {
+
+	if b.buf.err != nil {
+
+		return b.buf.err
+
+	}
+
+	if n < 0x00 {
+
+		return BufferInvalidByteCountError.count(op, n, b.buf.cap)
+
+	}
+
+	if off < 0x00 {
+
+		return BufferUnderwriteError.at(op, off, n, b.buf.cap)
+
+	}
+
+	if n > b.buf.cap-off {
+
+		return BufferOverwriteError.at(op, off, n, b.buf.cap)
+
+	}
+
+	if b.buf.crossed(off, n) != nil {
+
+		return BufferPlaceholderSpliceError.at(op, off, n, b.buf.cap)
+
+	}
+	return nil
+
+}
+
+// Insert inserts data into the buffer at the specified offset. see
+// Buffer.Insert for how the cursors are moved
+func (b *CheckedBuffer) Insert(off int64, data []byte) (err error) {
+
+	if err = b.spliceError("Insert", off, 0x00); err != nil {
+
+		return
+
+	}
+
+	b.buf.Insert(off, data)
+	return
+
+}
+
+// Delete removes the n bytes at the specified offset from the buffer.
+// see Buffer.Delete for how the cursors are moved
+func (b *CheckedBuffer) Delete(off, n int64) (err error) {
+
+	if err = b.spliceError("Delete", off, n); err != nil {
+
+		return
+
+	}
+
+	b.buf.Delete(off, n)
+	return
+
+}
+
+// Splice replaces the n bytes at the specified offset with data. see
+// Buffer.Splice for how the cursors are moved
+func (b *CheckedBuffer) Splice(off, n int64, data []byte) (err error) {
+
+	if err = b.spliceError("Splice", off, n); err != nil {
+
+		return
+
+	}
+
+	b.buf.Splice(off, n, data)
+	return
+
+}
@@ -0,0 +1,234 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferSplice(t *testing.T) {
+
+	for i, c := range []struct {
+		op       func(*Buffer)
+		expected []byte
+		off      int64
+		boff     int64
+	}{
+		// the byte offset starts at 3 and the bit offset at 13
+		{func(b *Buffer) { b.Insert(0x00, []byte{0xaa, 0xbb}) }, []byte{0xaa, 0xbb, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05}, 5, 29},
+		{func(b *Buffer) { b.Insert(0x03, []byte{0xaa}) }, []byte{0x00, 0x01, 0x02, 0xaa, 0x03, 0x04, 0x05}, 4, 13},
+		{func(b *Buffer) { b.Insert(0x06, []byte{0xaa}) }, []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0xaa}, 3, 13},
+		{func(b *Buffer) { b.Delete(0x00, 1) }, []byte{0x01, 0x02, 0x03, 0x04, 0x05}, 2, 5},
+		{func(b *Buffer) { b.Delete(0x01, 3) }, []byte{0x00, 0x04, 0x05}, 1, 8},
+		{func(b *Buffer) { b.Delete(0x04, 2) }, []byte{0x00, 0x01, 0x02, 0x03}, 3, 13},
+		{func(b *Buffer) { b.Splice(0x02, 2, []byte{0xaa, 0xbb, 0xcc}) }, []byte{0x00, 0x01, 0xaa, 0xbb, 0xcc, 0x04, 0x05}, 2, 13},
+		{func(b *Buffer) { b.Splice(0x00, 4, []byte{0xaa}) }, []byte{0xaa, 0x04, 0x05}, 0, 0},
+		{func(b *Buffer) { b.Splice(0x06, 0, nil) }, []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05}, 3, 13},
+	} {
+
+		buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05})
+		buf.SeekByte(0x03, false)
+		buf.SeekBit(0x0d, false)
+
+		c.op(buf)
+		if !cmp.Equal(c.expected, buf.Bytes()) || buf.ByteCapacity() != int64(len(c.expected)) {
+
+			t.Fatalf("case %d: expected byte array does not match the one gotten (got %#v, expected %#v)", i, buf.Bytes(), c.expected)
+
+		}
+
+		if buf.ByteOffset() != c.off || buf.BitOffset() != c.boff {
+
+			t.Fatalf("case %d: incorrect offsets (got %d and %d, expected %d and %d)", i, buf.ByteOffset(), buf.BitOffset(), c.off, c.boff)
+
+		}
+
+	}
+
+}
+
+func TestBufferSpliceCapacity(t *testing.T) {
+
+	backing := make([]byte, 4, 16)
+	buf := NewBuffer(backing)
+
+	// spare capacity is reused by Grow
+	buf.Insert(0x02, []byte{0x01, 0x02})
+	if &buf.Bytes()[0] != &backing[0] {
+
+		t.Fatalf("insertion reallocated the buffer")
+
+	}
+
+	buf.Insert(0x00, make([]byte, 16))
+	if buf.ByteCapacity() != 22 || buf.ReadU16BEAt(0x12) != 0x0102 {
+
+		t.Fatalf("unexpected state (got %#v)", buf.Bytes())
+
+	}
+
+}
+
+func TestBufferSpliceUnified(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x00, 0x01, 0x02, 0x03})
+	buf.SetCursorMode(UnifiedCursor)
+	buf.SeekBit(0x14, false)
+
+	buf.Delete(0x00, 1)
+	if buf.ByteOffset() != 0x02 || buf.BitOffset() != 0x0c {
+
+		t.Fatalf("incorrect offsets (got %d and %d, expected 2 and 12)", buf.ByteOffset(), buf.BitOffset())
+
+	}
+
+}
+
+func TestBufferSpliceRollback(t *testing.T) {
+
+	data := []byte{0x00, 0x01, 0x02, 0x03}
+
+	buf := NewBuffer(append([]byte{}, data...))
+	buf.SeekByte(0x02, false)
+
+	m := buf.Mark()
+	buf.Delete(0x00, 2)
+	buf.Insert(0x01, make([]byte, 32))
+	buf.Splice(0x00, 3, []byte{0xff})
+	buf.Rollback(m)
+
+	if !cmp.Equal(data, buf.Bytes()) || buf.ByteOffset() != 0x02 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v at offset %d, expected %#v)", buf.Bytes(), buf.ByteOffset(), data)
+
+	}
+
+}
+
+func TestBufferSplicePlaceholders(t *testing.T) {
+
+	buf := NewBuffer([]byte{0x01})
+	buf.SetGrowth(true, 0)
+	buf.SeekByte(0x01, false)
+
+	p := buf.ReserveNext(PrefixU8)
+	buf.WriteBytesNext([]byte{0x02, 0x03, 0x04})
+
+	// the slot moves along with the bytes after it, and so does the
+	// section it describes
+	buf.Insert(0x00, []byte{0xaa})
+	buf.Delete(0x04, 1)
+	if p.Offset() != 0x02 || p.Len() != 2 {
+
+		t.Fatalf("unexpected placeholder state (got slot at %d and length %d)", p.Offset(), p.Len())
+
+	}
+
+	p.Close()
+	if expected := []byte{0xaa, 0x01, 0x02, 0x02, 0x04}; !cmp.Equal(expected, buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+}
+
+func TestBufferSpliceErrors(t *testing.T) {
+
+	for i, c := range []struct {
+		op       func(*Buffer)
+		expected Error
+	}{
+		{func(b *Buffer) { b.Insert(0x03, []byte{0x00}) }, BufferOverwriteError},
+		{func(b *Buffer) { b.Insert(-0x01, []byte{0x00}) }, BufferUnderwriteError},
+		{func(b *Buffer) { b.Delete(0x01, 2) }, BufferOverwriteError},
+		{func(b *Buffer) { b.Delete(0x00, -1) }, BufferInvalidByteCountError},
+		{func(b *Buffer) { b.Splice(-0x01, 1, nil) }, BufferUnderwriteError},
+		{func(b *Buffer) { b.Delete(0x01, math.MaxInt64) }, BufferOverwriteError},
+		{func(b *Buffer) { b.Reserve(0x00, PrefixU16BE); b.Delete(0x01, 1) }, BufferPlaceholderSpliceError},
+		{func(b *Buffer) { b.Reserve(0x00, PrefixU16BE); b.Insert(0x01, []byte{0x00}) }, BufferPlaceholderSpliceError},
+	} {
+
+		buf := NewBuffer([]byte{0x00, 0x00})
+		buf.SetSticky(true)
+
+		c.op(buf)
+		if !errors.Is(buf.Err(), c.expected) || buf.ByteCapacity() != 2 {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, buf.Err(), c.expected)
+
+		}
+
+	}
+
+}
+
+func TestCheckedBufferSplice(t *testing.T) {
+
+	buf := NewCheckedBuffer([]byte{0x00, 0x01})
+
+	if err := buf.Splice(0x01, 1, []byte{0xaa, 0xbb}); err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if err := buf.Delete(0x02, 2); !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+	if err := buf.Delete(0x01, math.MaxInt64); !errors.Is(err, BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", err, BufferOverwriteError)
+
+	}
+
+	if expected := []byte{0x00, 0xaa, 0xbb}; !cmp.Equal(expected, buf.Buffer().Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Buffer().Bytes(), expected)
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkBufferSplice(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 1024))
+
+	for n := 0; n < b.N; n++ {
+
+		buf.Insert(0x10, []byte{0x00, 0x00, 0x00, 0x00})
+		buf.Delete(0x20, 4)
+
+	}
+
+}
@@ -0,0 +1,727 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"bytes"
+	"math"
+)
+
+// StringPrefix specifies the integer type used to store the length of
+// a length-prefixed string or the value of a Placeholder
+type StringPrefix byte
+
+const (
+	// PrefixU8 stores the length of a string in a single byte
+	PrefixU8 StringPrefix = iota
+
+	// PrefixU16LE stores the length of a string in a little-endian
+	// uint16
+	PrefixU16LE
+
+	// PrefixU16BE stores the length of a string in a big-endian uint16
+	PrefixU16BE
+
+	// PrefixU32LE stores the length of a string in a little-endian
+	// uint32
+	PrefixU32LE
+
+	// PrefixU32BE stores the length of a string in a big-endian uint32
+	PrefixU32BE
+
+	// PrefixUvarint stores the length of a string in a protobuf-style
+	// unsigned varint
+	PrefixUvarint
+
+	// PrefixU64LE stores the length of a string in a little-endian
+	// uint64
+	PrefixU64LE
+
+	// PrefixU64BE stores the length of a string in a big-endian uint64
+	PrefixU64BE
+)
+
+// width returns the amount of bytes taken up by the prefix of a string
+// that is n bytes long
+func (p StringPrefix) width(n uint64) int64 {
+
+	switch p {
+
+	case PrefixU8:
+		return 1
+
+	case PrefixU16LE, PrefixU16BE:
+		return 2
+
+	case PrefixU32LE, PrefixU32BE:
+		return 4
+
+	case PrefixU64LE, PrefixU64BE:
+		return 8
+
+	}
+	return uleb128Size(n)
+
+}
+
+// limit returns the length of the longest string the prefix is able to
+// describe
+func (p StringPrefix) limit() uint64 {
+
+	switch p {
+
+	case PrefixU8:
+		return math.MaxUint8
+
+	case PrefixU16LE, PrefixU16BE:
+		return math.MaxUint16
+
+	case PrefixU32LE, PrefixU32BE:
+		return math.MaxUint32
+
+	case PrefixU64LE, PrefixU64BE:
+		return math.MaxUint64
+
+	}
+	return math.MaxInt64
+
+}
+
+// read decodes the prefix at the start of buf. n is the amount of bytes
+// it took up, which is zero if buf ended before the prefix did and
+// negative if a varint prefix does not fit in 64 bits
+func (p StringPrefix) read(buf []byte) (length uint64, n int64) {
+
+	if p == PrefixUvarint {
+
+		return uleb128(buf)
+
+	}
+
+	n = p.width(0)
+	if int64(len(buf)) < n {
+
+		return 0, 0
+
+	}
+
+	switch p {
+
+	case PrefixU8:
+		length = uint64(buf[0])
+
+	case PrefixU16LE:
+		length = uint64(buf[0]) | uint64(buf[1])<<8
+
+	case PrefixU16BE:
+		length = uint64(buf[0])<<8 | uint64(buf[1])
+
+	case PrefixU32LE:
+		length = uint64(buf[0]) | uint64(buf[1])<<8 | uint64(buf[2])<<16 | uint64(buf[3])<<24
+
+	case PrefixU32BE:
+		length = uint64(buf[0])<<24 | uint64(buf[1])<<16 | uint64(buf[2])<<8 | uint64(buf[3])
+
+	case PrefixU64LE:
+		for i := 7; i >= 0x00; i-- {
+
+			length = length<<8 | uint64(buf[i])
+
+		}
+
+	case PrefixU64BE:
+		for i := 0; i < 8; i++ {
+
+			length = length<<8 | uint64(buf[i])
+
+		}
+
+	}
+	return
+
+}
+
+// put encodes length as the prefix at the start of buf and returns the
+// amount of bytes written. buf must be large enough to hold it
+func (p StringPrefix) put(buf []byte, length uint64) (n int64) {
+
+	switch p {
+
+	case PrefixU8:
+		buf[0] = byte(length)
+
+	case PrefixU16LE:
+		buf[0] = byte(length)
+		buf[1] = byte(length >> 8)
+
+	case PrefixU16BE:
+		buf[0] = byte(length >> 8)
+		buf[1] = byte(length)
+
+	case PrefixU32LE:
+		buf[0] = byte(length)
+		buf[1] = byte(length >> 8)
+		buf[2] = byte(length >> 16)
+		buf[3] = byte(length >> 24)
+
+	case PrefixU32BE:
+		buf[0] = byte(length >> 24)
+		buf[1] = byte(length >> 16)
+		buf[2] = byte(length >> 8)
+		buf[3] = byte(length)
+
+	case PrefixU64LE:
+		for i := 0; i < 8; i++ {
+
+			buf[i] = byte(length >> (uint(i) * 8))
+
+		}
+
+	case PrefixU64BE:
+		for i := 0; i < 8; i++ {
+
+			buf[i] = byte(length >> (56 - uint(i)*8))
+
+		}
+
+	default:
+		return putULEB128(buf, length)
+
+	}
+	return p.width(length)
+
+}
+
+/* internal use functions */
+
+// cstringEnd returns the offset of the end of the region of buf that is
+// searched for the terminator of a string starting at off that is at
+// most max bytes long. a negative max searches up to the end of buf
+func cstringEnd(buf []byte, off, max int64) int64 {
+
+	// off+max+1 would overflow for a large max, so the room left after
+	// off is compared against instead
+	end := int64(len(buf))
+	if max >= 0x00 && max < end-off-1 {
+
+		end = off + max + 1
+
+	}
+	return end
+
+}
+
+// trimPadding returns field without its trailing pad bytes
+func trimPadding(field []byte, pad byte) []byte {
+
+	i := len(field)
+	for i > 0x00 && field[i-1] == pad {
+
+		i--
+
+	}
+	return field[:i]
+
+}
+
+/* Buffer */
+
+// ReadCString reads a NUL-terminated string from the buffer at the
+// specified offset without modifying the internal offset value. the
+// string may be at most max bytes long not counting its terminator, or
+// of any length if max is negative. n is the amount of bytes it took up,
+// including the terminator. the bytes are copied into the string once,
+// so it remains valid after the buffer is modified
+func (b *Buffer) ReadCString(off, max int64) (out string, n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off >= b.cap {
+
+		b.fail(BufferOverreadError.at("ReadCString", off, 1, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadCString", off, 1, b.cap))
+		return
+
+	}
+
+	end := cstringEnd(b.buf, off, max)
+	i := int64(bytes.IndexByte(b.buf[off:end], 0x00))
+	if i < 0x00 {
+
+		b.fail(BufferStringUnterminatedError.at("ReadCString", off, end-off, b.cap))
+		return
+
+	}
+
+	out, n = string(b.buf[off:off+i]), i+1
+	return
+
+}
+
+// ReadCStringNext reads a NUL-terminated string from the buffer at the
+// current offset and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadCStringNext(max int64) (out string) {
+
+	out, n := b.ReadCString(b.cursor("ReadCStringNext"), max)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// WriteCString writes a NUL-terminated string to the buffer at the
+// specified offset without modifying the internal offset value
+func (b *Buffer) WriteCString(off int64, data string) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	n := int64(len(data)) + 1
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at("WriteCString", off, n, b.cap))
+		return
+
+	}
+
+	if !b.writable(off, n) {
+
+		b.fail(BufferOverwriteError.at("WriteCString", off, n, b.cap))
+		return
+
+	}
+
+	b.save(off, n)
+	copy(b.buf[off:], data)
+	b.buf[off+n-1] = 0x00
+
+}
+
+// WriteCStringNext writes a NUL-terminated string to the buffer at the
+// current offset and moves the offset forward the amount of bytes
+// written
+func (b *Buffer) WriteCStringNext(data string) {
+
+	b.WriteCString(b.cursor("WriteCStringNext"), data)
+	b.SeekByte(int64(len(data))+1, true)
+
+}
+
+// ReadPString reads a string preceded by its length from the buffer at
+// the specified offset without modifying the internal offset value. n
+// is the amount of bytes it took up, including the prefix
+func (b *Buffer) ReadPString(off int64, prefix StringPrefix) (out string, n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off >= b.cap {
+
+		b.fail(BufferOverreadError.at("ReadPString", off, prefix.width(0), b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadPString", off, prefix.width(0), b.cap))
+		return
+
+	}
+
+	length, m := prefix.read(b.buf[off:b.cap])
+	if m == 0x00 && prefix == PrefixUvarint {
+
+		b.fail(BufferVarintTruncatedError.at("ReadPString", off, b.cap-off, b.cap))
+		return
+
+	} else if m == 0x00 {
+
+		b.fail(BufferOverreadError.at("ReadPString", off, prefix.width(0), b.cap))
+		return
+
+	} else if m < 0x00 {
+
+		b.fail(BufferVarintOverflowError.at("ReadPString", off, maxVarintLen, b.cap))
+		return
+
+	}
+
+	if length > uint64(b.cap-off-m) {
+
+		b.fail(BufferOverreadError.at("ReadPString", off, m+int64(length), b.cap))
+		return
+
+	}
+
+	out, n = string(b.buf[off+m:off+m+int64(length)]), m+int64(length)
+	return
+
+}
+
+// ReadPStringNext reads a string preceded by its length from the buffer
+// at the current offset and moves the offset forward the amount of
+// bytes read
+func (b *Buffer) ReadPStringNext(prefix StringPrefix) (out string) {
+
+	out, n := b.ReadPString(b.cursor("ReadPStringNext"), prefix)
+	b.SeekByte(n, true)
+	return
+
+}
+
+// WritePString writes a string preceded by its length to the buffer at
+// the specified offset without modifying the internal offset value
+func (b *Buffer) WritePString(off int64, prefix StringPrefix, data string) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	length := uint64(len(data))
+	if length > prefix.limit() {
+
+		b.fail(BufferStringTooLongError.at("WritePString", off, int64(length), b.cap))
+		return
+
+	}
+
+	n := prefix.width(length) + int64(length)
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at("WritePString", off, n, b.cap))
+		return
+
+	}
+
+	if !b.writable(off, n) {
+
+		b.fail(BufferOverwriteError.at("WritePString", off, n, b.cap))
+		return
+
+	}
+
+	b.save(off, n)
+	copy(b.buf[off+prefix.put(b.buf[off:], length):], data)
+
+}
+
+// WritePStringNext writes a string preceded by its length to the buffer
+// at the current offset and moves the offset forward the amount of
+// bytes written
+func (b *Buffer) WritePStringNext(prefix StringPrefix, data string) {
+
+	b.WritePString(b.cursor("WritePStringNext"), prefix, data)
+	b.SeekByte(prefix.width(uint64(len(data)))+int64(len(data)), true)
+
+}
+
+// ReadFixedString reads a string stored in a field of width bytes from
+// the buffer at the specified offset without modifying the internal
+// offset value. any pad bytes at the end of the field are left out of
+// the string
+func (b *Buffer) ReadFixedString(off, width int64, pad byte) (out string) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if width < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("ReadFixedString", width, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadFixedString", off, width, b.cap))
+		return
+
+	}
+
+	if width > (b.cap - off) {
+
+		b.fail(BufferOverreadError.at("ReadFixedString", off, width, b.cap))
+		return
+
+	}
+
+	out = string(trimPadding(b.buf[off:off+width], pad))
+	return
+
+}
+
+// ReadFixedStringNext reads a string stored in a field of width bytes
+// from the buffer at the current offset and moves the offset forward
+// the width of the field
+func (b *Buffer) ReadFixedStringNext(width int64, pad byte) (out string) {
+
+	out = b.ReadFixedString(b.cursor("ReadFixedStringNext"), width, pad)
+	b.SeekByte(width, true)
+	return
+
+}
+
+// WriteFixedString writes a string to a field of width bytes in the
+// buffer at the specified offset without modifying the internal offset
+// value. the rest of the field is filled with pad bytes
+func (b *Buffer) WriteFixedString(off, width int64, data string, pad byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if width < 0x00 {
+
+		b.fail(BufferInvalidByteCountError.count("WriteFixedString", width, b.cap))
+		return
+
+	}
+
+	if int64(len(data)) > width {
+
+		b.fail(BufferStringTooLongError.at("WriteFixedString", off, int64(len(data)), b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at("WriteFixedString", off, width, b.cap))
+		return
+
+	}
+
+	if !b.writable(off, width) {
+
+		b.fail(BufferOverwriteError.at("WriteFixedString", off, width, b.cap))
+		return
+
+	}
+
+	b.save(off, width)
+	for i := off + int64(copy(b.buf[off:], data)); i < off+width; i++ {
+
+		b.buf[i] = pad
+
+	}
+
+}
+
+// WriteFixedStringNext writes a string to a field of width bytes in the
+// buffer at the current offset and moves the offset forward the width
+// of the field
+func (b *Buffer) WriteFixedStringNext(width int64, data string, pad byte) {
+
+	b.WriteFixedString(b.cursor("WriteFixedStringNext"), width, data, pad)
+	b.SeekByte(width, true)
+
+}
+
+/* MiniBuffer */
+
+// ReadCString stores a NUL-terminated string read from the buffer at
+// the specified offset in out and the amount of bytes it took up,
+// including the terminator, in n without modifying the internal offset
+// value. the string may be at most max bytes long not counting its
+// terminator, or of any length if max is negative
+func (b *MiniBuffer) ReadCString(out *string, n *int64, off, max int64) {
+
+	end := cstringEnd(b.buf, off, max)
+	i := int64(bytes.IndexByte(b.buf[off:end], 0x00))
+	if i < 0x00 {
+
+		panic(BufferStringUnterminatedError.at("ReadCString", off, end-off, b.cap))
+
+	}
+	*out, *n = string(b.buf[off:off+i]), i+1
+
+}
+
+// ReadCStringNext stores a NUL-terminated string read from the buffer
+// at the current offset in out and moves the offset forward the amount
+// of bytes read
+func (b *MiniBuffer) ReadCStringNext(out *string, max int64) {
+
+	var n int64
+	b.ReadCString(out, &n, b.off, max)
+	b.SeekByte(n, true)
+
+}
+
+// WriteCString writes a NUL-terminated string to the buffer at the
+// specified offset without modifying the internal offset value
+func (b *MiniBuffer) WriteCString(off int64, data string) {
+
+	n := int64(len(data)) + 1
+	if b.grow && (off+n) > b.cap {
+
+		b.reserve(off + n)
+
+	}
+
+	copy(b.buf[off:], data)
+	b.buf[off+n-1] = 0x00
+
+}
+
+// WriteCStringNext writes a NUL-terminated string to the buffer at the
+// current offset and moves the offset forward the amount of bytes
+// written
+func (b *MiniBuffer) WriteCStringNext(data string) {
+
+	b.WriteCString(b.off, data)
+	b.SeekByte(int64(len(data))+1, true)
+
+}
+
+// ReadPString stores a string preceded by its length read from the
+// buffer at the specified offset in out and the amount of bytes it took
+// up, including the prefix, in n without modifying the internal offset
+// value
+func (b *MiniBuffer) ReadPString(out *string, n *int64, off int64, prefix StringPrefix) {
+
+	length, m := prefix.read(b.buf[off:])
+	if m <= 0x00 && prefix == PrefixUvarint {
+
+		b.varintFailure("ReadPString", off, m)
+
+	} else if m == 0x00 {
+
+		panic(BufferOverreadError.at("ReadPString", off, prefix.width(0), b.cap))
+
+	}
+	*out, *n = string(b.buf[off+m:off+m+int64(length)]), m+int64(length)
+
+}
+
+// ReadPStringNext stores a string preceded by its length read from the
+// buffer at the current offset in out and moves the offset forward the
+// amount of bytes read
+func (b *MiniBuffer) ReadPStringNext(out *string, prefix StringPrefix) {
+
+	var n int64
+	b.ReadPString(out, &n, b.off, prefix)
+	b.SeekByte(n, true)
+
+}
+
+// WritePString writes a string preceded by its length to the buffer at
+// the specified offset without modifying the internal offset value. it
+// panics if the length of the string does not fit in the prefix
+func (b *MiniBuffer) WritePString(off int64, prefix StringPrefix, data string) {
+
+	length := uint64(len(data))
+	if length > prefix.limit() {
+
+		panic(BufferStringTooLongError.at("WritePString", off, int64(length), b.cap))
+
+	}
+
+	n := prefix.width(length) + int64(length)
+	if b.grow && (off+n) > b.cap {
+
+		b.reserve(off + n)
+
+	}
+
+	copy(b.buf[off+prefix.put(b.buf[off:], length):], data)
+
+}
+
+// WritePStringNext writes a string preceded by its length to the buffer
+// at the current offset and moves the offset forward the amount of
+// bytes written
+func (b *MiniBuffer) WritePStringNext(prefix StringPrefix, data string) {
+
+	b.WritePString(b.off, prefix, data)
+	b.SeekByte(prefix.width(uint64(len(data)))+int64(len(data)), true)
+
+}
+
+// ReadFixedString stores a string read from a field of width bytes in
+// the buffer at the specified offset in out without modifying the
+// internal offset value. any pad bytes at the end of the field are left
+// out of the string
+func (b *MiniBuffer) ReadFixedString(out *string, off, width int64, pad byte) {
+
+	*out = string(trimPadding(b.buf[off:off+width], pad))
+
+}
+
+// ReadFixedStringNext stores a string read from a field of width bytes
+// in the buffer at the current offset in out and moves the offset
+// forward the width of the field
+func (b *MiniBuffer) ReadFixedStringNext(out *string, width int64, pad byte) {
+
+	b.ReadFixedString(out, b.off, width, pad)
+	b.SeekByte(width, true)
+
+}
+
+// WriteFixedString writes a string to a field of width bytes in the
+// buffer at the specified offset without modifying the internal offset
+// value. the rest of the field is filled with pad bytes. it panics if
+// the string is longer than the field
+func (b *MiniBuffer) WriteFixedString(off, width int64, data string, pad byte) {
+
+	if int64(len(data)) > width {
+
+		panic(BufferStringTooLongError.at("WriteFixedString", off, int64(len(data)), b.cap))
+
+	}
+
+	if b.grow && (off+width) > b.cap {
+
+		b.reserve(off + width)
+
+	}
+
+	for i := off + int64(copy(b.buf[off:], data)); i < off+width; i++ {
+
+		b.buf[i] = pad
+
+	}
+
+}
+
+// WriteFixedStringNext writes a string to a field of width bytes in the
+// buffer at the current offset and moves the offset forward the width
+// of the field
+func (b *MiniBuffer) WriteFixedStringNext(width int64, data string, pad byte) {
+
+	b.WriteFixedString(b.off, width, data, pad)
+	b.SeekByte(width, true)
+
+}
@@ -0,0 +1,282 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferCString(t *testing.T) {
+
+	var expected = []byte{'a', 'b', 0x00, 'c', 0x00}
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	buf.WriteCStringNext("ab")
+	buf.WriteCStringNext("c")
+	if !cmp.Equal(expected, buf.Bytes()) || buf.ByteOffset() != 5 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	if out := buf.ReadCStringNext(-1); out != "ab" || buf.ByteOffset() != 3 {
+
+		t.Fatalf("expected string does not match the one gotten (got %q at offset %d, expected \"ab\")", out, buf.ByteOffset())
+
+	}
+
+	if out, n := buf.ReadCString(0x03, 1); out != "c" || n != 2 {
+
+		t.Fatalf("expected string does not match the one gotten (got %q with length %d, expected \"c\")", out, n)
+
+	}
+
+	// the string is copied out of the buffer
+	out, _ := buf.ReadCString(0x00, 2)
+	buf.WriteByte(0x00, 'x')
+	if out != "ab" {
+
+		t.Fatalf("string was modified along with the buffer: %q", out)
+
+	}
+
+}
+
+func TestBufferPString(t *testing.T) {
+
+	for _, c := range []struct {
+		prefix StringPrefix
+		bytes  []byte
+	}{
+		{PrefixU8, []byte{0x02, 'h', 'i'}},
+		{PrefixU16LE, []byte{0x02, 0x00, 'h', 'i'}},
+		{PrefixU16BE, []byte{0x00, 0x02, 'h', 'i'}},
+		{PrefixU32LE, []byte{0x02, 0x00, 0x00, 0x00, 'h', 'i'}},
+		{PrefixU32BE, []byte{0x00, 0x00, 0x00, 0x02, 'h', 'i'}},
+		{PrefixUvarint, []byte{0x02, 'h', 'i'}},
+		{PrefixU64LE, []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 'h', 'i'}},
+		{PrefixU64BE, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 'h', 'i'}},
+	} {
+
+		buf := NewBuffer(make([]byte, len(c.bytes)))
+
+		buf.WritePStringNext(c.prefix, "hi")
+		if !cmp.Equal(c.bytes, buf.Bytes()) || buf.ByteOffset() != buf.ByteCapacity() {
+
+			t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), c.bytes)
+
+		}
+
+		out, n := buf.ReadPString(0x00, c.prefix)
+		if out != "hi" || n != int64(len(c.bytes)) {
+
+			t.Fatalf("expected string does not match the one gotten (got %q with length %d, expected \"hi\")", out, n)
+
+		}
+
+	}
+
+	long := strings.Repeat("a", 300)
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	buf.WritePStringNext(PrefixUvarint, long)
+	buf.SeekByte(0x00, false)
+	if out := buf.ReadPStringNext(PrefixUvarint); out != long || buf.ByteOffset() != 302 {
+
+		t.Fatalf("expected string does not match the one gotten (got a %d byte string at offset %d, expected a 300 byte one)", len(out), buf.ByteOffset())
+
+	}
+
+}
+
+func TestBufferFixedString(t *testing.T) {
+
+	var expected = []byte{'a', 'b', ' ', ' ', 'c', 0x00, 0x00}
+
+	buf := NewBuffer(make([]byte, 7))
+
+	buf.WriteFixedStringNext(4, "ab", ' ')
+	buf.WriteFixedStringNext(3, "c", 0x00)
+	if !cmp.Equal(expected, buf.Bytes()) || buf.ByteOffset() != 7 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	if out := buf.ReadFixedStringNext(4, ' '); out != "ab" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected \"ab\")", out)
+
+	}
+
+	if out := buf.ReadFixedString(0x04, 3, 0x00); out != "c" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected \"c\")", out)
+
+	}
+
+	if out := buf.ReadFixedString(0x02, 2, ' '); out != "" {
+
+		t.Fatalf("expected an empty string (got %q)", out)
+
+	}
+
+}
+
+func TestBufferStringErrors(t *testing.T) {
+
+	for i, c := range []struct {
+		bytes    []byte
+		op       func(*Buffer)
+		expected Error
+	}{
+		{[]byte{'a', 'b'}, func(b *Buffer) { b.ReadCStringNext(-1) }, BufferStringUnterminatedError},
+		{[]byte{'a', 'b', 0x00}, func(b *Buffer) { b.ReadCStringNext(1) }, BufferStringUnterminatedError},
+		{[]byte{}, func(b *Buffer) { b.ReadCStringNext(-1) }, BufferOverreadError},
+		{[]byte{0x00}, func(b *Buffer) { b.ReadPStringNext(PrefixU16BE) }, BufferOverreadError},
+		{[]byte{0x03, 'a', 'b'}, func(b *Buffer) { b.ReadPStringNext(PrefixU8) }, BufferOverreadError},
+		{[]byte{0x80}, func(b *Buffer) { b.ReadPStringNext(PrefixUvarint) }, BufferVarintTruncatedError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WriteCStringNext("ab") }, BufferOverwriteError},
+		{make([]byte, 300), func(b *Buffer) { b.WritePStringNext(PrefixU8, strings.Repeat("a", 256)) }, BufferStringTooLongError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WriteFixedStringNext(2, "abc", ' ') }, BufferStringTooLongError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.ReadFixedStringNext(3, ' ') }, BufferOverreadError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.ReadFixedString(0x02, -1, 0x00) }, BufferInvalidByteCountError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.ReadFixedStringNext(-1, ' ') }, BufferInvalidByteCountError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.ReadFixedString(0x01, math.MaxInt64, ' ') }, BufferOverreadError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WriteFixedStringNext(-1, "", ' ') }, BufferInvalidByteCountError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WritePString(-0x01, PrefixU8, "") }, BufferUnderwriteError},
+		{[]byte{'a', 'b'}, func(b *Buffer) { b.ReadCString(0x00, math.MaxInt64) }, BufferStringUnterminatedError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WriteCString(math.MaxInt64, "a") }, BufferOverwriteError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WritePString(math.MaxInt64-1, PrefixU8, "a") }, BufferOverwriteError},
+		{[]byte{0x00, 0x00}, func(b *Buffer) { b.WriteFixedString(math.MaxInt64, 2, "", ' ') }, BufferOverwriteError},
+	} {
+
+		buf := NewBuffer(c.bytes)
+		buf.SetSticky(true)
+
+		c.op(buf)
+		if !errors.Is(buf.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, buf.Err(), c.expected)
+
+		}
+
+		if buf.ByteOffset() != 0x00 {
+
+			t.Fatalf("case %d: incorrect offset: %d", i, buf.ByteOffset())
+
+		}
+
+	}
+
+}
+
+func TestMiniBufferStrings(t *testing.T) {
+
+	var (
+		out1 string
+		out2 string
+		out3 string
+		n    int64
+	)
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf)
+	buf.SetGrowth(true, 0)
+
+	buf.WriteCStringNext("ab")
+	buf.WritePStringNext(PrefixU16LE, "cd")
+	buf.WriteFixedStringNext(3, "e", ' ')
+	if !cmp.Equal([]byte{'a', 'b', 0x00, 0x02, 0x00, 'c', 'd', 'e', ' ', ' '}, buf.buf) {
+
+		t.Fatalf("unexpected byte array: %#v", buf.buf)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	buf.ReadCStringNext(&out1, -1)
+	buf.ReadPString(&out2, &n, buf.off, PrefixU16LE)
+	buf.ReadFixedString(&out3, 0x07, 3, ' ')
+	if out1 != "ab" || out2 != "cd" || out3 != "e" || n != 4 || buf.off != 3 {
+
+		t.Fatalf("unexpected values read: %q, %q, %q (length %d, offset %d)", out1, out2, out3, n, buf.off)
+
+	}
+
+}
+
+func TestMiniBufferStringPanic(t *testing.T) {
+
+	defer panicChecker(t, BufferStringUnterminatedError.at("ReadCString", 0x00, 2, 3))
+
+	var out string
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{'a', 'b', 'c'})
+
+	buf.ReadCStringNext(&out, 1)
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkBufferReadCString(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer([]byte("crunch\x00"))
+
+	var out string
+	for n := 0; n < b.N; n++ {
+
+		out, _ = buf.ReadCString(0x00, -1)
+
+	}
+
+	_ = out
+
+}
+
+func BenchmarkBufferWritePString(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 7))
+
+	for n := 0; n < b.N; n++ {
+
+		buf.WritePString(0x00, PrefixU8, "crunch")
+
+	}
+
+}
@@ -0,0 +1,362 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"math/bits"
+	"unicode/utf16"
+)
+
+// byteOrderMark is the code point used to mark the byte order of
+// UTF-16 and UTF-32 text
+const byteOrderMark = 0xfeff
+
+// TextOrder specifies the byte order of UTF-16 and UTF-32 text and
+// whether or not it starts with a byte order mark
+type TextOrder byte
+
+const (
+	// TextBE stores text in big-endian without a byte order mark
+	TextBE TextOrder = iota
+
+	// TextLE stores text in little-endian without a byte order mark
+	TextLE
+
+	// TextBOMOrBE stores text after a byte order mark. when reading,
+	// the byte order is taken from the mark and is big-endian if there
+	// is none. when writing, a big-endian mark is written
+	TextBOMOrBE
+
+	// TextBOMOrLE is the same as TextBOMOrBE, except that it falls back
+	// to and writes little-endian
+	TextBOMOrLE
+)
+
+// little returns whether or not o is little-endian when no byte order
+// mark says otherwise
+func (o TextOrder) little() bool {
+
+	return o == TextLE || o == TextBOMOrLE
+
+}
+
+// bom returns whether or not text in the order o has a byte order mark
+func (o TextOrder) bom() bool {
+
+	return o == TextBOMOrBE || o == TextBOMOrLE
+
+}
+
+// decodeUTF16 decodes the code units read from a buffer in the order
+// o, swapping their bytes if a byte order mark says they are in the
+// other one. unpaired surrogates are replaced with U+FFFD
+func (o TextOrder) decodeUTF16(units []uint16) string {
+
+	if o.bom() && len(units) > 0x00 {
+
+		switch units[0] {
+
+		case byteOrderMark:
+			units = units[1:]
+
+		case bits.ReverseBytes16(byteOrderMark):
+			units = units[1:]
+			for i, u := range units {
+
+				units[i] = bits.ReverseBytes16(u)
+
+			}
+
+		}
+
+	}
+	return string(utf16.Decode(units))
+
+}
+
+// encodeUTF16 returns the code units of data, preceded by a byte order
+// mark if o has one. invalid UTF-8 in data is replaced with U+FFFD
+func (o TextOrder) encodeUTF16(data string) []uint16 {
+
+	runes := []rune(data)
+	if o.bom() {
+
+		runes = append([]rune{byteOrderMark}, runes...)
+
+	}
+	return utf16.Encode(runes)
+
+}
+
+// decodeUTF32 is the UTF-32 variant of decodeUTF16. values that are
+// not valid code points are replaced with U+FFFD
+func (o TextOrder) decodeUTF32(units []uint32) string {
+
+	if o.bom() && len(units) > 0x00 {
+
+		switch units[0] {
+
+		case byteOrderMark:
+			units = units[1:]
+
+		case bits.ReverseBytes32(byteOrderMark):
+			units = units[1:]
+			for i, u := range units {
+
+				units[i] = bits.ReverseBytes32(u)
+
+			}
+
+		}
+
+	}
+
+	runes := make([]rune, len(units))
+	for i, u := range units {
+
+		// converting an invalid rune to a string yields U+FFFD
+		runes[i] = rune(u)
+
+	}
+	return string(runes)
+
+}
+
+// encodeUTF32 is the UTF-32 variant of encodeUTF16
+func (o TextOrder) encodeUTF32(data string) (units []uint32) {
+
+	if o.bom() {
+
+		units = append(units, byteOrderMark)
+
+	}
+
+	for _, r := range data {
+
+		units = append(units, uint32(r))
+
+	}
+	return
+
+}
+
+/* Buffer */
+
+// ReadUTF16 reads n UTF-16 code units from the buffer at the specified
+// offset in the order order without modifying the internal offset
+// value and returns them as a string. a byte order mark counts as one
+// of the n code units
+func (b *Buffer) ReadUTF16(off, n int64, order TextOrder) (out string) {
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		return order.decodeUTF16(b.ReadU16LE(off, n))
+
+	}
+	return order.decodeUTF16(b.ReadU16BE(off, n))
+
+}
+
+// ReadUTF16Next reads n UTF-16 code units from the buffer at the
+// current offset in the order order and moves the offset forward the
+// amount of bytes read
+func (b *Buffer) ReadUTF16Next(n int64, order TextOrder) (out string) {
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		return order.decodeUTF16(b.ReadU16LENext(n))
+
+	}
+	return order.decodeUTF16(b.ReadU16BENext(n))
+
+}
+
+// WriteUTF16 writes a string as UTF-16 to the buffer at the specified
+// offset in the order order without modifying the internal offset
+// value. it returns the amount of code units written, or 0 if the write
+// failed
+func (b *Buffer) WriteUTF16(off int64, data string, order TextOrder) (n int64) {
+
+	units := order.encodeUTF16(data)
+	if len(units) == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		b.WriteU16LE(off, units)
+
+	} else {
+
+		b.WriteU16BE(off, units)
+
+	}
+
+	if b.err != nil {
+
+		return
+
+	}
+	return int64(len(units))
+
+}
+
+// WriteUTF16Next writes a string as UTF-16 to the buffer at the current
+// offset in the order order and moves the offset forward the amount of
+// bytes written. it returns the amount of code units written, or 0 if
+// the write failed
+func (b *Buffer) WriteUTF16Next(data string, order TextOrder) (n int64) {
+
+	units := order.encodeUTF16(data)
+	if len(units) == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		b.WriteU16LENext(units)
+
+	} else {
+
+		b.WriteU16BENext(units)
+
+	}
+
+	if b.err != nil {
+
+		return
+
+	}
+	return int64(len(units))
+
+}
+
+// ReadUTF32 reads n UTF-32 code units from the buffer at the specified
+// offset in the order order without modifying the internal offset
+// value and returns them as a string. a byte order mark counts as one
+// of the n code units
+func (b *Buffer) ReadUTF32(off, n int64, order TextOrder) (out string) {
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		return order.decodeUTF32(b.ReadU32LE(off, n))
+
+	}
+	return order.decodeUTF32(b.ReadU32BE(off, n))
+
+}
+
+// ReadUTF32Next reads n UTF-32 code units from the buffer at the
+// current offset in the order order and moves the offset forward the
+// amount of bytes read
+func (b *Buffer) ReadUTF32Next(n int64, order TextOrder) (out string) {
+
+	if n == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		return order.decodeUTF32(b.ReadU32LENext(n))
+
+	}
+	return order.decodeUTF32(b.ReadU32BENext(n))
+
+}
+
+// WriteUTF32 writes a string as UTF-32 to the buffer at the specified
+// offset in the order order without modifying the internal offset
+// value. it returns the amount of code units written, or 0 if the write
+// failed
+func (b *Buffer) WriteUTF32(off int64, data string, order TextOrder) (n int64) {
+
+	units := order.encodeUTF32(data)
+	if len(units) == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		b.WriteU32LE(off, units)
+
+	} else {
+
+		b.WriteU32BE(off, units)
+
+	}
+
+	if b.err != nil {
+
+		return
+
+	}
+	return int64(len(units))
+
+}
+
+// WriteUTF32Next writes a string as UTF-32 to the buffer at the current
+// offset in the order order and moves the offset forward the amount of
+// bytes written. it returns the amount of code units written, or 0 if
+// the write failed
+func (b *Buffer) WriteUTF32Next(data string, order TextOrder) (n int64) {
+
+	units := order.encodeUTF32(data)
+	if len(units) == 0x00 {
+
+		return
+
+	}
+
+	if order.little() {
+
+		b.WriteU32LENext(units)
+
+	} else {
+
+		b.WriteU32BENext(units)
+
+	}
+
+	if b.err != nil {
+
+		return
+
+	}
+	return int64(len(units))
+
+}
@@ -0,0 +1,169 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferUTF16(t *testing.T) {
+
+	for _, c := range []struct {
+		order TextOrder
+		bytes []byte
+	}{
+		{TextBE, []byte{0x00, 'h', 0xd8, 0x3d, 0xde, 0x00}},
+		{TextLE, []byte{'h', 0x00, 0x3d, 0xd8, 0x00, 0xde}},
+		{TextBOMOrBE, []byte{0xfe, 0xff, 0x00, 'h', 0xd8, 0x3d, 0xde, 0x00}},
+		{TextBOMOrLE, []byte{0xff, 0xfe, 'h', 0x00, 0x3d, 0xd8, 0x00, 0xde}},
+	} {
+
+		buf := NewBuffer(make([]byte, len(c.bytes)))
+
+		n := buf.WriteUTF16Next("h\U0001f600", c.order)
+		if !cmp.Equal(c.bytes, buf.Bytes()) || n*2 != buf.ByteOffset() {
+
+			t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), c.bytes)
+
+		}
+
+		if out := buf.ReadUTF16(0x00, n, c.order); out != "h\U0001f600" {
+
+			t.Fatalf("expected string does not match the one gotten (got %q, expected %q)", out, "h\U0001f600")
+
+		}
+
+	}
+
+}
+
+func TestBufferUTF16Detection(t *testing.T) {
+
+	buf := NewBuffer([]byte{0xff, 0xfe, 'h', 0x00, 'i', 0x00, 0x00, 'h', 0x00, 'i'})
+
+	// the byte order mark takes precedence over the fallback order
+	if out := buf.ReadUTF16Next(3, TextBOMOrBE); out != "hi" || buf.ByteOffset() != 6 {
+
+		t.Fatalf("expected string does not match the one gotten (got %q at offset %d, expected \"hi\")", out, buf.ByteOffset())
+
+	}
+
+	if out := buf.ReadUTF16Next(2, TextBOMOrBE); out != "hi" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected \"hi\")", out)
+
+	}
+
+	// without detection, the byte order mark is left in the string
+	if out := buf.ReadUTF16(0x00, 1, TextLE); out != "\ufeff" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected %q)", out, "\ufeff")
+
+	}
+
+}
+
+func TestBufferUTF16Invalid(t *testing.T) {
+
+	// an unpaired high surrogate followed by a lone low one
+	buf := NewBuffer([]byte{0xd8, 0x3d, 0x00, 'a', 0xde, 0x00})
+
+	if out := buf.ReadUTF16(0x00, 3, TextBE); out != "\ufffda\ufffd" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected %q)", out, "\ufffda\ufffd")
+
+	}
+
+	buf.WriteUTF16(0x00, "\xff", TextBE)
+	if out := buf.ReadUTF16(0x00, 1, TextBE); out != "\ufffd" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected %q)", out, "\ufffd")
+
+	}
+
+}
+
+func TestBufferUTF32(t *testing.T) {
+
+	var expected = []byte{0x00, 0x00, 0xfe, 0xff, 0x00, 0x01, 0xf6, 0x00, 0x00, 0x00, 0x00, 'a'}
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	n := buf.WriteUTF32Next("\U0001f600a", TextBOMOrBE)
+	if !cmp.Equal(expected, buf.Bytes()) || n != 3 {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), expected)
+
+	}
+
+	if out := buf.ReadUTF32(0x00, 3, TextBOMOrLE); out != "\U0001f600a" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected %q)", out, "\U0001f600a")
+
+	}
+
+	buf.SeekByte(0x00, false)
+	buf.WriteU32LENext([]uint32{0xd800, 0x110000, 'b'})
+	if out := buf.ReadUTF32(0x00, 3, TextLE); out != "\ufffd\ufffdb" {
+
+		t.Fatalf("expected string does not match the one gotten (got %q, expected %q)", out, "\ufffd\ufffdb")
+
+	}
+
+}
+
+func TestBufferTextErrors(t *testing.T) {
+
+	buf := NewBuffer(make([]byte, 3))
+	buf.SetSticky(true)
+
+	buf.ReadUTF16Next(2, TextLE)
+	if !errors.Is(buf.Err(), BufferOverreadError) || buf.ByteOffset() != 0x00 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v at offset %d, expected %v)", buf.Err(), buf.ByteOffset(), BufferOverreadError)
+
+	}
+
+	buf.ClearErr()
+	if n := buf.WriteUTF32(0x00, "a", TextBE); n != 0x00 || !errors.Is(buf.Err(), BufferOverwriteError) {
+
+		t.Fatalf("expected error does not match the one gotten (got %v and %d units, expected %v)", buf.Err(), n, BufferOverwriteError)
+
+	}
+
+	// failed writes do not count any units as written
+	for i, write := range []func() int64{
+		func() int64 { return buf.WriteUTF16(0x02, "ab", TextLE) },
+		func() int64 { return buf.WriteUTF16Next("abcd", TextBE) },
+		func() int64 { return buf.WriteUTF32Next("a", TextLE) },
+	} {
+
+		buf.ClearErr()
+		if n := write(); n != 0x00 || buf.Err() == nil {
+
+			t.Fatalf("case %d: unexpected result (got %d units and %v)", i, n, buf.Err())
+
+		}
+
+	}
+
+}
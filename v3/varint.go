@@ -0,0 +1,646 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+// maxVarintLen is the maximum amount of bytes a 64-bit variable-length
+// integer can take up
+const maxVarintLen = 10
+
+/* internal use functions */
+
+// uleb128 decodes an unsigned LEB128 value from the start of buf. n is
+// the amount of bytes it took up, which is zero if buf ended before the
+// value did and negative if the value does not fit in 64 bits
+func uleb128(buf []byte) (out uint64, n int64) {
+
+	for i, c := range buf {
+
+		if i == maxVarintLen-1 {
+
+			if c > 0x01 {
+
+				return 0, -1
+
+			}
+			return out | uint64(c)<<63, maxVarintLen
+
+		}
+
+		out |= uint64(c&0x7f) << (7 * uint(i))
+		if c < 0x80 {
+
+			return out, int64(i + 1)
+
+		}
+
+	}
+	return 0, 0
+
+}
+
+// sleb128 decodes a signed LEB128 value from the start of buf. n is
+// the same as it is in uleb128
+func sleb128(buf []byte) (out int64, n int64) {
+
+	for i, c := range buf {
+
+		// the last byte may only hold the sign bit and its extension
+		if i == maxVarintLen-1 {
+
+			if c != 0x00 && c != 0x7f {
+
+				return 0, -1
+
+			}
+			return out | int64(c)<<63, maxVarintLen
+
+		}
+
+		out |= int64(c&0x7f) << (7 * uint(i))
+		if c < 0x80 {
+
+			if c&0x40 != 0x00 {
+
+				out |= -1 << (7 * uint(i+1))
+
+			}
+			return out, int64(i + 1)
+
+		}
+
+	}
+	return 0, 0
+
+}
+
+// putULEB128 encodes data as unsigned LEB128 at the start of buf and
+// returns the amount of bytes written. buf must be large enough to
+// hold it
+func putULEB128(buf []byte, data uint64) (n int64) {
+
+	for data >= 0x80 {
+
+		buf[n] = byte(data) | 0x80
+		data >>= 7
+		n++
+
+	}
+	buf[n] = byte(data)
+	return n + 1
+
+}
+
+// putSLEB128 encodes data as signed LEB128 at the start of buf and
+// returns the amount of bytes written. buf must be large enough to
+// hold it
+func putSLEB128(buf []byte, data int64) (n int64) {
+
+	for {
+
+		c := byte(data & 0x7f)
+		data >>= 7
+		if (data == 0x00 && c&0x40 == 0x00) || (data == -1 && c&0x40 != 0x00) {
+
+			buf[n] = c
+			return n + 1
+
+		}
+
+		buf[n] = c | 0x80
+		n++
+
+	}
+
+}
+
+// uleb128Size returns the amount of bytes data takes up when encoded
+// as unsigned LEB128
+func uleb128Size(data uint64) (n int64) {
+
+	n = 1
+	for data >= 0x80 {
+
+		data >>= 7
+		n++
+
+	}
+	return
+
+}
+
+// sleb128Size returns the amount of bytes data takes up when encoded
+// as signed LEB128
+func sleb128Size(data int64) (n int64) {
+
+	n = 1
+	for data < -0x40 || data >= 0x40 {
+
+		data >>= 7
+		n++
+
+	}
+	return
+
+}
+
+// zigzag maps signed integers to unsigned ones so that values close to
+// zero stay small when encoded as a varint
+func zigzag(data int64) uint64 {
+
+	return uint64(data<<1) ^ uint64(data>>63)
+
+}
+
+// unzigzag reverses zigzag
+func unzigzag(data uint64) int64 {
+
+	return int64(data>>1) ^ -int64(data&0x01)
+
+}
+
+/* Buffer */
+
+// readULEB128 implements the unsigned variable-length integer reads,
+// reporting errors under the name op
+func (b *Buffer) readULEB128(op string, off int64) (out uint64, n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off >= b.cap {
+
+		b.fail(BufferOverreadError.at(op, off, 1, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at(op, off, 1, b.cap))
+		return
+
+	}
+
+	out, n = uleb128(b.buf[off:])
+	if n == 0x00 {
+
+		b.fail(BufferVarintTruncatedError.at(op, off, b.cap-off, b.cap))
+
+	} else if n < 0x00 {
+
+		b.fail(BufferVarintOverflowError.at(op, off, maxVarintLen, b.cap))
+		n = 0x00
+
+	}
+	return
+
+}
+
+// writeULEB128 implements the unsigned variable-length integer writes,
+// reporting errors under the name op
+func (b *Buffer) writeULEB128(op string, off int64, data uint64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	n := uleb128Size(data)
+	if (off+n) > b.cap && !b.reserve(off+n) {
+
+		b.fail(BufferOverwriteError.at(op, off, n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at(op, off, n, b.cap))
+		return
+
+	}
+
+	b.save(off, n)
+	putULEB128(b.buf[off:], data)
+
+}
+
+// ReadULEB128 reads an unsigned LEB128 integer from the buffer at the
+// specified offset without modifying the internal offset value. n is
+// the amount of bytes the integer took up
+func (b *Buffer) ReadULEB128(off int64) (out uint64, n int64) {
+
+	return b.readULEB128("ReadULEB128", off)
+
+}
+
+// ReadULEB128Next reads an unsigned LEB128 integer from the buffer at
+// the current offset and moves the offset forward the amount of bytes
+// read
+func (b *Buffer) ReadULEB128Next() (out uint64) {
+
+	out, n := b.ReadULEB128(b.cursor("ReadULEB128Next"))
+	b.SeekByte(n, true)
+	return
+
+}
+
+// WriteULEB128 writes an unsigned LEB128 integer to the buffer at the
+// specified offset without modifying the internal offset value
+func (b *Buffer) WriteULEB128(off int64, data uint64) {
+
+	b.writeULEB128("WriteULEB128", off, data)
+
+}
+
+// WriteULEB128Next writes an unsigned LEB128 integer to the buffer at
+// the current offset and moves the offset forward the amount of bytes
+// written
+func (b *Buffer) WriteULEB128Next(data uint64) {
+
+	b.WriteULEB128(b.cursor("WriteULEB128Next"), data)
+	b.SeekByte(uleb128Size(data), true)
+
+}
+
+// ReadSLEB128 reads a signed LEB128 integer from the buffer at the
+// specified offset without modifying the internal offset value. n is
+// the amount of bytes the integer took up
+func (b *Buffer) ReadSLEB128(off int64) (out int64, n int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	if off >= b.cap {
+
+		b.fail(BufferOverreadError.at("ReadSLEB128", off, 1, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderreadError.at("ReadSLEB128", off, 1, b.cap))
+		return
+
+	}
+
+	out, n = sleb128(b.buf[off:])
+	if n == 0x00 {
+
+		b.fail(BufferVarintTruncatedError.at("ReadSLEB128", off, b.cap-off, b.cap))
+
+	} else if n < 0x00 {
+
+		b.fail(BufferVarintOverflowError.at("ReadSLEB128", off, maxVarintLen, b.cap))
+		n = 0x00
+
+	}
+	return
+
+}
+
+// ReadSLEB128Next reads a signed LEB128 integer from the buffer at the
+// current offset and moves the offset forward the amount of bytes read
+func (b *Buffer) ReadSLEB128Next() (out int64) {
+
+	out, n := b.ReadSLEB128(b.cursor("ReadSLEB128Next"))
+	b.SeekByte(n, true)
+	return
+
+}
+
+// WriteSLEB128 writes a signed LEB128 integer to the buffer at the
+// specified offset without modifying the internal offset value
+func (b *Buffer) WriteSLEB128(off int64, data int64) {
+
+	if b.err != nil {
+
+		return
+
+	}
+
+	n := sleb128Size(data)
+	if (off+n) > b.cap && !b.reserve(off+n) {
+
+		b.fail(BufferOverwriteError.at("WriteSLEB128", off, n, b.cap))
+		return
+
+	}
+
+	if off < 0x00 {
+
+		b.fail(BufferUnderwriteError.at("WriteSLEB128", off, n, b.cap))
+		return
+
+	}
+
+	b.save(off, n)
+	putSLEB128(b.buf[off:], data)
+
+}
+
+// WriteSLEB128Next writes a signed LEB128 integer to the buffer at the
+// current offset and moves the offset forward the amount of bytes
+// written
+func (b *Buffer) WriteSLEB128Next(data int64) {
+
+	b.WriteSLEB128(b.cursor("WriteSLEB128Next"), data)
+	b.SeekByte(sleb128Size(data), true)
+
+}
+
+// ReadUvarint reads a protobuf-style unsigned varint from the buffer
+// at the specified offset without modifying the internal offset value.
+// n is the amount of bytes the varint took up. the encoding is the
+// same as unsigned LEB128
+func (b *Buffer) ReadUvarint(off int64) (out uint64, n int64) {
+
+	return b.readULEB128("ReadUvarint", off)
+
+}
+
+// ReadUvarintNext reads a protobuf-style unsigned varint from the
+// buffer at the current offset and moves the offset forward the amount
+// of bytes read
+func (b *Buffer) ReadUvarintNext() (out uint64) {
+
+	out, n := b.ReadUvarint(b.cursor("ReadUvarintNext"))
+	b.SeekByte(n, true)
+	return
+
+}
+
+// WriteUvarint writes a protobuf-style unsigned varint to the buffer
+// at the specified offset without modifying the internal offset value
+func (b *Buffer) WriteUvarint(off int64, data uint64) {
+
+	b.writeULEB128("WriteUvarint", off, data)
+
+}
+
+// WriteUvarintNext writes a protobuf-style unsigned varint to the
+// buffer at the current offset and moves the offset forward the amount
+// of bytes written
+func (b *Buffer) WriteUvarintNext(data uint64) {
+
+	b.WriteUvarint(b.cursor("WriteUvarintNext"), data)
+	b.SeekByte(uleb128Size(data), true)
+
+}
+
+// ReadVarint reads a protobuf-style zigzag-encoded signed varint from
+// the buffer at the specified offset without modifying the internal
+// offset value. n is the amount of bytes the varint took up
+func (b *Buffer) ReadVarint(off int64) (out int64, n int64) {
+
+	u, n := b.readULEB128("ReadVarint", off)
+	return unzigzag(u), n
+
+}
+
+// ReadVarintNext reads a protobuf-style zigzag-encoded signed varint
+// from the buffer at the current offset and moves the offset forward
+// the amount of bytes read
+func (b *Buffer) ReadVarintNext() (out int64) {
+
+	out, n := b.ReadVarint(b.cursor("ReadVarintNext"))
+	b.SeekByte(n, true)
+	return
+
+}
+
+// WriteVarint writes a protobuf-style zigzag-encoded signed varint to
+// the buffer at the specified offset without modifying the internal
+// offset value
+func (b *Buffer) WriteVarint(off int64, data int64) {
+
+	b.writeULEB128("WriteVarint", off, zigzag(data))
+
+}
+
+// WriteVarintNext writes a protobuf-style zigzag-encoded signed varint
+// to the buffer at the current offset and moves the offset forward the
+// amount of bytes written
+func (b *Buffer) WriteVarintNext(data int64) {
+
+	b.WriteVarint(b.cursor("WriteVarintNext"), data)
+	b.SeekByte(uleb128Size(zigzag(data)), true)
+
+}
+
+/* MiniBuffer */
+
+// varintFailure panics with the error matching the n returned by
+// uleb128 or sleb128. MiniBuffer does not check bounds, but it can not
+// tell a malformed varint apart from a valid one without doing so
+func (b *MiniBuffer) varintFailure(op string, off, n int64) {
+
+	if n == 0x00 {
+
+		panic(BufferVarintTruncatedError.at(op, off, b.cap-off, b.cap))
+
+	}
+	panic(BufferVarintOverflowError.at(op, off, maxVarintLen, b.cap))
+
+}
+
+// ReadULEB128 stores an unsigned LEB128 integer read from the buffer
+// at the specified offset in out and the amount of bytes it took up in
+// n without modifying the internal offset value
+func (b *MiniBuffer) ReadULEB128(out *uint64, n *int64, off int64) {
+
+	*out, *n = uleb128(b.buf[off:])
+	if *n <= 0x00 {
+
+		b.varintFailure("ReadULEB128", off, *n)
+
+	}
+
+}
+
+// ReadULEB128Next stores an unsigned LEB128 integer read from the
+// buffer at the current offset in out and moves the offset forward the
+// amount of bytes read
+func (b *MiniBuffer) ReadULEB128Next(out *uint64) {
+
+	var n int64
+	b.ReadULEB128(out, &n, b.off)
+	b.SeekByte(n, true)
+
+}
+
+// WriteULEB128 writes an unsigned LEB128 integer to the buffer at the
+// specified offset without modifying the internal offset value
+func (b *MiniBuffer) WriteULEB128(off int64, data uint64) {
+
+	if b.grow && (off+uleb128Size(data)) > b.cap {
+
+		b.reserve(off + uleb128Size(data))
+
+	}
+
+	putULEB128(b.buf[off:], data)
+
+}
+
+// WriteULEB128Next writes an unsigned LEB128 integer to the buffer at
+// the current offset and moves the offset forward the amount of bytes
+// written
+func (b *MiniBuffer) WriteULEB128Next(data uint64) {
+
+	b.WriteULEB128(b.off, data)
+	b.SeekByte(uleb128Size(data), true)
+
+}
+
+// ReadSLEB128 stores a signed LEB128 integer read from the buffer at
+// the specified offset in out and the amount of bytes it took up in n
+// without modifying the internal offset value
+func (b *MiniBuffer) ReadSLEB128(out *int64, n *int64, off int64) {
+
+	*out, *n = sleb128(b.buf[off:])
+	if *n <= 0x00 {
+
+		b.varintFailure("ReadSLEB128", off, *n)
+
+	}
+
+}
+
+// ReadSLEB128Next stores a signed LEB128 integer read from the buffer
+// at the current offset in out and moves the offset forward the amount
+// of bytes read
+func (b *MiniBuffer) ReadSLEB128Next(out *int64) {
+
+	var n int64
+	b.ReadSLEB128(out, &n, b.off)
+	b.SeekByte(n, true)
+
+}
+
+// WriteSLEB128 writes a signed LEB128 integer to the buffer at the
+// specified offset without modifying the internal offset value
+func (b *MiniBuffer) WriteSLEB128(off int64, data int64) {
+
+	if b.grow && (off+sleb128Size(data)) > b.cap {
+
+		b.reserve(off + sleb128Size(data))
+
+	}
+
+	putSLEB128(b.buf[off:], data)
+
+}
+
+// WriteSLEB128Next writes a signed LEB128 integer to the buffer at the
+// current offset and moves the offset forward the amount of bytes
+// written
+func (b *MiniBuffer) WriteSLEB128Next(data int64) {
+
+	b.WriteSLEB128(b.off, data)
+	b.SeekByte(sleb128Size(data), true)
+
+}
+
+// ReadUvarint stores a protobuf-style unsigned varint read from the
+// buffer at the specified offset in out and the amount of bytes it
+// took up in n without modifying the internal offset value
+func (b *MiniBuffer) ReadUvarint(out *uint64, n *int64, off int64) {
+
+	*out, *n = uleb128(b.buf[off:])
+	if *n <= 0x00 {
+
+		b.varintFailure("ReadUvarint", off, *n)
+
+	}
+
+}
+
+// ReadUvarintNext stores a protobuf-style unsigned varint read from the
+// buffer at the current offset in out and moves the offset forward the
+// amount of bytes read
+func (b *MiniBuffer) ReadUvarintNext(out *uint64) {
+
+	var n int64
+	b.ReadUvarint(out, &n, b.off)
+	b.SeekByte(n, true)
+
+}
+
+// WriteUvarint writes a protobuf-style unsigned varint to the buffer
+// at the specified offset without modifying the internal offset value
+func (b *MiniBuffer) WriteUvarint(off int64, data uint64) {
+
+	b.WriteULEB128(off, data)
+
+}
+
+// WriteUvarintNext writes a protobuf-style unsigned varint to the
+// buffer at the current offset and moves the offset forward the amount
+// of bytes written
+func (b *MiniBuffer) WriteUvarintNext(data uint64) {
+
+	b.WriteULEB128Next(data)
+
+}
+
+// ReadVarint stores a protobuf-style zigzag-encoded signed varint read
+// from the buffer at the specified offset in out and the amount of
+// bytes it took up in n without modifying the internal offset value
+func (b *MiniBuffer) ReadVarint(out *int64, n *int64, off int64) {
+
+	u, m := uleb128(b.buf[off:])
+	if m <= 0x00 {
+
+		b.varintFailure("ReadVarint", off, m)
+
+	}
+	*out, *n = unzigzag(u), m
+
+}
+
+// ReadVarintNext stores a protobuf-style zigzag-encoded signed varint
+// read from the buffer at the current offset in out and moves the
+// offset forward the amount of bytes read
+func (b *MiniBuffer) ReadVarintNext(out *int64) {
+
+	var n int64
+	b.ReadVarint(out, &n, b.off)
+	b.SeekByte(n, true)
+
+}
+
+// WriteVarint writes a protobuf-style zigzag-encoded signed varint to
+// the buffer at the specified offset without modifying the internal
+// offset value
+func (b *MiniBuffer) WriteVarint(off int64, data int64) {
+
+	b.WriteULEB128(off, zigzag(data))
+
+}
+
+// WriteVarintNext writes a protobuf-style zigzag-encoded signed varint
+// to the buffer at the current offset and moves the offset forward the
+// amount of bytes written
+func (b *MiniBuffer) WriteVarintNext(data int64) {
+
+	b.WriteULEB128Next(zigzag(data))
+
+}
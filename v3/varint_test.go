@@ -0,0 +1,265 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+tests
+
+*/
+
+func TestBufferULEB128(t *testing.T) {
+
+	for _, expected := range []uint64{0, 1, 127, 128, 624485, math.MaxUint32, math.MaxUint64} {
+
+		buf := NewBuffer()
+		buf.SetGrowth(true, 0)
+
+		buf.WriteULEB128Next(expected)
+		if buf.ByteOffset() != uleb128Size(expected) {
+
+			t.Fatalf("incorrect offset after writing %d: %d", expected, buf.ByteOffset())
+
+		}
+
+		// the encoding is the same as the one used by encoding/binary
+		reference := make([]byte, binary.MaxVarintLen64)
+		if !cmp.Equal(reference[:binary.PutUvarint(reference, expected)], buf.Bytes()) {
+
+			t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), reference)
+
+		}
+
+		buf.SeekByte(0x00, false)
+		out := buf.ReadULEB128Next()
+		if expected != out || buf.ByteOffset() != buf.ByteCapacity() {
+
+			t.Fatalf("expected uint64 does not match the one gotten (got %d at offset %d, expected %d)", out, buf.ByteOffset(), expected)
+
+		}
+
+	}
+
+}
+
+func TestBufferSLEB128(t *testing.T) {
+
+	for _, c := range []struct {
+		value int64
+		bytes []byte
+	}{
+		{0, []byte{0x00}},
+		{2, []byte{0x02}},
+		{-2, []byte{0x7e}},
+		{127, []byte{0xff, 0x00}},
+		{-127, []byte{0x81, 0x7f}},
+		{-123456, []byte{0xc0, 0xbb, 0x78}},
+		{math.MaxInt64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}},
+		{math.MinInt64, []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f}},
+	} {
+
+		buf := NewBuffer(make([]byte, len(c.bytes)))
+
+		buf.WriteSLEB128Next(c.value)
+		if !cmp.Equal(c.bytes, buf.Bytes()) || buf.ByteOffset() != buf.ByteCapacity() {
+
+			t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), c.bytes)
+
+		}
+
+		out, n := buf.ReadSLEB128(0x00)
+		if c.value != out || n != int64(len(c.bytes)) {
+
+			t.Fatalf("expected int64 does not match the one gotten (got %d with length %d, expected %d)", out, n, c.value)
+
+		}
+
+	}
+
+}
+
+func TestBufferVarint(t *testing.T) {
+
+	var expected = []int64{0, -1, 1, -64, 64, math.MinInt64, math.MaxInt64}
+
+	buf := NewBuffer()
+	buf.SetGrowth(true, 0)
+
+	for _, v := range expected {
+
+		buf.WriteVarintNext(v)
+
+	}
+
+	// the encoding is the same as the one used by encoding/binary
+	reference, n := make([]byte, len(expected)*binary.MaxVarintLen64), 0
+	for _, v := range expected {
+
+		n += binary.PutVarint(reference[n:], v)
+
+	}
+	if !cmp.Equal(reference[:n], buf.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", buf.Bytes(), reference)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	for _, v := range expected {
+
+		if out := buf.ReadVarintNext(); out != v {
+
+			t.Fatalf("expected int64 does not match the one gotten (got %d, expected %d)", out, v)
+
+		}
+
+	}
+
+	buf.SeekByte(0x00, false)
+	buf.WriteUvarintNext(300)
+	if out, n := buf.ReadUvarint(0x00); out != 300 || n != 2 {
+
+		t.Fatalf("expected uint64 does not match the one gotten (got %d with length %d, expected 300)", out, n)
+
+	}
+
+}
+
+func TestBufferVarintErrors(t *testing.T) {
+
+	for i, c := range []struct {
+		bytes    []byte
+		read     func(*Buffer)
+		expected Error
+	}{
+		{[]byte{0x80, 0x80}, func(b *Buffer) { b.ReadULEB128Next() }, BufferVarintTruncatedError},
+		{[]byte{0xff}, func(b *Buffer) { b.ReadSLEB128Next() }, BufferVarintTruncatedError},
+		{[]byte{}, func(b *Buffer) { b.ReadVarintNext() }, BufferOverreadError},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02}, func(b *Buffer) { b.ReadUvarintNext() }, BufferVarintOverflowError},
+		{[]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00}, func(b *Buffer) { b.ReadULEB128Next() }, BufferVarintOverflowError},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, func(b *Buffer) { b.ReadSLEB128Next() }, BufferVarintOverflowError},
+		{[]byte{0x00}, func(b *Buffer) { b.WriteUvarintNext(128) }, BufferOverwriteError},
+		{[]byte{0x00}, func(b *Buffer) { b.WriteSLEB128(-0x01, 0) }, BufferUnderwriteError},
+	} {
+
+		buf := NewBuffer(c.bytes)
+		buf.SetSticky(true)
+
+		c.read(buf)
+		if !errors.Is(buf.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, buf.Err(), c.expected)
+
+		}
+
+		if buf.ByteOffset() != 0x00 {
+
+			t.Fatalf("case %d: incorrect offset: %d", i, buf.ByteOffset())
+
+		}
+
+	}
+
+}
+
+func TestMiniBufferVarint(t *testing.T) {
+
+	var (
+		out1 uint64
+		out2 int64
+		out3 int64
+		n    int64
+	)
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf)
+	buf.SetGrowth(true, 0)
+
+	buf.WriteULEB128Next(624485)
+	buf.WriteSLEB128Next(-123456)
+	buf.WriteVarintNext(-3)
+	if !cmp.Equal([]byte{0xe5, 0x8e, 0x26, 0xc0, 0xbb, 0x78, 0x05}, buf.buf) {
+
+		t.Fatalf("unexpected byte array: %#v", buf.buf)
+
+	}
+
+	buf.SeekByte(0x00, false)
+	buf.ReadUvarintNext(&out1)
+	buf.ReadSLEB128Next(&out2)
+	buf.ReadVarint(&out3, &n, buf.off)
+	if out1 != 624485 || out2 != -123456 || out3 != -3 || n != 1 || buf.off != 6 {
+
+		t.Fatalf("unexpected values read: %d, %d, %d (length %d, offset %d)", out1, out2, out3, n, buf.off)
+
+	}
+
+}
+
+func TestMiniBufferVarintPanic(t *testing.T) {
+
+	defer panicChecker(t, BufferVarintTruncatedError.at("ReadULEB128", 0x00, 2, 2))
+
+	var out uint64
+
+	buf := &MiniBuffer{}
+	NewMiniBuffer(&buf, []byte{0x80, 0x80})
+
+	buf.ReadULEB128Next(&out)
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkBufferWriteULEB128(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer(make([]byte, 10))
+
+	for n := 0; n < b.N; n++ {
+
+		buf.WriteULEB128(0x00, 624485)
+
+	}
+
+}
+
+func BenchmarkBufferReadULEB128(b *testing.B) {
+
+	b.ReportAllocs()
+
+	buf := NewBuffer([]byte{0xe5, 0x8e, 0x26})
+
+	var out uint64
+	for n := 0; n < b.N; n++ {
+
+		out, _ = buf.ReadULEB128(0x00)
+
+	}
+
+	_ = out
+
+}
@@ -0,0 +1,1245 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"errors"
+	"io"
+)
+
+// defaultWriterSize is the size of the window of a Writer created with
+// NewWriter
+const defaultWriterSize = 4096
+
+// Writer implements a streaming encoder in go that writes data to an
+// io.Writer through a window that is flushed whenever it fills up, so
+// that output of any length can be encoded using a bounded amount of
+// memory. it only moves forward, and its byte and bit operations share
+// a single position as they do in a Buffer using UnifiedCursor.
+//
+// a byte that has only been partially written by the bit methods stays
+// in the window until it is completed, so Flush only writes whole
+// bytes. AlignByte and Close pad it with zero bits instead.
+//
+// instead of panicking, a Writer records the first error it runs into
+// and every following call becomes a no-op, like a Buffer in sticky mode
+type Writer struct {
+	w io.Writer
+
+	// buf holds the window. the bytes that have not been flushed yet
+	// are buf[:tail], followed by the partially written byte at tail if
+	// bit is not zero
+	buf  []byte
+	tail int
+
+	// off is the amount of bytes flushed before the window and bit is
+	// the bit offset within the byte at tail
+	off int64
+	bit int64
+
+	err error
+
+	order BitOrder
+
+	// scratch is the buffer the typed methods operate on
+	scratch Buffer
+}
+
+// NewWriter initializes a new Writer that writes to w with a window of
+// the default size
+func NewWriter(w io.Writer) *Writer {
+
+	return NewWriterSize(w, defaultWriterSize)
+
+}
+
+// NewWriterSize initializes a new Writer that writes to w with a window
+// of at least size bytes. the window only grows past size if a single
+// value needs more than that
+func NewWriterSize(w io.Writer, size int) *Writer {
+
+	if size < 16 {
+
+		size = 16
+
+	}
+
+	return &Writer{
+		w:   w,
+		buf: make([]byte, size),
+	}
+
+}
+
+/* internal use methods */
+
+// output writes p to the underlying io.Writer, recording an error if it
+// could not be written in full
+func (b *Writer) output(p []byte) bool {
+
+	n, err := b.w.Write(p)
+	b.off += int64(n)
+	if err == nil && n < len(p) {
+
+		err = io.ErrShortWrite
+
+	}
+
+	if err != nil {
+
+		b.err = err
+		return false
+
+	}
+	return true
+
+}
+
+// flush writes the whole bytes in the window and moves the partially
+// written one, if any, to the start of it
+func (b *Writer) flush() bool {
+
+	if b.tail == 0x00 {
+
+		return true
+
+	}
+
+	if !b.output(b.buf[:b.tail]) {
+
+		return false
+
+	}
+
+	if b.bit > 0x00 {
+
+		b.buf[0] = b.buf[b.tail]
+
+	}
+	b.tail = 0x00
+	return true
+
+}
+
+// reserve makes sure that at least n bytes, counting the partially
+// written one, are available at the end of the window, flushing or
+// growing it if they are not
+func (b *Writer) reserve(n int) bool {
+
+	if b.tail+n <= len(b.buf) {
+
+		return true
+
+	}
+
+	if !b.flush() {
+
+		return false
+
+	}
+
+	if n > len(b.buf) {
+
+		buf := make([]byte, n)
+		buf[0] = b.buf[0]
+		b.buf = buf
+
+	}
+	return true
+
+}
+
+// align moves the position to the next byte boundary if it is not on
+// one already, padding the partially written byte with zero bits
+func (b *Writer) align() {
+
+	if b.bit > 0x00 {
+
+		b.tail++
+		b.bit = 0x00
+
+	}
+
+}
+
+// window produces the next n bytes of the output and returns the
+// scratch buffer pointed at them, or nil if there is no room for them
+func (b *Writer) window(op string, n int64) *Buffer {
+
+	if b.err != nil {
+
+		return nil
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidByteCountError.count(op, n, b.ByteOffset())
+		return nil
+
+	}
+
+	b.align()
+	if !b.reserve(int(n)) {
+
+		return nil
+
+	}
+
+	b.scratch.buf = b.buf[b.tail : b.tail+int(n) : b.tail+int(n)]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+	b.tail += int(n)
+	return &b.scratch
+
+}
+
+// bitWindow is the bit-level variant of window. it produces the next n
+// bits of the output and returns the scratch buffer pointed at the
+// bytes containing them, along with the offset of the first of them
+// within it
+func (b *Writer) bitWindow(op string, n int64) (*Buffer, int64) {
+
+	if b.err != nil {
+
+		return nil, 0x00
+
+	}
+
+	if n < 0x00 {
+
+		b.err = BufferInvalidBitCountError.count(op, n, b.BitOffset())
+		return nil, 0x00
+
+	}
+
+	length := int((b.bit + n + 7) / 8)
+	if !b.reserve(length) {
+
+		return nil, 0x00
+
+	}
+
+	// the bits that are not written yet are kept at zero, so that the
+	// last byte is padded with them
+	start := b.tail
+	if b.bit > 0x00 {
+
+		start++
+
+	}
+
+	for i := start; i < b.tail+length; i++ {
+
+		b.buf[i] = 0x00
+
+	}
+
+	b.scratch.buf = b.buf[b.tail : b.tail+length : b.tail+length]
+	b.scratch.order = b.order
+	b.scratch.Refresh()
+
+	bit := b.bit
+	b.tail += int((b.bit + n) / 8)
+	b.bit = (b.bit + n) % 8
+	return &b.scratch, bit
+
+}
+
+/* bitfield methods */
+
+// SetBitNext sets the next bit and moves the position forward a bit
+func (b *Writer) SetBitNext() {
+
+	if w, bit := b.bitWindow("SetBitNext", 1); w != nil {
+
+		w.SetBit(bit)
+
+	}
+
+}
+
+// ClearBitNext clears the next bit and moves the position forward a bit
+func (b *Writer) ClearBitNext() {
+
+	b.bitWindow("ClearBitNext", 1)
+
+}
+
+// SetBitsNext writes the last n bits of data and moves the position
+// forward the amount of bits written
+func (b *Writer) SetBitsNext(data uint64, n int64) {
+
+	if w, bit := b.bitWindow("SetBitsNext", n); w != nil {
+
+		w.SetBits(bit, data, n)
+
+	}
+
+}
+
+// AlignByte moves the position forward to the next byte boundary if it
+// is not on one already, padding the current byte with zero bits
+func (b *Writer) AlignByte() {
+
+	if b.err != nil {
+
+		return
+
+	}
+	b.align()
+
+}
+
+/* byte buffer methods */
+
+// WriteBytesNext writes data, starting at the next byte boundary, and
+// moves the position forward the amount of bytes written. slices at
+// least as large as the window are written to the underlying io.Writer
+// directly
+func (b *Writer) WriteBytesNext(data []byte) {
+
+	if b.err != nil {
+
+		return
+
+	}
+	b.write(data)
+
+}
+
+// WriteByteNext writes a byte, starting at the next byte boundary, and
+// moves the position forward a byte
+func (b *Writer) WriteByteNext(data byte) {
+
+	if w := b.window("WriteByteNext", 1); w != nil {
+
+		w.buf[0] = data
+
+	}
+
+}
+
+// WriteU16LENext writes a slice of uint16s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU16LENext(data []uint16) {
+	if w := b.window("WriteU16LENext", int64(len(data))*2); w != nil && len(data) > 0 {
+		w.WriteU16LE(0, data)
+	}
+}
+
+// PutU16LENext writes a uint16 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU16LENext(data uint16) {
+	if w := b.window("PutU16LENext", 2); w != nil {
+		w.PutU16LE(0, data)
+	}
+}
+
+// PutU16LEBitsNext writes a uint16 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU16LEBitsNext(data uint16) {
+	if w, bit := b.bitWindow("PutU16LEBitsNext", 16); w != nil {
+		w.PutU16LEBits(bit, data)
+	}
+}
+
+// WriteU16BENext writes a slice of uint16s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU16BENext(data []uint16) {
+	if w := b.window("WriteU16BENext", int64(len(data))*2); w != nil && len(data) > 0 {
+		w.WriteU16BE(0, data)
+	}
+}
+
+// PutU16BENext writes a uint16 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU16BENext(data uint16) {
+	if w := b.window("PutU16BENext", 2); w != nil {
+		w.PutU16BE(0, data)
+	}
+}
+
+// PutU16BEBitsNext writes a uint16 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU16BEBitsNext(data uint16) {
+	if w, bit := b.bitWindow("PutU16BEBitsNext", 16); w != nil {
+		w.PutU16BEBits(bit, data)
+	}
+}
+
+// WriteU24LENext writes a slice of uint32s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU24LENext(data []uint32) {
+	if w := b.window("WriteU24LENext", int64(len(data))*3); w != nil && len(data) > 0 {
+		w.WriteU24LE(0, data)
+	}
+}
+
+// PutU24LENext writes a uint32 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU24LENext(data uint32) {
+	if w := b.window("PutU24LENext", 3); w != nil {
+		w.PutU24LE(0, data)
+	}
+}
+
+// PutU24LEBitsNext writes a uint32 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU24LEBitsNext(data uint32) {
+	if w, bit := b.bitWindow("PutU24LEBitsNext", 24); w != nil {
+		w.PutU24LEBits(bit, data)
+	}
+}
+
+// WriteU24BENext writes a slice of uint32s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU24BENext(data []uint32) {
+	if w := b.window("WriteU24BENext", int64(len(data))*3); w != nil && len(data) > 0 {
+		w.WriteU24BE(0, data)
+	}
+}
+
+// PutU24BENext writes a uint32 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU24BENext(data uint32) {
+	if w := b.window("PutU24BENext", 3); w != nil {
+		w.PutU24BE(0, data)
+	}
+}
+
+// PutU24BEBitsNext writes a uint32 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU24BEBitsNext(data uint32) {
+	if w, bit := b.bitWindow("PutU24BEBitsNext", 24); w != nil {
+		w.PutU24BEBits(bit, data)
+	}
+}
+
+// WriteU32LENext writes a slice of uint32s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU32LENext(data []uint32) {
+	if w := b.window("WriteU32LENext", int64(len(data))*4); w != nil && len(data) > 0 {
+		w.WriteU32LE(0, data)
+	}
+}
+
+// PutU32LENext writes a uint32 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU32LENext(data uint32) {
+	if w := b.window("PutU32LENext", 4); w != nil {
+		w.PutU32LE(0, data)
+	}
+}
+
+// PutU32LEBitsNext writes a uint32 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU32LEBitsNext(data uint32) {
+	if w, bit := b.bitWindow("PutU32LEBitsNext", 32); w != nil {
+		w.PutU32LEBits(bit, data)
+	}
+}
+
+// WriteU32BENext writes a slice of uint32s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU32BENext(data []uint32) {
+	if w := b.window("WriteU32BENext", int64(len(data))*4); w != nil && len(data) > 0 {
+		w.WriteU32BE(0, data)
+	}
+}
+
+// PutU32BENext writes a uint32 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU32BENext(data uint32) {
+	if w := b.window("PutU32BENext", 4); w != nil {
+		w.PutU32BE(0, data)
+	}
+}
+
+// PutU32BEBitsNext writes a uint32 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU32BEBitsNext(data uint32) {
+	if w, bit := b.bitWindow("PutU32BEBitsNext", 32); w != nil {
+		w.PutU32BEBits(bit, data)
+	}
+}
+
+// WriteU40LENext writes a slice of uint64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU40LENext(data []uint64) {
+	if w := b.window("WriteU40LENext", int64(len(data))*5); w != nil && len(data) > 0 {
+		w.WriteU40LE(0, data)
+	}
+}
+
+// PutU40LENext writes a uint64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU40LENext(data uint64) {
+	if w := b.window("PutU40LENext", 5); w != nil {
+		w.PutU40LE(0, data)
+	}
+}
+
+// PutU40LEBitsNext writes a uint64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU40LEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU40LEBitsNext", 40); w != nil {
+		w.PutU40LEBits(bit, data)
+	}
+}
+
+// WriteU40BENext writes a slice of uint64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU40BENext(data []uint64) {
+	if w := b.window("WriteU40BENext", int64(len(data))*5); w != nil && len(data) > 0 {
+		w.WriteU40BE(0, data)
+	}
+}
+
+// PutU40BENext writes a uint64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU40BENext(data uint64) {
+	if w := b.window("PutU40BENext", 5); w != nil {
+		w.PutU40BE(0, data)
+	}
+}
+
+// PutU40BEBitsNext writes a uint64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU40BEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU40BEBitsNext", 40); w != nil {
+		w.PutU40BEBits(bit, data)
+	}
+}
+
+// WriteU48LENext writes a slice of uint64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU48LENext(data []uint64) {
+	if w := b.window("WriteU48LENext", int64(len(data))*6); w != nil && len(data) > 0 {
+		w.WriteU48LE(0, data)
+	}
+}
+
+// PutU48LENext writes a uint64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU48LENext(data uint64) {
+	if w := b.window("PutU48LENext", 6); w != nil {
+		w.PutU48LE(0, data)
+	}
+}
+
+// PutU48LEBitsNext writes a uint64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU48LEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU48LEBitsNext", 48); w != nil {
+		w.PutU48LEBits(bit, data)
+	}
+}
+
+// WriteU48BENext writes a slice of uint64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU48BENext(data []uint64) {
+	if w := b.window("WriteU48BENext", int64(len(data))*6); w != nil && len(data) > 0 {
+		w.WriteU48BE(0, data)
+	}
+}
+
+// PutU48BENext writes a uint64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU48BENext(data uint64) {
+	if w := b.window("PutU48BENext", 6); w != nil {
+		w.PutU48BE(0, data)
+	}
+}
+
+// PutU48BEBitsNext writes a uint64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU48BEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU48BEBitsNext", 48); w != nil {
+		w.PutU48BEBits(bit, data)
+	}
+}
+
+// WriteU56LENext writes a slice of uint64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU56LENext(data []uint64) {
+	if w := b.window("WriteU56LENext", int64(len(data))*7); w != nil && len(data) > 0 {
+		w.WriteU56LE(0, data)
+	}
+}
+
+// PutU56LENext writes a uint64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU56LENext(data uint64) {
+	if w := b.window("PutU56LENext", 7); w != nil {
+		w.PutU56LE(0, data)
+	}
+}
+
+// PutU56LEBitsNext writes a uint64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU56LEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU56LEBitsNext", 56); w != nil {
+		w.PutU56LEBits(bit, data)
+	}
+}
+
+// WriteU56BENext writes a slice of uint64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU56BENext(data []uint64) {
+	if w := b.window("WriteU56BENext", int64(len(data))*7); w != nil && len(data) > 0 {
+		w.WriteU56BE(0, data)
+	}
+}
+
+// PutU56BENext writes a uint64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU56BENext(data uint64) {
+	if w := b.window("PutU56BENext", 7); w != nil {
+		w.PutU56BE(0, data)
+	}
+}
+
+// PutU56BEBitsNext writes a uint64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU56BEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU56BEBitsNext", 56); w != nil {
+		w.PutU56BEBits(bit, data)
+	}
+}
+
+// WriteU64LENext writes a slice of uint64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU64LENext(data []uint64) {
+	if w := b.window("WriteU64LENext", int64(len(data))*8); w != nil && len(data) > 0 {
+		w.WriteU64LE(0, data)
+	}
+}
+
+// PutU64LENext writes a uint64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU64LENext(data uint64) {
+	if w := b.window("PutU64LENext", 8); w != nil {
+		w.PutU64LE(0, data)
+	}
+}
+
+// PutU64LEBitsNext writes a uint64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU64LEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU64LEBitsNext", 64); w != nil {
+		w.PutU64LEBits(bit, data)
+	}
+}
+
+// WriteU64BENext writes a slice of uint64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteU64BENext(data []uint64) {
+	if w := b.window("WriteU64BENext", int64(len(data))*8); w != nil && len(data) > 0 {
+		w.WriteU64BE(0, data)
+	}
+}
+
+// PutU64BENext writes a uint64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutU64BENext(data uint64) {
+	if w := b.window("PutU64BENext", 8); w != nil {
+		w.PutU64BE(0, data)
+	}
+}
+
+// PutU64BEBitsNext writes a uint64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutU64BEBitsNext(data uint64) {
+	if w, bit := b.bitWindow("PutU64BEBitsNext", 64); w != nil {
+		w.PutU64BEBits(bit, data)
+	}
+}
+
+// WriteI16LENext writes a slice of int16s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI16LENext(data []int16) {
+	if w := b.window("WriteI16LENext", int64(len(data))*2); w != nil && len(data) > 0 {
+		w.WriteI16LE(0, data)
+	}
+}
+
+// PutI16LENext writes an int16 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI16LENext(data int16) {
+	if w := b.window("PutI16LENext", 2); w != nil {
+		w.PutI16LE(0, data)
+	}
+}
+
+// PutI16LEBitsNext writes an int16 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI16LEBitsNext(data int16) {
+	if w, bit := b.bitWindow("PutI16LEBitsNext", 16); w != nil {
+		w.PutI16LEBits(bit, data)
+	}
+}
+
+// WriteI16BENext writes a slice of int16s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI16BENext(data []int16) {
+	if w := b.window("WriteI16BENext", int64(len(data))*2); w != nil && len(data) > 0 {
+		w.WriteI16BE(0, data)
+	}
+}
+
+// PutI16BENext writes an int16 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI16BENext(data int16) {
+	if w := b.window("PutI16BENext", 2); w != nil {
+		w.PutI16BE(0, data)
+	}
+}
+
+// PutI16BEBitsNext writes an int16 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI16BEBitsNext(data int16) {
+	if w, bit := b.bitWindow("PutI16BEBitsNext", 16); w != nil {
+		w.PutI16BEBits(bit, data)
+	}
+}
+
+// WriteI24LENext writes a slice of int32s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI24LENext(data []int32) {
+	if w := b.window("WriteI24LENext", int64(len(data))*3); w != nil && len(data) > 0 {
+		w.WriteI24LE(0, data)
+	}
+}
+
+// PutI24LENext writes an int32 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI24LENext(data int32) {
+	if w := b.window("PutI24LENext", 3); w != nil {
+		w.PutI24LE(0, data)
+	}
+}
+
+// PutI24LEBitsNext writes an int32 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI24LEBitsNext(data int32) {
+	if w, bit := b.bitWindow("PutI24LEBitsNext", 24); w != nil {
+		w.PutI24LEBits(bit, data)
+	}
+}
+
+// WriteI24BENext writes a slice of int32s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI24BENext(data []int32) {
+	if w := b.window("WriteI24BENext", int64(len(data))*3); w != nil && len(data) > 0 {
+		w.WriteI24BE(0, data)
+	}
+}
+
+// PutI24BENext writes an int32 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI24BENext(data int32) {
+	if w := b.window("PutI24BENext", 3); w != nil {
+		w.PutI24BE(0, data)
+	}
+}
+
+// PutI24BEBitsNext writes an int32 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI24BEBitsNext(data int32) {
+	if w, bit := b.bitWindow("PutI24BEBitsNext", 24); w != nil {
+		w.PutI24BEBits(bit, data)
+	}
+}
+
+// WriteI32LENext writes a slice of int32s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI32LENext(data []int32) {
+	if w := b.window("WriteI32LENext", int64(len(data))*4); w != nil && len(data) > 0 {
+		w.WriteI32LE(0, data)
+	}
+}
+
+// PutI32LENext writes an int32 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI32LENext(data int32) {
+	if w := b.window("PutI32LENext", 4); w != nil {
+		w.PutI32LE(0, data)
+	}
+}
+
+// PutI32LEBitsNext writes an int32 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI32LEBitsNext(data int32) {
+	if w, bit := b.bitWindow("PutI32LEBitsNext", 32); w != nil {
+		w.PutI32LEBits(bit, data)
+	}
+}
+
+// WriteI32BENext writes a slice of int32s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI32BENext(data []int32) {
+	if w := b.window("WriteI32BENext", int64(len(data))*4); w != nil && len(data) > 0 {
+		w.WriteI32BE(0, data)
+	}
+}
+
+// PutI32BENext writes an int32 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI32BENext(data int32) {
+	if w := b.window("PutI32BENext", 4); w != nil {
+		w.PutI32BE(0, data)
+	}
+}
+
+// PutI32BEBitsNext writes an int32 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI32BEBitsNext(data int32) {
+	if w, bit := b.bitWindow("PutI32BEBitsNext", 32); w != nil {
+		w.PutI32BEBits(bit, data)
+	}
+}
+
+// WriteI40LENext writes a slice of int64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI40LENext(data []int64) {
+	if w := b.window("WriteI40LENext", int64(len(data))*5); w != nil && len(data) > 0 {
+		w.WriteI40LE(0, data)
+	}
+}
+
+// PutI40LENext writes an int64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI40LENext(data int64) {
+	if w := b.window("PutI40LENext", 5); w != nil {
+		w.PutI40LE(0, data)
+	}
+}
+
+// PutI40LEBitsNext writes an int64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI40LEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI40LEBitsNext", 40); w != nil {
+		w.PutI40LEBits(bit, data)
+	}
+}
+
+// WriteI40BENext writes a slice of int64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI40BENext(data []int64) {
+	if w := b.window("WriteI40BENext", int64(len(data))*5); w != nil && len(data) > 0 {
+		w.WriteI40BE(0, data)
+	}
+}
+
+// PutI40BENext writes an int64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI40BENext(data int64) {
+	if w := b.window("PutI40BENext", 5); w != nil {
+		w.PutI40BE(0, data)
+	}
+}
+
+// PutI40BEBitsNext writes an int64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI40BEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI40BEBitsNext", 40); w != nil {
+		w.PutI40BEBits(bit, data)
+	}
+}
+
+// WriteI48LENext writes a slice of int64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI48LENext(data []int64) {
+	if w := b.window("WriteI48LENext", int64(len(data))*6); w != nil && len(data) > 0 {
+		w.WriteI48LE(0, data)
+	}
+}
+
+// PutI48LENext writes an int64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI48LENext(data int64) {
+	if w := b.window("PutI48LENext", 6); w != nil {
+		w.PutI48LE(0, data)
+	}
+}
+
+// PutI48LEBitsNext writes an int64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI48LEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI48LEBitsNext", 48); w != nil {
+		w.PutI48LEBits(bit, data)
+	}
+}
+
+// WriteI48BENext writes a slice of int64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI48BENext(data []int64) {
+	if w := b.window("WriteI48BENext", int64(len(data))*6); w != nil && len(data) > 0 {
+		w.WriteI48BE(0, data)
+	}
+}
+
+// PutI48BENext writes an int64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI48BENext(data int64) {
+	if w := b.window("PutI48BENext", 6); w != nil {
+		w.PutI48BE(0, data)
+	}
+}
+
+// PutI48BEBitsNext writes an int64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI48BEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI48BEBitsNext", 48); w != nil {
+		w.PutI48BEBits(bit, data)
+	}
+}
+
+// WriteI56LENext writes a slice of int64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI56LENext(data []int64) {
+	if w := b.window("WriteI56LENext", int64(len(data))*7); w != nil && len(data) > 0 {
+		w.WriteI56LE(0, data)
+	}
+}
+
+// PutI56LENext writes an int64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI56LENext(data int64) {
+	if w := b.window("PutI56LENext", 7); w != nil {
+		w.PutI56LE(0, data)
+	}
+}
+
+// PutI56LEBitsNext writes an int64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI56LEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI56LEBitsNext", 56); w != nil {
+		w.PutI56LEBits(bit, data)
+	}
+}
+
+// WriteI56BENext writes a slice of int64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI56BENext(data []int64) {
+	if w := b.window("WriteI56BENext", int64(len(data))*7); w != nil && len(data) > 0 {
+		w.WriteI56BE(0, data)
+	}
+}
+
+// PutI56BENext writes an int64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI56BENext(data int64) {
+	if w := b.window("PutI56BENext", 7); w != nil {
+		w.PutI56BE(0, data)
+	}
+}
+
+// PutI56BEBitsNext writes an int64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI56BEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI56BEBitsNext", 56); w != nil {
+		w.PutI56BEBits(bit, data)
+	}
+}
+
+// WriteI64LENext writes a slice of int64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI64LENext(data []int64) {
+	if w := b.window("WriteI64LENext", int64(len(data))*8); w != nil && len(data) > 0 {
+		w.WriteI64LE(0, data)
+	}
+}
+
+// PutI64LENext writes an int64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI64LENext(data int64) {
+	if w := b.window("PutI64LENext", 8); w != nil {
+		w.PutI64LE(0, data)
+	}
+}
+
+// PutI64LEBitsNext writes an int64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI64LEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI64LEBitsNext", 64); w != nil {
+		w.PutI64LEBits(bit, data)
+	}
+}
+
+// WriteI64BENext writes a slice of int64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteI64BENext(data []int64) {
+	if w := b.window("WriteI64BENext", int64(len(data))*8); w != nil && len(data) > 0 {
+		w.WriteI64BE(0, data)
+	}
+}
+
+// PutI64BENext writes an int64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutI64BENext(data int64) {
+	if w := b.window("PutI64BENext", 8); w != nil {
+		w.PutI64BE(0, data)
+	}
+}
+
+// PutI64BEBitsNext writes an int64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutI64BEBitsNext(data int64) {
+	if w, bit := b.bitWindow("PutI64BEBitsNext", 64); w != nil {
+		w.PutI64BEBits(bit, data)
+	}
+}
+
+// WriteF32LENext writes a slice of float32s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteF32LENext(data []float32) {
+	if w := b.window("WriteF32LENext", int64(len(data))*4); w != nil && len(data) > 0 {
+		w.WriteF32LE(0, data)
+	}
+}
+
+// PutF32LENext writes a float32 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutF32LENext(data float32) {
+	if w := b.window("PutF32LENext", 4); w != nil {
+		w.PutF32LE(0, data)
+	}
+}
+
+// PutF32LEBitsNext writes a float32 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutF32LEBitsNext(data float32) {
+	if w, bit := b.bitWindow("PutF32LEBitsNext", 32); w != nil {
+		w.PutF32LEBits(bit, data)
+	}
+}
+
+// WriteF32BENext writes a slice of float32s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteF32BENext(data []float32) {
+	if w := b.window("WriteF32BENext", int64(len(data))*4); w != nil && len(data) > 0 {
+		w.WriteF32BE(0, data)
+	}
+}
+
+// PutF32BENext writes a float32 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutF32BENext(data float32) {
+	if w := b.window("PutF32BENext", 4); w != nil {
+		w.PutF32BE(0, data)
+	}
+}
+
+// PutF32BEBitsNext writes a float32 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutF32BEBitsNext(data float32) {
+	if w, bit := b.bitWindow("PutF32BEBitsNext", 32); w != nil {
+		w.PutF32BEBits(bit, data)
+	}
+}
+
+// WriteF64LENext writes a slice of float64s to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteF64LENext(data []float64) {
+	if w := b.window("WriteF64LENext", int64(len(data))*8); w != nil && len(data) > 0 {
+		w.WriteF64LE(0, data)
+	}
+}
+
+// PutF64LENext writes a float64 to the output in
+// little-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutF64LENext(data float64) {
+	if w := b.window("PutF64LENext", 8); w != nil {
+		w.PutF64LE(0, data)
+	}
+}
+
+// PutF64LEBitsNext writes a float64 to the output in
+// little-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutF64LEBitsNext(data float64) {
+	if w, bit := b.bitWindow("PutF64LEBitsNext", 64); w != nil {
+		w.PutF64LEBits(bit, data)
+	}
+}
+
+// WriteF64BENext writes a slice of float64s to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) WriteF64BENext(data []float64) {
+	if w := b.window("WriteF64BENext", int64(len(data))*8); w != nil && len(data) > 0 {
+		w.WriteF64BE(0, data)
+	}
+}
+
+// PutF64BENext writes a float64 to the output in
+// big-endian and moves the position forward the amount of bytes written
+func (b *Writer) PutF64BENext(data float64) {
+	if w := b.window("PutF64BENext", 8); w != nil {
+		w.PutF64BE(0, data)
+	}
+}
+
+// PutF64BEBitsNext writes a float64 to the output in
+// big-endian at the current bit position and moves it forward the amount of bits written
+func (b *Writer) PutF64BEBitsNext(data float64) {
+	if w, bit := b.bitWindow("PutF64BEBitsNext", 64); w != nil {
+		w.PutF64BEBits(bit, data)
+	}
+}
+
+// write writes p starting at the next byte boundary, bypassing the
+// window if p is at least as large as it, and returns the amount of
+// bytes written
+func (b *Writer) write(p []byte) int {
+
+	b.align()
+	if len(p) >= len(b.buf) {
+
+		if !b.flush() {
+
+			return 0x00
+
+		}
+
+		off := b.off
+		b.output(p)
+		return int(b.off - off)
+
+	}
+
+	if !b.reserve(len(p)) {
+
+		return 0x00
+
+	}
+
+	b.tail += copy(b.buf[b.tail:], p)
+	return len(p)
+
+}
+
+// Write implements io.Writer. it writes p, starting at the next byte
+// boundary, and moves the position forward the amount of bytes written
+func (b *Writer) Write(p []byte) (n int, err error) {
+
+	if b.err != nil {
+
+		return 0, b.err
+
+	}
+
+	n = b.write(p)
+	return n, b.err
+
+}
+
+// Flush writes the whole bytes in the window to the underlying
+// io.Writer. a partially written byte stays in the window until it is
+// completed, aligned or the writer is closed
+func (b *Writer) Flush() error {
+
+	if b.err != nil {
+
+		return b.err
+
+	}
+
+	b.flush()
+	return b.err
+
+}
+
+// Close pads the partially written byte, if any, with zero bits and
+// flushes the window. it does not close the underlying io.Writer, and
+// every following write records WriterClosedError
+func (b *Writer) Close() error {
+
+	if errors.Is(b.err, WriterClosedError) {
+
+		return nil
+
+	}
+
+	if b.err != nil {
+
+		return b.err
+
+	}
+
+	b.align()
+	if !b.flush() {
+
+		return b.err
+
+	}
+
+	b.err = WriterClosedError
+	return nil
+
+}
+
+// Reset discards the state of the writer, including anything that has
+// not been flushed, and makes it write to w
+func (b *Writer) Reset(w io.Writer) {
+
+	b.w = w
+	b.tail = 0x00
+	b.off = 0x00
+	b.bit = 0x00
+	b.err = nil
+
+}
+
+// SetBitOrder sets the order in which the bits of each byte are
+// numbered by the bit methods of the writer. it defaults to MSBFirst
+func (b *Writer) SetBitOrder(order BitOrder) {
+
+	b.order = order
+
+}
+
+// Buffered returns the amount of bytes that have been written to the
+// window but not flushed yet, counting a partially written byte
+func (b *Writer) Buffered() int {
+
+	if b.bit > 0x00 {
+
+		return b.tail + 1
+
+	}
+	return b.tail
+
+}
+
+// ByteOffset returns the amount of bytes written so far, counting a
+// partially written byte
+func (b *Writer) ByteOffset() int64 {
+
+	return b.off + int64(b.Buffered())
+
+}
+
+// BitOffset returns the amount of bits written so far
+func (b *Writer) BitOffset() int64 {
+
+	return (b.off+int64(b.tail))*8 + b.bit
+
+}
+
+// Err returns the error recorded by the writer, if any. it is
+// WriterClosedError once the writer has been closed
+func (b *Writer) Err() error {
+
+	return b.err
+
+}
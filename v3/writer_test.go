@@ -0,0 +1,277 @@
+/*
+
+crunch - utilities for taking bytes out of things
+Copyright (c) 2019-2020 superwhiskers <whiskerdev@protonmail.com>
+
+This Source Code Form is subject to the terms of the Mozilla Public
+License, v. 2.0. If a copy of the MPL was not distributed with this
+file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+*/
+
+package v3
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+/*
+
+utilities
+
+*/
+
+// limitedWriter is an io.Writer that fails once more than n bytes have
+// been written to it
+type limitedWriter struct {
+	n   int
+	err error
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+
+	if len(p) > w.n {
+
+		n := w.n
+		w.n = 0x00
+		return n, w.err
+
+	}
+
+	w.n -= len(p)
+	return len(p), nil
+
+}
+
+/*
+
+tests
+
+*/
+
+func TestWriter(t *testing.T) {
+
+	var out bytes.Buffer
+
+	// the smallest window is used so that most writes flush it
+	w := NewWriterSize(&out, 0)
+	ref := NewBuffer(make([]byte, 256))
+	ref.SetCursorMode(UnifiedCursor)
+
+	for i := 0; i < 8; i++ {
+
+		for _, b := range []interface {
+			PutU32LENext(uint32)
+			WriteI24BENext([]int32)
+			SetBitsNext(uint64, int64)
+			SetBitNext()
+			PutU16BEBitsNext(uint16)
+			WriteBytesNext([]byte)
+			WriteF64LENext([]float64)
+		}{w, ref} {
+
+			b.PutU32LENext(0xdeadbeef + uint32(i))
+			b.WriteI24BENext([]int32{-1, int32(i)})
+			b.SetBitsNext(0x15, 5)
+			b.SetBitNext()
+			b.PutU16BEBitsNext(0xabcd)
+			// byte writes start at the next byte boundary
+			b.WriteBytesNext([]byte{0x01, byte(i)})
+			b.WriteF64LENext([]float64{1.5})
+
+		}
+
+		if w.ByteOffset() != ref.ByteOffset() || w.BitOffset() != ref.BitOffset() {
+
+			t.Fatalf("iteration %d: incorrect offsets (got %d and %d, expected %d and %d)", i, w.ByteOffset(), w.BitOffset(), ref.ByteOffset(), ref.BitOffset())
+
+		}
+
+	}
+
+	if err := w.Close(); err != nil {
+
+		t.Fatalf("unexpected error: %v", err)
+
+	}
+
+	if expected := ref.Bytes()[:ref.ByteOffset()]; !cmp.Equal(expected, out.Bytes()) {
+
+		t.Fatalf("expected byte array does not match the one gotten (got %#v, expected %#v)", out.Bytes(), expected)
+
+	}
+
+}
+
+func TestWriterPartialByte(t *testing.T) {
+
+	var out bytes.Buffer
+
+	w := NewWriter(&out)
+	w.WriteByteNext(0xff)
+	w.SetBitsNext(0x5, 3)
+
+	// the partially written byte is kept back by Flush
+	if err := w.Flush(); err != nil || !cmp.Equal([]byte{0xff}, out.Bytes()) || w.Buffered() != 1 {
+
+		t.Fatalf("unexpected result (got %#v with %d bytes buffered and %v)", out.Bytes(), w.Buffered(), err)
+
+	}
+
+	w.SetBitsNext(0x1f, 5)
+	w.SetBitsNext(0x1, 2)
+	w.ClearBitNext()
+	if err := w.Close(); err != nil || !cmp.Equal([]byte{0xff, 0xbf, 0x40}, out.Bytes()) {
+
+		t.Fatalf("unexpected result (got %#v and %v)", out.Bytes(), err)
+
+	}
+
+	// closing twice does nothing, but writing afterwards fails
+	w.PutU16LENext(0x00)
+	if err := w.Close(); err != nil || !errors.Is(w.Err(), WriterClosedError) || out.Len() != 3 {
+
+		t.Fatalf("expected error does not match the one gotten (got %v, expected %v)", w.Err(), WriterClosedError)
+
+	}
+
+}
+
+func TestWriterLargeWrite(t *testing.T) {
+
+	var out bytes.Buffer
+
+	data := countingBytes(100)
+
+	w := NewWriterSize(&out, 16)
+	w.WriteByteNext(0xff)
+
+	// slices larger than the window bypass it
+	w.WriteBytesNext(data)
+	if out.Len() != 101 || w.Buffered() != 0x00 {
+
+		t.Fatalf("unexpected result (got %d bytes written and %d buffered)", out.Len(), w.Buffered())
+
+	}
+
+	// values larger than the window grow it
+	w.WriteU64BENext(make([]uint64, 4))
+	if err := w.Flush(); err != nil || out.Len() != 133 || w.ByteOffset() != 133 {
+
+		t.Fatalf("unexpected result (got %d bytes written at offset %d and %v)", out.Len(), w.ByteOffset(), err)
+
+	}
+
+}
+
+func TestWriterErrors(t *testing.T) {
+
+	failure := errors.New("failure")
+
+	for i, c := range []struct {
+		op       func(*Writer)
+		expected error
+	}{
+		{func(w *Writer) { w.WriteBytesNext(make([]byte, 20)) }, failure},
+		{func(w *Writer) { w.PutU64LENext(0x00); w.PutU64LENext(0x00); w.WriteByteNext(0x00) }, failure},
+		{func(w *Writer) { w.WriteI16LENext(make([]int16, 9)); w.Flush() }, failure},
+		{func(w *Writer) { w.SetBitsNext(0x00, -1) }, BufferInvalidBitCountError},
+		{func(w *Writer) { w.WriteU32BENext(make([]uint32, 4)); w.Close() }, failure},
+	} {
+
+		w := NewWriterSize(&limitedWriter{n: 10, err: failure}, 16)
+
+		c.op(w)
+		if !errors.Is(w.Err(), c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, w.Err(), c.expected)
+
+		}
+
+		// every following call is a no-op
+		w.PutU32BENext(0x00)
+		if err := w.Flush(); !errors.Is(err, c.expected) {
+
+			t.Fatalf("case %d: expected error does not match the one gotten (got %v, expected %v)", i, err, c.expected)
+
+		}
+
+	}
+
+	// a writer that accepts less than it is given without an error
+	// still fails
+	w := NewWriterSize(&limitedWriter{n: 10}, 16)
+	if n, err := w.Write(make([]byte, 16)); n != 10 || err != io.ErrShortWrite {
+
+		t.Fatalf("unexpected result (got %d bytes and %v)", n, err)
+
+	}
+
+}
+
+func TestWriterIO(t *testing.T) {
+
+	var out bytes.Buffer
+
+	data := countingBytes(5000)
+
+	w := NewWriterSize(&out, 64)
+	w.SetBitNext()
+	for off := 0; off < len(data); off += 7 {
+
+		end := off + 7
+		if end > len(data) {
+
+			end = len(data)
+
+		}
+
+		if n, err := w.Write(data[off:end]); n != end-off || err != nil {
+
+			t.Fatalf("unexpected result (got %d bytes and %v)", n, err)
+
+		}
+
+	}
+
+	// writes start at the next byte boundary
+	if err := w.Flush(); err != nil || !cmp.Equal(append([]byte{0x80}, data...), out.Bytes()) {
+
+		t.Fatalf("unexpected result (got %d bytes and %v)", out.Len(), err)
+
+	}
+
+	w.Reset(io.Discard)
+	w.PutU16LENext(0x00)
+	if w.ByteOffset() != 2 || w.Buffered() != 2 {
+
+		t.Fatalf("incorrect offset after reset (got %d)", w.ByteOffset())
+
+	}
+
+}
+
+/*
+
+benchmarks
+
+*/
+
+func BenchmarkWriterPutU32LENext(b *testing.B) {
+
+	b.ReportAllocs()
+
+	w := NewWriter(io.Discard)
+
+	for n := 0; n < b.N; n++ {
+
+		w.PutU32LENext(uint32(n))
+
+	}
+
+}